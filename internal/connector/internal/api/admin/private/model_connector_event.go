@@ -0,0 +1,28 @@
+/*
+ * Connector Service Fleet Manager Admin APIs
+ *
+ * Connector Service Fleet Manager Admin is a Rest API to manage connector clusters.
+ *
+ * API version: 0.0.3
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ConnectorEvent An operation performed on a connector or a phase change reported for its deployment
+type ConnectorEvent struct {
+	Id          string             `json:"id"`
+	ConnectorId string             `json:"connector_id"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Type        ConnectorEventType `json:"type"`
+	// The connector operation, one of create, assign, unassign, update, stop, restart or delete
+	Operation    string                `json:"operation,omitempty"`
+	DesiredState ConnectorDesiredState `json:"desired_state,omitempty"`
+	State        ConnectorState        `json:"state,omitempty"`
+	// The user that performed the operation, the id of the connector namespace whose deletion or expiry deleted or unassigned the connector, or the id of the connector cluster that reported the deployment state
+	Actor string `json:"actor,omitempty"`
+}
@@ -0,0 +1,19 @@
+/*
+ * Connector Service Fleet Manager Admin APIs
+ *
+ * Connector Service Fleet Manager Admin is a Rest API to manage connector clusters.
+ *
+ * API version: 0.0.3
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ConnectorEventType the model 'ConnectorEventType'
+type ConnectorEventType string
+
+// List of ConnectorEventType
+const (
+	CONNECTOREVENTTYPE_OPERATION         ConnectorEventType = "operation"
+	CONNECTOREVENTTYPE_DEPLOYMENT_STATUS ConnectorEventType = "deployment_status"
+)
@@ -0,0 +1,29 @@
+package dbapi
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+)
+
+type ConnectorEventType string
+
+const (
+	// ConnectorEventTypeOperation - connector operation requested by a user, e.g. stop or restart
+	ConnectorEventTypeOperation ConnectorEventType = "operation"
+	// ConnectorEventTypeDeploymentStatus - connector deployment phase change reported by the agent
+	ConnectorEventTypeDeploymentStatus ConnectorEventType = "deployment_status"
+)
+
+// ConnectorEvent records an operation performed on a connector or a phase change of its deployment
+type ConnectorEvent struct {
+	db.Model
+	ConnectorID string `gorm:"not null;index"`
+	Type        ConnectorEventType
+	// connector operation, set for operation events
+	Operation    string
+	DesiredState ConnectorDesiredState
+	Phase        ConnectorStatusPhase
+	// user name for operation events, or connector cluster id for deployment status events
+	Actor string
+}
+
+type ConnectorEventList []*ConnectorEvent
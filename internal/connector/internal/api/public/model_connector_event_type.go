@@ -0,0 +1,20 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorEventType the model 'ConnectorEventType'
+type ConnectorEventType string
+
+// List of ConnectorEventType
+const (
+	CONNECTOREVENTTYPE_OPERATION         ConnectorEventType = "operation"
+	CONNECTOREVENTTYPE_DEPLOYMENT_STATUS ConnectorEventType = "deployment_status"
+)
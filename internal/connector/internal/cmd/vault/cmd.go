@@ -14,6 +14,8 @@ func NewVaultCommand(env *environments.Env) *cobra.Command {
 
 	// add sub-commands
 	cmd.AddCommand(NewListCommand(env))
+	cmd.AddCommand(NewReEncryptCommand(env))
+	cmd.AddCommand(NewMigrateCommand(env))
 
 	return cmd
 }
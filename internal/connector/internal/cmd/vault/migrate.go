@@ -0,0 +1,254 @@
+package vault
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/flags"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagTargetKind   = "target-kind"
+	FlagDryRun       = "dry-run"
+	FlagDeleteSource = "delete-source"
+	FlagProgressFile = "progress-file"
+)
+
+func NewMigrateCommand(env *environments.Env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the vault secrets to another vault kind",
+		Long: "Copy the secrets of the configured vault to a vault of the target kind, preserving their owning resource. " +
+			"The target vault is configured with the same vault flags as the configured vault. " +
+			"The tmp vault only holds its secrets in memory and can be neither the configured nor the target vault. " +
+			"Copied secrets are verified by reading them back from the target vault, and recorded in the progress file so that an interrupted migration can be resumed. " +
+			"Run the migration again after switching the fleet manager to the target vault to copy the secrets created in the meantime.",
+
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			err := env.CreateServices()
+			if err != nil {
+				glog.Fatalf("Unable to initialize environment: %s", err.Error())
+			}
+		},
+
+		Run: func(cmd *cobra.Command, args []string) {
+			options := migrateOptions{
+				targetKind:   flags.MustGetDefinedString(FlagTargetKind, cmd.Flags()),
+				dryRun:       flags.MustGetBool(FlagDryRun, cmd.Flags()),
+				deleteSource: flags.MustGetBool(FlagDeleteSource, cmd.Flags()),
+				progressFile: flags.MustGetString(FlagProgressFile, cmd.Flags()),
+			}
+			env.MustInvoke(func(vaultConfig *vault.Config, vaultService vault.VaultService, connectionFactory *db.ConnectionFactory) {
+				runMigrate(vaultConfig, vaultService, connectionFactory, options)
+			})
+		},
+	}
+	cmd.Flags().String(FlagTargetKind, "", "The kind of vault to migrate the secrets to: aws|database|hashicorp")
+	cmd.Flags().Bool(FlagDryRun, false, "List the secrets that would be migrated without copying them")
+	cmd.Flags().Bool(FlagDeleteSource, false, "Delete the secrets from the configured vault once they have been copied and verified")
+	cmd.Flags().String(FlagProgressFile, "vault-migration-progress", "File recording the migrated secrets, used to resume an interrupted migration")
+	return cmd
+}
+
+type migrateOptions struct {
+	targetKind   string
+	dryRun       bool
+	deleteSource bool
+	progressFile string
+}
+
+type migrateResult struct {
+	migrated int
+	skipped  int
+	failed   int
+}
+
+func runMigrate(vaultConfig *vault.Config, source vault.VaultService, connectionFactory *db.ConnectionFactory, options migrateOptions) {
+	if err := validateMigrateKinds(source.Kind(), options.targetKind); err != nil {
+		glog.Fatalf("Invalid vault migration: %s", err.Error())
+	}
+
+	targetConfig := *vaultConfig
+	targetConfig.Kind = options.targetKind
+	if err := targetConfig.ReadFiles(); err != nil {
+		glog.Fatalf("Unable to read the %s vault configuration: %s", options.targetKind, err.Error())
+	}
+	if err := targetConfig.Validate(nil); err != nil {
+		glog.Fatalf("Invalid %s vault configuration: %s", options.targetKind, err.Error())
+	}
+	target, err := vault.NewVaultService(&targetConfig, connectionFactory)
+	if err != nil {
+		glog.Fatalf("Unable to create the %s vault: %s", options.targetKind, err.Error())
+	}
+
+	progress, err := openMigrateProgress(options.progressFile, options.dryRun)
+	if err != nil {
+		glog.Fatalf("Unable to open the progress file: %s", err.Error())
+	}
+	defer progress.close()
+
+	result, err := migrateSecrets(source, target, progress, options, os.Stdout)
+	fmt.Printf("migrated: %d, skipped: %d, failed: %d\n", result.migrated, result.skipped, result.failed)
+	if err != nil {
+		glog.Fatalf("Unable to migrate secrets: %s", err.Error())
+	}
+	if result.failed > 0 {
+		glog.Fatalf("%d secrets could not be migrated, run the migration again to retry them", result.failed)
+	}
+}
+
+// validateMigrateKinds checks that the secrets can be migrated from a vault of the source kind to a vault of the target
+// kind. The tmp vault keeps its secrets in the memory of the process using it: the migrate command would start from an
+// empty vault, and the secrets copied to it would be lost when the command exits.
+func validateMigrateKinds(sourceKind string, targetKind string) error {
+	if sourceKind == vault.KindTmp {
+		return fmt.Errorf("the secrets of the %s vault are only held in the memory of the fleet manager and cannot be migrated", vault.KindTmp)
+	}
+	if targetKind == vault.KindTmp {
+		return fmt.Errorf("the secrets cannot be migrated to the %s vault as they would be lost when the migration exits", vault.KindTmp)
+	}
+	if targetKind == sourceKind {
+		return fmt.Errorf("the target vault kind must be different from the configured vault kind %s", sourceKind)
+	}
+	return nil
+}
+
+// migrateSecrets copies the secrets of the source vault that are not recorded in the progress to the target vault.
+// A secret that fails to migrate is reported and left in the source vault, without stopping the migration.
+func migrateSecrets(source vault.VaultService, target vault.VaultService, progress *migrateProgress, options migrateOptions, out io.Writer) (migrateResult, error) {
+	var result migrateResult
+
+	// secrets are collected first, so that the source vault is not modified while it is being listed
+	type secret struct {
+		name           string
+		owningResource string
+	}
+	var secrets []secret
+	if err := source.ForEachSecret(func(name string, owningResource string) bool {
+		secrets = append(secrets, secret{name: name, owningResource: owningResource})
+		return true
+	}); err != nil {
+		return result, err
+	}
+
+	for _, s := range secrets {
+		if progress.done(s.name) {
+			result.skipped++
+			continue
+		}
+		if options.dryRun {
+			_, _ = fmt.Fprintf(out, "would migrate %s (owner %q)\n", s.name, s.owningResource)
+			result.migrated++
+			continue
+		}
+
+		if err := migrateSecret(source, target, s.name, s.owningResource, options.deleteSource); err != nil {
+			_, _ = fmt.Fprintf(out, "failed to migrate %s: %s\n", s.name, err.Error())
+			result.failed++
+			continue
+		}
+		if err := progress.record(s.name); err != nil {
+			return result, err
+		}
+		_, _ = fmt.Fprintf(out, "migrated %s\n", s.name)
+		result.migrated++
+	}
+
+	return result, nil
+}
+
+func migrateSecret(source vault.VaultService, target vault.VaultService, name string, owningResource string, deleteSource bool) error {
+	value, err := source.GetSecretString(name)
+	if err != nil {
+		return fmt.Errorf("failed to read secret: %w", err)
+	}
+
+	// a secret already in the target vault has been copied by an interrupted migration without a progress record
+	existing, err := target.GetSecretString(name)
+	switch {
+	case err == nil && existing != value:
+		return fmt.Errorf("secret already exists in the %s vault with a different value", target.Kind())
+	case err != nil && !errors.Is(err, vault.NotFound):
+		return fmt.Errorf("failed to check whether the secret exists in the %s vault: %w", target.Kind(), err)
+	case err != nil:
+		if err := target.SetSecretString(name, value, owningResource); err != nil {
+			return fmt.Errorf("failed to copy secret: %w", err)
+		}
+		copied, err := target.GetSecretString(name)
+		if err != nil {
+			return fmt.Errorf("failed to verify secret: %w", err)
+		}
+		if copied != value {
+			return fmt.Errorf("failed to verify secret: copied value differs from the source value")
+		}
+	}
+
+	if deleteSource {
+		if err := source.DeleteSecretString(name); err != nil && !errors.Is(err, vault.NotFound) {
+			return fmt.Errorf("failed to delete secret from the %s vault: %w", source.Kind(), err)
+		}
+	}
+	return nil
+}
+
+// migrateProgress records the names of the migrated secrets, one per line
+type migrateProgress struct {
+	migrated map[string]bool
+	file     *os.File
+}
+
+func openMigrateProgress(path string, readOnly bool) (*migrateProgress, error) {
+	progress := &migrateProgress{migrated: map[string]bool{}}
+	if path == "" {
+		return progress, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	scanner := bufio.NewScanner(strings.NewReader(string(content)))
+	for scanner.Scan() {
+		if name := strings.TrimSpace(scanner.Text()); name != "" {
+			progress.migrated[name] = true
+		}
+	}
+
+	if !readOnly {
+		progress.file, err = os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return progress, nil
+}
+
+func (p *migrateProgress) done(name string) bool {
+	return p.migrated[name]
+}
+
+func (p *migrateProgress) record(name string) error {
+	p.migrated[name] = true
+	if p.file == nil {
+		return nil
+	}
+	if _, err := fmt.Fprintln(p.file, name); err != nil {
+		return err
+	}
+	return p.file.Sync()
+}
+
+func (p *migrateProgress) close() {
+	if p.file != nil {
+		_ = p.file.Close()
+	}
+}
@@ -0,0 +1,205 @@
+package vault
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/onsi/gomega"
+)
+
+func newMigrateVaults(g *gomega.WithT) (*vault.TmpVaultService, *vault.TmpVaultService) {
+	source, err := vault.NewTmpVaultService()
+	g.Expect(err).To(gomega.BeNil())
+	target, err := vault.NewTmpVaultService()
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(source.SetSecretString("secret-1", "value-1", "owner-1")).To(gomega.Succeed())
+	g.Expect(source.SetSecretString("secret-2", "value-2", "owner-2")).To(gomega.Succeed())
+	return source, target
+}
+
+// failingGetVaultService is a vault failing to read its secrets with another error than vault.NotFound
+type failingGetVaultService struct {
+	*vault.TmpVaultService
+}
+
+func (f failingGetVaultService) GetSecretString(name string) (string, error) {
+	return "", errors.New("vault unavailable")
+}
+
+func Test_validateMigrateKinds(t *testing.T) {
+	tests := []struct {
+		name       string
+		sourceKind string
+		targetKind string
+		wantErr    bool
+	}{
+		{
+			name:       "should accept a migration between two persistent vaults",
+			sourceKind: vault.KindAws,
+			targetKind: vault.KindDatabase,
+			wantErr:    false,
+		},
+		{
+			name:       "should reject a migration from the tmp vault",
+			sourceKind: vault.KindTmp,
+			targetKind: vault.KindDatabase,
+			wantErr:    true,
+		},
+		{
+			name:       "should reject a migration to the tmp vault",
+			sourceKind: vault.KindAws,
+			targetKind: vault.KindTmp,
+			wantErr:    true,
+		},
+		{
+			name:       "should reject a migration to the configured vault kind",
+			sourceKind: vault.KindDatabase,
+			targetKind: vault.KindDatabase,
+			wantErr:    true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			err := validateMigrateKinds(tt.sourceKind, tt.targetKind)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func Test_migrateSecret_TargetReadFailure(t *testing.T) {
+	g := gomega.NewWithT(t)
+	source, target := newMigrateVaults(g)
+
+	// the secret is neither overwritten in the target vault nor deleted from the source vault
+	err := migrateSecret(source, failingGetVaultService{target}, "secret-1", "owner-1", true)
+	g.Expect(err).ToNot(gomega.BeNil())
+	_, err = target.GetSecretString("secret-1")
+	g.Expect(errors.Is(err, vault.NotFound)).To(gomega.BeTrue())
+	value, err := source.GetSecretString("secret-1")
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(value).To(gomega.Equal("value-1"))
+}
+
+func Test_migrateSecrets(t *testing.T) {
+	tests := []struct {
+		name             string
+		options          migrateOptions
+		setupFn          func(source, target *vault.TmpVaultService)
+		progress         []string
+		wantResult       migrateResult
+		wantTargetSecret map[string]string
+		wantSourceCount  int
+	}{
+		{
+			name:             "should copy the secrets with their owning resource",
+			wantResult:       migrateResult{migrated: 2},
+			wantTargetSecret: map[string]string{"secret-1": "owner-1", "secret-2": "owner-2"},
+			wantSourceCount:  2,
+		},
+		{
+			name:             "should delete the migrated secrets from the source",
+			options:          migrateOptions{deleteSource: true},
+			wantResult:       migrateResult{migrated: 2},
+			wantTargetSecret: map[string]string{"secret-1": "owner-1", "secret-2": "owner-2"},
+			wantSourceCount:  0,
+		},
+		{
+			name:             "should not copy the secrets in dry run",
+			options:          migrateOptions{dryRun: true, deleteSource: true},
+			wantResult:       migrateResult{migrated: 2},
+			wantTargetSecret: map[string]string{},
+			wantSourceCount:  2,
+		},
+		{
+			name:             "should skip the secrets recorded in the progress",
+			progress:         []string{"secret-1"},
+			wantResult:       migrateResult{migrated: 1, skipped: 1},
+			wantTargetSecret: map[string]string{"secret-2": "owner-2"},
+			wantSourceCount:  2,
+		},
+		{
+			name:    "should keep the secrets that already exist in the target with a different value",
+			options: migrateOptions{deleteSource: true},
+			setupFn: func(source, target *vault.TmpVaultService) {
+				_ = target.SetSecretString("secret-1", "other-value", "other-owner")
+			},
+			wantResult:       migrateResult{migrated: 1, failed: 1},
+			wantTargetSecret: map[string]string{"secret-1": "other-owner", "secret-2": "owner-2"},
+			wantSourceCount:  1,
+		},
+		{
+			name:    "should accept the secrets that already exist in the target with the same value",
+			options: migrateOptions{deleteSource: true},
+			setupFn: func(source, target *vault.TmpVaultService) {
+				_ = target.SetSecretString("secret-1", "value-1", "owner-1")
+			},
+			wantResult:       migrateResult{migrated: 2},
+			wantTargetSecret: map[string]string{"secret-1": "owner-1", "secret-2": "owner-2"},
+			wantSourceCount:  0,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			source, target := newMigrateVaults(g)
+			if tt.setupFn != nil {
+				tt.setupFn(source, target)
+			}
+			progress := &migrateProgress{migrated: map[string]bool{}}
+			for _, name := range tt.progress {
+				progress.migrated[name] = true
+			}
+
+			result, err := migrateSecrets(source, target, progress, tt.options, io.Discard)
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(result).To(gomega.Equal(tt.wantResult))
+
+			targetSecrets := map[string]string{}
+			g.Expect(target.ForEachSecret(func(name string, owningResource string) bool {
+				targetSecrets[name] = owningResource
+				return true
+			})).To(gomega.Succeed())
+			g.Expect(targetSecrets).To(gomega.Equal(tt.wantTargetSecret))
+
+			sourceCount := 0
+			g.Expect(source.ForEachSecret(func(name string, owningResource string) bool {
+				sourceCount++
+				return true
+			})).To(gomega.Succeed())
+			g.Expect(sourceCount).To(gomega.Equal(tt.wantSourceCount))
+		})
+	}
+}
+
+func Test_migrateProgress(t *testing.T) {
+	g := gomega.NewWithT(t)
+	path := filepath.Join(t.TempDir(), "progress")
+
+	progress, err := openMigrateProgress(path, false)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(progress.record("secret-1")).To(gomega.Succeed())
+	g.Expect(progress.record("secret-2")).To(gomega.Succeed())
+	progress.close()
+
+	// an interrupted migration resumes from the recorded secrets
+	progress, err = openMigrateProgress(path, true)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(progress.done("secret-1")).To(gomega.BeTrue())
+	g.Expect(progress.done("secret-3")).To(gomega.BeFalse())
+
+	// the progress is not written in dry run
+	g.Expect(progress.record("secret-3")).To(gomega.Succeed())
+	progress.close()
+	content, err := os.ReadFile(path)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(string(content)).To(gomega.Equal("secret-1\nsecret-2\n"))
+}
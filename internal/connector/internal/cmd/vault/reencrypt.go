@@ -0,0 +1,43 @@
+package vault
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+)
+
+func NewReEncryptCommand(env *environments.Env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "re-encrypt",
+		Short: "Re-encrypt the database vault secrets with the current key",
+		Long: "Re-encrypt the database vault secrets with the current key. " +
+			"Keys other than the current key can be removed from the vault keys file once all the secrets have been re-encrypted.",
+
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			err := env.CreateServices()
+			if err != nil {
+				glog.Fatalf("Unable to initialize environment: %s", err.Error())
+			}
+		},
+
+		Run: func(cmd *cobra.Command, args []string) {
+			env.MustInvoke(runReEncrypt)
+		},
+	}
+	return cmd
+}
+
+func runReEncrypt(vaultService vault.VaultService) {
+	databaseVaultService, ok := vaultService.(*vault.DatabaseVaultService)
+	if !ok {
+		glog.Fatalf("Secrets can only be re-encrypted in the %s vault, the configured vault is %s", vault.KindDatabase, vaultService.Kind())
+	}
+	count, err := databaseVaultService.ReEncryptSecrets()
+	fmt.Printf("re-encrypted %d secrets\n", count)
+	if err != nil {
+		glog.Fatalf("Unable to re-encrypt secrets: %s", err.Error())
+	}
+}
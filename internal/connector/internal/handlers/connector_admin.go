@@ -10,6 +10,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/admin/private"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/authz"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/phase"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/workers"
 	"gorm.io/gorm"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/presenters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 	"github.com/goava/di"
 
@@ -33,6 +35,7 @@ type ConnectorAdminHandler struct {
 	Service               services.ConnectorClusterService
 	ConnectorsService     services.ConnectorsService
 	NamespaceService      services.ConnectorNamespaceService
+	EventsService         services.ConnectorEventsService
 	QuotaConfig           *config.ConnectorsQuotaConfig
 	ConnectorCluster      *ConnectorClusterHandler //TODO: eventually move deployment handling into a deployment service
 	ConnectorTypesService services.ConnectorTypesService
@@ -403,6 +406,40 @@ func (h *ConnectorAdminHandler) GetConnector(writer http.ResponseWriter, request
 	handlers.HandleGet(writer, request, &cfg)
 }
 
+// GetConnectorEvents lists the events of a connector, including connectors that have been deleted
+func (h *ConnectorAdminHandler) GetConnectorEvents(writer http.ResponseWriter, request *http.Request) {
+	connectorId := mux.Vars(request)["connector_id"]
+	listArgs := coreservices.NewListArguments(request.URL.Query())
+	cfg := handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+
+			events, paging, err := h.EventsService.List(request.Context(), connectorId, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			result := private.ConnectorEventList{
+				Kind:  "ConnectorEventList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+			}
+
+			result.Items = make([]private.ConnectorEvent, len(events))
+			for i, event := range events {
+				result.Items[i] = presenters.PresentPrivateConnectorEvent(event)
+			}
+
+			return result, nil
+		},
+	}
+
+	handlers.HandleGet(writer, request, &cfg)
+}
+
 func (h *ConnectorAdminHandler) PatchConnector(writer http.ResponseWriter, request *http.Request) {
 	body, err := io.ReadAll(request.Body)
 	if err != nil {
@@ -422,6 +459,7 @@ func (h *ConnectorAdminHandler) PatchConnector(writer http.ResponseWriter, reque
 		connectorsService:     h.ConnectorsService,
 		connectorTypesService: h.ConnectorTypesService,
 		namespaceService:      h.NamespaceService,
+		eventsService:         h.EventsService,
 		authZService:          h.AuthZService,
 		connectorsConfig:      h.ConnectorsConfig,
 	}.Patch(writer, request)
@@ -438,9 +476,16 @@ func (h *ConnectorAdminHandler) DeleteConnector(writer http.ResponseWriter, requ
 			// check force flag to force deletion of connector and deployments
 			if parseBoolParam(request.URL.Query().Get("force")) {
 				serviceError = h.ConnectorsService.ForceDelete(request.Context(), connectorId)
+				if serviceError == nil {
+					h.EventsService.RecordOperation(request.Context(), &dbapi.Connector{
+						Model:        db.Model{ID: connectorId},
+						DesiredState: dbapi.ConnectorDeleted,
+						Status:       dbapi.ConnectorStatus{Phase: dbapi.ConnectorStatusPhaseDeleted},
+					}, phase.DeleteConnector)
+				}
 			} else {
 				ctx := request.Context()
-				return nil, HandleConnectorDelete(ctx, h.ConnectorsService, h.NamespaceService, connectorId)
+				return nil, HandleConnectorDelete(ctx, h.ConnectorsService, h.NamespaceService, h.EventsService, connectorId)
 			}
 			return nil, serviceError
 		},
@@ -42,6 +42,7 @@ type ConnectorsHandler struct {
 	connectorsService     services.ConnectorsService
 	connectorTypesService services.ConnectorTypesService
 	namespaceService      services.ConnectorNamespaceService
+	eventsService         services.ConnectorEventsService
 	vaultService          vault.VaultService
 	authZService          authz.AuthZService
 	connectorsConfig      *config.ConnectorsConfig
@@ -55,12 +56,13 @@ var stateToOperationsMap = map[public.ConnectorDesiredState]phase.ConnectorOpera
 }
 
 func NewConnectorsHandler(connectorsService services.ConnectorsService, connectorTypesService services.ConnectorTypesService,
-	namespaceService services.ConnectorNamespaceService, vaultService vault.VaultService, authZService authz.AuthZService,
-	connectorsConfig *config.ConnectorsConfig) *ConnectorsHandler {
+	namespaceService services.ConnectorNamespaceService, eventsService services.ConnectorEventsService, vaultService vault.VaultService,
+	authZService authz.AuthZService, connectorsConfig *config.ConnectorsConfig) *ConnectorsHandler {
 	return &ConnectorsHandler{
 		connectorsService:     connectorsService,
 		connectorTypesService: connectorTypesService,
 		namespaceService:      namespaceService,
+		eventsService:         eventsService,
 		vaultService:          vaultService,
 		authZService:          authZService,
 		connectorsConfig:      connectorsConfig,
@@ -130,6 +132,7 @@ func (h ConnectorsHandler) Create(w http.ResponseWriter, r *http.Request) {
 			if svcErr := h.connectorsService.Create(r.Context(), convResource); svcErr != nil {
 				return nil, svcErr
 			}
+			h.eventsService.RecordOperation(r.Context(), convResource, phase.CreateConnector)
 
 			if err := stripSecretReferences(convResource, ct); err != nil {
 				return nil, err
@@ -296,6 +299,7 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			if serr != nil {
 				return nil, serr
 			}
+			h.eventsService.RecordOperation(r.Context(), p, operation)
 
 			newSecrets, err := getSecretRefs(p, ct)
 			if err != nil {
@@ -491,14 +495,14 @@ func (h ConnectorsHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		Action: func() (interface{}, *errors.ServiceError) {
 
 			ctx := r.Context()
-			return nil, HandleConnectorDelete(ctx, h.connectorsService, h.namespaceService, connectorId)
+			return nil, HandleConnectorDelete(ctx, h.connectorsService, h.namespaceService, h.eventsService, connectorId)
 		},
 	}
 	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
 }
 
 func HandleConnectorDelete(ctx context.Context, connectorsService services.ConnectorsService,
-	namespaceService services.ConnectorNamespaceService, connectorId string) *errors.ServiceError {
+	namespaceService services.ConnectorNamespaceService, eventsService services.ConnectorEventsService, connectorId string) *errors.ServiceError {
 
 	c, err := connectorsService.Get(ctx, connectorId)
 	if err != nil {
@@ -524,9 +528,50 @@ func HandleConnectorDelete(ctx context.Context, connectorsService services.Conne
 			err = connectorsService.Update(ctx, &c.Connector)
 		}
 	}
+	if err == nil {
+		eventsService.RecordOperation(ctx, &c.Connector, phase.DeleteConnector)
+	}
 	return err
 }
 
+// ListEvents is the handler for listing the operations and deployment phase changes of a connector
+func (h ConnectorsHandler) ListEvents(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			// check that the connector is visible to the user
+			if _, err := h.connectorsService.Get(ctx, connectorId); err != nil {
+				return nil, err
+			}
+
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			events, paging, err := h.eventsService.List(ctx, connectorId, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			resourceList := public.ConnectorEventList{
+				Kind:  "ConnectorEventList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+			}
+			resourceList.Items = make([]public.ConnectorEvent, len(events))
+			for i, event := range events {
+				resourceList.Items[i] = presenters.PresentConnectorEvent(event)
+			}
+
+			return resourceList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
 func (h ConnectorsHandler) List(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlers.HandlerConfig{
 		Validate: []handlers.Validate{},
@@ -15,6 +15,9 @@ const (
 	VaultServiceSuccessCount = "vault_service_success_count"
 	VaultServiceFailureCount = "vault_service_failure_count"
 	VaultServiceErrorsCount  = "vault_service_errors_count"
+
+	VaultOrphanedSecretsCount        = "vault_orphaned_secrets_count"
+	VaultOrphanedSecretsDeletedCount = "vault_orphaned_secrets_deleted_count"
 )
 
 var VaultServiceMetricsLabels = []string{
@@ -81,6 +84,32 @@ func IncreaseVaultServiceErrorsCount(operationType string) {
 
 // #### Metrics for Vault Service - End ####
 
+// #### Metrics for Vault orphaned secrets ####
+
+var vaultOrphanedSecretsCountMetric = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Subsystem: CosFleetManager,
+		Name:      VaultOrphanedSecretsCount,
+		Help:      "number of vault secrets whose owning resource no longer exists, found by the last vault scan",
+	})
+
+func UpdateVaultOrphanedSecretsCount(count int) {
+	vaultOrphanedSecretsCountMetric.Set(float64(count))
+}
+
+var vaultOrphanedSecretsDeletedCountMetric = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Subsystem: CosFleetManager,
+		Name:      VaultOrphanedSecretsDeletedCount,
+		Help:      "count of orphaned vault secrets deleted after their grace period",
+	})
+
+func IncreaseVaultOrphanedSecretsDeletedCount() {
+	vaultOrphanedSecretsDeletedCountMetric.Inc()
+}
+
+// #### Metrics for Vault orphaned secrets - End ####
+
 // register the metric(s)
 func init() {
 	// metrics for vault service
@@ -88,6 +117,10 @@ func init() {
 	prometheus.MustRegister(vaultServiceSuccessCountMetric)
 	prometheus.MustRegister(vaultServiceFailureCountMetric)
 	prometheus.MustRegister(vaultServiceErrorsCountMetric)
+
+	// metrics for vault orphaned secrets
+	prometheus.MustRegister(vaultOrphanedSecretsCountMetric)
+	prometheus.MustRegister(vaultOrphanedSecretsDeletedCountMetric)
 }
 
 // ResetMetricsForVaultService will reset the metrics related to Vault Service requests
@@ -99,7 +132,14 @@ func ResetMetricsForVaultService() {
 	vaultServiceErrorsCountMetric.Reset()
 }
 
+// ResetMetricsForVaultOrphanedSecrets will reset the metrics related to the vault orphaned secrets
+// This is needed because if current process is not the leader anymore, the metrics need to be reset otherwise staled data will be scraped
+func ResetMetricsForVaultOrphanedSecrets() {
+	vaultOrphanedSecretsCountMetric.Set(0)
+}
+
 // Reset the metrics we have defined. It is mainly used for testing.
 func Reset() {
 	ResetMetricsForVaultService()
+	ResetMetricsForVaultOrphanedSecrets()
 }
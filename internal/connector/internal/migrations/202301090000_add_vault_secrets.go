@@ -0,0 +1,31 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addVaultSecrets(migrationID string) *gormigrate.Migration {
+
+	type VaultSecret struct {
+		Name           string `gorm:"primaryKey"`
+		OwningResource string `gorm:"index"`
+		KeyID          string `gorm:"index;not null"`
+		EncryptedKey   []byte `gorm:"not null"`
+		EncryptedValue []byte `gorm:"not null"`
+		CreatedAt      time.Time
+		UpdatedAt      time.Time
+	}
+
+	return db.CreateMigrationFromActions(migrationID,
+		// encrypted connector secrets of the database vault
+		db.CreateTableAction(&VaultSecret{}),
+	)
+}
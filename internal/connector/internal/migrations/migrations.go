@@ -49,6 +49,9 @@ var migrations = []*gormigrate.Migration{
 	addConnectorResourceAnnotations("202211070000"),
 	renameNamespaceProfileAnnotations("202211280000"),
 	addOrgIDAnnotations("202212050000"),
+	addVaultSecrets("202301090000"),
+	addConnectorOrphanedSecretLease("202301100000"),
+	addConnectorEvents("202301110000"),
 }
 
 func New(dbConfig *db.DatabaseConfig) (*db.Migration, func(), error) {
@@ -0,0 +1,33 @@
+package presenters
+
+import (
+	admin "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+)
+
+func PresentConnectorEvent(from *dbapi.ConnectorEvent) public.ConnectorEvent {
+	return public.ConnectorEvent{
+		Id:           from.ID,
+		ConnectorId:  from.ConnectorID,
+		CreatedAt:    from.CreatedAt,
+		Type:         public.ConnectorEventType(from.Type),
+		Operation:    from.Operation,
+		DesiredState: public.ConnectorDesiredState(from.DesiredState),
+		State:        public.ConnectorState(from.Phase),
+		Actor:        from.Actor,
+	}
+}
+
+func PresentPrivateConnectorEvent(from *dbapi.ConnectorEvent) admin.ConnectorEvent {
+	return admin.ConnectorEvent{
+		Id:           from.ID,
+		ConnectorId:  from.ConnectorID,
+		CreatedAt:    from.CreatedAt,
+		Type:         admin.ConnectorEventType(from.Type),
+		Operation:    from.Operation,
+		DesiredState: admin.ConnectorDesiredState(from.DesiredState),
+		State:        admin.ConnectorState(from.Phase),
+		Actor:        from.Actor,
+	}
+}
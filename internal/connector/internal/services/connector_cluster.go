@@ -61,11 +61,13 @@ type connectorClusterService struct {
 	keycloakService           sso.KafkaKeycloakService
 	connectorsService         ConnectorsService
 	connectorNamespaceService ConnectorNamespaceService
+	connectorEventsService    ConnectorEventsService
 }
 
 func NewConnectorClusterService(connectionFactory *db.ConnectionFactory, bus signalbus.SignalBus, vaultService vault.VaultService,
 	connectorTypesService ConnectorTypesService, connectorsService ConnectorsService,
-	keycloakService sso.KafkaKeycloakService, connectorNamespaceService ConnectorNamespaceService) *connectorClusterService {
+	keycloakService sso.KafkaKeycloakService, connectorNamespaceService ConnectorNamespaceService,
+	connectorEventsService ConnectorEventsService) *connectorClusterService {
 	return &connectorClusterService{
 		connectionFactory:         connectionFactory,
 		bus:                       bus,
@@ -74,6 +76,7 @@ func NewConnectorClusterService(connectionFactory *db.ConnectionFactory, bus sig
 		connectorsService:         connectorsService,
 		keycloakService:           keycloakService,
 		connectorNamespaceService: connectorNamespaceService,
+		connectorEventsService:    connectorEventsService,
 	}
 }
 
@@ -496,7 +499,7 @@ func (k *connectorClusterService) UpdateConnectorDeploymentStatus(ctx context.Co
 
 	// lets get the connector id of the deployment..
 	deployment := dbapi.ConnectorDeployment{}
-	if err := dbConn.Unscoped().Select("connector_id", "deleted_at").
+	if err := dbConn.Unscoped().Select("connector_id", "cluster_id", "deleted_at").
 		Where("id = ?", deploymentStatus.ID).
 		First(&deployment).Error; err != nil {
 		return services.HandleGetError("Connector deployment", "id", deploymentStatus.ID, err)
@@ -523,6 +526,16 @@ func (k *connectorClusterService) UpdateConnectorDeploymentStatus(ctx context.Co
 		return services.HandleGetError("Connector", "id", deployment.ConnectorID, err)
 	}
 
+	if connectorStatus.Phase != deploymentStatus.Phase {
+		k.connectorEventsService.Record(ctx, &dbapi.ConnectorEvent{
+			ConnectorID:  deployment.ConnectorID,
+			Type:         dbapi.ConnectorEventTypeDeploymentStatus,
+			DesiredState: connector.DesiredState,
+			Phase:        deploymentStatus.Phase,
+			Actor:        deployment.ClusterID,
+		})
+	}
+
 	connectorStatus.Phase = deploymentStatus.Phase
 	if deploymentStatus.Phase == dbapi.ConnectorStatusPhaseDeleted {
 		// we don't need the deployment anymore...
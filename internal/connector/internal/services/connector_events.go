@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/phase"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/queryparser"
+)
+
+// ConnectorEventsService records the history of connector operations and deployment phase changes.
+// Recording events is best effort: a failure to record an event is logged and does not fail the recorded operation.
+type ConnectorEventsService interface {
+	Record(ctx context.Context, event *dbapi.ConnectorEvent)
+	RecordOperation(ctx context.Context, connector *dbapi.Connector, operation phase.ConnectorOperation)
+	List(ctx context.Context, connectorID string, listArgs *services.ListArguments) (dbapi.ConnectorEventList, *api.PagingMeta, *errors.ServiceError)
+}
+
+var _ ConnectorEventsService = &connectorEventsService{}
+
+type connectorEventsService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewConnectorEventsService(connectionFactory *db.ConnectionFactory) *connectorEventsService {
+	return &connectorEventsService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func GetValidConnectorEventColumns() []string {
+	return []string{"id", "created_at", "type", "operation", "desired_state", "phase", "actor"}
+}
+
+// Record saves a connector event
+func (k *connectorEventsService) Record(ctx context.Context, event *dbapi.ConnectorEvent) {
+	if event.ID == "" {
+		event.ID = api.NewID()
+	}
+	dbConn := k.connectionFactory.New()
+	if err := dbConn.Create(event).Error; err != nil {
+		logger.Logger.Errorf("failed to record %s event for connector %s: %v", event.Type, event.ConnectorID, err)
+	}
+}
+
+// RecordOperation saves an event for an operation performed on a connector by the user in the context
+func (k *connectorEventsService) RecordOperation(ctx context.Context, connector *dbapi.Connector, operation phase.ConnectorOperation) {
+	// an unknown user is recorded as an empty actor rather than failing the operation
+	actor := ""
+	if claims, err := auth.GetClaimsFromContext(ctx); err == nil {
+		actor, _ = claims.GetUsername()
+	}
+
+	k.Record(ctx, &dbapi.ConnectorEvent{
+		ConnectorID:  connector.ID,
+		Type:         dbapi.ConnectorEventTypeOperation,
+		Operation:    string(operation),
+		DesiredState: connector.DesiredState,
+		Phase:        connector.Status.Phase,
+		Actor:        actor,
+	})
+}
+
+// List returns the events of a connector within the requested paging window, most recent first by default.
+// Callers are responsible for checking that the connector is visible to the user.
+func (k *connectorEventsService) List(ctx context.Context, connectorID string, listArgs *services.ListArguments) (dbapi.ConnectorEventList, *api.PagingMeta, *errors.ServiceError) {
+	if err := listArgs.Validate(GetValidConnectorEventColumns()); err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "Unable to list connector events: %s", err.Error())
+	}
+
+	dbConn := k.connectionFactory.New()
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	dbConn = dbConn.Model(&dbapi.ConnectorEvent{}).Where("connector_id = ?", connectorID)
+
+	// Apply search query
+	if len(listArgs.Search) > 0 {
+		queryParser := queryparser.NewQueryParser(GetValidConnectorEventColumns()...)
+		searchDbQuery, err := queryParser.Parse(listArgs.Search)
+		if err != nil {
+			return nil, pagingMeta, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "Unable to list connector events: %s", err.Error())
+		}
+		dbConn = dbConn.Where(searchDbQuery.Query, searchDbQuery.Values...)
+	}
+
+	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
+	total := int64(pagingMeta.Total)
+	dbConn.Count(&total)
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
+
+	// Set the order by arguments if any
+	if len(listArgs.OrderBy) == 0 {
+		dbConn = dbConn.Order("created_at DESC")
+	} else {
+		for _, orderByArg := range listArgs.OrderBy {
+			dbConn = dbConn.Order(orderByArg)
+		}
+	}
+
+	var events dbapi.ConnectorEventList
+	if err := dbConn.Find(&events).Error; err != nil {
+		return events, pagingMeta, errors.GeneralError("unable to list connector events: %s", err)
+	}
+
+	return events, pagingMeta, nil
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/phase"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func TestConnectorEventsService_RecordOperation(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := auth.SetTokenInContext(context.TODO(), &jwt.Token{
+		Claims: jwt.MapClaims{
+			"username": "test-user",
+		},
+	})
+
+	var values []interface{}
+	insert := mocket.Catcher.Reset().NewMock().WithQuery(`INSERT INTO "connector_events"`).
+		WithCallback(func(_ string, args []driver.NamedValue) {
+			for _, arg := range args {
+				values = append(values, arg.Value)
+			}
+		})
+
+	k := NewConnectorEventsService(db.NewMockConnectionFactory(nil))
+	connector := &dbapi.Connector{
+		Model:        db.Model{ID: "connector-1"},
+		DesiredState: dbapi.ConnectorStopped,
+		Status:       dbapi.ConnectorStatus{Phase: dbapi.ConnectorStatusPhaseAssigned},
+	}
+	k.RecordOperation(ctx, connector, phase.StopConnector)
+	g.Expect(insert.Triggered).To(gomega.BeTrue())
+	g.Expect(values).To(gomega.ContainElements("connector-1", "operation", "stop", "stopped", "assigned", "test-user"))
+}
+
+func TestConnectorEventsService_RecordOperation_Failure(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	// failing to record an event must not panic nor fail the recorded operation
+	insert := mocket.Catcher.Reset().NewMock().WithQuery(`INSERT INTO "connector_events"`).WithQueryException().WithExecException()
+
+	k := NewConnectorEventsService(db.NewMockConnectionFactory(nil))
+	g.Expect(func() {
+		k.RecordOperation(context.TODO(), &dbapi.Connector{Model: db.Model{ID: "connector-1"}}, phase.DeleteConnector)
+	}).ToNot(gomega.Panic())
+	g.Expect(insert.Triggered).To(gomega.BeTrue())
+}
+
+func TestConnectorEventsService_List(t *testing.T) {
+	tests := []struct {
+		name      string
+		listArgs  *services.ListArguments
+		setupFn   func()
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name:     "should list the connector events",
+			listArgs: &services.ListArguments{Page: 1, Size: 10},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1) FROM "connector_events" WHERE (connector_id = $1)`).
+					WithReply([]map[string]interface{}{{"count": 2}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connector_events" WHERE (connector_id = $1)`).
+					WithReply([]map[string]interface{}{
+						{"id": "event-2", "connector_id": "connector-1", "type": "deployment_status", "phase": "ready", "actor": "cluster-1"},
+						{"id": "event-1", "connector_id": "connector-1", "type": "operation", "operation": "create", "actor": "test-user"},
+					})
+			},
+			wantCount: 2,
+		},
+		{
+			name:     "should return an error for an invalid order by column",
+			listArgs: &services.ListArguments{Page: 1, Size: 10, OrderBy: []string{"connector_id"}},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: true,
+		},
+		{
+			name:     "should return an error for an invalid search",
+			listArgs: &services.ListArguments{Page: 1, Size: 10, Search: "connector_id = other"},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			k := NewConnectorEventsService(db.NewMockConnectionFactory(nil))
+			events, _, err := k.List(context.TODO(), "connector-1", tt.listArgs)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(events).To(gomega.HaveLen(tt.wantCount))
+		})
+	}
+}
@@ -498,24 +498,30 @@ func (k *connectorNamespaceService) deleteNamespaceConnectors(ctx context.Contex
 	}
 
 	// get all connectors that are currently not being deleted or unassigned
-	var connectorIds []string
-	if err := dbConn.Model(&dbapi.Connector{}).Select("id").
+	var connectors []dbapi.Connector
+	if err := dbConn.Model(&dbapi.Connector{}).Select("id", "namespace_id").
 		Where("namespace_id IN ? AND desired_state NOT IN ?",
 			namespaceIds, []string{string(dbapi.ConnectorDeleted), string(dbapi.ConnectorUnassigned)}).
-		Find(&connectorIds).Error; err != nil {
+		Find(&connectors).Error; err != nil {
 		return count, services.HandleGetError("Connector", "namespace_id", namespaces, err)
 	}
 
 	// no connectors
-	if len(connectorIds) == 0 {
+	if len(connectors) == 0 {
 		return count, nil
 	}
+	connectorIds := make([]string, len(connectors))
+	for i, c := range connectors {
+		connectorIds[i] = c.ID
+	}
 
 	// set connectors' desired state to 'deleted' by default
 	connectorDesiredState := dbapi.ConnectorDeleted
+	operation := phase.DeleteConnector
 	if k.connectorsConfig.ConnectorEnableUnassignedConnectors {
 		// set connectors' state to 'unassigned' if it's supported, i.e. cascade delete is disabled
 		connectorDesiredState = dbapi.ConnectorUnassigned
+		operation = phase.UnassignConnector
 	}
 	// set connector desired state to connectorDesiredState and status to "deleting" to remove from namespaces
 	if err := dbConn.Where("deleted_at IS NULL AND id IN ?", connectorIds).
@@ -528,6 +534,27 @@ func (k *connectorNamespaceService) deleteNamespaceConnectors(ctx context.Contex
 		return count, services.HandleUpdateError("Connector", err)
 	}
 
+	// record the operation on each connector in the same transaction, the namespace being the actor
+	events := make([]dbapi.ConnectorEvent, len(connectors))
+	for i, c := range connectors {
+		var namespaceId string
+		if c.NamespaceId != nil {
+			namespaceId = *c.NamespaceId
+		}
+		events[i] = dbapi.ConnectorEvent{
+			Model:        db.Model{ID: api.NewID()},
+			ConnectorID:  c.ID,
+			Type:         dbapi.ConnectorEventTypeOperation,
+			Operation:    string(operation),
+			DesiredState: connectorDesiredState,
+			Phase:        dbapi.ConnectorStatusPhaseDeleting,
+			Actor:        namespaceId,
+		}
+	}
+	if err := dbConn.Create(&events).Error; err != nil {
+		return count, errors.GeneralError("failed to record events of connectors in namespaces %v: %v", namespaceIds, err)
+	}
+
 	// notify connector status update
 	_ = db.AddPostCommitAction(ctx, func() {
 		k.bus.Notify("reconcile:connector")
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func TestConnectorNamespaceService_deleteNamespaceConnectors(t *testing.T) {
+	tests := []struct {
+		name                string
+		enableUnassigned    bool
+		wantOperation       string
+		wantDesiredState    string
+		insertEventsFailure bool
+		wantErr             bool
+	}{
+		{
+			name:             "should record a delete event for each connector of the namespaces",
+			wantOperation:    "delete",
+			wantDesiredState: "deleted",
+		},
+		{
+			name:             "should record an unassign event for each connector of the namespaces when unassigned connectors are enabled",
+			enableUnassigned: true,
+			wantOperation:    "unassign",
+			wantDesiredState: "unassigned",
+		},
+		{
+			name:                "should return an error if the events cannot be recorded",
+			insertEventsFailure: true,
+			wantErr:             true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`SELECT "id","cluster_id" FROM "connector_namespaces"`).
+				WithReply([]map[string]interface{}{{"id": "namespace-1", "cluster_id": "cluster-1"}})
+			mocket.Catcher.NewMock().WithQuery(`SELECT "id","namespace_id" FROM "connectors"`).
+				WithReply([]map[string]interface{}{
+					{"id": "connector-1", "namespace_id": "namespace-1"},
+					{"id": "connector-2", "namespace_id": "namespace-1"},
+				})
+			var values []interface{}
+			insertEvents := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "connector_events"`).
+				WithCallback(func(_ string, args []driver.NamedValue) {
+					for _, arg := range args {
+						values = append(values, arg.Value)
+					}
+				})
+			if tt.insertEventsFailure {
+				insertEvents.WithQueryException().WithExecException()
+			}
+
+			connectionFactory := db.NewMockConnectionFactory(nil)
+			k := NewConnectorNamespaceService(connectionFactory,
+				&config.ConnectorsConfig{ConnectorEnableUnassignedConnectors: tt.enableUnassigned},
+				&config.ConnectorsQuotaConfig{}, signalbus.NewSignalBus())
+			count, err := k.deleteNamespaceConnectors(context.TODO(), connectionFactory.New(), "id IN ?", []string{"namespace-1"})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(count).To(gomega.Equal(int64(1)))
+			g.Expect(insertEvents.Triggered).To(gomega.BeTrue())
+			if !tt.wantErr {
+				g.Expect(values).To(gomega.ContainElements("connector-1", "connector-2", "operation",
+					tt.wantOperation, tt.wantDesiredState, "deleting", "namespace-1"))
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
@@ -18,32 +19,95 @@ type Config struct {
 	SecretPrefix        string `json:"secret_prefix"`
 	SecretPrefixEnable  bool   `json:"secret_prefix_enable"`
 	Region              string `json:"region"`
+	// HashiCorp Vault KV v2 secrets engine
+	HashicorpAddress             string `json:"hashicorp_address"`
+	HashicorpNamespace           string `json:"hashicorp_namespace"`
+	HashicorpMountPath           string `json:"hashicorp_mount_path"`
+	HashicorpAuthMethod          string `json:"hashicorp_auth_method"`
+	HashicorpToken               string `json:"hashicorp_token"`
+	HashicorpTokenFile           string `json:"hashicorp_token_file"`
+	HashicorpAppRoleMountPath    string `json:"hashicorp_approle_mount_path"`
+	HashicorpAppRoleRoleID       string `json:"hashicorp_approle_role_id"`
+	HashicorpAppRoleRoleIDFile   string `json:"hashicorp_approle_role_id_file"`
+	HashicorpAppRoleSecretID     string `json:"hashicorp_approle_secret_id"`
+	HashicorpAppRoleSecretIDFile string `json:"hashicorp_approle_secret_id_file"`
+	// Encrypted secrets in the fleet manager database
+	DatabaseKeys     string `json:"database_keys"`
+	DatabaseKeysFile string `json:"database_keys_file"`
+	DatabaseKeyID    string `json:"database_key_id"`
+	// Garbage collection of the secrets whose owning resource no longer exists
+	OrphanedSecretsGCEnabled     bool          `json:"orphaned_secrets_gc_enabled"`
+	OrphanedSecretsGCInterval    time.Duration `json:"orphaned_secrets_gc_interval"`
+	OrphanedSecretsGCGracePeriod time.Duration `json:"orphaned_secrets_gc_grace_period"`
 }
 
 func NewConfig() *Config {
 	return &Config{
-		Kind:                KindTmp,
-		AccessKeyFile:       "secrets/vault/aws_access_key_id",
-		SecretAccessKeyFile: "secrets/vault/aws_secret_access_key",
-		Region:              DefaultRegion,
-		SecretPrefixEnable:  false,
-		SecretPrefix:        "managed-connectors",
+		Kind:                         KindTmp,
+		AccessKeyFile:                "secrets/vault/aws_access_key_id",
+		SecretAccessKeyFile:          "secrets/vault/aws_secret_access_key",
+		Region:                       DefaultRegion,
+		SecretPrefixEnable:           false,
+		SecretPrefix:                 "managed-connectors",
+		HashicorpAddress:             "http://127.0.0.1:8200",
+		HashicorpMountPath:           "secret",
+		HashicorpAuthMethod:          HashicorpAuthToken,
+		HashicorpTokenFile:           "secrets/vault/hashicorp_token",
+		HashicorpAppRoleMountPath:    "approle",
+		HashicorpAppRoleRoleIDFile:   "secrets/vault/hashicorp_approle_role_id",
+		HashicorpAppRoleSecretIDFile: "secrets/vault/hashicorp_approle_secret_id",
+		DatabaseKeysFile:             "secrets/vault/database_keys",
+		OrphanedSecretsGCEnabled:     false,
+		OrphanedSecretsGCInterval:    1 * time.Hour,
+		OrphanedSecretsGCGracePeriod: 24 * time.Hour,
 	}
 }
 
 func (c *Config) AddFlags(fs *pflag.FlagSet) {
-	fs.StringVar(&c.Kind, "vault-kind", c.Kind, "The kind of vault to use: aws|tmp")
+	fs.StringVar(&c.Kind, "vault-kind", c.Kind, "The kind of vault to use: aws|database|hashicorp|tmp")
 	fs.StringVar(&c.AccessKeyFile, "vault-access-key-file", c.AccessKeyFile, "File containing vault access key")
 	fs.StringVar(&c.SecretAccessKeyFile, "vault-secret-access-key-file", c.SecretAccessKeyFile, "File containing vault secret access key")
-	fs.BoolVar(&c.SecretPrefixEnable, "vault-secret-prefix-enable", c.SecretPrefixEnable, "Enable use of a prefix for all managed connectors secret names in AWS vault, default false")
-	fs.StringVar(&c.SecretPrefix, "vault-secret-prefix", c.SecretPrefix, "Prefix to use for all managed connectors secret names in AWS vault")
+	fs.BoolVar(&c.SecretPrefixEnable, "vault-secret-prefix-enable", c.SecretPrefixEnable, "Enable use of a prefix for all managed connectors secret names in AWS or HashiCorp vault, default false")
+	fs.StringVar(&c.SecretPrefix, "vault-secret-prefix", c.SecretPrefix, "Prefix to use for all managed connectors secret names in AWS or HashiCorp vault")
 	fs.StringVar(&c.Region, "vault-region", c.Region, "The region of the vault")
+	fs.StringVar(&c.HashicorpAddress, "vault-hashicorp-address", c.HashicorpAddress, "The address of the HashiCorp vault")
+	fs.StringVar(&c.HashicorpNamespace, "vault-hashicorp-namespace", c.HashicorpNamespace, "The HashiCorp vault namespace, empty when namespaces are not used")
+	fs.StringVar(&c.HashicorpMountPath, "vault-hashicorp-mount-path", c.HashicorpMountPath, "The mount path of the KV v2 secrets engine in HashiCorp vault")
+	fs.StringVar(&c.HashicorpAuthMethod, "vault-hashicorp-auth-method", c.HashicorpAuthMethod, "The HashiCorp vault auth method to use: token|approle")
+	fs.StringVar(&c.HashicorpTokenFile, "vault-hashicorp-token-file", c.HashicorpTokenFile, "File containing the HashiCorp vault token, used with the token auth method")
+	fs.StringVar(&c.HashicorpAppRoleMountPath, "vault-hashicorp-approle-mount-path", c.HashicorpAppRoleMountPath, "The mount path of the AppRole auth method in HashiCorp vault")
+	fs.StringVar(&c.HashicorpAppRoleRoleIDFile, "vault-hashicorp-approle-role-id-file", c.HashicorpAppRoleRoleIDFile, "File containing the HashiCorp vault AppRole role id, used with the approle auth method")
+	fs.StringVar(&c.HashicorpAppRoleSecretIDFile, "vault-hashicorp-approle-secret-id-file", c.HashicorpAppRoleSecretIDFile, "File containing the HashiCorp vault AppRole secret id, used with the approle auth method")
+	fs.StringVar(&c.DatabaseKeysFile, "vault-database-keys-file", c.DatabaseKeysFile, "File containing the keys encrypting the secrets stored in the database, one '<key id>:<base64 encoded 32 bytes key>' per line")
+	fs.StringVar(&c.DatabaseKeyID, "vault-database-key-id", c.DatabaseKeyID, "The id of the key encrypting new secrets stored in the database, defaults to the last key of vault-database-keys-file")
+	fs.BoolVar(&c.OrphanedSecretsGCEnabled, "vault-orphaned-secrets-gc-enabled", c.OrphanedSecretsGCEnabled, "Enable the deletion of the vault secrets whose owning resource no longer exists")
+	fs.DurationVar(&c.OrphanedSecretsGCInterval, "vault-orphaned-secrets-gc-interval", c.OrphanedSecretsGCInterval, "Interval between the scans of the vault for orphaned secrets")
+	fs.DurationVar(&c.OrphanedSecretsGCGracePeriod, "vault-orphaned-secrets-gc-grace-period", c.OrphanedSecretsGCGracePeriod, "How long a secret has to be orphaned before it is deleted, so that the secrets of resources being created are kept")
 }
 
 func (c *Config) Validate(env *environments.Env) error {
 	if c.Kind == KindAws && c.SecretPrefixEnable && len(c.SecretPrefix) == 0 {
 		return fmt.Errorf("error validating AWS vault config, vault-secret-prefix must be set to a non-empty value if vault-secret-prefix-enable is true")
 	}
+	if c.OrphanedSecretsGCEnabled && (c.OrphanedSecretsGCInterval <= 0 || c.OrphanedSecretsGCGracePeriod <= 0) {
+		return fmt.Errorf("error validating vault config, vault-orphaned-secrets-gc-interval and vault-orphaned-secrets-gc-grace-period must be positive durations")
+	}
+	// without a secret prefix, an AWS or HashiCorp vault may be shared with other environments whose connector secrets
+	// would be seen as orphaned
+	if c.OrphanedSecretsGCEnabled && !c.SecretPrefixEnable && c.Kind != KindDatabase && c.Kind != KindTmp {
+		return fmt.Errorf("error validating vault config, vault-secret-prefix-enable must be true to enable vault-orphaned-secrets-gc-enabled with the %s vault", c.Kind)
+	}
+	if c.Kind == KindHashicorp {
+		if c.SecretPrefixEnable && len(c.SecretPrefix) == 0 {
+			return fmt.Errorf("error validating HashiCorp vault config, vault-secret-prefix must be set to a non-empty value if vault-secret-prefix-enable is true")
+		}
+		if len(c.HashicorpAddress) == 0 || len(c.HashicorpMountPath) == 0 {
+			return fmt.Errorf("error validating HashiCorp vault config, vault-hashicorp-address and vault-hashicorp-mount-path must be set to non-empty values")
+		}
+		if c.HashicorpAuthMethod != HashicorpAuthToken && c.HashicorpAuthMethod != HashicorpAuthAppRole {
+			return fmt.Errorf("error validating HashiCorp vault config, invalid vault-hashicorp-auth-method: %s", c.HashicorpAuthMethod)
+		}
+	}
 	return nil
 }
 
@@ -58,5 +122,27 @@ func (c *Config) ReadFiles() error {
 			return err
 		}
 	}
+	if c.Kind == KindDatabase && c.DatabaseKeys == "" {
+		if err := shared.ReadFileValueString(c.DatabaseKeysFile, &c.DatabaseKeys); err != nil {
+			return err
+		}
+	}
+	if c.Kind == KindHashicorp {
+		switch c.HashicorpAuthMethod {
+		case HashicorpAuthToken:
+			if c.HashicorpToken == "" {
+				return shared.ReadFileValueString(c.HashicorpTokenFile, &c.HashicorpToken)
+			}
+		case HashicorpAuthAppRole:
+			if c.HashicorpAppRoleRoleID == "" {
+				if err := shared.ReadFileValueString(c.HashicorpAppRoleRoleIDFile, &c.HashicorpAppRoleRoleID); err != nil {
+					return err
+				}
+			}
+			if c.HashicorpAppRoleSecretID == "" {
+				return shared.ReadFileValueString(c.HashicorpAppRoleSecretIDFile, &c.HashicorpAppRoleSecretID)
+			}
+		}
+	}
 	return nil
 }
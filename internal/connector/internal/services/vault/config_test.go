@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestConfig_Validate_OrphanedSecretsGC(t *testing.T) {
+	tests := []struct {
+		name               string
+		kind               string
+		secretPrefixEnable bool
+		wantErr            bool
+	}{
+		{
+			name: "should allow the garbage collection of the secrets of the tmp vault",
+			kind: KindTmp,
+		},
+		{
+			name: "should allow the garbage collection of the secrets stored in the database",
+			kind: KindDatabase,
+		},
+		{
+			name:               "should allow the garbage collection of the secrets of an aws vault with a secret prefix",
+			kind:               KindAws,
+			secretPrefixEnable: true,
+		},
+		{
+			name:    "should refuse the garbage collection of the secrets of an aws vault without a secret prefix",
+			kind:    KindAws,
+			wantErr: true,
+		},
+		{
+			name:               "should allow the garbage collection of the secrets of a hashicorp vault with a secret prefix",
+			kind:               KindHashicorp,
+			secretPrefixEnable: true,
+		},
+		{
+			name:    "should refuse the garbage collection of the secrets of a hashicorp vault without a secret prefix",
+			kind:    KindHashicorp,
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			c := NewConfig()
+			c.Kind = tt.kind
+			c.SecretPrefixEnable = tt.secretPrefixEnable
+			c.OrphanedSecretsGCEnabled = true
+			g.Expect(c.Validate(nil) != nil).To(gomega.Equal(tt.wantErr))
+
+			// the secret prefix is only required when the garbage collection is enabled
+			c.OrphanedSecretsGCEnabled = false
+			g.Expect(c.Validate(nil)).To(gomega.Succeed())
+		})
+	}
+}
@@ -3,28 +3,41 @@ package vault
 import (
 	"fmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 )
 
 const (
-	KindTmp = "tmp"
-	KindAws = "aws"
+	KindTmp       = "tmp"
+	KindAws       = "aws"
+	KindHashicorp = "hashicorp"
+	KindDatabase  = "database"
 
 	DefaultRegion = "us-east-1"
+
+	// owning resource of the connector secrets
+	ConnectorOwningResourcePrefix = "/v1/connector/"
 )
 
 type VaultService interface {
 	SetSecretString(name string, value string, owningResource string) error
 	GetSecretString(name string) (string, error)
 	DeleteSecretString(name string) error
+	// ForEachSecret calls f for each secret until f returns false, the names passed to f can be passed to the other operations
 	ForEachSecret(f func(name string, owningResource string) bool) error
 	Kind() string
 }
 
-func NewVaultService(vaultConfig *Config) (VaultService, error) {
+// NewVaultService creates the vault service of the configured kind. The connection factory is only used by the
+// database vault service.
+func NewVaultService(vaultConfig *Config, connectionFactory *db.ConnectionFactory) (VaultService, error) {
 	metrics.ResetMetricsForVaultService()
 	switch vaultConfig.Kind {
 	case KindAws:
 		return NewAwsVaultService(vaultConfig)
+	case KindHashicorp:
+		return NewHashicorpVaultService(vaultConfig)
+	case KindDatabase:
+		return NewDatabaseVaultService(vaultConfig, connectionFactory)
 	case KindTmp:
 		return NewTmpVaultService()
 	default:
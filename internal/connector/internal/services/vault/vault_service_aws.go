@@ -1,6 +1,8 @@
 package vault
 
 import (
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -117,7 +119,8 @@ func (k *awsVaultService) ForEachSecret(f func(name string, owningResource strin
 			owner := getTag(entry.Tags, OwnerResourceTagKey)
 			name := ""
 			if entry.Name != nil {
-				name = *entry.Name
+				// return the name passed to the other operations
+				name = k.trimVaultSecretName(*entry.Name)
 			}
 			metrics.IncreaseVaultServiceSuccessCount("get")
 			if !f(name, owner) {
@@ -167,3 +170,10 @@ func (k *awsVaultService) getVaultSecretName(name string) string {
 	}
 	return name
 }
+
+func (k *awsVaultService) trimVaultSecretName(name string) string {
+	if k.secretPrefixEnable {
+		return strings.TrimPrefix(name, k.secretPrefix)
+	}
+	return name
+}
@@ -73,7 +73,7 @@ func Test_awsVaultService(t *testing.T) {
 			t.Run(tt.name, func(t *testing.T) {
 				g = gomega.NewWithT(t)
 
-				svc, err := NewVaultService(tt.config)
+				svc, err := NewVaultService(tt.config, nil)
 				g.Expect(err).To(gomega.BeNil())
 
 				err = tt.config.Validate(nil)
@@ -0,0 +1,347 @@
+package vault
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	databaseKeySize   = 32
+	databaseBatchSize = 100
+)
+
+var _ VaultService = &DatabaseVaultService{}
+
+// databaseSecret is a secret stored in the fleet manager database. Secrets are envelope encrypted: the value is
+// encrypted with a random data key, which is itself encrypted with the configured key identified by KeyID. Rotating
+// the configured key only requires the data keys to be re-encrypted.
+type databaseSecret struct {
+	Name           string `gorm:"primaryKey"`
+	OwningResource string
+	KeyID          string
+	EncryptedKey   []byte
+	EncryptedValue []byte
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (databaseSecret) TableName() string {
+	return "vault_secrets"
+}
+
+// databaseKeyring holds the keys encrypting the data keys of the secrets, new secrets being encrypted with the
+// current key
+type databaseKeyring struct {
+	currentKeyID string
+	keys         map[string]cipher.AEAD
+}
+
+// parseDatabaseKeys parses one '<key id>:<base64 encoded key>' per line, ignoring empty lines and lines starting
+// with '#'. The current key defaults to the last key.
+func parseDatabaseKeys(content string, currentKeyID string) (*databaseKeyring, error) {
+	keyring := &databaseKeyring{keys: map[string]cipher.AEAD{}}
+	lastKeyID := ""
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		id := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || id == "" {
+			return nil, fmt.Errorf("invalid database vault key, expected '<key id>:<base64 encoded key>'")
+		}
+		if _, ok := keyring.keys[id]; ok {
+			return nil, fmt.Errorf("duplicate database vault key %q", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid database vault key %q: %w", id, err)
+		}
+		if len(key) != databaseKeySize {
+			return nil, fmt.Errorf("invalid database vault key %q: expected %d bytes, got %d", id, databaseKeySize, len(key))
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid database vault key %q: %w", id, err)
+		}
+		keyring.keys[id] = aead
+		lastKeyID = id
+	}
+
+	if len(keyring.keys) == 0 {
+		return nil, fmt.Errorf("no database vault key configured")
+	}
+	keyring.currentKeyID = lastKeyID
+	if currentKeyID != "" {
+		if _, ok := keyring.keys[currentKeyID]; !ok {
+			return nil, fmt.Errorf("database vault key %q is not configured", currentKeyID)
+		}
+		keyring.currentKeyID = currentKeyID
+	}
+	return keyring, nil
+}
+
+// seal encrypts the value of a secret with a new data key, and the data key with the current key. The name of the
+// secret is authenticated so that encrypted values cannot be swapped between secrets.
+func (r *databaseKeyring) seal(name string, value string) (*databaseSecret, error) {
+	dataKey := make([]byte, databaseKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	encryptedValue, err := encrypt(dataAEAD, []byte(value), name)
+	if err != nil {
+		return nil, err
+	}
+	encryptedKey, err := encrypt(r.keys[r.currentKeyID], dataKey, name)
+	if err != nil {
+		return nil, err
+	}
+	return &databaseSecret{
+		Name:           name,
+		KeyID:          r.currentKeyID,
+		EncryptedKey:   encryptedKey,
+		EncryptedValue: encryptedValue,
+	}, nil
+}
+
+func (r *databaseKeyring) open(secret *databaseSecret) (string, error) {
+	dataKey, err := r.openDataKey(secret)
+	if err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	value, err := decrypt(dataAEAD, secret.EncryptedValue, secret.Name)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt secret %s: %w", secret.Name, err)
+	}
+	return string(value), nil
+}
+
+// reseal re-encrypts the data key of a secret with the current key, leaving its encrypted value unchanged
+func (r *databaseKeyring) reseal(secret *databaseSecret) (*databaseSecret, error) {
+	dataKey, err := r.openDataKey(secret)
+	if err != nil {
+		return nil, err
+	}
+	encryptedKey, err := encrypt(r.keys[r.currentKeyID], dataKey, secret.Name)
+	if err != nil {
+		return nil, err
+	}
+	resealed := *secret
+	resealed.KeyID = r.currentKeyID
+	resealed.EncryptedKey = encryptedKey
+	return &resealed, nil
+}
+
+func (r *databaseKeyring) openDataKey(secret *databaseSecret) ([]byte, error) {
+	key, ok := r.keys[secret.KeyID]
+	if !ok {
+		return nil, fmt.Errorf("database vault key %q of secret %s is not configured", secret.KeyID, secret.Name)
+	}
+	dataKey, err := decrypt(key, secret.EncryptedKey, secret.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data key of secret %s: %w", secret.Name, err)
+	}
+	return dataKey, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encrypt returns the random nonce followed by the ciphertext
+func encrypt(aead cipher.AEAD, plaintext []byte, additionalData string) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, []byte(additionalData)), nil
+}
+
+func decrypt(aead cipher.AEAD, ciphertext []byte, additionalData string) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, []byte(additionalData))
+}
+
+// DatabaseVaultService stores secrets encrypted in the fleet manager database, for installations without an external
+// secret manager
+type DatabaseVaultService struct {
+	connectionFactory *db.ConnectionFactory
+	keyring           *databaseKeyring
+}
+
+func NewDatabaseVaultService(vaultConfig *Config, connectionFactory *db.ConnectionFactory) (*DatabaseVaultService, error) {
+	if connectionFactory == nil {
+		return nil, fmt.Errorf("database vault requires a database connection")
+	}
+	keyring, err := parseDatabaseKeys(vaultConfig.DatabaseKeys, vaultConfig.DatabaseKeyID)
+	if err != nil {
+		return nil, err
+	}
+	return &DatabaseVaultService{
+		connectionFactory: connectionFactory,
+		keyring:           keyring,
+	}, nil
+}
+
+func (k *DatabaseVaultService) Kind() string {
+	return KindDatabase
+}
+
+func (k *DatabaseVaultService) GetSecretString(name string) (string, error) {
+	metrics.IncreaseVaultServiceTotalCount("get")
+
+	var secret databaseSecret
+	if err := k.connectionFactory.New().Where("name = ?", name).First(&secret).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			metrics.IncreaseVaultServiceErrorsCount("get")
+			return "", fmt.Errorf("secret %s: %w", name, NotFound)
+		}
+		metrics.IncreaseVaultServiceFailureCount("get")
+		return "", err
+	}
+
+	value, err := k.keyring.open(&secret)
+	if err != nil {
+		metrics.IncreaseVaultServiceFailureCount("get")
+		return "", err
+	}
+	metrics.IncreaseVaultServiceSuccessCount("get")
+	return value, nil
+}
+
+func (k *DatabaseVaultService) SetSecretString(name string, value string, owningResource string) error {
+	metrics.IncreaseVaultServiceTotalCount("set")
+
+	secret, err := k.keyring.seal(name, value)
+	if err != nil {
+		metrics.IncreaseVaultServiceFailureCount("set")
+		return err
+	}
+	secret.OwningResource = owningResource
+
+	if err := k.connectionFactory.New().Clauses(clause.OnConflict{UpdateAll: true}).Create(secret).Error; err != nil {
+		metrics.IncreaseVaultServiceFailureCount("set")
+		return err
+	}
+	metrics.IncreaseVaultServiceSuccessCount("set")
+	return nil
+}
+
+func (k *DatabaseVaultService) DeleteSecretString(name string) error {
+	metrics.IncreaseVaultServiceTotalCount("delete")
+
+	result := k.connectionFactory.New().Where("name = ?", name).Delete(&databaseSecret{})
+	if result.Error != nil {
+		metrics.IncreaseVaultServiceFailureCount("delete")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		metrics.IncreaseVaultServiceErrorsCount("delete")
+		return fmt.Errorf("secret %s: %w", name, NotFound)
+	}
+	metrics.IncreaseVaultServiceSuccessCount("delete")
+	return nil
+}
+
+func (k *DatabaseVaultService) ForEachSecret(f func(name string, owningResource string) bool) error {
+	last := ""
+	for {
+		var secrets []databaseSecret
+		if err := k.connectionFactory.New().
+			Select("name", "owning_resource").
+			Where("name > ?", last).
+			Order("name").
+			Limit(databaseBatchSize).
+			Find(&secrets).Error; err != nil {
+			metrics.IncreaseVaultServiceFailureCount("get")
+			return err
+		}
+
+		for _, secret := range secrets {
+			metrics.IncreaseVaultServiceTotalCount("get")
+			metrics.IncreaseVaultServiceSuccessCount("get")
+			if !f(secret.Name, secret.OwningResource) {
+				return nil
+			}
+		}
+
+		if len(secrets) < databaseBatchSize {
+			return nil
+		}
+		last = secrets[len(secrets)-1].Name
+	}
+}
+
+// ReEncryptSecrets re-encrypts the data keys of all the secrets that are not encrypted with the current key, and
+// returns the number of re-encrypted secrets. Keys other than the current key can be removed from the configuration
+// once it completes.
+func (k *DatabaseVaultService) ReEncryptSecrets() (int, error) {
+	count := 0
+	last := ""
+	for {
+		var secrets []databaseSecret
+		if err := k.connectionFactory.New().
+			Select("name", "key_id", "encrypted_key").
+			Where("key_id <> ?", k.keyring.currentKeyID).
+			Where("name > ?", last).
+			Order("name").
+			Limit(databaseBatchSize).
+			Find(&secrets).Error; err != nil {
+			return count, err
+		}
+
+		for i := range secrets {
+			resealed, err := k.keyring.reseal(&secrets[i])
+			if err != nil {
+				return count, err
+			}
+			// the update only applies to the data key read above. A secret updated concurrently has a new data key,
+			// possibly encrypted with the same previous key by a replica that does not know the current key yet, and
+			// is left for the next run rather than overwritten with a data key that no longer decrypts its value
+			result := k.connectionFactory.New().Model(&databaseSecret{}).
+				Where("name = ? AND key_id = ? AND encrypted_key = ?", secrets[i].Name, secrets[i].KeyID, secrets[i].EncryptedKey).
+				Updates(map[string]interface{}{
+					"key_id":        resealed.KeyID,
+					"encrypted_key": resealed.EncryptedKey,
+					"updated_at":    time.Now(),
+				})
+			if result.Error != nil {
+				return count, result.Error
+			}
+			count += int(result.RowsAffected)
+		}
+
+		if len(secrets) < databaseBatchSize {
+			return count, nil
+		}
+		last = secrets[len(secrets)-1].Name
+	}
+}
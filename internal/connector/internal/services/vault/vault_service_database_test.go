@@ -0,0 +1,251 @@
+package vault
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+var (
+	testKey1 = base64.StdEncoding.EncodeToString([]byte(strings.Repeat("1", databaseKeySize)))
+	testKey2 = base64.StdEncoding.EncodeToString([]byte(strings.Repeat("2", databaseKeySize)))
+)
+
+func Test_parseDatabaseKeys(t *testing.T) {
+	tests := []struct {
+		name         string
+		content      string
+		currentKeyID string
+		wantErr      bool
+		wantKeyID    string
+	}{
+		{
+			name:      "should default to the last key",
+			content:   "# keys\nkey-1:" + testKey1 + "\n\nkey-2:" + testKey2 + "\n",
+			wantErr:   false,
+			wantKeyID: "key-2",
+		},
+		{
+			name:         "should use the configured current key",
+			content:      "key-1:" + testKey1 + "\nkey-2:" + testKey2,
+			currentKeyID: "key-1",
+			wantErr:      false,
+			wantKeyID:    "key-1",
+		},
+		{
+			name:         "should return an error if the current key is not configured",
+			content:      "key-1:" + testKey1,
+			currentKeyID: "key-2",
+			wantErr:      true,
+		},
+		{
+			name:    "should return an error if no key is configured",
+			content: "# no keys\n",
+			wantErr: true,
+		},
+		{
+			name:    "should return an error if a key has no id",
+			content: testKey1,
+			wantErr: true,
+		},
+		{
+			name:    "should return an error if a key is duplicated",
+			content: "key-1:" + testKey1 + "\nkey-1:" + testKey2,
+			wantErr: true,
+		},
+		{
+			name:    "should return an error if a key is not 32 bytes long",
+			content: "key-1:" + base64.StdEncoding.EncodeToString([]byte("short")),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error if a key is not base64 encoded",
+			content: "key-1:not base64",
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			keyring, err := parseDatabaseKeys(tt.content, tt.currentKeyID)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if err == nil {
+				g.Expect(keyring.currentKeyID).To(gomega.Equal(tt.wantKeyID))
+			}
+		})
+	}
+}
+
+func Test_databaseKeyring_rotation(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	oldKeyring, err := parseDatabaseKeys("key-1:"+testKey1, "")
+	g.Expect(err).To(gomega.BeNil())
+	secret, err := oldKeyring.seal("secret-1", "value-1")
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(secret.KeyID).To(gomega.Equal("key-1"))
+	g.Expect(string(secret.EncryptedValue)).ToNot(gomega.ContainSubstring("value-1"))
+
+	// secrets encrypted with a previous key can still be decrypted after adding a new key
+	keyring, err := parseDatabaseKeys("key-1:"+testKey1+"\nkey-2:"+testKey2, "")
+	g.Expect(err).To(gomega.BeNil())
+	value, err := keyring.open(secret)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(value).To(gomega.Equal("value-1"))
+
+	resealed, err := keyring.reseal(secret)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(resealed.KeyID).To(gomega.Equal("key-2"))
+	g.Expect(resealed.EncryptedValue).To(gomega.Equal(secret.EncryptedValue))
+
+	// the previous key is no longer needed once the secret is re-encrypted
+	newKeyring, err := parseDatabaseKeys("key-2:"+testKey2, "")
+	g.Expect(err).To(gomega.BeNil())
+	value, err = newKeyring.open(resealed)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(value).To(gomega.Equal("value-1"))
+	_, err = newKeyring.open(secret)
+	g.Expect(err).ToNot(gomega.BeNil())
+
+	// encrypted values are bound to the name of their secret
+	swapped := *resealed
+	swapped.Name = "secret-2"
+	_, err = newKeyring.open(&swapped)
+	g.Expect(err).ToNot(gomega.BeNil())
+}
+
+func TestDatabaseVaultService_GetSecretString(t *testing.T) {
+	keyring, err := parseDatabaseKeys("key-1:"+testKey1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret, err := keyring.seal("secret-1", "value-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		setupFn      func()
+		want         string
+		wantErr      bool
+		wantNotFound bool
+	}{
+		{
+			name: "should return the decrypted secret",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "vault_secrets" WHERE name = $1`).
+					WithReply([]map[string]interface{}{{
+						"name":            secret.Name,
+						"key_id":          secret.KeyID,
+						"encrypted_key":   secret.EncryptedKey,
+						"encrypted_value": secret.EncryptedValue,
+					}})
+			},
+			want:    "value-1",
+			wantErr: false,
+		},
+		{
+			name: "should return not found if the secret does not exist",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr:      true,
+			wantNotFound: true,
+		},
+		{
+			name: "should return an error if the query fails",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "vault_secrets"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			svc := &DatabaseVaultService{connectionFactory: db.NewMockConnectionFactory(nil), keyring: keyring}
+			value, err := svc.GetSecretString("secret-1")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantNotFound {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(NotFound.Error())))
+			}
+			g.Expect(value).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestDatabaseVaultService_DeleteSecretString(t *testing.T) {
+	g := gomega.NewWithT(t)
+	svc := &DatabaseVaultService{connectionFactory: db.NewMockConnectionFactory(nil)}
+
+	mocket.Catcher.Reset().NewMock().WithQuery(`DELETE FROM "vault_secrets" WHERE name = $1`).WithRowsNum(1)
+	g.Expect(svc.DeleteSecretString("secret-1")).To(gomega.Succeed())
+
+	mocket.Catcher.Reset().NewMock().WithQuery(`DELETE FROM "vault_secrets" WHERE name = $1`).WithRowsNum(0)
+	g.Expect(svc.DeleteSecretString("secret-1")).To(gomega.MatchError(gomega.ContainSubstring(NotFound.Error())))
+}
+
+func TestDatabaseVaultService_ReEncryptSecrets(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	oldKeyring, err := parseDatabaseKeys("key-1:"+testKey1, "")
+	g.Expect(err).To(gomega.BeNil())
+	secret, err := oldKeyring.seal("secret-1", "value-1")
+	g.Expect(err).To(gomega.BeNil())
+
+	keyring, err := parseDatabaseKeys("key-1:"+testKey1+"\nkey-2:"+testKey2, "")
+	g.Expect(err).To(gomega.BeNil())
+	svc := &DatabaseVaultService{connectionFactory: db.NewMockConnectionFactory(nil), keyring: keyring}
+
+	mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "name","key_id","encrypted_key" FROM "vault_secrets" WHERE key_id <> $1`).
+		WithReply([]map[string]interface{}{{
+			"name":          secret.Name,
+			"key_id":        secret.KeyID,
+			"encrypted_key": secret.EncryptedKey,
+		}})
+	var updateArgs []interface{}
+	mocket.Catcher.NewMock().WithQuery(`UPDATE "vault_secrets"`).WithRowsNum(1).
+		WithCallback(func(_ string, args []driver.NamedValue) {
+			for _, arg := range args {
+				updateArgs = append(updateArgs, arg.Value)
+			}
+		})
+	count, err := svc.ReEncryptSecrets()
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(count).To(gomega.Equal(1))
+	// the update is conditioned on the data key that was re-encrypted
+	g.Expect(updateArgs).To(gomega.ContainElements(secret.Name, secret.KeyID, secret.EncryptedKey))
+
+	// a secret whose data key was replaced concurrently is not overwritten nor counted
+	mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "name","key_id","encrypted_key" FROM "vault_secrets" WHERE key_id <> $1`).
+		WithReply([]map[string]interface{}{{
+			"name":          secret.Name,
+			"key_id":        secret.KeyID,
+			"encrypted_key": secret.EncryptedKey,
+		}})
+	mocket.Catcher.NewMock().WithQuery(`UPDATE "vault_secrets"`).WithRowsNum(0)
+	count, err = svc.ReEncryptSecrets()
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(count).To(gomega.Equal(0))
+
+	// secrets encrypted with a key that is no longer configured cannot be re-encrypted
+	mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "name","key_id","encrypted_key" FROM "vault_secrets" WHERE key_id <> $1`).
+		WithReply([]map[string]interface{}{{
+			"name":          secret.Name,
+			"key_id":        "key-0",
+			"encrypted_key": secret.EncryptedKey,
+		}})
+	_, err = svc.ReEncryptSecrets()
+	g.Expect(err).ToNot(gomega.BeNil())
+}
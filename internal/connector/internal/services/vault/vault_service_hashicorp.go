@@ -0,0 +1,349 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
+)
+
+const (
+	HashicorpAuthToken   = "token"
+	HashicorpAuthAppRole = "approle"
+
+	// AppRole tokens are renewed by logging in again this long before they expire
+	hashicorpTokenExpiryMargin = 30 * time.Second
+	hashicorpRequestTimeout    = 30 * time.Second
+)
+
+var _ VaultService = &hashicorpVaultService{}
+
+// hashicorpVaultService stores secrets in a HashiCorp Vault KV v2 secrets engine. The value of a secret is stored in
+// the "value" key of the secret data, and its owning resource in the custom metadata of the secret.
+type hashicorpVaultService struct {
+	client             *http.Client
+	address            string
+	namespace          string
+	mountPath          string
+	authMethod         string
+	appRoleMountPath   string
+	roleID             string
+	secretID           string
+	secretPrefixEnable bool
+	secretPrefix       string
+
+	mu          sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+// hashicorpError is returned for the unexpected responses of HashiCorp vault
+type hashicorpError struct {
+	StatusCode int
+	Errors     []string
+}
+
+func (e *hashicorpError) Error() string {
+	return fmt.Sprintf("hashicorp vault returned status %d: %s", e.StatusCode, strings.Join(e.Errors, ", "))
+}
+
+func NewHashicorpVaultService(vaultConfig *Config) (*hashicorpVaultService, error) {
+	if vaultConfig.HashicorpAddress == "" {
+		return nil, fmt.Errorf("hashicorp vault address is required")
+	}
+	mountPath := strings.Trim(vaultConfig.HashicorpMountPath, "/")
+	if mountPath == "" {
+		return nil, fmt.Errorf("hashicorp vault mount path is required")
+	}
+
+	k := &hashicorpVaultService{
+		client:             &http.Client{Timeout: hashicorpRequestTimeout},
+		address:            strings.TrimSuffix(vaultConfig.HashicorpAddress, "/"),
+		namespace:          vaultConfig.HashicorpNamespace,
+		mountPath:          mountPath,
+		authMethod:         vaultConfig.HashicorpAuthMethod,
+		appRoleMountPath:   strings.Trim(vaultConfig.HashicorpAppRoleMountPath, "/"),
+		roleID:             vaultConfig.HashicorpAppRoleRoleID,
+		secretID:           vaultConfig.HashicorpAppRoleSecretID,
+		secretPrefixEnable: vaultConfig.SecretPrefixEnable,
+		secretPrefix:       strings.Trim(vaultConfig.SecretPrefix, "/") + "/",
+	}
+
+	switch k.authMethod {
+	case "", HashicorpAuthToken:
+		if vaultConfig.HashicorpToken == "" {
+			return nil, fmt.Errorf("hashicorp vault token is required with the %s auth method", HashicorpAuthToken)
+		}
+		k.authMethod = HashicorpAuthToken
+		k.token = vaultConfig.HashicorpToken
+	case HashicorpAuthAppRole:
+		if k.roleID == "" || k.secretID == "" {
+			return nil, fmt.Errorf("hashicorp vault role id and secret id are required with the %s auth method", HashicorpAuthAppRole)
+		}
+		if k.appRoleMountPath == "" {
+			k.appRoleMountPath = HashicorpAuthAppRole
+		}
+	default:
+		return nil, fmt.Errorf("unsupported hashicorp vault auth method: %s", k.authMethod)
+	}
+
+	return k, nil
+}
+
+func (k *hashicorpVaultService) Kind() string {
+	return KindHashicorp
+}
+
+func (k *hashicorpVaultService) GetSecretString(name string) (string, error) {
+	metrics.IncreaseVaultServiceTotalCount("get")
+
+	var result struct {
+		Data struct {
+			Data map[string]string `json:"data"`
+		} `json:"data"`
+	}
+	err := k.do(http.MethodGet, k.secretPath("data", name), nil, &result)
+	if err != nil {
+		if isHashicorpNotFound(err) {
+			metrics.IncreaseVaultServiceErrorsCount("get")
+			return "", fmt.Errorf("secret %s: %w", name, NotFound)
+		}
+		metrics.IncreaseVaultServiceFailureCount("get")
+		return "", err
+	}
+
+	value, ok := result.Data.Data["value"]
+	if !ok {
+		// a secret without a value is either deleted or not written by the fleet manager
+		metrics.IncreaseVaultServiceErrorsCount("get")
+		return "", fmt.Errorf("secret %s: %w", name, NotFound)
+	}
+	metrics.IncreaseVaultServiceSuccessCount("get")
+	return value, nil
+}
+
+// SetSecretString writes the owning resource to the custom metadata of the secret before its value, as they cannot be
+// written in a single request. This way a value is never stored without its owner, which would hide it from the
+// orphaned secrets garbage collection.
+func (k *hashicorpVaultService) SetSecretString(name string, value string, owningResource string) error {
+	metrics.IncreaseVaultServiceTotalCount("set")
+
+	if owningResource != "" {
+		metadata := map[string]interface{}{
+			"custom_metadata": map[string]string{OwnerResourceTagKey: owningResource},
+		}
+		if err := k.do(http.MethodPost, k.secretPath("metadata", name), metadata, nil); err != nil {
+			metrics.IncreaseVaultServiceFailureCount("set")
+			return err
+		}
+	}
+
+	data := map[string]interface{}{
+		"data": map[string]string{"value": value},
+	}
+	if err := k.do(http.MethodPost, k.secretPath("data", name), data, nil); err != nil {
+		metrics.IncreaseVaultServiceFailureCount("set")
+		return err
+	}
+
+	metrics.IncreaseVaultServiceSuccessCount("set")
+	return nil
+}
+
+func (k *hashicorpVaultService) DeleteSecretString(name string) error {
+	metrics.IncreaseVaultServiceTotalCount("delete")
+
+	// deleting the metadata of a missing secret succeeds in vault, so check its existence first
+	if err := k.do(http.MethodGet, k.secretPath("metadata", name), nil, nil); err != nil {
+		if isHashicorpNotFound(err) {
+			metrics.IncreaseVaultServiceErrorsCount("delete")
+			return fmt.Errorf("secret %s: %w", name, NotFound)
+		}
+		metrics.IncreaseVaultServiceFailureCount("delete")
+		return err
+	}
+
+	// deleting the metadata permanently deletes all the versions of the secret
+	if err := k.do(http.MethodDelete, k.secretPath("metadata", name), nil, nil); err != nil {
+		metrics.IncreaseVaultServiceFailureCount("delete")
+		return err
+	}
+	metrics.IncreaseVaultServiceSuccessCount("delete")
+	return nil
+}
+
+// ForEachSecret lists the secrets recursively from the metadata of the KV v2 secrets engine. Secret names are
+// relative to the secret prefix, so that they can be passed back to the other operations.
+func (k *hashicorpVaultService) ForEachSecret(f func(name string, owningResource string) bool) error {
+	_, err := k.forEachSecret("", f)
+	if err != nil {
+		metrics.IncreaseVaultServiceFailureCount("get")
+		return err
+	}
+	return nil
+}
+
+func (k *hashicorpVaultService) forEachSecret(dir string, f func(name string, owningResource string) bool) (bool, error) {
+	var list struct {
+		Data struct {
+			Keys []string `json:"keys"`
+		} `json:"data"`
+	}
+	err := k.do(http.MethodGet, k.secretPath("metadata", dir)+"?list=true", nil, &list)
+	if err != nil {
+		if isHashicorpNotFound(err) {
+			// vault returns not found when listing an empty directory
+			return true, nil
+		}
+		return false, err
+	}
+
+	for _, key := range list.Data.Keys {
+		name := dir + key
+		if strings.HasSuffix(key, "/") {
+			next, err := k.forEachSecret(name, f)
+			if !next || err != nil {
+				return next, err
+			}
+			continue
+		}
+
+		metrics.IncreaseVaultServiceTotalCount("get")
+		var metadata struct {
+			Data struct {
+				CustomMetadata map[string]string `json:"custom_metadata"`
+			} `json:"data"`
+		}
+		if err := k.do(http.MethodGet, k.secretPath("metadata", name), nil, &metadata); err != nil {
+			if isHashicorpNotFound(err) {
+				// deleted while listing
+				metrics.IncreaseVaultServiceErrorsCount("get")
+				continue
+			}
+			return false, err
+		}
+		metrics.IncreaseVaultServiceSuccessCount("get")
+		if !f(name, metadata.Data.CustomMetadata[OwnerResourceTagKey]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// secretPath returns the api path of a secret, or of a directory of secrets when name is empty or ends with "/"
+func (k *hashicorpVaultService) secretPath(api string, name string) string {
+	if k.secretPrefixEnable {
+		name = k.secretPrefix + name
+	}
+	segments := strings.Split(name, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return "/v1/" + k.mountPath + "/" + api + "/" + strings.Join(segments, "/")
+}
+
+// do sends an authenticated request to vault and decodes the response into result when it is not nil. The request is
+// retried once with a new AppRole token if the current token has been revoked or has expired.
+func (k *hashicorpVaultService) do(method string, path string, body interface{}, result interface{}) error {
+	token, err := k.getToken(false)
+	if err != nil {
+		return err
+	}
+	err = k.send(method, path, token, body, result)
+	if e, ok := err.(*hashicorpError); ok && e.StatusCode == http.StatusForbidden && k.authMethod == HashicorpAuthAppRole {
+		if token, err = k.getToken(true); err != nil {
+			return err
+		}
+		err = k.send(method, path, token, body, result)
+	}
+	return err
+}
+
+func (k *hashicorpVaultService) getToken(renew bool) (string, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.authMethod == HashicorpAuthToken {
+		return k.token, nil
+	}
+	if !renew && k.token != "" && (k.tokenExpiry.IsZero() || time.Now().Add(hashicorpTokenExpiryMargin).Before(k.tokenExpiry)) {
+		return k.token, nil
+	}
+
+	var login struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int64  `json:"lease_duration"`
+		} `json:"auth"`
+	}
+	credentials := map[string]string{
+		"role_id":   k.roleID,
+		"secret_id": k.secretID,
+	}
+	if err := k.send(http.MethodPost, "/v1/auth/"+k.appRoleMountPath+"/login", "", credentials, &login); err != nil {
+		return "", fmt.Errorf("failed to login to hashicorp vault with approle: %w", err)
+	}
+	if login.Auth.ClientToken == "" {
+		return "", fmt.Errorf("failed to login to hashicorp vault with approle: no client token returned")
+	}
+
+	k.token = login.Auth.ClientToken
+	k.tokenExpiry = time.Time{}
+	if login.Auth.LeaseDuration > 0 {
+		k.tokenExpiry = time.Now().Add(time.Duration(login.Auth.LeaseDuration) * time.Second)
+	}
+	return k.token, nil
+}
+
+func (k *hashicorpVaultService) send(method string, path string, token string, body interface{}, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		content, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(content)
+	}
+
+	req, err := http.NewRequest(method, k.address+path, reader)
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if k.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", k.namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		vaultErr := &hashicorpError{StatusCode: resp.StatusCode}
+		_ = json.NewDecoder(resp.Body).Decode(vaultErr)
+		return vaultErr
+	}
+	if result == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+func isHashicorpNotFound(err error) bool {
+	e, ok := err.(*hashicorpError)
+	return ok && e.StatusCode == http.StatusNotFound
+}
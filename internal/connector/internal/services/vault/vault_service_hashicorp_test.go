@@ -0,0 +1,351 @@
+package vault
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+const (
+	standInToken    = "stand-in-token"
+	standInRoleID   = "stand-in-role-id"
+	standInSecretID = "stand-in-secret-id"
+)
+
+type standInSecret struct {
+	data           map[string]interface{}
+	customMetadata map[string]string
+}
+
+// HashicorpStandIn is an http stand-in for the subset of the HashiCorp vault api used by the KV v2 vault service,
+// with the KV v2 secrets engine mounted at "secret" and the AppRole auth method mounted at "approle"
+type HashicorpStandIn struct {
+	*httptest.Server
+	mu        sync.Mutex
+	secrets   map[string]*standInSecret
+	tokens    map[string]bool
+	logins    int
+	namespace string
+	// failedWrites makes the writes to the given api, i.e. "data" or "metadata", fail
+	failedWrites map[string]bool
+}
+
+func NewHashicorpStandIn() *HashicorpStandIn {
+	s := &HashicorpStandIn{
+		secrets: map[string]*standInSecret{},
+		tokens:  map[string]bool{standInToken: true},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// HashicorpStandInConfig returns a vault config for the stand-in using the token auth method
+func HashicorpStandInConfig(s *HashicorpStandIn) *Config {
+	c := NewConfig()
+	c.Kind = KindHashicorp
+	c.HashicorpAddress = s.URL
+	c.HashicorpToken = standInToken
+	return c
+}
+
+func (s *HashicorpStandIn) revokeTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = map[string]bool{}
+}
+
+func (s *HashicorpStandIn) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.namespace != "" && r.Header.Get("X-Vault-Namespace") != s.namespace {
+		writeStandIn(w, http.StatusNotFound, nil)
+		return
+	}
+
+	if r.URL.Path == "/v1/auth/approle/login" && r.Method == http.MethodPost {
+		var credentials map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&credentials)
+		if credentials["role_id"] != standInRoleID || credentials["secret_id"] != standInSecretID {
+			writeStandIn(w, http.StatusBadRequest, map[string]interface{}{"errors": []string{"invalid role or secret id"}})
+			return
+		}
+		s.logins++
+		token := strings.Repeat("t", s.logins)
+		s.tokens[token] = true
+		writeStandIn(w, http.StatusOK, map[string]interface{}{
+			"auth": map[string]interface{}{"client_token": token, "lease_duration": 3600},
+		})
+		return
+	}
+
+	if !s.tokens[r.Header.Get("X-Vault-Token")] {
+		writeStandIn(w, http.StatusForbidden, map[string]interface{}{"errors": []string{"permission denied"}})
+		return
+	}
+
+	var name string
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/v1/secret/data/"):
+		name = strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")
+		switch r.Method {
+		case http.MethodGet:
+			secret, ok := s.secrets[name]
+			if !ok {
+				writeStandIn(w, http.StatusNotFound, map[string]interface{}{"errors": []string{}})
+				return
+			}
+			writeStandIn(w, http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"data": secret.data}})
+		case http.MethodPost:
+			if s.failedWrites["data"] {
+				writeStandIn(w, http.StatusInternalServerError, map[string]interface{}{"errors": []string{"internal error"}})
+				return
+			}
+			var body struct {
+				Data map[string]interface{} `json:"data"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if secret, ok := s.secrets[name]; ok {
+				secret.data = body.Data
+			} else {
+				s.secrets[name] = &standInSecret{data: body.Data}
+			}
+			writeStandIn(w, http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"version": 1}})
+		default:
+			writeStandIn(w, http.StatusMethodNotAllowed, nil)
+		}
+	case strings.HasPrefix(r.URL.Path, "/v1/secret/metadata/"):
+		name = strings.TrimPrefix(r.URL.Path, "/v1/secret/metadata/")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Query().Get("list") == "true":
+			keys := s.list(name)
+			if len(keys) == 0 {
+				writeStandIn(w, http.StatusNotFound, map[string]interface{}{"errors": []string{}})
+				return
+			}
+			writeStandIn(w, http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"keys": keys}})
+		case r.Method == http.MethodGet:
+			secret, ok := s.secrets[name]
+			if !ok {
+				writeStandIn(w, http.StatusNotFound, map[string]interface{}{"errors": []string{}})
+				return
+			}
+			writeStandIn(w, http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"custom_metadata": secret.customMetadata}})
+		case r.Method == http.MethodPost:
+			if s.failedWrites["metadata"] {
+				writeStandIn(w, http.StatusInternalServerError, map[string]interface{}{"errors": []string{"internal error"}})
+				return
+			}
+			var body struct {
+				CustomMetadata map[string]string `json:"custom_metadata"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if secret, ok := s.secrets[name]; ok {
+				secret.customMetadata = body.CustomMetadata
+			} else {
+				s.secrets[name] = &standInSecret{customMetadata: body.CustomMetadata}
+			}
+			writeStandIn(w, http.StatusNoContent, nil)
+		case r.Method == http.MethodDelete:
+			delete(s.secrets, name)
+			writeStandIn(w, http.StatusNoContent, nil)
+		default:
+			writeStandIn(w, http.StatusMethodNotAllowed, nil)
+		}
+	default:
+		writeStandIn(w, http.StatusNotFound, nil)
+	}
+}
+
+// list returns the keys directly under dir, with a trailing "/" for sub directories like vault does
+func (s *HashicorpStandIn) list(dir string) []string {
+	found := map[string]bool{}
+	for name := range s.secrets {
+		if !strings.HasPrefix(name, dir) {
+			continue
+		}
+		rest := strings.TrimPrefix(name, dir)
+		if i := strings.Index(rest, "/"); i >= 0 {
+			rest = rest[:i+1]
+		}
+		found[rest] = true
+	}
+	keys := make([]string, 0, len(found))
+	for key := range found {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func writeStandIn(w http.ResponseWriter, status int, body interface{}) {
+	if body == nil || status == http.StatusNoContent {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestNewHashicorpVaultService(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  func(c *Config)
+		wantErr bool
+	}{
+		{
+			name:    "should accept a token",
+			config:  func(c *Config) {},
+			wantErr: false,
+		},
+		{
+			name:    "should return an error if the token is missing",
+			config:  func(c *Config) { c.HashicorpToken = "" },
+			wantErr: true,
+		},
+		{
+			name: "should return an error if the approle credentials are missing",
+			config: func(c *Config) {
+				c.HashicorpAuthMethod = HashicorpAuthAppRole
+				c.HashicorpAppRoleRoleID = standInRoleID
+			},
+			wantErr: true,
+		},
+		{
+			name:    "should return an error if the mount path is missing",
+			config:  func(c *Config) { c.HashicorpMountPath = "/" },
+			wantErr: true,
+		},
+		{
+			name:    "should return an error if the auth method is not supported",
+			config:  func(c *Config) { c.HashicorpAuthMethod = "kubernetes" },
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			c := NewConfig()
+			c.Kind = KindHashicorp
+			c.HashicorpToken = standInToken
+			tt.config(c)
+			_, err := NewHashicorpVaultService(c)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func TestHashicorpVaultService_AppRole(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	standIn := NewHashicorpStandIn()
+	defer standIn.Close()
+
+	c := HashicorpStandInConfig(standIn)
+	c.HashicorpToken = ""
+	c.HashicorpAuthMethod = HashicorpAuthAppRole
+	c.HashicorpAppRoleRoleID = standInRoleID
+	c.HashicorpAppRoleSecretID = standInSecretID
+	svc, err := NewHashicorpVaultService(c)
+	g.Expect(err).To(gomega.BeNil())
+
+	g.Expect(svc.SetSecretString("secret-1", "value-1", "owner-1")).To(gomega.Succeed())
+	g.Expect(standIn.logins).To(gomega.Equal(1))
+
+	// the token is reused until it is rejected
+	standIn.revokeTokens()
+	value, err := svc.GetSecretString("secret-1")
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(value).To(gomega.Equal("value-1"))
+	g.Expect(standIn.logins).To(gomega.Equal(2))
+
+	c.HashicorpAppRoleSecretID = "wrong"
+	svc, err = NewHashicorpVaultService(c)
+	g.Expect(err).To(gomega.BeNil())
+	_, err = svc.GetSecretString("secret-1")
+	g.Expect(err).ToNot(gomega.BeNil())
+}
+
+func TestHashicorpVaultService_SetSecretString(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	standIn := NewHashicorpStandIn()
+	defer standIn.Close()
+	svc, err := NewHashicorpVaultService(HashicorpStandInConfig(standIn))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	// the value is not written when its owner cannot be
+	standIn.failedWrites = map[string]bool{"metadata": true}
+	g.Expect(svc.SetSecretString("secret-1", "value-1", "owner-1")).ToNot(gomega.Succeed())
+	g.Expect(standIn.secrets).To(gomega.BeEmpty())
+
+	// the owner is already recorded when the value cannot be written
+	standIn.failedWrites = map[string]bool{"data": true}
+	g.Expect(svc.SetSecretString("secret-1", "value-1", "owner-1")).ToNot(gomega.Succeed())
+	_, err = svc.GetSecretString("secret-1")
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(NotFound.Error())))
+	owners := map[string]string{}
+	g.Expect(svc.ForEachSecret(func(name string, owningResource string) bool {
+		owners[name] = owningResource
+		return true
+	})).To(gomega.Succeed())
+	g.Expect(owners).To(gomega.Equal(map[string]string{"secret-1": "owner-1"}))
+
+	standIn.failedWrites = nil
+	g.Expect(svc.SetSecretString("secret-1", "value-1", "owner-1")).To(gomega.Succeed())
+	value, err := svc.GetSecretString("secret-1")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(value).To(gomega.Equal("value-1"))
+}
+
+func TestHashicorpVaultService_ForEachSecret(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	standIn := NewHashicorpStandIn()
+	defer standIn.Close()
+	standIn.namespace = "connectors"
+
+	c := HashicorpStandInConfig(standIn)
+	c.HashicorpNamespace = "connectors"
+	c.SecretPrefixEnable = true
+	c.SecretPrefix = "managed-connectors"
+	svc, err := NewHashicorpVaultService(c)
+	g.Expect(err).To(gomega.BeNil())
+
+	g.Expect(svc.SetSecretString("secret-1", "value-1", "owner-1")).To(gomega.Succeed())
+	g.Expect(svc.SetSecretString("nested/secret-2", "value-2", "owner-2")).To(gomega.Succeed())
+	g.Expect(svc.SetSecretString("secret-3", "value-3", "")).To(gomega.Succeed())
+	g.Expect(standIn.secrets).To(gomega.HaveKey("managed-connectors/nested/secret-2"))
+
+	secrets := map[string]string{}
+	g.Expect(svc.ForEachSecret(func(name string, owningResource string) bool {
+		secrets[name] = owningResource
+		return true
+	})).To(gomega.Succeed())
+	g.Expect(secrets).To(gomega.Equal(map[string]string{
+		"secret-1":        "owner-1",
+		"nested/secret-2": "owner-2",
+		"secret-3":        "",
+	}))
+
+	count := 0
+	g.Expect(svc.ForEachSecret(func(name string, owningResource string) bool {
+		count++
+		return false
+	})).To(gomega.Succeed())
+	g.Expect(count).To(gomega.Equal(1))
+
+	// names returned by ForEachSecret can be passed back to the other operations
+	g.Expect(svc.DeleteSecretString("nested/secret-2")).To(gomega.Succeed())
+	_, err = svc.GetSecretString("nested/secret-2")
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(NotFound.Error())))
+}
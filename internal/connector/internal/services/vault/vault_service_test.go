@@ -27,6 +27,17 @@ func TestNewVaultService(t *testing.T) {
 	}
 	g.Expect(vc.ReadFiles()).To(gomega.BeNil())
 
+	// Test against a HashiCorp vault if configured, e.g. one started with `vault server -dev`, or against a stand-in..
+	standIn := vault.NewHashicorpStandIn()
+	defer standIn.Close()
+	hashicorpConfig := vault.HashicorpStandInConfig(standIn)
+	if address, token := os.Getenv("VAULT_ADDR"), os.Getenv("VAULT_TOKEN"); address != "" && token != "" {
+		hashicorpConfig.HashicorpAddress = address
+		hashicorpConfig.HashicorpToken = token
+	}
+	hashicorpPrefixConfig := *hashicorpConfig
+	hashicorpPrefixConfig.SecretPrefixEnable = true
+
 	tests := []struct {
 		config       *vault.Config
 		wantErrOnNew bool
@@ -61,6 +72,14 @@ func TestNewVaultService(t *testing.T) {
 			skip: vc.Kind != vault.KindAws,
 			name: vault.KindAws + "-with-prefix",
 		},
+		{
+			config: hashicorpConfig,
+			name:   vault.KindHashicorp + "-no-prefix",
+		},
+		{
+			config: &hashicorpPrefixConfig,
+			name:   vault.KindHashicorp + "-with-prefix",
+		},
 		{
 			config:       &vault.Config{Kind: "wrong"},
 			wantErrOnNew: true,
@@ -73,7 +92,7 @@ func TestNewVaultService(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
 
-			svc, err := vault.NewVaultService(tt.config)
+			svc, err := vault.NewVaultService(tt.config, nil)
 			g.Expect(err != nil).Should(gomega.Equal(tt.wantErrOnNew), "NewVaultService() error = %v, wantErr %v", err, tt.wantErrOnNew)
 			if err == nil {
 				if tt.skip {
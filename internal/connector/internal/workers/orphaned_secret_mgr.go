@@ -0,0 +1,171 @@
+package workers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const orphanedSecretBatchSize = 100
+
+// OrphanedSecretManager represents a manager that periodically deletes the vault secrets whose owning resource
+// no longer exists, e.g. the secrets of failed connector creates
+type OrphanedSecretManager struct {
+	workers.BaseWorker
+	vaultService vault.VaultService
+	vaultConfig  *vault.Config
+	db           *db.ConnectionFactory
+	lastScan     time.Time
+	// time at which each orphaned secret was first found, secrets are deleted once orphaned for the grace period
+	orphanedSince map[string]time.Time
+}
+
+// NewOrphanedSecretManager creates a new orphaned secret manager
+func NewOrphanedSecretManager(
+	vaultService vault.VaultService,
+	vaultConfig *vault.Config,
+	db *db.ConnectionFactory,
+	reconciler workers.Reconciler,
+) *OrphanedSecretManager {
+	return &OrphanedSecretManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "connector_orphaned_secret",
+			Reconciler: reconciler,
+		},
+		vaultService:  vaultService,
+		vaultConfig:   vaultConfig,
+		db:            db,
+		orphanedSince: map[string]time.Time{},
+	}
+}
+
+// Start initializes the orphaned secret manager to delete orphaned secrets
+func (k *OrphanedSecretManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop causes the process for deleting orphaned secrets to stop.
+func (k *OrphanedSecretManager) Stop() {
+	k.StopWorker(k)
+	// the next leader starts the grace periods over
+	k.lastScan = time.Time{}
+	k.orphanedSince = map[string]time.Time{}
+	metrics.ResetMetricsForVaultOrphanedSecrets()
+}
+
+func (k *OrphanedSecretManager) Reconcile() []error {
+	if !k.vaultConfig.OrphanedSecretsGCEnabled {
+		return nil
+	}
+	now := time.Now()
+	if now.Sub(k.lastScan) < k.vaultConfig.OrphanedSecretsGCInterval {
+		return nil
+	}
+
+	glog.V(5).Infoln("Reconciling orphaned vault secrets...")
+	errs := k.reconcileOrphanedSecrets(now)
+	if len(errs) == 0 {
+		k.lastScan = now
+	}
+	return errs
+}
+
+func (k *OrphanedSecretManager) reconcileOrphanedSecrets(now time.Time) []error {
+	// secrets are collected first, so that the vault is not modified while it is being listed
+	owners := map[string]string{}
+	if err := k.vaultService.ForEachSecret(func(name string, owningResource string) bool {
+		owners[name] = owningResource
+		return true
+	}); err != nil {
+		return []error{errors.Wrap(err, "failed to list vault secrets")}
+	}
+
+	orphaned, err := k.findOrphanedSecrets(owners)
+	if err != nil {
+		return []error{err}
+	}
+	metrics.UpdateVaultOrphanedSecretsCount(len(orphaned))
+
+	var errs []error
+	orphanedSince := make(map[string]time.Time, len(orphaned))
+	for _, name := range orphaned {
+		since, found := k.orphanedSince[name]
+		if !found {
+			since = now
+		}
+		if now.Sub(since) < k.vaultConfig.OrphanedSecretsGCGracePeriod {
+			orphanedSince[name] = since
+			continue
+		}
+
+		glog.Infof("deleting vault secret %s of deleted resource %s", name, owners[name])
+		if err := k.vaultService.DeleteSecretString(name); err != nil && !errors.Is(err, vault.NotFound) {
+			errs = append(errs, errors.Wrapf(err, "failed to delete orphaned vault secret %s", name))
+			orphanedSince[name] = since
+			continue
+		}
+		metrics.IncreaseVaultOrphanedSecretsDeletedCount()
+	}
+	// forget the secrets that have been deleted or whose owning resource has been found
+	k.orphanedSince = orphanedSince
+
+	return errs
+}
+
+// findOrphanedSecrets returns the secrets owned by connectors that no longer exist. Secrets without a connector as
+// owning resource are not managed by the fleet manager and are never orphaned.
+func (k *OrphanedSecretManager) findOrphanedSecrets(owners map[string]string) ([]string, error) {
+	secretsByConnector := map[string][]string{}
+	for name, owner := range owners {
+		if strings.HasPrefix(owner, vault.ConnectorOwningResourcePrefix) {
+			id := strings.TrimPrefix(owner, vault.ConnectorOwningResourcePrefix)
+			secretsByConnector[id] = append(secretsByConnector[id], name)
+		}
+	}
+
+	existing, err := k.findExistingIDs(&dbapi.Connector{}, secretsByConnector)
+	if err != nil {
+		return nil, err
+	}
+	var orphaned []string
+	for id, names := range secretsByConnector {
+		if !existing[id] {
+			orphaned = append(orphaned, names...)
+		}
+	}
+	return orphaned, nil
+}
+
+// findExistingIDs returns the ids of the resources that have not been deleted
+func (k *OrphanedSecretManager) findExistingIDs(model interface{}, secrets map[string][]string) (map[string]bool, error) {
+	ids := make([]string, 0, len(secrets))
+	for id := range secrets {
+		ids = append(ids, id)
+	}
+
+	existing := map[string]bool{}
+	for start := 0; start < len(ids); start += orphanedSecretBatchSize {
+		end := start + orphanedSecretBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		var found []string
+		if err := k.db.New().Model(model).Where("id IN ?", ids[start:end]).Pluck("id", &found).Error; err != nil {
+			return nil, errors.Wrap(err, "failed to find the owning resources of vault secrets")
+		}
+		for _, id := range found {
+			existing[id] = true
+		}
+	}
+	return existing, nil
+}
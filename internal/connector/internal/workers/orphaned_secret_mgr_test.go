@@ -0,0 +1,83 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func TestOrphanedSecretManager_reconcileOrphanedSecrets(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	vaultService, err := vault.NewTmpVaultService()
+	g.Expect(err).To(gomega.BeNil())
+	secrets := map[string]string{
+		"connector-secret":         vault.ConnectorOwningResourcePrefix + "connector-1",
+		"deleted-connector-secret": vault.ConnectorOwningResourcePrefix + "connector-2",
+		"unowned-secret":           "",
+		"other-secret":             "/v1/other/other-1",
+	}
+	for name, owner := range secrets {
+		g.Expect(vaultService.SetSecretString(name, "value", owner)).To(gomega.Succeed())
+	}
+
+	vaultConfig := vault.NewConfig()
+	vaultConfig.OrphanedSecretsGCEnabled = true
+	m := NewOrphanedSecretManager(vaultService, vaultConfig, db.NewMockConnectionFactory(nil), workers.Reconciler{})
+
+	mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "id" FROM "connectors"`).
+		WithReply([]map[string]interface{}{{"id": "connector-1"}})
+
+	listSecrets := func() []string {
+		var names []string
+		_ = vaultService.ForEachSecret(func(name string, owningResource string) bool {
+			names = append(names, name)
+			return true
+		})
+		return names
+	}
+
+	// orphaned secrets are kept during the grace period
+	now := time.Now()
+	g.Expect(m.reconcileOrphanedSecrets(now)).To(gomega.BeEmpty())
+	g.Expect(listSecrets()).To(gomega.HaveLen(4))
+	g.Expect(m.orphanedSince).To(gomega.HaveLen(1))
+
+	g.Expect(m.reconcileOrphanedSecrets(now.Add(vaultConfig.OrphanedSecretsGCGracePeriod / 2))).To(gomega.BeEmpty())
+	g.Expect(listSecrets()).To(gomega.HaveLen(4))
+
+	// and deleted once the grace period has elapsed
+	g.Expect(m.reconcileOrphanedSecrets(now.Add(vaultConfig.OrphanedSecretsGCGracePeriod))).To(gomega.BeEmpty())
+	g.Expect(listSecrets()).To(gomega.ConsistOf("connector-secret", "unowned-secret", "other-secret"))
+	g.Expect(m.orphanedSince).To(gomega.BeEmpty())
+}
+
+func TestOrphanedSecretManager_Reconcile(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	vaultService, err := vault.NewTmpVaultService()
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(vaultService.SetSecretString("secret", "value", vault.ConnectorOwningResourcePrefix+"connector-1")).To(gomega.Succeed())
+	mocket.Catcher.Reset()
+
+	vaultConfig := vault.NewConfig()
+	m := NewOrphanedSecretManager(vaultService, vaultConfig, db.NewMockConnectionFactory(nil), workers.Reconciler{})
+
+	// disabled by default
+	g.Expect(m.Reconcile()).To(gomega.BeEmpty())
+	g.Expect(m.orphanedSince).To(gomega.BeEmpty())
+
+	vaultConfig.OrphanedSecretsGCEnabled = true
+	g.Expect(m.Reconcile()).To(gomega.BeEmpty())
+	g.Expect(m.orphanedSince).To(gomega.HaveKey("secret"))
+
+	// the vault is not scanned again before the interval has elapsed
+	m.orphanedSince = map[string]time.Time{}
+	g.Expect(m.Reconcile()).To(gomega.BeEmpty())
+	g.Expect(m.orphanedSince).To(gomega.BeEmpty())
+}
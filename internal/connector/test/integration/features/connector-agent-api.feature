@@ -797,6 +797,15 @@ Feature: connector agent API
     And the ".status.state" selection from the response should match "ready"
     And the ".status.error" selection from the response should match "null"
 
+    # the deployment phase changes reported by the agent are recorded in the connector events, most recent first
+    When I GET path "/v1/kafka_connectors/${connector_id}/events"
+    Then the response code should be 200
+    And the ".kind" selection from the response should match "ConnectorEventList"
+    And the ".items[0].type" selection from the response should match "deployment_status"
+    And the ".items[0].state" selection from the response should match "ready"
+    And the ".items[0].actor" selection from the response should match "${connector_cluster_id}"
+    And the ".items[1].state" selection from the response should match "failed"
+
     #-----------------------------------------------------------------------------------------------------------------
     # In this part of the Scenario we test whether agent gets deployments even when missing secrets in the vault
     #-----------------------------------------------------------------------------------------------------------------
@@ -1251,6 +1260,15 @@ Feature: connector agent API
     Then the response code should be 200
     And the ".desired_state" selection from the response should match "ready"
 
+    # restarting a stopped connector is recorded as a restart operation
+    When I GET path "/v1/admin/kafka_connectors/${connector_id}/events"
+    Then the response code should be 200
+    And the ".kind" selection from the response should match "ConnectorEventList"
+    And the ".items[0].type" selection from the response should match "operation"
+    And the ".items[0].operation" selection from the response should match "restart"
+    And the ".items[0].desired_state" selection from the response should match "ready"
+    And the ".items[1].operation" selection from the response should match "stop"
+
     When I PATCH path "/v1/admin/kafka_connectors/${connector_id}" with json body:
         """
         {
@@ -37,6 +37,12 @@ const (
 	KafkaOperationDelete KafkaOperation = "delete"
 	// KafkaOperationDeprovision = Kafka cluster deprovision operations
 	KafkaOperationDeprovision KafkaOperation = "deprovision"
+	// KafkaOperationSuspend = Kafka cluster suspend operations
+	KafkaOperationSuspend KafkaOperation = "suspend"
+	// KafkaOperationResume = Kafka cluster resume operations
+	KafkaOperationResume KafkaOperation = "resume"
+	// KafkaOperationResize = Kafka cluster resize operations
+	KafkaOperationResize KafkaOperation = "resize"
 
 	// ObservabilityCanaryPodLabelKey that will be used by the observability operator to scrap metrics
 	ObservabilityCanaryPodLabelKey = "managed-kafka-canary"
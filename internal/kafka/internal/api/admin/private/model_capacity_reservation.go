@@ -0,0 +1,34 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// CapacityReservation struct for CapacityReservation
+type CapacityReservation struct {
+	Id             string    `json:"id"`
+	Kind           string    `json:"kind"`
+	Href           string    `json:"href"`
+	OrganisationId string    `json:"organisation_id"`
+	CloudProvider  string    `json:"cloud_provider"`
+	Region         string    `json:"region"`
+	InstanceType   string    `json:"instance_type"`
+	StreamingUnits int32     `json:"streaming_units"`
+	ExpiresAt      time.Time `json:"expires_at"`
+	// Streaming units consumed by the Kafka instances of the organisation in the region for the instance type. They are shared by all the reservations of the organisation for that region and instance type
+	ConsumedStreamingUnits int32 `json:"consumed_streaming_units"`
+	// boolean value indicating whether the reservation has expired and no longer reserves any streaming unit
+	Expired   bool      `json:"expired"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,28 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// CapacityReservationRequest struct for CapacityReservationRequest
+type CapacityReservationRequest struct {
+	// Organisation the streaming units are reserved for
+	OrganisationId string `json:"organisation_id"`
+	CloudProvider  string `json:"cloud_provider"`
+	Region         string `json:"region"`
+	InstanceType   string `json:"instance_type"`
+	// Number of streaming units reserved
+	StreamingUnits int32 `json:"streaming_units"`
+	// Time after which the streaming units are no longer reserved
+	ExpiresAt time.Time `json:"expires_at"`
+}
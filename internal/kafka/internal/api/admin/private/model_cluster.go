@@ -0,0 +1,49 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// Cluster struct for Cluster
+type Cluster struct {
+	Id            string `json:"id"`
+	Kind          string `json:"kind"`
+	Href          string `json:"href"`
+	ClusterId     string `json:"cluster_id"`
+	CloudProvider string `json:"cloud_provider,omitempty"`
+	Region        string `json:"region,omitempty"`
+	MultiAz       bool   `json:"multi_az,omitempty"`
+	Status        string `json:"status"`
+	// Values: [ocm, aws_eks, standalone]
+	ProviderType   string `json:"provider_type,omitempty"`
+	ClusterType    string `json:"cluster_type,omitempty"`
+	OrganizationId string `json:"organization_id,omitempty"`
+	ClusterDns     string `json:"cluster_dns,omitempty"`
+	// Comma separated list of the instance types that can be provisioned on the cluster
+	SupportedInstanceType string `json:"supported_instance_type,omitempty"`
+	// Whether the cluster has been cordoned or is being drained. New Kafka instances are not placed on unschedulable clusters
+	Unschedulable bool `json:"unschedulable"`
+	// Time of the last status report received from the kas fleetshard operator of the cluster
+	StatusReportedAt time.Time `json:"status_reported_at,omitempty"`
+	// Why the cluster has been quarantined. Only set when the status of the cluster is quarantined
+	QuarantineReason string `json:"quarantine_reason,omitempty"`
+	// Kafka instance count of the cluster, each instance weighted by the capacity it consumes. Kafka instances being deleted are not counted
+	KafkaCount          int32                        `json:"kafka_count"`
+	DynamicCapacityInfo []ClusterDynamicCapacityInfo `json:"dynamic_capacity_info,omitempty"`
+	// Labels of the cluster. Kafka instances having the same placement attributes are preferably placed on the cluster
+	Labels map[string]string `json:"labels,omitempty"`
+	// Taints of the cluster. Only Kafka instances having all these placement attributes can be placed on the cluster
+	Taints    []ClusterTaint `json:"taints,omitempty"`
+	CreatedAt time.Time      `json:"created_at,omitempty"`
+	UpdatedAt time.Time      `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,20 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterCapacityConsumption struct for ClusterCapacityConsumption
+type ClusterCapacityConsumption struct {
+	MaxStreamingUnits      int32 `json:"max_streaming_units"`
+	ConsumedStreamingUnits int32 `json:"consumed_streaming_units"`
+	FreeStreamingUnits     int32 `json:"free_streaming_units"`
+	// Whether a data plane cluster supporting the instance type is being created in the region
+	OngoingScaleUp bool `json:"ongoing_scale_up"`
+}
@@ -0,0 +1,23 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterCapacitySimulation struct for ClusterCapacitySimulation
+type ClusterCapacitySimulation struct {
+	Kind       string                               `json:"kind"`
+	Placements []ClusterCapacitySimulationPlacement `json:"placements"`
+	// Kafka instances that could not be placed on any data plane cluster
+	Rejections []ClusterCapacitySimulationRejection `json:"rejections"`
+	// Data plane cluster scale ups triggered by the dynamic scaling. Empty when dynamic scaling is disabled
+	ScaleUps          []ClusterCapacitySimulationScaleUp `json:"scale_ups"`
+	ConsumptionBefore ClusterCapacityConsumption         `json:"consumption_before"`
+	ConsumptionAfter  ClusterCapacityConsumption         `json:"consumption_after"`
+}
@@ -0,0 +1,18 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterCapacitySimulationRejection struct for ClusterCapacitySimulationRejection
+type ClusterCapacitySimulationRejection struct {
+	// Position of the Kafka instance in the simulation, starting from 1
+	Kafka  int32  `json:"kafka"`
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,22 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterCapacitySimulationRequest struct for ClusterCapacitySimulationRequest
+type ClusterCapacitySimulationRequest struct {
+	CloudProvider string `json:"cloud_provider"`
+	Region        string `json:"region"`
+	InstanceType  string `json:"instance_type"`
+	SizeId        string `json:"size_id"`
+	// Number of Kafka instances arriving one after the other. Between 1 and 1000
+	Count          int32  `json:"count"`
+	OrganisationId string `json:"organisation_id,omitempty"`
+}
@@ -0,0 +1,20 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterCapacitySimulationScaleUp struct for ClusterCapacitySimulationScaleUp
+type ClusterCapacitySimulationScaleUp struct {
+	// Position of the last Kafka instance handled before the scale up is triggered. 0 when it is triggered before the first Kafka instance arrives
+	AfterKafka    int32  `json:"after_kafka"`
+	CloudProvider string `json:"cloud_provider"`
+	Region        string `json:"region"`
+	InstanceType  string `json:"instance_type"`
+}
@@ -0,0 +1,34 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ClusterDrain struct for ClusterDrain
+type ClusterDrain struct {
+	Id        string `json:"id"`
+	Kind      string `json:"kind"`
+	Href      string `json:"href"`
+	ClusterId string `json:"cluster_id"`
+	// Values: [in_progress, failed, completed]
+	Status string `json:"status"`
+	// Reason of the latest status change, e.g. the migration that failed when the drain was stopped
+	StatusReason        string               `json:"status_reason,omitempty"`
+	BatchSize           int32                `json:"batch_size"`
+	StallTimeoutMinutes int32                `json:"stall_timeout_minutes"`
+	AllowDataLoss       bool                 `json:"allow_data_loss"`
+	CreatedAt           time.Time            `json:"created_at,omitempty"`
+	UpdatedAt           time.Time            `json:"updated_at,omitempty"`
+	Progress            ClusterDrainProgress `json:"progress"`
+	Kafkas              []ClusterDrainKafka  `json:"kafkas,omitempty"`
+}
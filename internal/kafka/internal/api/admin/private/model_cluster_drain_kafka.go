@@ -0,0 +1,27 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ClusterDrainKafka struct for ClusterDrainKafka
+type ClusterDrainKafka struct {
+	KafkaId string `json:"kafka_id"`
+	// Values: [pending, migrating, completed, failed, skipped]
+	Status string `json:"status"`
+	// ID of the data plane cluster the Kafka instance is migrated to
+	TargetClusterId string    `json:"target_cluster_id,omitempty"`
+	FailedReason    string    `json:"failed_reason,omitempty"`
+	StartedAt       time.Time `json:"started_at,omitempty"`
+	FinishedAt      time.Time `json:"finished_at,omitempty"`
+}
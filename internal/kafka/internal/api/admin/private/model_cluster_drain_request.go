@@ -0,0 +1,21 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterDrainRequest struct for ClusterDrainRequest
+type ClusterDrainRequest struct {
+	// Maximum number of Kafka instances being migrated at the same time. Defaults to 5
+	BatchSize int32 `json:"batch_size,omitempty"`
+	// Time after which the migration of a Kafka instance is considered stalled and the drain is stopped. Defaults to 60
+	StallTimeoutMinutes int32 `json:"stall_timeout_minutes,omitempty"`
+	// Whether the Kafka instances holding data are migrated. Their data is not migrated: they are provisioned again, empty, on their new data plane cluster. Defaults to false
+	AllowDataLoss bool `json:"allow_data_loss,omitempty"`
+}
@@ -0,0 +1,22 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterDynamicCapacityInfo Dynamic scaling capacity of a data plane cluster for an instance type
+type ClusterDynamicCapacityInfo struct {
+	InstanceType string `json:"instance_type"`
+	// Maximum number of worker nodes of the machine pool of the instance type
+	MaxNodes int32 `json:"max_nodes"`
+	// Maximum number of streaming units fitting into the maximum number of worker nodes
+	MaxUnits int32 `json:"max_units"`
+	// Remaining number of streaming units that can be placed into the machine pool
+	RemainingUnits int32 `json:"remaining_units"`
+}
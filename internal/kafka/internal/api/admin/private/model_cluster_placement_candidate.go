@@ -0,0 +1,22 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterPlacementCandidate struct for ClusterPlacementCandidate
+type ClusterPlacementCandidate struct {
+	ClusterId string `json:"cluster_id"`
+	// Whether the Kafka instance can be placed on the cluster
+	Eligible bool  `json:"eligible"`
+	Score    int32 `json:"score"`
+	// Streaming units left on the cluster once the Kafka instance is placed on it. Not set when the capacity of the cluster is unlimited
+	RemainingStreamingUnits *int32   `json:"remaining_streaming_units,omitempty"`
+	Reasons                 []string `json:"reasons"`
+}
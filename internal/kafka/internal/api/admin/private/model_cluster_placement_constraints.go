@@ -0,0 +1,17 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterPlacementConstraints struct for ClusterPlacementConstraints
+type ClusterPlacementConstraints struct {
+	Labels map[string]string `json:"labels,omitempty"`
+	Taints []ClusterTaint    `json:"taints,omitempty"`
+}
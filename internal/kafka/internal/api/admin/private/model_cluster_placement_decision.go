@@ -0,0 +1,20 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterPlacementDecision struct for ClusterPlacementDecision
+type ClusterPlacementDecision struct {
+	Kind string `json:"kind"`
+	// Cluster the Kafka instance would be placed on. Not set when no cluster is eligible
+	ClusterId string `json:"cluster_id,omitempty"`
+	// Clusters considered for the placement, highest score first
+	Candidates []ClusterPlacementCandidate `json:"candidates"`
+}
@@ -0,0 +1,21 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterPlacementRequest struct for ClusterPlacementRequest
+type ClusterPlacementRequest struct {
+	CloudProvider  string `json:"cloud_provider"`
+	Region         string `json:"region"`
+	MultiAz        bool   `json:"multi_az,omitempty"`
+	InstanceType   string `json:"instance_type"`
+	SizeId         string `json:"size_id"`
+	OrganisationId string `json:"organisation_id,omitempty"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterStatusUpdateRequest struct for ClusterStatusUpdateRequest
+type ClusterStatusUpdateRequest struct {
+	// Values: [cluster_provisioned, waiting_for_kas_fleetshard_operator, ready, failed, deprovisioning]
+	Status string `json:"status"`
+}
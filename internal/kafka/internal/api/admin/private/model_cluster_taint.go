@@ -0,0 +1,17 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterTaint Repels from the cluster the Kafka instances whose placement attribute named key does not have the given value. Supported keys: [organisation_id, instance_type]
+type ClusterTaint struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
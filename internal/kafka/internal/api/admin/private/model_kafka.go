@@ -56,4 +56,13 @@ type Kafka struct {
 	Namespace                  string                           `json:"namespace,omitempty"`
 	SizeId                     string                           `json:"size_id,omitempty"`
 	MaxDataRetentionSize       SupportedKafkaSizeBytesValueItem `json:"max_data_retention_size,omitempty"`
+	// Kafka version waiting for the maintenance window of the Kafka instance to be rolled out
+	PendingKafkaVersion string `json:"pending_kafka_version,omitempty"`
+	// Strimzi version waiting for the maintenance window of the Kafka instance to be rolled out
+	PendingStrimziVersion string `json:"pending_strimzi_version,omitempty"`
+	// Kafka IBP version waiting for the maintenance window of the Kafka instance to be rolled out
+	PendingKafkaIbpVersion string            `json:"pending_kafka_ibp_version,omitempty"`
+	MaintenanceWindow      MaintenanceWindow `json:"maintenance_window,omitempty"`
+	// Whether the Kafka instance is protected against deletion
+	DeletionProtection bool `json:"deletion_protection,omitempty"`
 }
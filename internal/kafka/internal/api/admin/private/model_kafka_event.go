@@ -0,0 +1,35 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// KafkaEvent A change of a Kafka instance
+type KafkaEvent struct {
+	Id      string `json:"id"`
+	Kind    string `json:"kind"`
+	KafkaId string `json:"kafka_id"`
+	// Values: [status_change, upgrade_requested, upgrade_completed, placement_change, resize]
+	EventType string `json:"event_type"`
+	// The field of the Kafka instance that changed
+	Field string `json:"field"`
+	// The value of the field before the change
+	PreviousValue string `json:"previous_value,omitempty"`
+	// The value of the field after the change
+	Value string `json:"value,omitempty"`
+	// The data plane cluster the Kafka instance was assigned to when the change happened
+	ClusterId string `json:"cluster_id,omitempty"`
+	// A human readable description of the change
+	Message   string    `json:"message,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
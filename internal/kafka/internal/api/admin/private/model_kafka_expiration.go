@@ -0,0 +1,24 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// KafkaExpiration The expiration of a Kafka instance
+type KafkaExpiration struct {
+	Id string `json:"id"`
+	// The time at which the Kafka instance expires. Not set when the Kafka instance does not expire
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// The number of seconds left before the Kafka instance expires. Not set when the Kafka instance does not expire
+	RemainingSeconds *int64 `json:"remaining_seconds,omitempty"`
+}
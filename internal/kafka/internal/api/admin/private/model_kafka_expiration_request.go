@@ -0,0 +1,21 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// KafkaExpirationRequest struct for KafkaExpirationRequest
+type KafkaExpirationRequest struct {
+	// The new time at which the Kafka instance expires
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,31 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// KafkaPendingUpgrade struct for KafkaPendingUpgrade
+type KafkaPendingUpgrade struct {
+	Id                     string            `json:"id"`
+	OrganisationId         string            `json:"organisation_id,omitempty"`
+	Status                 string            `json:"status,omitempty"`
+	DesiredKafkaVersion    string            `json:"desired_kafka_version,omitempty"`
+	DesiredStrimziVersion  string            `json:"desired_strimzi_version,omitempty"`
+	DesiredKafkaIbpVersion string            `json:"desired_kafka_ibp_version,omitempty"`
+	PendingKafkaVersion    string            `json:"pending_kafka_version,omitempty"`
+	PendingStrimziVersion  string            `json:"pending_strimzi_version,omitempty"`
+	PendingKafkaIbpVersion string            `json:"pending_kafka_ibp_version,omitempty"`
+	MaintenanceWindow      MaintenanceWindow `json:"maintenance_window,omitempty"`
+	// Time at which the pending upgrades are expected to be rolled out
+	ScheduledAt time.Time `json:"scheduled_at,omitempty"`
+}
@@ -22,4 +22,6 @@ type KafkaUpdateRequest struct {
 	MaxDataRetentionSize string `json:"max_data_retention_size,omitempty"`
 	// boolean value indicating whether kafka should be suspended or not depending on the value provided. Suspended kafkas have their certain resources removed and become inaccessible until fully unsuspended (restored to Ready state).
 	Suspended *bool `json:"suspended,omitempty"`
+	// boolean value indicating whether the requested versions and the pending versions of the Kafka instance should be rolled out right away, regardless of its maintenance window
+	ForceUpgrade *bool `json:"force_upgrade,omitempty"`
 }
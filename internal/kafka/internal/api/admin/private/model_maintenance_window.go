@@ -0,0 +1,21 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// MaintenanceWindow Weekly recurring period of time during which the upgrades of a Kafka instance are rolled out
+type MaintenanceWindow struct {
+	// Day of the week the maintenance window starts on. Values: [sunday, monday, tuesday, wednesday, thursday, friday, saturday]
+	DayOfWeek string `json:"day_of_week"`
+	// Hour of the day, in UTC, the maintenance window starts at. Values are between 0 and 23
+	StartHour int32 `json:"start_hour"`
+	// Duration of the maintenance window in hours. Values are between 1 and 24
+	DurationHours int32 `json:"duration_hours"`
+}
@@ -0,0 +1,40 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// UpgradeCampaign struct for UpgradeCampaign
+type UpgradeCampaign struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	Href string `json:"href"`
+	Name string `json:"name,omitempty"`
+	// Values: [in_progress, paused, completed, cancelled]
+	Status string `json:"status"`
+	// Reason of the latest status change, e.g. the upgrade that failed when the campaign was paused automatically
+	StatusReason             string                  `json:"status_reason,omitempty"`
+	Filter                   UpgradeCampaignFilter   `json:"filter,omitempty"`
+	KafkaVersion             string                  `json:"kafka_version"`
+	StrimziVersion           string                  `json:"strimzi_version,omitempty"`
+	KafkaIbpVersion          string                  `json:"kafka_ibp_version,omitempty"`
+	BatchSize                int32                   `json:"batch_size"`
+	MaxConcurrentUpgrades    int32                   `json:"max_concurrent_upgrades"`
+	StallTimeoutMinutes      int32                   `json:"stall_timeout_minutes"`
+	IgnoreMaintenanceWindows bool                    `json:"ignore_maintenance_windows"`
+	CreatedAt                time.Time               `json:"created_at,omitempty"`
+	UpdatedAt                time.Time               `json:"updated_at,omitempty"`
+	Progress                 UpgradeCampaignProgress `json:"progress"`
+	// Kafka instances targeted by the campaign. Only returned when getting a single campaign
+	Kafkas []UpgradeCampaignKafka `json:"kafkas,omitempty"`
+}
@@ -0,0 +1,20 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// UpgradeCampaignFilter Selects the Kafka instances targeted by an upgrade campaign. Empty fields match all the Kafka instances
+type UpgradeCampaignFilter struct {
+	InstanceType string `json:"instance_type,omitempty"`
+	Region       string `json:"region,omitempty"`
+	ClusterId    string `json:"cluster_id,omitempty"`
+	// Kafka version currently run by the targeted Kafka instances
+	ActualKafkaVersion string `json:"actual_kafka_version,omitempty"`
+}
@@ -0,0 +1,25 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// UpgradeCampaignKafka struct for UpgradeCampaignKafka
+type UpgradeCampaignKafka struct {
+	KafkaId string `json:"kafka_id"`
+	// Values: [pending, upgrading, completed, failed, skipped]
+	Status       string    `json:"status"`
+	FailedReason string    `json:"failed_reason,omitempty"`
+	StartedAt    time.Time `json:"started_at,omitempty"`
+	FinishedAt   time.Time `json:"finished_at,omitempty"`
+}
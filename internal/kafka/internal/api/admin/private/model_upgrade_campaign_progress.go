@@ -0,0 +1,21 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// UpgradeCampaignProgress struct for UpgradeCampaignProgress
+type UpgradeCampaignProgress struct {
+	Total     int32 `json:"total"`
+	Pending   int32 `json:"pending"`
+	Upgrading int32 `json:"upgrading"`
+	Completed int32 `json:"completed"`
+	Failed    int32 `json:"failed"`
+	Skipped   int32 `json:"skipped"`
+}
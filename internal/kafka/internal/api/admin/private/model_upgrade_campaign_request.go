@@ -0,0 +1,31 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// UpgradeCampaignRequest struct for UpgradeCampaignRequest
+type UpgradeCampaignRequest struct {
+	Name   string                `json:"name,omitempty"`
+	Filter UpgradeCampaignFilter `json:"filter,omitempty"`
+	// Kafka version the targeted Kafka instances are upgraded to
+	KafkaVersion string `json:"kafka_version"`
+	// Strimzi version the targeted Kafka instances are upgraded to. The current Strimzi version of each Kafka instance is kept if not provided
+	StrimziVersion string `json:"strimzi_version,omitempty"`
+	// Kafka IBP version the targeted Kafka instances are upgraded to. The current Kafka IBP version of each Kafka instance is kept if not provided
+	KafkaIbpVersion string `json:"kafka_ibp_version,omitempty"`
+	// Number of Kafka instances whose upgrade is started at each run of the campaign. Defaults to 10
+	BatchSize int32 `json:"batch_size,omitempty"`
+	// Maximum number of Kafka instances being upgraded at the same time. Defaults to 10
+	MaxConcurrentUpgrades int32 `json:"max_concurrent_upgrades,omitempty"`
+	// Time after which the upgrade of a Kafka instance is considered stalled and the campaign is paused. Defaults to 60
+	StallTimeoutMinutes int32 `json:"stall_timeout_minutes,omitempty"`
+	// boolean value indicating whether the Kafka instances should be upgraded regardless of their maintenance window
+	IgnoreMaintenanceWindows bool `json:"ignore_maintenance_windows,omitempty"`
+}
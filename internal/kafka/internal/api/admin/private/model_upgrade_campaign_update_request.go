@@ -0,0 +1,17 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// UpgradeCampaignUpdateRequest struct for UpgradeCampaignUpdateRequest
+type UpgradeCampaignUpdateRequest struct {
+	// Status the upgrade campaign is moved to. Values: [in_progress, paused, cancelled]
+	Status string `json:"status"`
+}
@@ -0,0 +1,35 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+// CapacityReservation guarantees an organisation that its kafkas of the given instance type can consume up to the given
+// streaming units in a region until the reservation expires. The streaming units reserved and not consumed yet by the
+// kafkas of the organisation cannot be used by the kafkas of other organisations.
+type CapacityReservation struct {
+	api.Meta
+	OrganisationId string    `json:"organisation_id" gorm:"index"`
+	CloudProvider  string    `json:"cloud_provider"`
+	Region         string    `json:"region"`
+	InstanceType   string    `json:"instance_type"`
+	StreamingUnits int       `json:"streaming_units"`
+	ExpiresAt      time.Time `json:"expires_at" gorm:"index"`
+}
+
+type CapacityReservationList []*CapacityReservation
+
+func (c *CapacityReservation) BeforeCreate(scope *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = api.NewID()
+	}
+	return nil
+}
+
+// IsExpired returns true if the reservation no longer guarantees any capacity at the given time
+func (c *CapacityReservation) IsExpired(now time.Time) bool {
+	return !c.ExpiresAt.After(now)
+}
@@ -0,0 +1,96 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+type ClusterDrainStatus string
+
+const (
+	// ClusterDrainStatusInProgress - drain whose kafkas are being migrated to other data plane clusters in batches
+	ClusterDrainStatusInProgress ClusterDrainStatus = "in_progress"
+	// ClusterDrainStatusFailed - drain that was stopped because the migration of one of its kafkas failed or stalled.
+	// The data plane cluster is left unschedulable.
+	ClusterDrainStatusFailed ClusterDrainStatus = "failed"
+	// ClusterDrainStatusCompleted - drain whose data plane cluster is empty and has been handed over for deprovisioning
+	ClusterDrainStatusCompleted ClusterDrainStatus = "completed"
+)
+
+func (s ClusterDrainStatus) String() string {
+	return string(s)
+}
+
+type ClusterDrainKafkaStatus string
+
+const (
+	// ClusterDrainKafkaStatusPending - kafka waiting for its batch to be migrated
+	ClusterDrainKafkaStatusPending ClusterDrainKafkaStatus = "pending"
+	// ClusterDrainKafkaStatusMigrating - kafka that has been assigned to its target data plane cluster and is being provisioned on it
+	ClusterDrainKafkaStatusMigrating ClusterDrainKafkaStatus = "migrating"
+	// ClusterDrainKafkaStatusCompleted - kafka that is no longer running on the drained data plane cluster
+	ClusterDrainKafkaStatusCompleted ClusterDrainKafkaStatus = "completed"
+	// ClusterDrainKafkaStatusFailed - kafka whose migration failed or stalled
+	ClusterDrainKafkaStatusFailed ClusterDrainKafkaStatus = "failed"
+	// ClusterDrainKafkaStatusSkipped - kafka that was not migrated e.g. because it was deleted or because it is in failed status
+	ClusterDrainKafkaStatusSkipped ClusterDrainKafkaStatus = "skipped"
+)
+
+func (s ClusterDrainKafkaStatus) String() string {
+	return string(s)
+}
+
+// ClusterDrain migrates the kafkas of an unschedulable data plane cluster to other data plane clusters in batches
+// and hands the cluster over for deprovisioning once it is empty
+type ClusterDrain struct {
+	api.Meta
+	ClusterID           string             `json:"cluster_id" gorm:"index"`
+	Status              ClusterDrainStatus `json:"status" gorm:"index"`
+	StatusReason        string             `json:"status_reason"`
+	BatchSize           int                `json:"batch_size"`
+	StallTimeoutMinutes int                `json:"stall_timeout_minutes"`
+	// TargetClusterID is the data plane cluster the kafkas are preferably migrated to. The placement strategy is used
+	// when it is empty or when the target cluster is no longer ready
+	TargetClusterID string `json:"target_cluster_id"`
+	// AllowDataLoss allows the migration of the kafkas holding data. Their data is not migrated: they are provisioned
+	// again, empty, on their new data plane cluster
+	AllowDataLoss bool                `json:"allow_data_loss"`
+	Kafkas        []ClusterDrainKafka `json:"kafkas" gorm:"foreignKey:ClusterDrainID;references:ID"`
+}
+
+func (c *ClusterDrain) BeforeCreate(scope *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = api.NewID()
+	}
+	return nil
+}
+
+// CountKafkasByStatus returns the number of kafkas of the drain in each status
+func (c *ClusterDrain) CountKafkasByStatus() map[ClusterDrainKafkaStatus]int {
+	counts := map[ClusterDrainKafkaStatus]int{}
+	for _, kafka := range c.Kafkas {
+		counts[kafka.Status]++
+	}
+	return counts
+}
+
+// ClusterDrainKafka tracks the migration of a kafka of a drained data plane cluster
+type ClusterDrainKafka struct {
+	api.Meta
+	ClusterDrainID  string                  `json:"cluster_drain_id" gorm:"index"`
+	KafkaID         string                  `json:"kafka_id" gorm:"index"`
+	Status          ClusterDrainKafkaStatus `json:"status"`
+	TargetClusterID string                  `json:"target_cluster_id"`
+	FailedReason    string                  `json:"failed_reason"`
+	StartedAt       *time.Time              `json:"started_at"`
+	FinishedAt      *time.Time              `json:"finished_at"`
+}
+
+func (k *ClusterDrainKafka) BeforeCreate(scope *gorm.DB) error {
+	if k.ID == "" {
+		k.ID = api.NewID()
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package dbapi
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+type ClusterRotationStatus string
+
+const (
+	// ClusterRotationStatusProvisioning - rotation waiting for its replacement data plane cluster to be ready
+	ClusterRotationStatusProvisioning ClusterRotationStatus = "provisioning"
+	// ClusterRotationStatusDraining - rotation whose kafkas are being migrated from the rotated data plane cluster to its replacement
+	ClusterRotationStatusDraining ClusterRotationStatus = "draining"
+	// ClusterRotationStatusCompleted - rotation whose rotated data plane cluster has been handed over for deprovisioning
+	ClusterRotationStatusCompleted ClusterRotationStatus = "completed"
+	// ClusterRotationStatusFailed - rotation that was stopped because its replacement data plane cluster could not be
+	// provisioned or because the drain of the rotated data plane cluster failed
+	ClusterRotationStatusFailed ClusterRotationStatus = "failed"
+)
+
+var ClusterRotationInProgressStatuses = []string{ClusterRotationStatusProvisioning.String(), ClusterRotationStatusDraining.String()}
+
+func (s ClusterRotationStatus) String() string {
+	return string(s)
+}
+
+// ClusterRotation replaces an outdated data plane cluster by a newly provisioned one, drains the kafkas of the outdated
+// data plane cluster to its replacement and hands it over for deprovisioning
+type ClusterRotation struct {
+	api.Meta
+	ClusterID string `json:"cluster_id" gorm:"index"`
+	// ReplacementID is the id of the replacement data plane cluster record, set when the replacement is registered
+	ReplacementID string `json:"replacement_id" gorm:"index"`
+	// ReplacementClusterID is the cluster id of the replacement data plane cluster, set once the provisioning of the replacement has started
+	ReplacementClusterID string                `json:"replacement_cluster_id" gorm:"index"`
+	Status               ClusterRotationStatus `json:"status" gorm:"index"`
+	StatusReason         string                `json:"status_reason"`
+	// Reason is why the data plane cluster is rotated e.g. its age or its OpenShift version
+	Reason string `json:"reason"`
+}
+
+func (c *ClusterRotation) BeforeCreate(scope *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = api.NewID()
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package dbapi
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+type KafkaEventType string
+
+const (
+	// KafkaEventTypeStatusChange - the status of the kafka changed e.g. from provisioning to ready or from ready to suspending
+	KafkaEventTypeStatusChange KafkaEventType = "status_change"
+	// KafkaEventTypeUpgradeRequested - one of the desired versions of the kafka changed
+	KafkaEventTypeUpgradeRequested KafkaEventType = "upgrade_requested"
+	// KafkaEventTypeUpgradeCompleted - one of the versions reported by the data plane for the kafka changed
+	KafkaEventTypeUpgradeCompleted KafkaEventType = "upgrade_completed"
+	// KafkaEventTypePlacementChange - the kafka was assigned to, unassigned from or moved between data plane clusters
+	KafkaEventTypePlacementChange KafkaEventType = "placement_change"
+	// KafkaEventTypeResize - the size of the kafka changed
+	KafkaEventTypeResize KafkaEventType = "resize"
+)
+
+func (t KafkaEventType) String() string {
+	return string(t)
+}
+
+// KafkaEvent records a change of one of the columns of a kafka request
+type KafkaEvent struct {
+	api.Meta
+	KafkaID       string         `json:"kafka_id" gorm:"index"`
+	EventType     KafkaEventType `json:"event_type"`
+	Field         string         `json:"field"`
+	PreviousValue string         `json:"previous_value"`
+	Value         string         `json:"value"`
+	ClusterID     string         `json:"cluster_id"`
+	Message       string         `json:"message"`
+}
+
+type KafkaEventList []*KafkaEvent
+
+func (e *KafkaEvent) BeforeCreate(scope *gorm.DB) error {
+	if e.ID == "" {
+		e.ID = api.NewID()
+	}
+	return nil
+}
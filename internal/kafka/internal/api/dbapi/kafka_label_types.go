@@ -0,0 +1,49 @@
+package dbapi
+
+import (
+	"sort"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+// KafkaLabel is a user defined key/value pair of a kafka request, i.e. to mark it by team, cost center or environment
+type KafkaLabel struct {
+	api.Meta
+	KafkaID string `json:"kafka_id" gorm:"index"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
+
+type KafkaLabels []KafkaLabel
+
+func (l *KafkaLabel) BeforeCreate(scope *gorm.DB) error {
+	if l.ID == "" {
+		l.ID = api.NewID()
+	}
+	return nil
+}
+
+// NewKafkaLabels returns the labels for the given key/value pairs, sorted by key
+func NewKafkaLabels(labels map[string]string) KafkaLabels {
+	kafkaLabels := KafkaLabels{}
+	for key, value := range labels {
+		kafkaLabels = append(kafkaLabels, KafkaLabel{Key: key, Value: value})
+	}
+	sort.Slice(kafkaLabels, func(i, j int) bool {
+		return kafkaLabels[i].Key < kafkaLabels[j].Key
+	})
+	return kafkaLabels
+}
+
+// ToMap returns the key/value pairs of the labels or nil if there are no labels
+func (l KafkaLabels) ToMap() map[string]string {
+	if len(l) == 0 {
+		return nil
+	}
+	labels := make(map[string]string, len(l))
+	for _, label := range l {
+		labels[label.Key] = label.Value
+	}
+	return labels
+}
@@ -58,7 +58,29 @@ type KafkaRequest struct {
 	DesiredKafkaBillingModel string `json:"desired_kafka_billing_model"`
 	// ExpiresAt contains the timestamp of when a Kafka instance is scheduled to expire.
 	// On expiration, the Kafka instance will be marked for deletion, its status will be set to 'deprovision'.
+	// It is only set when the expiration of the Kafka instance has been extended by an admin, the Kafka instance
+	// expires at the end of the lifespan of its size otherwise.
 	ExpiresAt time.Time `json:"expires_at"`
+	// ExpirationNotificationThresholdSeconds is the smallest threshold, in seconds before the expiration of the Kafka
+	// instance, for which an expiration notification has been sent. It is 0 if no notification has been sent yet.
+	ExpirationNotificationThresholdSeconds int64 `json:"expiration_notification_threshold_seconds"`
+	// MaintenanceWindow is the weekly period of time during which upgrades of the Kafka instance are rolled out.
+	// When it is not set, the maintenance window of the organisation of the Kafka instance is used, if any.
+	MaintenanceWindow MaintenanceWindow `json:"maintenance_window" gorm:"embedded;embeddedPrefix:maintenance_window_"`
+	// The pending versions are the versions requested by an admin that will become the desired versions of the
+	// Kafka instance during its next maintenance window
+	PendingKafkaVersion    string `json:"pending_kafka_version"`
+	PendingStrimziVersion  string `json:"pending_strimzi_version"`
+	PendingKafkaIBPVersion string `json:"pending_kafka_ibp_version"`
+	// DeletionProtection prevents the Kafka instance from being deleted, whether by its owner, when its owner is
+	// deprovisioned or when it expires. Only admins can explicitly bypass it.
+	DeletionProtection bool `json:"deletion_protection"`
+	// DeletionRequestedAt is set when the deletion of the Kafka instance has been requested while a deletion grace
+	// period is configured. The Kafka instance is suspended and can be restored until the grace period expires.
+	DeletionRequestedAt *time.Time `json:"deletion_requested_at"`
+	// Labels are the user defined key/value pairs of the Kafka instance. They are stored in the kafka_labels table and
+	// only loaded when the kafka request is retrieved on behalf of a user.
+	Labels KafkaLabels `json:"labels" gorm:"foreignKey:KafkaID"`
 }
 
 type KafkaList []*KafkaRequest
@@ -104,8 +126,13 @@ func (k *KafkaRequest) SetRoutes(routes []DataPlaneKafkaRoute) error {
 
 // GetExpirationTime returns when the Kafka request will expire based on the
 // provided lifespanSeconds value. lifespanSeconds is assumed to be greater
-// than 0
+// than 0. The expiration time set by an admin in ExpiresAt, if any, takes
+// precedence over the lifespan.
 func (k *KafkaRequest) GetExpirationTime(lifespanSeconds int) *time.Time {
+	if !k.ExpiresAt.IsZero() {
+		expireTime := k.ExpiresAt
+		return &expireTime
+	}
 	expireTime := k.CreatedAt.Add(time.Duration(lifespanSeconds) * time.Second)
 	return &expireTime
 }
@@ -0,0 +1,98 @@
+package dbapi
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+const (
+	hoursInAWeek                      = 7 * 24
+	maintenanceWindowMinDurationHours = 1
+	maintenanceWindowMaxDurationHours = 24
+	maintenanceWindowMaxStartHour     = 23
+)
+
+// maintenanceWindowDaysOfWeek contains the accepted days of week of a maintenance window, ordered as time.Weekday
+var maintenanceWindowDaysOfWeek = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+// MaintenanceWindow is a weekly recurring period of time during which the upgrades of a Kafka instance are rolled out.
+// The start hour is expressed in UTC.
+type MaintenanceWindow struct {
+	DayOfWeek     string `json:"day_of_week"`
+	StartHour     int    `json:"start_hour"`
+	DurationHours int    `json:"duration_hours"`
+}
+
+// IsSet returns true if a maintenance window has been defined
+func (w MaintenanceWindow) IsSet() bool {
+	return w.DayOfWeek != ""
+}
+
+// Validate returns an error if the maintenance window is not valid
+func (w MaintenanceWindow) Validate() error {
+	if w.weekday() < 0 {
+		return fmt.Errorf("day_of_week %q is not valid. Accepted values are: %v", w.DayOfWeek, maintenanceWindowDaysOfWeek)
+	}
+	if w.StartHour < 0 || w.StartHour > maintenanceWindowMaxStartHour {
+		return fmt.Errorf("start_hour %d is not valid. It must be between 0 and %d", w.StartHour, maintenanceWindowMaxStartHour)
+	}
+	if w.DurationHours < maintenanceWindowMinDurationHours || w.DurationHours > maintenanceWindowMaxDurationHours {
+		return fmt.Errorf("duration_hours %d is not valid. It must be between %d and %d", w.DurationHours, maintenanceWindowMinDurationHours, maintenanceWindowMaxDurationHours)
+	}
+	return nil
+}
+
+// IsActive returns true if the given time is within the maintenance window
+func (w MaintenanceWindow) IsActive(t time.Time) bool {
+	return w.sinceStart(t) < time.Duration(w.DurationHours)*time.Hour
+}
+
+// NextStart returns the start of the maintenance window the given time is in, or the start of the next maintenance
+// window if the given time is not within a maintenance window
+func (w MaintenanceWindow) NextStart(t time.Time) time.Time {
+	sinceStart := w.sinceStart(t)
+	windowStart := t.UTC().Add(-sinceStart).Truncate(time.Hour)
+	if w.IsActive(t) {
+		return windowStart
+	}
+	return windowStart.Add(hoursInAWeek * time.Hour)
+}
+
+// sinceStart returns how much time has passed between the start of the latest maintenance window and the given time
+func (w MaintenanceWindow) sinceStart(t time.Time) time.Duration {
+	t = t.UTC()
+	week := hoursInAWeek * time.Hour
+	elapsedInWeek := time.Duration(int(t.Weekday())*24+t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second
+	windowStartInWeek := time.Duration(w.weekday()*24+w.StartHour) * time.Hour
+	return ((elapsedInWeek-windowStartInWeek)%week + week) % week
+}
+
+func (w MaintenanceWindow) weekday() int {
+	for i, day := range maintenanceWindowDaysOfWeek {
+		if strings.EqualFold(day, w.DayOfWeek) {
+			return i
+		}
+	}
+	return -1
+}
+
+// OrganisationMaintenanceWindow is the default maintenance window of the Kafka instances of an organisation.
+// It applies to all the Kafka instances of the organisation which do not have a maintenance window of their own.
+type OrganisationMaintenanceWindow struct {
+	api.Meta
+	OrganisationId    string            `json:"organisation_id" gorm:"index"`
+	MaintenanceWindow MaintenanceWindow `json:"maintenance_window" gorm:"embedded;embeddedPrefix:maintenance_window_"`
+}
+
+func (o *OrganisationMaintenanceWindow) BeforeCreate(scope *gorm.DB) error {
+	if o.ID == "" {
+		o.ID = api.NewID()
+	}
+	return nil
+}
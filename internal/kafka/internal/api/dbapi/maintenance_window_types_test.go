@@ -0,0 +1,133 @@
+package dbapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func TestMaintenanceWindow_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  MaintenanceWindow
+		wantErr bool
+	}{
+		{
+			name:    "should accept a valid maintenance window",
+			window:  MaintenanceWindow{DayOfWeek: "monday", StartHour: 22, DurationHours: 4},
+			wantErr: false,
+		},
+		{
+			name:    "should accept a day of week regardless of its case",
+			window:  MaintenanceWindow{DayOfWeek: "Sunday", StartHour: 0, DurationHours: 24},
+			wantErr: false,
+		},
+		{
+			name:    "should reject an invalid day of week",
+			window:  MaintenanceWindow{DayOfWeek: "someday", StartHour: 0, DurationHours: 1},
+			wantErr: true,
+		},
+		{
+			name:    "should reject a start hour greater than 23",
+			window:  MaintenanceWindow{DayOfWeek: "monday", StartHour: 24, DurationHours: 1},
+			wantErr: true,
+		},
+		{
+			name:    "should reject a negative start hour",
+			window:  MaintenanceWindow{DayOfWeek: "monday", StartHour: -1, DurationHours: 1},
+			wantErr: true,
+		},
+		{
+			name:    "should reject a duration of 0 hours",
+			window:  MaintenanceWindow{DayOfWeek: "monday", StartHour: 0, DurationHours: 0},
+			wantErr: true,
+		},
+		{
+			name:    "should reject a duration greater than 24 hours",
+			window:  MaintenanceWindow{DayOfWeek: "monday", StartHour: 0, DurationHours: 25},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(tt.window.Validate() != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func TestMaintenanceWindow_IsActiveAndNextStart(t *testing.T) {
+	// 2022-12-18 is a sunday
+	sunday := time.Date(2022, time.December, 18, 0, 0, 0, 0, time.UTC)
+	saturdayNightWindow := MaintenanceWindow{DayOfWeek: "saturday", StartHour: 22, DurationHours: 4}
+
+	tests := []struct {
+		name          string
+		window        MaintenanceWindow
+		now           time.Time
+		wantActive    bool
+		wantNextStart time.Time
+	}{
+		{
+			name:          "should be active at the start of the maintenance window",
+			window:        MaintenanceWindow{DayOfWeek: "sunday", StartHour: 2, DurationHours: 3},
+			now:           sunday.Add(2 * time.Hour),
+			wantActive:    true,
+			wantNextStart: sunday.Add(2 * time.Hour),
+		},
+		{
+			name:          "should be active within the maintenance window",
+			window:        MaintenanceWindow{DayOfWeek: "sunday", StartHour: 2, DurationHours: 3},
+			now:           sunday.Add(4*time.Hour + 59*time.Minute),
+			wantActive:    true,
+			wantNextStart: sunday.Add(2 * time.Hour),
+		},
+		{
+			name:          "should not be active at the end of the maintenance window",
+			window:        MaintenanceWindow{DayOfWeek: "sunday", StartHour: 2, DurationHours: 3},
+			now:           sunday.Add(5 * time.Hour),
+			wantActive:    false,
+			wantNextStart: sunday.Add(7*24*time.Hour + 2*time.Hour),
+		},
+		{
+			name:          "should not be active before the maintenance window",
+			window:        MaintenanceWindow{DayOfWeek: "tuesday", StartHour: 10, DurationHours: 1},
+			now:           sunday.Add(30 * time.Minute),
+			wantActive:    false,
+			wantNextStart: sunday.Add(2*24*time.Hour + 10*time.Hour),
+		},
+		{
+			name:          "should be active in a maintenance window spanning over the end of the week",
+			window:        saturdayNightWindow,
+			now:           sunday.Add(time.Hour),
+			wantActive:    true,
+			wantNextStart: sunday.Add(-2 * time.Hour),
+		},
+		{
+			name:          "should not be active after a maintenance window spanning over the end of the week",
+			window:        saturdayNightWindow,
+			now:           sunday.Add(2 * time.Hour),
+			wantActive:    false,
+			wantNextStart: sunday.Add(6*24*time.Hour + 22*time.Hour),
+		},
+		{
+			name:          "should take the time zone of the given time into account",
+			window:        MaintenanceWindow{DayOfWeek: "sunday", StartHour: 2, DurationHours: 3},
+			now:           sunday.Add(3 * time.Hour).In(time.FixedZone("UTC-5", -5*60*60)),
+			wantActive:    true,
+			wantNextStart: sunday.Add(2 * time.Hour),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(tt.window.IsActive(tt.now)).To(gomega.Equal(tt.wantActive))
+			g.Expect(tt.window.NextStart(tt.now)).To(gomega.Equal(tt.wantNextStart))
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+type UpgradeCampaignStatus string
+
+const (
+	// UpgradeCampaignStatusInProgress - campaign whose kafkas are being rolled out in batches
+	UpgradeCampaignStatusInProgress UpgradeCampaignStatus = "in_progress"
+	// UpgradeCampaignStatusPaused - campaign that was paused by an admin or because of a failed or stalled upgrade
+	UpgradeCampaignStatusPaused UpgradeCampaignStatus = "paused"
+	// UpgradeCampaignStatusCompleted - campaign whose kafkas have all been processed
+	UpgradeCampaignStatusCompleted UpgradeCampaignStatus = "completed"
+	// UpgradeCampaignStatusCancelled - campaign that was cancelled by an admin. Its pending kafkas will not be upgraded
+	UpgradeCampaignStatusCancelled UpgradeCampaignStatus = "cancelled"
+)
+
+func (s UpgradeCampaignStatus) String() string {
+	return string(s)
+}
+
+// IsFinal returns true if the campaign cannot change status anymore
+func (s UpgradeCampaignStatus) IsFinal() bool {
+	return s == UpgradeCampaignStatusCompleted || s == UpgradeCampaignStatusCancelled
+}
+
+type UpgradeCampaignKafkaStatus string
+
+const (
+	// UpgradeCampaignKafkaStatusPending - kafka waiting for its batch to be rolled out
+	UpgradeCampaignKafkaStatusPending UpgradeCampaignKafkaStatus = "pending"
+	// UpgradeCampaignKafkaStatusUpgrading - kafka whose desired versions have been set to the target versions of the campaign
+	UpgradeCampaignKafkaStatusUpgrading UpgradeCampaignKafkaStatus = "upgrading"
+	// UpgradeCampaignKafkaStatusCompleted - kafka that reached the target versions of the campaign
+	UpgradeCampaignKafkaStatusCompleted UpgradeCampaignKafkaStatus = "completed"
+	// UpgradeCampaignKafkaStatusFailed - kafka whose upgrade failed or stalled
+	UpgradeCampaignKafkaStatusFailed UpgradeCampaignKafkaStatus = "failed"
+	// UpgradeCampaignKafkaStatusSkipped - kafka that could not be upgraded e.g. because it was deleted or because the
+	// target versions are not available in its data plane cluster
+	UpgradeCampaignKafkaStatusSkipped UpgradeCampaignKafkaStatus = "skipped"
+)
+
+func (s UpgradeCampaignKafkaStatus) String() string {
+	return string(s)
+}
+
+// UpgradeCampaignFilter selects the kafkas targeted by an upgrade campaign. Empty fields match all the kafkas.
+type UpgradeCampaignFilter struct {
+	InstanceType       string `json:"instance_type"`
+	Region             string `json:"region"`
+	ClusterID          string `json:"cluster_id"`
+	ActualKafkaVersion string `json:"actual_kafka_version"`
+}
+
+// UpgradeCampaign rolls the kafkas matching its filter to the target versions in batches
+type UpgradeCampaign struct {
+	api.Meta
+	Name                     string                 `json:"name"`
+	Status                   UpgradeCampaignStatus  `json:"status" gorm:"index"`
+	StatusReason             string                 `json:"status_reason"`
+	Filter                   UpgradeCampaignFilter  `json:"filter" gorm:"embedded;embeddedPrefix:filter_"`
+	KafkaVersion             string                 `json:"kafka_version"`
+	StrimziVersion           string                 `json:"strimzi_version"`
+	KafkaIBPVersion          string                 `json:"kafka_ibp_version"`
+	BatchSize                int                    `json:"batch_size"`
+	MaxConcurrentUpgrades    int                    `json:"max_concurrent_upgrades"`
+	StallTimeoutMinutes      int                    `json:"stall_timeout_minutes"`
+	IgnoreMaintenanceWindows bool                   `json:"ignore_maintenance_windows"`
+	Kafkas                   []UpgradeCampaignKafka `json:"kafkas" gorm:"foreignKey:UpgradeCampaignID;references:ID"`
+	// KafkaCounts is the number of kafkas of the campaign in each status. It is set instead of Kafkas when the
+	// campaigns are listed.
+	KafkaCounts map[UpgradeCampaignKafkaStatus]int `json:"-" gorm:"-"`
+}
+
+type UpgradeCampaignList []*UpgradeCampaign
+
+func (c *UpgradeCampaign) BeforeCreate(scope *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = api.NewID()
+	}
+	return nil
+}
+
+// CountKafkasByStatus returns the number of kafkas of the campaign in each status
+func (c *UpgradeCampaign) CountKafkasByStatus() map[UpgradeCampaignKafkaStatus]int {
+	if c.KafkaCounts != nil {
+		return c.KafkaCounts
+	}
+
+	counts := map[UpgradeCampaignKafkaStatus]int{}
+	for _, kafka := range c.Kafkas {
+		counts[kafka.Status]++
+	}
+	return counts
+}
+
+// UpgradeCampaignKafka tracks the progress of the upgrade of a kafka targeted by an upgrade campaign
+type UpgradeCampaignKafka struct {
+	api.Meta
+	UpgradeCampaignID string                     `json:"upgrade_campaign_id" gorm:"index"`
+	KafkaID           string                     `json:"kafka_id" gorm:"index"`
+	Status            UpgradeCampaignKafkaStatus `json:"status"`
+	FailedReason      string                     `json:"failed_reason"`
+	StartedAt         *time.Time                 `json:"started_at"`
+	FinishedAt        *time.Time                 `json:"finished_at"`
+}
+
+func (k *UpgradeCampaignKafka) BeforeCreate(scope *gorm.DB) error {
+	if k.ID == "" {
+		k.ID = api.NewID()
+	}
+	return nil
+}
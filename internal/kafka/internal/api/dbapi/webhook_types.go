@@ -0,0 +1,143 @@
+package dbapi
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+type WebhookEventType string
+
+const (
+	// WebhookEventTypeKafkaReady - the kafka was reported as ready by the data plane
+	WebhookEventTypeKafkaReady WebhookEventType = "kafka.ready"
+	// WebhookEventTypeKafkaFailed - the kafka was reported as failed by the data plane
+	WebhookEventTypeKafkaFailed WebhookEventType = "kafka.failed"
+	// WebhookEventTypeKafkaDeleted - the kafka was removed from its data plane cluster
+	WebhookEventTypeKafkaDeleted WebhookEventType = "kafka.deleted"
+	// WebhookEventTypeKafkaSuspended - the kafka was reported as suspended by the data plane
+	WebhookEventTypeKafkaSuspended WebhookEventType = "kafka.suspended"
+	// WebhookEventTypeKafkaUpgraded - the kafka version reported by the data plane changed
+	WebhookEventTypeKafkaUpgraded WebhookEventType = "kafka.upgraded"
+	// WebhookEventTypeKafkaExpiring - the kafka is about to expire
+	WebhookEventTypeKafkaExpiring WebhookEventType = "kafka.expiring"
+)
+
+// WebhookEventTypes are all the event types webhook subscriptions can subscribe to
+var WebhookEventTypes = []WebhookEventType{
+	WebhookEventTypeKafkaReady,
+	WebhookEventTypeKafkaFailed,
+	WebhookEventTypeKafkaDeleted,
+	WebhookEventTypeKafkaSuspended,
+	WebhookEventTypeKafkaUpgraded,
+	WebhookEventTypeKafkaExpiring,
+}
+
+func (t WebhookEventType) String() string {
+	return string(t)
+}
+
+// IsValid returns true if the event type is one of the supported webhook event types
+func (t WebhookEventType) IsValid() bool {
+	for _, eventType := range WebhookEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryStatusPending - delivery waiting for its next attempt
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryStatusSucceeded - delivery acknowledged by the subscriber with a 2xx response
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryStatusFailed - delivery that was not acknowledged after all its attempts
+	WebhookDeliveryStatusFailed WebhookDeliveryStatus = "failed"
+)
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+// WebhookSubscription is an endpoint of an organisation notified of the lifecycle events of the kafkas of the
+// organisation
+type WebhookSubscription struct {
+	api.Meta
+	OrganisationId string `json:"organisation_id" gorm:"index"`
+	Owner          string `json:"owner"`
+	Url            string `json:"url"`
+	// Secret is used to sign the payloads sent to the url. It is only returned when the subscription is created
+	Secret string `json:"-"`
+	// EventTypes is the comma separated list of the event types the subscription subscribes to
+	EventTypes string `json:"event_types"`
+	Enabled    bool   `json:"enabled"`
+}
+
+type WebhookSubscriptionList []*WebhookSubscription
+
+func (s *WebhookSubscription) BeforeCreate(scope *gorm.DB) error {
+	if s.ID == "" {
+		s.ID = api.NewID()
+	}
+	return nil
+}
+
+// GetEventTypes returns the event types the subscription subscribes to
+func (s *WebhookSubscription) GetEventTypes() []WebhookEventType {
+	var eventTypes []WebhookEventType
+	for _, eventType := range strings.Split(s.EventTypes, ",") {
+		if eventType != "" {
+			eventTypes = append(eventTypes, WebhookEventType(eventType))
+		}
+	}
+	return eventTypes
+}
+
+// SetEventTypes sets the event types the subscription subscribes to
+func (s *WebhookSubscription) SetEventTypes(eventTypes []WebhookEventType) {
+	values := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		values = append(values, eventType.String())
+	}
+	s.EventTypes = strings.Join(values, ",")
+}
+
+// Subscribes returns true if the subscription subscribes to the given event type
+func (s *WebhookSubscription) Subscribes(eventType WebhookEventType) bool {
+	for _, subscribed := range s.GetEventTypes() {
+		if subscribed == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery is the delivery of a kafka lifecycle event to a webhook subscription
+type WebhookDelivery struct {
+	api.Meta
+	SubscriptionID     string                `json:"subscription_id" gorm:"index"`
+	Subscription       *WebhookSubscription  `json:"-" gorm:"foreignKey:SubscriptionID"`
+	KafkaID            string                `json:"kafka_id"`
+	EventType          WebhookEventType      `json:"event_type"`
+	Payload            string                `json:"payload"`
+	Status             WebhookDeliveryStatus `json:"status" gorm:"index"`
+	Attempts           int                   `json:"attempts"`
+	NextAttemptAt      *time.Time            `json:"next_attempt_at"`
+	LastAttemptAt      *time.Time            `json:"last_attempt_at"`
+	ResponseStatusCode int                   `json:"response_status_code"`
+	LastError          string                `json:"last_error"`
+}
+
+type WebhookDeliveryList []*WebhookDelivery
+
+func (d *WebhookDelivery) BeforeCreate(scope *gorm.DB) error {
+	if d.ID == "" {
+		d.ID = api.NewID()
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.14.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// KafkaEvent A change of a Kafka instance
+type KafkaEvent struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	// Values: [status_change, upgrade_requested, upgrade_completed, resize]
+	EventType string `json:"event_type"`
+	// The value before the change. Empty when the Kafka instance has just been created
+	PreviousValue string `json:"previous_value,omitempty"`
+	// The value after the change
+	Value string `json:"value,omitempty"`
+	// A human readable description of the change
+	Message   string    `json:"message,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,25 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.14.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// KafkaExpiration The expiration of a Kafka instance
+type KafkaExpiration struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	// The time at which the Kafka instance expires. Not set when the Kafka instance does not expire
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// The number of seconds left before the Kafka instance expires. Not set when the Kafka instance does not expire
+	RemainingSeconds *int64 `json:"remaining_seconds,omitempty"`
+}
@@ -68,4 +68,10 @@ type KafkaRequest struct {
 	BillingCloudAccountId                 string `json:"billing_cloud_account_id,omitempty"`
 	Marketplace                           string `json:"marketplace,omitempty"`
 	BillingModel                          string `json:"billing_model,omitempty"`
+	// Whether the Kafka instance is protected against deletion
+	DeletionProtection bool `json:"deletion_protection,omitempty"`
+	// The time at which the deletion of the Kafka instance has been requested, if it is pending deletion. A Kafka instance pending deletion can be restored until its deletion grace period expires
+	DeletionRequestedAt *time.Time `json:"deletion_requested_at,omitempty"`
+	// User defined key/value pairs of the Kafka instance
+	Labels map[string]string `json:"labels,omitempty"`
 }
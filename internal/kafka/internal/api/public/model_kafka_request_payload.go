@@ -28,4 +28,8 @@ type KafkaRequestPayload struct {
 	Marketplace *string `json:"marketplace,omitempty"`
 	// billing model to use
 	BillingModel *string `json:"billing_model,omitempty"`
+	// Whether the Kafka instance is protected against deletion. A Kafka instance protected against deletion cannot be deleted until its deletion protection is disabled. The default value is false
+	DeletionProtection *bool `json:"deletion_protection,omitempty"`
+	// User defined key/value pairs used to organise Kafka instances, e.g. by team, cost center or environment. Keys must be at most 63 characters long, consist of alphanumeric characters, '-', '_' or '.', and start and end with an alphanumeric character. Values must follow the same rules but can be empty. A Kafka instance can have at most 20 labels.
+	Labels map[string]string `json:"labels,omitempty"`
 }
@@ -15,4 +15,10 @@ type KafkaUpdateRequest struct {
 	Owner *string `json:"owner,omitempty"`
 	// Whether connection reauthentication is enabled or not. If set to true, connection reauthentication on the Kafka instance will be required every 5 minutes.
 	ReauthenticationEnabled *bool `json:"reauthentication_enabled,omitempty"`
+	// The ID of the size the Kafka instance should be resized to. The size must be one of the sizes supported by the instance type of the Kafka instance. The Kafka instance must be in 'ready' state to be resized. Kafka instances are resized on the data plane cluster they run on and are never moved to another data plane cluster, as their data would not be moved along: a KAFKAS-MGMT-49 error is returned when that data plane cluster cannot accommodate the new size, even if other data plane clusters of the region could. A KAFKAS-MGMT-24 error is returned when the region cannot accommodate the new size.
+	SizeId *string `json:"size_id,omitempty"`
+	// Whether the Kafka instance is protected against deletion. A Kafka instance protected against deletion cannot be deleted until its deletion protection is disabled.
+	DeletionProtection *bool `json:"deletion_protection,omitempty"`
+	// The labels of the Kafka instance. When set, the labels replace all the existing labels of the Kafka instance. An empty object removes all the labels.
+	Labels *map[string]string `json:"labels,omitempty"`
 }
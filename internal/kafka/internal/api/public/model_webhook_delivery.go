@@ -0,0 +1,33 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.14.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// WebhookDelivery A delivery of a lifecycle event of a Kafka instance to a webhook subscription
+type WebhookDelivery struct {
+	Id        string `json:"id"`
+	Kind      string `json:"kind"`
+	KafkaId   string `json:"kafka_id"`
+	EventType string `json:"event_type"`
+	// Values: [pending, succeeded, failed]
+	Status   string `json:"status"`
+	Attempts int32  `json:"attempts"`
+	// When the delivery is attempted again. Only set for pending deliveries
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	LastAttemptAt *time.Time `json:"last_attempt_at,omitempty"`
+	// The status code of the response to the last attempt
+	ResponseStatusCode int32     `json:"response_status_code,omitempty"`
+	LastError          string    `json:"last_error,omitempty"`
+	CreatedAt          time.Time `json:"created_at"`
+}
@@ -0,0 +1,32 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.14.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// WebhookSubscription An endpoint notified of the lifecycle events of the Kafka instances of an organisation
+type WebhookSubscription struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	Href string `json:"href"`
+	// The URL the events are posted to
+	Url string `json:"url"`
+	// The event types the subscription subscribes to. Values: [kafka.ready, kafka.failed, kafka.deleted, kafka.suspended, kafka.upgraded, kafka.expiring]
+	EventTypes []string `json:"event_types"`
+	Enabled    bool     `json:"enabled"`
+	// The secret used to sign the payloads posted to the URL. The hex encoded HMAC-SHA256 of each payload is sent in the X-Webhook-Signature header prefixed with 'sha256='. Only returned when the subscription is created
+	Secret    string    `json:"secret,omitempty"`
+	Owner     string    `json:"owner,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
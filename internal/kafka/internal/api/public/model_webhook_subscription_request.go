@@ -0,0 +1,21 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.14.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// WebhookSubscriptionRequest Schema for the request body sent to /webhooks POST
+type WebhookSubscriptionRequest struct {
+	// The absolute https URL the events are posted to. Internal addresses are not allowed
+	Url string `json:"url"`
+	// The event types to subscribe to. Values: [kafka.ready, kafka.failed, kafka.deleted, kafka.suspended, kafka.upgraded, kafka.expiring]
+	EventTypes []string `json:"event_types"`
+	// Whether the events are posted to the URL. The default value is true
+	Enabled *bool `json:"enabled,omitempty"`
+}
@@ -0,0 +1,769 @@
+package clusters
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// eksClusterNamePrefix is the prefix of the names of the EKS clusters created by the provider
+	eksClusterNamePrefix = "mk-"
+	// eksDefaultNodeGroupName is the name of the node group created along with each EKS cluster
+	eksDefaultNodeGroupName = "mk-default"
+	// eksIdentityProviderUsernameClaim is the OIDC claim used as the kubernetes username of the identity provider users
+	eksIdentityProviderUsernameClaim = "preferred_username"
+	// olmGroupVersion and olmSubscriptionResource identify the OLM Subscription resource used to install the strimzi and
+	// kas-fleetshard operators
+	olmGroupVersion         = "operators.coreos.com/v1alpha1"
+	olmSubscriptionResource = "subscriptions"
+	// eksClusterDNSRecordTTL is the TTL of the wildcard record of the DNS of a cluster
+	eksClusterDNSRecordTTL = 300
+)
+
+// serviceResource identifies the kubernetes services, used to get the load balancer of the ingress controller
+var serviceResource = schema.GroupVersionResource{Version: "v1", Resource: "services"}
+
+// eksNodeTaintEffects maps the kubernetes node taint effects to the ones accepted by the EKS API
+var eksNodeTaintEffects = map[string]string{
+	"NoSchedule":       eks.TaintEffectNoSchedule,
+	"NoExecute":        eks.TaintEffectNoExecute,
+	"PreferNoSchedule": eks.TaintEffectPreferNoSchedule,
+}
+
+// eksClusterInfo is the provider specific information stored in the `cluster_spec` of an EKS data plane cluster
+type eksClusterInfo struct {
+	Region string `json:"region"`
+}
+
+// EKSProvider creates and manages data plane clusters in AWS EKS.
+// As OCM addons are not available on EKS, the strimzi and kas-fleetshard operators are installed by applying their
+// OLM manifests directly to the cluster, in the same way the StandaloneProvider does. As EKS clusters do not come with
+// OLM, it is installed from the configured OLM manifests first, if any.
+// EKS clusters do not come with an ingress domain either, so the DNS of each cluster is registered in Route53 under the
+// configured base domain and points to the load balancer of the ingress controller of the cluster.
+type EKSProvider struct {
+	connectionFactory *db.ConnectionFactory
+	eksClientFactory  aws.EKSClientFactory
+	awsClientFactory  aws.ClientFactory
+	awsConfig         *config.AWSConfig
+	eksConfig         *config.EKSConfig
+	// manifests builds the OLM manifests of the strimzi and kas-fleetshard operators
+	manifests       *StandaloneProvider
+	resourceApplier resourceApplier
+}
+
+// resourceApplier applies resources to the cluster targeted by the given rest config
+type resourceApplier interface {
+	Apply(restConfig *rest.Config, resources types.ResourceSet) (*types.ResourceSet, error)
+	// IsResourceServed returns whether the given resource of the given group version is served by the cluster
+	IsResourceServed(restConfig *rest.Config, groupVersion string, resource string) (bool, error)
+	// Get returns the given namespaced resource or nil if it does not exist
+	Get(restConfig *rest.Config, resource schema.GroupVersionResource, namespace string, name string) (*unstructured.Unstructured, error)
+}
+
+type dynamicClientResourceApplier struct{}
+
+func (d *dynamicClientResourceApplier) Apply(restConfig *rest.Config, resources types.ResourceSet) (*types.ResourceSet, error) {
+	return applyResourcesWithRestConfig(restConfig, resources)
+}
+
+func (d *dynamicClientResourceApplier) IsResourceServed(restConfig *rest.Config, groupVersion string, resource string) (bool, error) {
+	dc, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return false, err
+	}
+
+	resources, err := dc.ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, r := range resources.APIResources {
+		if r.Name == resource {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (d *dynamicClientResourceApplier) Get(restConfig *rest.Config, resource schema.GroupVersionResource, namespace string, name string) (*unstructured.Unstructured, error) {
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := dynamicClient.Resource(resource).Namespace(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return obj, nil
+}
+
+// blank assignment to verify that EKSProvider implements Provider
+var _ Provider = &EKSProvider{}
+
+func newEKSProvider(connectionFactory *db.ConnectionFactory, eksClientFactory aws.EKSClientFactory, awsClientFactory aws.ClientFactory, awsConfig *config.AWSConfig, eksConfig *config.EKSConfig, dataplaneClusterConfig *config.DataplaneClusterConfig) *EKSProvider {
+	return &EKSProvider{
+		connectionFactory: connectionFactory,
+		eksClientFactory:  eksClientFactory,
+		awsClientFactory:  awsClientFactory,
+		awsConfig:         awsConfig,
+		eksConfig:         eksConfig,
+		manifests:         newStandaloneProvider(connectionFactory, dataplaneClusterConfig),
+		resourceApplier:   &dynamicClientResourceApplier{},
+	}
+}
+
+func (p *EKSProvider) Create(request *types.ClusterRequest) (*types.ClusterSpec, error) {
+	if cloudproviders.ParseCloudProviderID(request.CloudProvider) != cloudproviders.AWS {
+		return nil, errors.Errorf("cloud provider %q is not supported by the EKS cluster provider", request.CloudProvider)
+	}
+
+	region, ok := p.eksConfig.GetRegion(request.Region)
+	if !ok {
+		return nil, errors.Errorf("region %q is not configured for the EKS cluster provider", request.Region)
+	}
+
+	client, err := p.newEKSClient(request.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterName := eksClusterNamePrefix + api.NewID()
+	eksCluster, err := client.CreateCluster(&eks.CreateClusterInput{
+		Name:    &clusterName,
+		RoleArn: &p.eksConfig.ClusterRoleARN,
+		Version: &p.eksConfig.KubernetesVersion,
+		ResourcesVpcConfig: &eks.VpcConfigRequest{
+			SubnetIds:        awssdk.StringSlice(region.SubnetIDs),
+			SecurityGroupIds: awssdk.StringSlice(region.SecurityGroupIDs),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create EKS cluster in region %s", request.Region)
+	}
+
+	additionalInfo, err := json.Marshal(eksClusterInfo{Region: request.Region})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal EKS cluster information")
+	}
+
+	return &types.ClusterSpec{
+		InternalID:     clusterName,
+		ExternalID:     awssdk.StringValue(eksCluster.Arn),
+		Status:         api.ClusterProvisioning,
+		AdditionalInfo: additionalInfo,
+	}, nil
+}
+
+// CheckClusterStatus reports the cluster as provisioned once both the EKS control plane and its default node group are active.
+// The default node group can only be created once the control plane is active, so it is created here.
+func (p *EKSProvider) CheckClusterStatus(spec *types.ClusterSpec) (*types.ClusterSpec, error) {
+	client, err := p.newEKSClientForCluster(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	eksCluster, err := client.DescribeCluster(spec.InternalID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get EKS cluster %s", spec.InternalID)
+	}
+
+	if spec.Status == "" {
+		spec.Status = api.ClusterProvisioning
+	}
+	if spec.ExternalID == "" {
+		spec.ExternalID = awssdk.StringValue(eksCluster.Arn)
+	}
+
+	switch awssdk.StringValue(eksCluster.Status) {
+	case eks.ClusterStatusFailed:
+		spec.Status = api.ClusterFailed
+		spec.StatusDetails = fmt.Sprintf("EKS cluster %s failed to be created", spec.InternalID)
+	case eks.ClusterStatusActive:
+		nodeGroupStatus, err := p.ensureDefaultNodeGroup(client, spec.InternalID)
+		if err != nil {
+			return nil, err
+		}
+		switch nodeGroupStatus {
+		case eks.NodegroupStatusActive:
+			spec.Status = api.ClusterProvisioned
+		case eks.NodegroupStatusCreateFailed:
+			spec.Status = api.ClusterFailed
+			spec.StatusDetails = fmt.Sprintf("default node group of EKS cluster %s failed to be created", spec.InternalID)
+		}
+	}
+
+	return spec, nil
+}
+
+// ensureDefaultNodeGroup creates the default node group of the cluster if it does not exist and returns its status
+func (p *EKSProvider) ensureDefaultNodeGroup(client aws.EKSClient, clusterName string) (string, error) {
+	nodeGroup, err := client.DescribeNodegroup(clusterName, eksDefaultNodeGroupName)
+	if err != nil && !aws.IsEKSResourceNotFoundError(err) {
+		return "", errors.Wrapf(err, "failed to get default node group of EKS cluster %s", clusterName)
+	}
+
+	if nodeGroup == nil {
+		cluster, err := p.findCluster(clusterName)
+		if err != nil {
+			return "", err
+		}
+		glog.Infof("creating default node group of EKS cluster %s", clusterName)
+		nodeGroup, err = p.createNodeGroup(client, &types.MachinePoolRequest{
+			ID:           eksDefaultNodeGroupName,
+			ClusterID:    clusterName,
+			InstanceSize: p.eksConfig.NodeInstanceType,
+			MultiAZ:      cluster.MultiAZ,
+			Replicas:     p.eksConfig.NodeGroupNodeCount,
+		}, cluster.Region)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return awssdk.StringValue(nodeGroup.Status), nil
+}
+
+// Delete deletes the DNS record of the cluster and its node groups first, as EKS does not allow to delete a cluster that
+// still has node groups, and then the cluster itself. It returns true once the cluster is gone.
+func (p *EKSProvider) Delete(spec *types.ClusterSpec) (bool, error) {
+	client, err := p.newEKSClientForCluster(spec)
+	if err != nil {
+		return false, err
+	}
+
+	eksCluster, err := client.DescribeCluster(spec.InternalID)
+	if err != nil {
+		if aws.IsEKSResourceNotFoundError(err) {
+			return true, nil
+		}
+		return false, errors.Wrapf(err, "failed to get EKS cluster %s", spec.InternalID)
+	}
+
+	if awssdk.StringValue(eksCluster.Status) == eks.ClusterStatusDeleting {
+		return false, nil
+	}
+
+	if err := p.deleteClusterDNSRecord(spec); err != nil {
+		return false, err
+	}
+
+	nodeGroups, err := client.ListNodegroups(spec.InternalID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list node groups of EKS cluster %s", spec.InternalID)
+	}
+
+	if len(nodeGroups) > 0 {
+		for _, nodeGroup := range nodeGroups {
+			if err := client.DeleteNodegroup(spec.InternalID, nodeGroup); err != nil && !aws.IsEKSResourceInUseError(err) && !aws.IsEKSResourceNotFoundError(err) {
+				return false, errors.Wrapf(err, "failed to delete node group %s of EKS cluster %s", nodeGroup, spec.InternalID)
+			}
+		}
+		return false, nil
+	}
+
+	if err := client.DeleteCluster(spec.InternalID); err != nil && !aws.IsEKSResourceInUseError(err) {
+		if aws.IsEKSResourceNotFoundError(err) {
+			return true, nil
+		}
+		return false, errors.Wrapf(err, "failed to delete EKS cluster %s", spec.InternalID)
+	}
+
+	return false, nil
+}
+
+func (p *EKSProvider) AddIdentityProvider(clusterSpec *types.ClusterSpec, identityProvider types.IdentityProviderInfo) (*types.IdentityProviderInfo, error) {
+	if identityProvider.OpenID == nil {
+		return nil, nil
+	}
+
+	client, err := p.newEKSClientForCluster(clusterSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	openID := identityProvider.OpenID
+	err = client.AssociateIdentityProviderConfig(&eks.AssociateIdentityProviderConfigInput{
+		ClusterName: &clusterSpec.InternalID,
+		Oidc: &eks.OidcIdentityProviderConfigRequest{
+			IdentityProviderConfigName: &openID.Name,
+			ClientId:                   &openID.ClientID,
+			IssuerUrl:                  &openID.Issuer,
+			UsernameClaim:              awssdk.String(eksIdentityProviderUsernameClaim),
+		},
+	})
+	// the identity provider is already associated to the cluster
+	if err != nil && !aws.IsEKSResourceInUseError(err) {
+		return nil, errors.Wrapf(err, "failed to add identity provider for EKS cluster %s", clusterSpec.InternalID)
+	}
+
+	openID.ID = openID.Name
+	return &identityProvider, nil
+}
+
+func (p *EKSProvider) ApplyResources(clusterSpec *types.ClusterSpec, resources types.ResourceSet) (*types.ResourceSet, error) {
+	restConfig, err := p.getRestConfig(clusterSpec)
+	if err != nil {
+		return nil, err
+	}
+	return p.resourceApplier.Apply(restConfig, resources)
+}
+
+// getRestConfig returns the rest config used to access the kubernetes API of the given EKS cluster
+func (p *EKSProvider) getRestConfig(clusterSpec *types.ClusterSpec) (*rest.Config, error) {
+	client, err := p.newEKSClientForCluster(clusterSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	eksCluster, err := client.DescribeCluster(clusterSpec.InternalID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get EKS cluster %s", clusterSpec.InternalID)
+	}
+
+	var caData []byte
+	if eksCluster.CertificateAuthority != nil {
+		caData, err = base64.StdEncoding.DecodeString(awssdk.StringValue(eksCluster.CertificateAuthority.Data))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode certificate authority of EKS cluster %s", clusterSpec.InternalID)
+		}
+	}
+
+	token, err := client.GetClusterToken(clusterSpec.InternalID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get token for EKS cluster %s", clusterSpec.InternalID)
+	}
+
+	return &rest.Config{
+		Host:        awssdk.StringValue(eksCluster.Endpoint),
+		BearerToken: token,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData: caData,
+		},
+	}, nil
+}
+
+// installOLM makes sure that OLM is available in the cluster so that the operators can be installed with their OLM
+// manifests. The configured OLM manifests, if any, are applied to the cluster: the custom resource definitions first and
+// the remaining resources once the OLM resources are served. Otherwise, an error is returned when OLM is not installed.
+// It returns false while the OLM resources are not served yet.
+func (p *EKSProvider) installOLM(clusterSpec *types.ClusterSpec, restConfig *rest.Config) (bool, error) {
+	var crds, resources []interface{}
+	for _, resource := range p.eksConfig.OLMResources {
+		if r, ok := resource.(map[string]interface{}); ok && r["kind"] == "CustomResourceDefinition" {
+			crds = append(crds, resource)
+		} else {
+			resources = append(resources, resource)
+		}
+	}
+
+	if len(crds) > 0 {
+		if _, err := p.resourceApplier.Apply(restConfig, types.ResourceSet{Resources: crds}); err != nil {
+			return false, errors.Wrapf(err, "failed to apply OLM custom resource definitions to EKS cluster %s", clusterSpec.InternalID)
+		}
+	}
+
+	served, err := p.resourceApplier.IsResourceServed(restConfig, olmGroupVersion, olmSubscriptionResource)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to check whether OLM is installed in EKS cluster %s", clusterSpec.InternalID)
+	}
+	if !served {
+		if len(p.eksConfig.OLMResources) == 0 {
+			return false, errors.Errorf("OLM is not installed in EKS cluster %s: the %q resource of %q is not served. Install OLM in the cluster or set the OLM manifests to install with --eks-olm-manifests-file", clusterSpec.InternalID, olmSubscriptionResource, olmGroupVersion)
+		}
+		glog.V(5).Infof("waiting for the OLM custom resource definitions to be served by EKS cluster %s", clusterSpec.InternalID)
+		return false, nil
+	}
+
+	if len(resources) > 0 {
+		if _, err := p.resourceApplier.Apply(restConfig, types.ResourceSet{Resources: resources}); err != nil {
+			return false, errors.Wrapf(err, "failed to apply OLM manifests to EKS cluster %s", clusterSpec.InternalID)
+		}
+	}
+	return true, nil
+}
+
+// installOLMOperator installs an operator by applying its OLM manifests once OLM is available in the cluster
+func (p *EKSProvider) installOLMOperator(clusterSpec *types.ClusterSpec, resources []interface{}) (bool, error) {
+	restConfig, err := p.getRestConfig(clusterSpec)
+	if err != nil {
+		return false, err
+	}
+
+	if ready, err := p.installOLM(clusterSpec, restConfig); err != nil || !ready {
+		return false, err
+	}
+
+	if _, err := p.resourceApplier.Apply(restConfig, types.ResourceSet{Resources: resources}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetClusterVersion returns an empty version as EKS clusters do not run OpenShift
+func (p *EKSProvider) GetClusterVersion(spec *types.ClusterSpec) (string, error) {
+	return "", nil
+}
+
+// GetClusterDNS returns the DNS of the cluster, built from the configured base domain as EKS clusters do not come with
+// a default ingress domain. A wildcard record of the DNS pointing to the load balancer of the ingress controller of the
+// cluster is registered in Route53, so that the hosts of the Kafka instances of the cluster resolve to its ingress.
+func (p *EKSProvider) GetClusterDNS(clusterSpec *types.ClusterSpec) (string, error) {
+	if p.eksConfig.BaseDomain == "" {
+		return "", errors.Errorf("base domain for EKS cluster %s is not configured", clusterSpec.InternalID)
+	}
+
+	ingressHostname, err := p.getIngressHostname(clusterSpec)
+	if err != nil {
+		return "", err
+	}
+	if ingressHostname == "" {
+		return "", errors.Errorf("load balancer of the ingress controller service %s/%s of EKS cluster %s is not available yet", p.eksConfig.IngressServiceNamespace, p.eksConfig.IngressServiceName, clusterSpec.InternalID)
+	}
+
+	clusterDNS := p.clusterDNS(clusterSpec)
+	if err := p.changeClusterDNSRecord(clusterDNS, ingressHostname, route53.ChangeActionUpsert); err != nil {
+		return "", errors.Wrapf(err, "failed to register DNS %s of EKS cluster %s", clusterDNS, clusterSpec.InternalID)
+	}
+	return clusterDNS, nil
+}
+
+func (p *EKSProvider) clusterDNS(clusterSpec *types.ClusterSpec) string {
+	return fmt.Sprintf("%s.%s", clusterSpec.InternalID, p.eksConfig.BaseDomain)
+}
+
+// getIngressHostname returns the hostname of the load balancer of the ingress controller service of the cluster, or
+// an empty string if it is not available
+func (p *EKSProvider) getIngressHostname(clusterSpec *types.ClusterSpec) (string, error) {
+	restConfig, err := p.getRestConfig(clusterSpec)
+	if err != nil {
+		return "", err
+	}
+
+	service, err := p.resourceApplier.Get(restConfig, serviceResource, p.eksConfig.IngressServiceNamespace, p.eksConfig.IngressServiceName)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get ingress controller service %s/%s of EKS cluster %s", p.eksConfig.IngressServiceNamespace, p.eksConfig.IngressServiceName, clusterSpec.InternalID)
+	}
+	if service == nil {
+		return "", nil
+	}
+
+	ingresses, _, err := unstructured.NestedSlice(service.Object, "status", "loadBalancer", "ingress")
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read the load balancer of ingress controller service %s/%s of EKS cluster %s", p.eksConfig.IngressServiceNamespace, p.eksConfig.IngressServiceName, clusterSpec.InternalID)
+	}
+	for _, ingress := range ingresses {
+		if i, ok := ingress.(map[string]interface{}); ok {
+			if hostname, ok := i["hostname"].(string); ok && hostname != "" {
+				return hostname, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// deleteClusterDNSRecord deletes the wildcard record of the DNS of the cluster. The record is left untouched when the load
+// balancer of the ingress controller of the cluster, which the record points to, cannot be found anymore.
+func (p *EKSProvider) deleteClusterDNSRecord(clusterSpec *types.ClusterSpec) error {
+	if p.eksConfig.BaseDomain == "" {
+		return nil
+	}
+
+	ingressHostname, err := p.getIngressHostname(clusterSpec)
+	if err != nil {
+		glog.Warningf("unable to delete the DNS record of EKS cluster %s: %v", clusterSpec.InternalID, err)
+		return nil
+	}
+	if ingressHostname == "" {
+		return nil
+	}
+
+	clusterDNS := p.clusterDNS(clusterSpec)
+	if err := p.changeClusterDNSRecord(clusterDNS, ingressHostname, route53.ChangeActionDelete); err != nil {
+		return errors.Wrapf(err, "failed to delete DNS %s of EKS cluster %s", clusterDNS, clusterSpec.InternalID)
+	}
+	return nil
+}
+
+// changeClusterDNSRecord applies the given action to the wildcard CNAME record of the cluster DNS in the hosted zone of
+// the base domain
+func (p *EKSProvider) changeClusterDNSRecord(clusterDNS string, ingressHostname string, action string) error {
+	client, err := p.awsClientFactory.NewClient(aws.Config{
+		AccessKeyID:     p.awsConfig.Route53AccessKey,
+		SecretAccessKey: p.awsConfig.Route53SecretAccessKey,
+	}, aws.DefaultAWSRoute53Region)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create Route53 client")
+	}
+
+	_, err = client.ChangeResourceRecordSets(p.eksConfig.BaseDomain, &route53.ChangeBatch{
+		Changes: []*route53.Change{
+			{
+				Action: awssdk.String(action),
+				ResourceRecordSet: &route53.ResourceRecordSet{
+					Name:            awssdk.String("*." + clusterDNS),
+					Type:            awssdk.String(route53.RRTypeCname),
+					TTL:             awssdk.Int64(eksClusterDNSRecordTTL),
+					ResourceRecords: []*route53.ResourceRecord{{Value: awssdk.String(ingressHostname)}},
+				},
+			},
+		},
+	})
+	return err
+}
+
+func (p *EKSProvider) GetCloudProviders() (*types.CloudProviderInfoList, error) {
+	items := []types.CloudProviderInfo{}
+	if len(p.eksConfig.Regions) > 0 {
+		items = append(items, types.CloudProviderInfo{
+			ID:          cloudproviders.AWS.String(),
+			Name:        cloudproviders.AWS.String(),
+			DisplayName: cloudproviders.CloudPoviderIDToDisplayNameMapping[cloudproviders.AWS],
+		})
+	}
+	return &types.CloudProviderInfoList{Items: items}, nil
+}
+
+func (p *EKSProvider) GetCloudProviderRegions(providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
+	items := []types.CloudProviderRegionInfo{}
+	if cloudproviders.ParseCloudProviderID(providerInf.ID) != cloudproviders.AWS {
+		return &types.CloudProviderRegionInfoList{Items: items}, nil
+	}
+
+	for _, region := range p.eksConfig.Regions {
+		items = append(items, types.CloudProviderRegionInfo{
+			ID:              region.Name,
+			CloudProviderID: providerInf.ID,
+			Name:            region.Name,
+			DisplayName:     region.Name,
+			SupportsMultiAZ: region.SupportsMultiAZ,
+		})
+	}
+	return &types.CloudProviderRegionInfoList{Items: items}, nil
+}
+
+// InstallStrimzi installs the strimzi operator by applying its OLM manifests, after installing OLM if needed
+func (p *EKSProvider) InstallStrimzi(clusterSpec *types.ClusterSpec) (bool, error) {
+	return p.installOLMOperator(clusterSpec, p.manifests.buildStrimziOperatorResources())
+}
+
+func (p *EKSProvider) InstallClusterLogging(clusterSpec *types.ClusterSpec, params []types.Parameter) (bool, error) {
+	return true, nil // NOOP for now
+}
+
+// InstallKasFleetshard installs the kas-fleetshard operator by applying its OLM manifests, after installing OLM if needed
+func (p *EKSProvider) InstallKasFleetshard(clusterSpec *types.ClusterSpec, params []types.Parameter) (bool, error) {
+	return p.installOLMOperator(clusterSpec, p.manifests.buildKASFleetShardOperatorResources(params))
+}
+
+func (p *EKSProvider) GetMachinePool(clusterID string, id string) (*types.MachinePoolInfo, error) {
+	cluster, err := p.findCluster(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := p.newEKSClient(cluster.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeGroup, err := client.DescribeNodegroup(clusterID, id)
+	if err != nil {
+		if aws.IsEKSResourceNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get node group %s of EKS cluster %s", id, clusterID)
+	}
+
+	var nodeTaints []types.CluserNodeTaint
+	for _, taint := range nodeGroup.Taints {
+		nodeTaints = append(nodeTaints, types.CluserNodeTaint{
+			Effect: kubernetesNodeTaintEffect(awssdk.StringValue(taint.Effect)),
+			Key:    awssdk.StringValue(taint.Key),
+			Value:  awssdk.StringValue(taint.Value),
+		})
+	}
+
+	res := &types.MachinePoolInfo{
+		ID:         awssdk.StringValue(nodeGroup.NodegroupName),
+		ClusterID:  clusterID,
+		MultiAZ:    len(nodeGroup.Subnets) > 1,
+		NodeLabels: awssdk.StringValueMap(nodeGroup.Labels),
+		NodeTaints: nodeTaints,
+	}
+	if len(nodeGroup.InstanceTypes) > 0 {
+		res.InstanceSize = awssdk.StringValue(nodeGroup.InstanceTypes[0])
+	}
+	if scaling := nodeGroup.ScalingConfig; scaling != nil {
+		res.Replicas = int(awssdk.Int64Value(scaling.DesiredSize))
+		res.AutoScalingEnabled = awssdk.Int64Value(scaling.MinSize) != awssdk.Int64Value(scaling.MaxSize)
+		res.AutoScaling = types.MachinePoolAutoScaling{
+			MinNodes: int(awssdk.Int64Value(scaling.MinSize)),
+			MaxNodes: int(awssdk.Int64Value(scaling.MaxSize)),
+		}
+	}
+
+	return res, nil
+}
+
+func (p *EKSProvider) CreateMachinePool(request *types.MachinePoolRequest) (*types.MachinePoolRequest, error) {
+	cluster, err := p.findCluster(request.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := p.newEKSClient(cluster.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.createNodeGroup(client, request, cluster.Region); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// noop method, it will always return a nil slice as the EKS provider does not have any resource quotas
+func (p *EKSProvider) GetClusterResourceQuotaCosts() ([]types.QuotaCost, error) {
+	var quotaCostList []types.QuotaCost
+	return quotaCostList, nil
+}
+
+func (p *EKSProvider) createNodeGroup(client aws.EKSClient, request *types.MachinePoolRequest, regionName string) (*eks.Nodegroup, error) {
+	region, ok := p.eksConfig.GetRegion(regionName)
+	if !ok {
+		return nil, errors.Errorf("region %q is not configured for the EKS cluster provider", regionName)
+	}
+
+	subnets := region.SubnetIDs
+	// single AZ node groups are placed in the first subnet
+	if !request.MultiAZ && len(subnets) > 1 {
+		subnets = subnets[:1]
+	}
+
+	scaling := &eks.NodegroupScalingConfig{
+		DesiredSize: awssdk.Int64(int64(request.Replicas)),
+		MinSize:     awssdk.Int64(int64(request.Replicas)),
+		MaxSize:     awssdk.Int64(int64(request.Replicas)),
+	}
+	if request.AutoScalingEnabled {
+		if request.AutoScaling.MinNodes > request.AutoScaling.MaxNodes {
+			return nil, fmt.Errorf("error creating node group '%s' for cluster id '%s': minimum number of nodes cannot be more than maximum number of nodes", request.ID, request.ClusterID)
+		}
+		scaling.DesiredSize = awssdk.Int64(int64(request.AutoScaling.MinNodes))
+		scaling.MinSize = awssdk.Int64(int64(request.AutoScaling.MinNodes))
+		scaling.MaxSize = awssdk.Int64(int64(request.AutoScaling.MaxNodes))
+	}
+
+	var taints []*eks.Taint
+	for _, nodeTaint := range request.NodeTaints {
+		effect, ok := eksNodeTaintEffects[nodeTaint.Effect]
+		if !ok {
+			return nil, errors.Errorf("error creating node group '%s' for cluster id '%s': unsupported taint effect %q", request.ID, request.ClusterID, nodeTaint.Effect)
+		}
+		taints = append(taints, &eks.Taint{
+			Effect: awssdk.String(effect),
+			Key:    awssdk.String(nodeTaint.Key),
+			Value:  awssdk.String(nodeTaint.Value),
+		})
+	}
+
+	var labels map[string]*string
+	if len(request.NodeLabels) > 0 {
+		labels = awssdk.StringMap(request.NodeLabels)
+	}
+
+	nodeGroup, err := client.CreateNodegroup(&eks.CreateNodegroupInput{
+		ClusterName:   &request.ClusterID,
+		NodegroupName: &request.ID,
+		NodeRole:      &p.eksConfig.NodeRoleARN,
+		InstanceTypes: awssdk.StringSlice([]string{request.InstanceSize}),
+		Subnets:       awssdk.StringSlice(subnets),
+		ScalingConfig: scaling,
+		Labels:        labels,
+		Taints:        taints,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create node group %s for EKS cluster %s", request.ID, request.ClusterID)
+	}
+
+	return nodeGroup, nil
+}
+
+// findCluster returns the cluster with the given cluster id from the database
+func (p *EKSProvider) findCluster(clusterID string) (*api.Cluster, error) {
+	var cluster api.Cluster
+	if err := p.connectionFactory.New().Where("cluster_id = ?", clusterID).First(&cluster).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to find cluster %s", clusterID)
+	}
+	return &cluster, nil
+}
+
+// newEKSClientForCluster returns an EKS client for the region of the given cluster.
+// The region is read from the cluster spec and falls back to the one stored in the database for the cluster.
+func (p *EKSProvider) newEKSClientForCluster(clusterSpec *types.ClusterSpec) (aws.EKSClient, error) {
+	var info eksClusterInfo
+	if len(clusterSpec.AdditionalInfo) > 0 {
+		if err := json.Unmarshal(clusterSpec.AdditionalInfo, &info); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal EKS cluster information of cluster %s", clusterSpec.InternalID)
+		}
+	}
+
+	if info.Region == "" {
+		cluster, err := p.findCluster(clusterSpec.InternalID)
+		if err != nil {
+			return nil, err
+		}
+		info.Region = cluster.Region
+	}
+
+	return p.newEKSClient(info.Region)
+}
+
+func (p *EKSProvider) newEKSClient(region string) (aws.EKSClient, error) {
+	client, err := p.eksClientFactory.NewEKSClient(aws.Config{
+		AccessKeyID:     p.awsConfig.AccessKey,
+		SecretAccessKey: p.awsConfig.SecretAccessKey,
+	}, region)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create EKS client for region %s", region)
+	}
+	return client, nil
+}
+
+func kubernetesNodeTaintEffect(eksEffect string) string {
+	for kubernetesEffect, effect := range eksNodeTaintEffects {
+		if effect == eksEffect {
+			return kubernetesEffect
+		}
+	}
+	return eksEffect
+}
@@ -0,0 +1,868 @@
+package clusters
+
+import (
+	"encoding/base64"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	mocket "github.com/selvatico/go-mocket"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	testEKSClusterName = "mk-test-cluster"
+	testEKSClusterArn  = "arn:aws:eks:us-east-1:123456789012:cluster/mk-test-cluster"
+	testEKSRegion      = "us-east-1"
+)
+
+var (
+	eksNotFoundErr = awserr.New(eks.ErrCodeResourceNotFoundException, "not found", nil)
+	eksInUseErr    = awserr.New(eks.ErrCodeResourceInUseException, "in use", nil)
+)
+
+type resourceApplierMock struct {
+	restConfigs []*rest.Config
+	applied     []types.ResourceSet
+	err         error
+	notServed   bool
+	servedErr   error
+	resource    *unstructured.Unstructured
+	getErr      error
+}
+
+func (r *resourceApplierMock) Apply(restConfig *rest.Config, resources types.ResourceSet) (*types.ResourceSet, error) {
+	r.restConfigs = append(r.restConfigs, restConfig)
+	if r.err != nil {
+		return nil, r.err
+	}
+	r.applied = append(r.applied, resources)
+	return &resources, nil
+}
+
+func (r *resourceApplierMock) IsResourceServed(restConfig *rest.Config, groupVersion string, resource string) (bool, error) {
+	if r.servedErr != nil {
+		return false, r.servedErr
+	}
+	return !r.notServed, nil
+}
+
+func (r *resourceApplierMock) Get(restConfig *rest.Config, resource schema.GroupVersionResource, namespace string, name string) (*unstructured.Unstructured, error) {
+	if r.getErr != nil {
+		return nil, r.getErr
+	}
+	return r.resource, nil
+}
+
+func newTestEKSConfig() *config.EKSConfig {
+	eksConfig := config.NewEKSConfig()
+	eksConfig.ClusterRoleARN = "cluster-role"
+	eksConfig.NodeRoleARN = "node-role"
+	eksConfig.BaseDomain = "example.com"
+	eksConfig.Regions = []config.EKSRegionConfig{
+		{
+			Name:             testEKSRegion,
+			SubnetIDs:        []string{"subnet-a", "subnet-b", "subnet-c"},
+			SecurityGroupIDs: []string{"sg-a"},
+			SupportsMultiAZ:  true,
+		},
+	}
+	return eksConfig
+}
+
+func newTestEKSProvider(client aws.EKSClient, applier resourceApplier) *EKSProvider {
+	provider := newEKSProvider(db.NewMockConnectionFactory(nil), aws.NewMockEKSClientFactory(client), aws.NewMockClientFactory(&aws.AWSClientMock{}), &config.AWSConfig{}, newTestEKSConfig(), config.NewDataplaneClusterConfig())
+	if applier != nil {
+		provider.resourceApplier = applier
+	}
+	return provider
+}
+
+func newTestIngressService(hostname string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"status": map[string]interface{}{
+			"loadBalancer": map[string]interface{}{
+				"ingress": []interface{}{map[string]interface{}{"hostname": hostname}},
+			},
+		},
+	}}
+}
+
+func newTestEKSClusterSpec() *types.ClusterSpec {
+	return &types.ClusterSpec{
+		InternalID:     testEKSClusterName,
+		AdditionalInfo: api.JSON(`{"region":"us-east-1"}`),
+	}
+}
+
+func TestEKSProvider_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *types.ClusterRequest
+		client  *aws.EKSClientMock
+		wantErr bool
+	}{
+		{
+			name:    "should return an error if the cloud provider is not aws",
+			request: &types.ClusterRequest{CloudProvider: "gcp", Region: testEKSRegion},
+			client:  &aws.EKSClientMock{},
+			wantErr: true,
+		},
+		{
+			name:    "should return an error if the region is not configured",
+			request: &types.ClusterRequest{CloudProvider: "aws", Region: "af-south-1"},
+			client:  &aws.EKSClientMock{},
+			wantErr: true,
+		},
+		{
+			name:    "should return an error if the cluster cannot be created",
+			request: &types.ClusterRequest{CloudProvider: "aws", Region: testEKSRegion},
+			client: &aws.EKSClientMock{
+				CreateClusterFunc: func(input *eks.CreateClusterInput) (*eks.Cluster, error) {
+					return nil, errors.New("failed to create cluster")
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "should create the cluster in the subnets of the requested region",
+			request: &types.ClusterRequest{CloudProvider: "aws", Region: testEKSRegion},
+			client: &aws.EKSClientMock{
+				CreateClusterFunc: func(input *eks.CreateClusterInput) (*eks.Cluster, error) {
+					return &eks.Cluster{Name: input.Name, Arn: awssdk.String(testEKSClusterArn)}, nil
+				},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			provider := newTestEKSProvider(tt.client, nil)
+			spec, err := provider.Create(tt.request)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			g.Expect(spec.InternalID).To(gomega.HavePrefix(eksClusterNamePrefix))
+			g.Expect(spec.ExternalID).To(gomega.Equal(testEKSClusterArn))
+			g.Expect(spec.Status).To(gomega.Equal(api.ClusterProvisioning))
+			g.Expect([]byte(spec.AdditionalInfo)).To(gomega.MatchJSON(`{"region":"us-east-1"}`))
+
+			calls := tt.client.CreateClusterCalls()
+			g.Expect(calls).To(gomega.HaveLen(1))
+			g.Expect(awssdk.StringValue(calls[0].Input.RoleArn)).To(gomega.Equal("cluster-role"))
+			g.Expect(awssdk.StringValueSlice(calls[0].Input.ResourcesVpcConfig.SubnetIds)).To(gomega.Equal([]string{"subnet-a", "subnet-b", "subnet-c"}))
+			g.Expect(awssdk.StringValueSlice(calls[0].Input.ResourcesVpcConfig.SecurityGroupIds)).To(gomega.Equal([]string{"sg-a"}))
+		})
+	}
+}
+
+func TestEKSProvider_CheckClusterStatus(t *testing.T) {
+	tests := []struct {
+		name                  string
+		client                *aws.EKSClientMock
+		setupFn               func()
+		wantErr               bool
+		wantStatus            api.ClusterStatus
+		wantNodeGroupsCreated int
+	}{
+		{
+			name: "should return an error if the cluster cannot be described",
+			client: &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return nil, errors.New("failed to describe cluster")
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should keep the cluster in provisioning while the control plane is being created",
+			client: &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return &eks.Cluster{Status: awssdk.String(eks.ClusterStatusCreating)}, nil
+				},
+			},
+			wantStatus: api.ClusterProvisioning,
+		},
+		{
+			name: "should mark the cluster as failed if the control plane failed to be created",
+			client: &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return &eks.Cluster{Status: awssdk.String(eks.ClusterStatusFailed)}, nil
+				},
+			},
+			wantStatus: api.ClusterFailed,
+		},
+		{
+			name: "should create the default node group once the control plane is active",
+			client: &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return &eks.Cluster{Status: awssdk.String(eks.ClusterStatusActive)}, nil
+				},
+				DescribeNodegroupFunc: func(clusterName string, nodegroupName string) (*eks.Nodegroup, error) {
+					return nil, eksNotFoundErr
+				},
+				CreateNodegroupFunc: func(input *eks.CreateNodegroupInput) (*eks.Nodegroup, error) {
+					return &eks.Nodegroup{Status: awssdk.String(eks.NodegroupStatusCreating)}, nil
+				},
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "clusters" WHERE cluster_id = $1`).WithReply([]map[string]interface{}{{"cluster_id": testEKSClusterName, "region": testEKSRegion, "multi_az": true}})
+			},
+			wantStatus:            api.ClusterProvisioning,
+			wantNodeGroupsCreated: 1,
+		},
+		{
+			name: "should mark the cluster as provisioned once the default node group is active",
+			client: &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return &eks.Cluster{Status: awssdk.String(eks.ClusterStatusActive)}, nil
+				},
+				DescribeNodegroupFunc: func(clusterName string, nodegroupName string) (*eks.Nodegroup, error) {
+					return &eks.Nodegroup{Status: awssdk.String(eks.NodegroupStatusActive)}, nil
+				},
+			},
+			wantStatus: api.ClusterProvisioned,
+		},
+		{
+			name: "should mark the cluster as failed if the default node group failed to be created",
+			client: &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return &eks.Cluster{Status: awssdk.String(eks.ClusterStatusActive)}, nil
+				},
+				DescribeNodegroupFunc: func(clusterName string, nodegroupName string) (*eks.Nodegroup, error) {
+					return &eks.Nodegroup{Status: awssdk.String(eks.NodegroupStatusCreateFailed)}, nil
+				},
+			},
+			wantStatus: api.ClusterFailed,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+			provider := newTestEKSProvider(tt.client, nil)
+			spec, err := provider.CheckClusterStatus(newTestEKSClusterSpec())
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+			g.Expect(spec.Status).To(gomega.Equal(tt.wantStatus))
+
+			calls := tt.client.CreateNodegroupCalls()
+			g.Expect(calls).To(gomega.HaveLen(tt.wantNodeGroupsCreated))
+			for _, call := range calls {
+				g.Expect(awssdk.StringValue(call.Input.NodegroupName)).To(gomega.Equal(eksDefaultNodeGroupName))
+				g.Expect(awssdk.StringValueSlice(call.Input.Subnets)).To(gomega.HaveLen(3))
+			}
+		})
+	}
+}
+
+func TestEKSProvider_Delete(t *testing.T) {
+	tests := []struct {
+		name                   string
+		client                 *aws.EKSClientMock
+		want                   bool
+		wantErr                bool
+		ingressService         *unstructured.Unstructured
+		wantNodeGroupsDeleted  int
+		wantClusterDeleteCalls int
+		wantDNSRecordDeleted   bool
+	}{
+		{
+			name: "should return true if the cluster does not exist anymore",
+			client: &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return nil, eksNotFoundErr
+				},
+			},
+			want: true,
+		},
+		{
+			name: "should return an error if the cluster cannot be described",
+			client: &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return nil, errors.New("failed to describe cluster")
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should wait for the cluster to be deleted",
+			client: &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return &eks.Cluster{Status: awssdk.String(eks.ClusterStatusDeleting)}, nil
+				},
+			},
+		},
+		{
+			name: "should delete the node groups before the cluster",
+			client: &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return &eks.Cluster{Status: awssdk.String(eks.ClusterStatusActive)}, nil
+				},
+				ListNodegroupsFunc: func(clusterName string) ([]string, error) {
+					return []string{eksDefaultNodeGroupName, "kafka-standard"}, nil
+				},
+				DeleteNodegroupFunc: func(clusterName string, nodegroupName string) error {
+					if nodegroupName == eksDefaultNodeGroupName {
+						return eksInUseErr
+					}
+					return nil
+				},
+			},
+			wantNodeGroupsDeleted: 2,
+		},
+		{
+			name: "should delete the DNS record of the cluster along with its node groups",
+			client: &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return &eks.Cluster{Status: awssdk.String(eks.ClusterStatusActive)}, nil
+				},
+				ListNodegroupsFunc: func(clusterName string) ([]string, error) {
+					return []string{eksDefaultNodeGroupName}, nil
+				},
+				DeleteNodegroupFunc: func(clusterName string, nodegroupName string) error {
+					return nil
+				},
+			},
+			ingressService:        newTestIngressService("ingress.elb.amazonaws.com"),
+			wantNodeGroupsDeleted: 1,
+			wantDNSRecordDeleted:  true,
+		},
+		{
+			name: "should delete the cluster once it has no node groups",
+			client: &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return &eks.Cluster{Status: awssdk.String(eks.ClusterStatusActive)}, nil
+				},
+				ListNodegroupsFunc: func(clusterName string) ([]string, error) {
+					return nil, nil
+				},
+				DeleteClusterFunc: func(clusterName string) error {
+					return nil
+				},
+			},
+			wantClusterDeleteCalls: 1,
+		},
+		{
+			name: "should return an error if the cluster cannot be deleted",
+			client: &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return &eks.Cluster{Status: awssdk.String(eks.ClusterStatusActive)}, nil
+				},
+				ListNodegroupsFunc: func(clusterName string) ([]string, error) {
+					return nil, nil
+				},
+				DeleteClusterFunc: func(clusterName string) error {
+					return errors.New("failed to delete cluster")
+				},
+			},
+			wantErr:                true,
+			wantClusterDeleteCalls: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			if tt.client.GetClusterTokenFunc == nil {
+				tt.client.GetClusterTokenFunc = func(clusterName string) (string, error) {
+					return "token", nil
+				}
+			}
+			route53Client := &aws.AWSClientMock{
+				ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
+					return &route53.ChangeResourceRecordSetsOutput{}, nil
+				},
+			}
+			provider := newTestEKSProvider(tt.client, &resourceApplierMock{resource: tt.ingressService})
+			provider.awsClientFactory = aws.NewMockClientFactory(route53Client)
+			removed, err := provider.Delete(newTestEKSClusterSpec())
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(removed).To(gomega.Equal(tt.want))
+			g.Expect(tt.client.DeleteNodegroupCalls()).To(gomega.HaveLen(tt.wantNodeGroupsDeleted))
+			g.Expect(tt.client.DeleteClusterCalls()).To(gomega.HaveLen(tt.wantClusterDeleteCalls))
+			if tt.wantDNSRecordDeleted {
+				calls := route53Client.ChangeResourceRecordSetsCalls()
+				g.Expect(calls).To(gomega.HaveLen(1))
+				g.Expect(awssdk.StringValue(calls[0].RecordChangeBatch.Changes[0].Action)).To(gomega.Equal(route53.ChangeActionDelete))
+				g.Expect(awssdk.StringValue(calls[0].RecordChangeBatch.Changes[0].ResourceRecordSet.Name)).To(gomega.Equal("*.mk-test-cluster.example.com"))
+			} else {
+				g.Expect(route53Client.ChangeResourceRecordSetsCalls()).To(gomega.BeEmpty())
+			}
+		})
+	}
+}
+
+func TestEKSProvider_AddIdentityProvider(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  *aws.EKSClientMock
+		wantErr bool
+	}{
+		{
+			name: "should associate the OpenID identity provider to the cluster",
+			client: &aws.EKSClientMock{
+				AssociateIdentityProviderConfigFunc: func(input *eks.AssociateIdentityProviderConfigInput) error {
+					return nil
+				},
+			},
+		},
+		{
+			name: "should not return an error if the identity provider is already associated",
+			client: &aws.EKSClientMock{
+				AssociateIdentityProviderConfigFunc: func(input *eks.AssociateIdentityProviderConfigInput) error {
+					return eksInUseErr
+				},
+			},
+		},
+		{
+			name: "should return an error if the identity provider cannot be associated",
+			client: &aws.EKSClientMock{
+				AssociateIdentityProviderConfigFunc: func(input *eks.AssociateIdentityProviderConfigInput) error {
+					return errors.New("failed to associate identity provider")
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			provider := newTestEKSProvider(tt.client, nil)
+			idp, err := provider.AddIdentityProvider(newTestEKSClusterSpec(), types.IdentityProviderInfo{
+				OpenID: &types.OpenIDIdentityProviderInfo{
+					Name:     "Kafka_SRE",
+					ClientID: "client-id",
+					Issuer:   "https://issuer",
+				},
+			})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+			g.Expect(idp.OpenID.ID).To(gomega.Equal("Kafka_SRE"))
+			calls := tt.client.AssociateIdentityProviderConfigCalls()
+			g.Expect(calls).To(gomega.HaveLen(1))
+			g.Expect(awssdk.StringValue(calls[0].Input.Oidc.IssuerUrl)).To(gomega.Equal("https://issuer"))
+		})
+	}
+}
+
+func TestEKSProvider_InstallStrimzi(t *testing.T) {
+	caData := []byte("ca-data")
+	olmCRD := map[string]interface{}{"apiVersion": "apiextensions.k8s.io/v1", "kind": "CustomResourceDefinition"}
+	olmNamespace := map[string]interface{}{"apiVersion": "v1", "kind": "Namespace"}
+	newClient := func() *aws.EKSClientMock {
+		return &aws.EKSClientMock{
+			DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+				return &eks.Cluster{
+					Endpoint:             awssdk.String("https://api.mk-test-cluster"),
+					CertificateAuthority: &eks.Certificate{Data: awssdk.String(base64.StdEncoding.EncodeToString(caData))},
+				}, nil
+			},
+			GetClusterTokenFunc: func(clusterName string) (string, error) {
+				return "token", nil
+			},
+		}
+	}
+	tests := []struct {
+		name         string
+		client       *aws.EKSClientMock
+		applier      *resourceApplierMock
+		olmResources []interface{}
+		want         bool
+		wantErr      bool
+		wantApplied  int
+	}{
+		{
+			name:        "should apply the strimzi operator manifests using the cluster endpoint and token",
+			client:      newClient(),
+			applier:     &resourceApplierMock{},
+			want:        true,
+			wantApplied: 1,
+		},
+		{
+			name: "should return an error if the token cannot be retrieved",
+			client: &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return &eks.Cluster{Endpoint: awssdk.String("https://api.mk-test-cluster")}, nil
+				},
+				GetClusterTokenFunc: func(clusterName string) (string, error) {
+					return "", errors.New("failed to get token")
+				},
+			},
+			applier: &resourceApplierMock{},
+			wantErr: true,
+		},
+		{
+			name:    "should return an error if the manifests cannot be applied",
+			client:  newClient(),
+			applier: &resourceApplierMock{err: errors.New("failed to apply resources")},
+			wantErr: true,
+		},
+		{
+			name:    "should return an error if OLM is not installed and no OLM manifests are configured",
+			client:  newClient(),
+			applier: &resourceApplierMock{notServed: true},
+			wantErr: true,
+		},
+		{
+			name:    "should return an error if it cannot be checked whether OLM is installed",
+			client:  newClient(),
+			applier: &resourceApplierMock{servedErr: errors.New("failed to discover resources")},
+			wantErr: true,
+		},
+		{
+			name:         "should only apply the OLM custom resource definitions while they are not served",
+			client:       newClient(),
+			applier:      &resourceApplierMock{notServed: true},
+			olmResources: []interface{}{olmCRD, olmNamespace},
+			want:         false,
+			wantApplied:  1,
+		},
+		{
+			name:         "should apply the OLM manifests before the strimzi operator manifests",
+			client:       newClient(),
+			applier:      &resourceApplierMock{},
+			olmResources: []interface{}{olmCRD, olmNamespace},
+			want:         true,
+			wantApplied:  3,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			provider := newTestEKSProvider(tt.client, tt.applier)
+			provider.eksConfig.OLMResources = tt.olmResources
+			ready, err := provider.InstallStrimzi(newTestEKSClusterSpec())
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(ready).To(gomega.Equal(tt.want))
+			if tt.wantErr {
+				return
+			}
+			g.Expect(tt.applier.applied).To(gomega.HaveLen(tt.wantApplied))
+			g.Expect(tt.applier.restConfigs[0].Host).To(gomega.Equal("https://api.mk-test-cluster"))
+			g.Expect(tt.applier.restConfigs[0].BearerToken).To(gomega.Equal("token"))
+			g.Expect(tt.applier.restConfigs[0].TLSClientConfig.CAData).To(gomega.Equal(caData))
+			if len(tt.olmResources) > 0 {
+				g.Expect(tt.applier.applied[0].Resources).To(gomega.Equal([]interface{}{olmCRD}))
+			}
+			if tt.wantApplied == 3 {
+				g.Expect(tt.applier.applied[1].Resources).To(gomega.Equal([]interface{}{olmNamespace}))
+			}
+		})
+	}
+}
+
+func TestEKSProvider_GetClusterDNS(t *testing.T) {
+	tests := []struct {
+		name          string
+		noBaseDomain  bool
+		applier       *resourceApplierMock
+		route53Err    error
+		want          string
+		wantErr       bool
+		wantDNSRecord bool
+	}{
+		{
+			name:          "should register the DNS of the cluster pointing to the load balancer of its ingress controller",
+			applier:       &resourceApplierMock{resource: newTestIngressService("ingress.elb.amazonaws.com")},
+			want:          "mk-test-cluster.example.com",
+			wantDNSRecord: true,
+		},
+		{
+			name:         "should return an error if the base domain is not configured",
+			noBaseDomain: true,
+			applier:      &resourceApplierMock{resource: newTestIngressService("ingress.elb.amazonaws.com")},
+			wantErr:      true,
+		},
+		{
+			name:    "should return an error if the ingress controller service does not exist",
+			applier: &resourceApplierMock{},
+			wantErr: true,
+		},
+		{
+			name:    "should return an error if the load balancer of the ingress controller is not available yet",
+			applier: &resourceApplierMock{resource: newTestIngressService("")},
+			wantErr: true,
+		},
+		{
+			name:    "should return an error if the ingress controller service cannot be retrieved",
+			applier: &resourceApplierMock{getErr: errors.New("failed to get service")},
+			wantErr: true,
+		},
+		{
+			name:          "should return an error if the DNS record cannot be registered",
+			applier:       &resourceApplierMock{resource: newTestIngressService("ingress.elb.amazonaws.com")},
+			route53Err:    errors.New("failed to change record sets"),
+			wantErr:       true,
+			wantDNSRecord: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			client := &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return &eks.Cluster{Endpoint: awssdk.String("https://api.mk-test-cluster")}, nil
+				},
+				GetClusterTokenFunc: func(clusterName string) (string, error) {
+					return "token", nil
+				},
+			}
+			route53Client := &aws.AWSClientMock{
+				ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
+					return &route53.ChangeResourceRecordSetsOutput{}, tt.route53Err
+				},
+			}
+			provider := newTestEKSProvider(client, tt.applier)
+			provider.awsClientFactory = aws.NewMockClientFactory(route53Client)
+			if tt.noBaseDomain {
+				provider.eksConfig.BaseDomain = ""
+			}
+
+			dns, err := provider.GetClusterDNS(newTestEKSClusterSpec())
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(dns).To(gomega.Equal(tt.want))
+			if !tt.wantDNSRecord {
+				g.Expect(route53Client.ChangeResourceRecordSetsCalls()).To(gomega.BeEmpty())
+				return
+			}
+			calls := route53Client.ChangeResourceRecordSetsCalls()
+			g.Expect(calls).To(gomega.HaveLen(1))
+			g.Expect(calls[0].DnsName).To(gomega.Equal("example.com"))
+			change := calls[0].RecordChangeBatch.Changes[0]
+			g.Expect(awssdk.StringValue(change.Action)).To(gomega.Equal(route53.ChangeActionUpsert))
+			g.Expect(awssdk.StringValue(change.ResourceRecordSet.Name)).To(gomega.Equal("*.mk-test-cluster.example.com"))
+			g.Expect(awssdk.StringValue(change.ResourceRecordSet.Type)).To(gomega.Equal(route53.RRTypeCname))
+			g.Expect(awssdk.StringValue(change.ResourceRecordSet.ResourceRecords[0].Value)).To(gomega.Equal("ingress.elb.amazonaws.com"))
+		})
+	}
+}
+
+func TestEKSProvider_GetCloudProviderRegions(t *testing.T) {
+	g := gomega.NewWithT(t)
+	provider := newTestEKSProvider(&aws.EKSClientMock{}, nil)
+
+	providers, err := provider.GetCloudProviders()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(providers.Items).To(gomega.Equal([]types.CloudProviderInfo{{ID: "aws", Name: "aws", DisplayName: "Amazon Web Services"}}))
+
+	regions, err := provider.GetCloudProviderRegions(providers.Items[0])
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(regions.Items).To(gomega.Equal([]types.CloudProviderRegionInfo{
+		{ID: testEKSRegion, CloudProviderID: "aws", Name: testEKSRegion, DisplayName: testEKSRegion, SupportsMultiAZ: true},
+	}))
+
+	regions, err = provider.GetCloudProviderRegions(types.CloudProviderInfo{ID: "gcp"})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(regions.Items).To(gomega.BeEmpty())
+}
+
+func TestEKSProvider_CreateMachinePool(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     *types.MachinePoolRequest
+		client      *aws.EKSClientMock
+		setupFn     func()
+		wantErr     bool
+		wantSubnets int
+		wantTaint   string
+	}{
+		{
+			name: "should return an error if the cluster cannot be found",
+			request: &types.MachinePoolRequest{
+				ID:        "kafka-standard",
+				ClusterID: testEKSClusterName,
+			},
+			client: &aws.EKSClientMock{},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "clusters"`).WithError(errors.New("record not found"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error if the taint effect is not supported",
+			request: &types.MachinePoolRequest{
+				ID:         "kafka-standard",
+				ClusterID:  testEKSClusterName,
+				NodeTaints: []types.CluserNodeTaint{{Effect: "Unknown", Key: "key", Value: "value"}},
+			},
+			client:  &aws.EKSClientMock{},
+			wantErr: true,
+		},
+		{
+			name: "should create an autoscaled multi AZ node group",
+			request: &types.MachinePoolRequest{
+				ID:                 "kafka-standard",
+				ClusterID:          testEKSClusterName,
+				InstanceSize:       "r5.xlarge",
+				MultiAZ:            true,
+				AutoScalingEnabled: true,
+				AutoScaling:        types.MachinePoolAutoScaling{MinNodes: 3, MaxNodes: 18},
+				NodeLabels:         map[string]string{"bf2.org/kafkaInstanceProfileType": "standard"},
+				NodeTaints:         []types.CluserNodeTaint{{Effect: "NoExecute", Key: "bf2.org/kafkaInstanceProfileType", Value: "standard"}},
+			},
+			client: &aws.EKSClientMock{
+				CreateNodegroupFunc: func(input *eks.CreateNodegroupInput) (*eks.Nodegroup, error) {
+					return &eks.Nodegroup{}, nil
+				},
+			},
+			wantSubnets: 3,
+			wantTaint:   eks.TaintEffectNoExecute,
+		},
+		{
+			name: "should create a single AZ node group in the first subnet of the region",
+			request: &types.MachinePoolRequest{
+				ID:           "kafka-developer",
+				ClusterID:    testEKSClusterName,
+				InstanceSize: "m5.xlarge",
+				Replicas:     1,
+			},
+			client: &aws.EKSClientMock{
+				CreateNodegroupFunc: func(input *eks.CreateNodegroupInput) (*eks.Nodegroup, error) {
+					return &eks.Nodegroup{}, nil
+				},
+			},
+			wantSubnets: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			if tt.setupFn != nil {
+				tt.setupFn()
+			} else {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "clusters" WHERE cluster_id = $1`).WithReply([]map[string]interface{}{{"cluster_id": testEKSClusterName, "region": testEKSRegion}})
+			}
+			provider := newTestEKSProvider(tt.client, nil)
+			_, err := provider.CreateMachinePool(tt.request)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			calls := tt.client.CreateNodegroupCalls()
+			g.Expect(calls).To(gomega.HaveLen(1))
+			input := calls[0].Input
+			g.Expect(awssdk.StringValueSlice(input.Subnets)).To(gomega.HaveLen(tt.wantSubnets))
+			g.Expect(awssdk.StringValueSlice(input.InstanceTypes)).To(gomega.Equal([]string{tt.request.InstanceSize}))
+			g.Expect(awssdk.StringValue(input.NodeRole)).To(gomega.Equal("node-role"))
+			if tt.request.AutoScalingEnabled {
+				g.Expect(awssdk.Int64Value(input.ScalingConfig.MinSize)).To(gomega.Equal(int64(tt.request.AutoScaling.MinNodes)))
+				g.Expect(awssdk.Int64Value(input.ScalingConfig.MaxSize)).To(gomega.Equal(int64(tt.request.AutoScaling.MaxNodes)))
+			} else {
+				g.Expect(awssdk.Int64Value(input.ScalingConfig.DesiredSize)).To(gomega.Equal(int64(tt.request.Replicas)))
+			}
+			if tt.wantTaint != "" {
+				g.Expect(input.Taints).To(gomega.HaveLen(1))
+				g.Expect(awssdk.StringValue(input.Taints[0].Effect)).To(gomega.Equal(tt.wantTaint))
+			}
+		})
+	}
+}
+
+func TestEKSProvider_GetMachinePool(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  *aws.EKSClientMock
+		want    *types.MachinePoolInfo
+		wantErr bool
+	}{
+		{
+			name: "should return nil if the node group does not exist",
+			client: &aws.EKSClientMock{
+				DescribeNodegroupFunc: func(clusterName string, nodegroupName string) (*eks.Nodegroup, error) {
+					return nil, eksNotFoundErr
+				},
+			},
+		},
+		{
+			name: "should return an error if the node group cannot be described",
+			client: &aws.EKSClientMock{
+				DescribeNodegroupFunc: func(clusterName string, nodegroupName string) (*eks.Nodegroup, error) {
+					return nil, errors.New("failed to describe node group")
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return the node group information",
+			client: &aws.EKSClientMock{
+				DescribeNodegroupFunc: func(clusterName string, nodegroupName string) (*eks.Nodegroup, error) {
+					return &eks.Nodegroup{
+						NodegroupName: awssdk.String(nodegroupName),
+						InstanceTypes: awssdk.StringSlice([]string{"r5.xlarge"}),
+						Subnets:       awssdk.StringSlice([]string{"subnet-a", "subnet-b"}),
+						ScalingConfig: &eks.NodegroupScalingConfig{
+							DesiredSize: awssdk.Int64(3),
+							MinSize:     awssdk.Int64(3),
+							MaxSize:     awssdk.Int64(6),
+						},
+						Labels: awssdk.StringMap(map[string]string{"label": "value"}),
+						Taints: []*eks.Taint{{Effect: awssdk.String(eks.TaintEffectNoSchedule), Key: awssdk.String("key"), Value: awssdk.String("value")}},
+					}, nil
+				},
+			},
+			want: &types.MachinePoolInfo{
+				ID:                 "kafka-standard",
+				ClusterID:          testEKSClusterName,
+				InstanceSize:       "r5.xlarge",
+				MultiAZ:            true,
+				Replicas:           3,
+				AutoScalingEnabled: true,
+				AutoScaling:        types.MachinePoolAutoScaling{MinNodes: 3, MaxNodes: 6},
+				NodeLabels:         map[string]string{"label": "value"},
+				NodeTaints:         []types.CluserNodeTaint{{Effect: "NoSchedule", Key: "key", Value: "value"}},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "clusters" WHERE cluster_id = $1`).WithReply([]map[string]interface{}{{"cluster_id": testEKSClusterName, "region": testEKSRegion}})
+			provider := newTestEKSProvider(tt.client, nil)
+			got, err := provider.GetMachinePool(testEKSClusterName, "kafka-standard")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -97,6 +97,17 @@ func (o *OCMProvider) CheckClusterStatus(spec *types.ClusterSpec) (*types.Cluste
 	return spec, nil
 }
 
+func (o *OCMProvider) GetClusterVersion(spec *types.ClusterSpec) (string, error) {
+	ocmCluster, err := o.ocmClient.GetCluster(spec.InternalID)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get cluster %s", spec.InternalID)
+	}
+	if version := ocmCluster.OpenshiftVersion(); version != "" {
+		return version, nil
+	}
+	return ocmCluster.Version().RawID(), nil
+}
+
 func (o *OCMProvider) Delete(spec *types.ClusterSpec) (bool, error) {
 	code, err := o.ocmClient.DeleteCluster(spec.InternalID)
 	if err != nil && code != http.StatusNotFound {
@@ -221,6 +221,71 @@ func TestOCMProvider_CheckClusterStatus(t *testing.T) {
 	}
 }
 
+func TestOCMProvider_GetClusterVersion(t *testing.T) {
+	type fields struct {
+		ocmClient ocm.Client
+	}
+
+	spec := &types.ClusterSpec{
+		InternalID: "test-internal-id",
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "should return the OpenShift version of the cluster",
+			fields: fields{
+				ocmClient: &ocm.ClientMock{
+					GetClusterFunc: func(clusterID string) (*clustersmgmtv1.Cluster, error) {
+						return clustersmgmtv1.NewCluster().OpenshiftVersion("4.11.9").Build()
+					},
+				},
+			},
+			want:    "4.11.9",
+			wantErr: false,
+		},
+		{
+			name: "should fall back to the version of the cluster when the OpenShift version is not set",
+			fields: fields{
+				ocmClient: &ocm.ClientMock{
+					GetClusterFunc: func(clusterID string) (*clustersmgmtv1.Cluster, error) {
+						return clustersmgmtv1.NewCluster().Version(clustersmgmtv1.NewVersion().RawID("4.10.38")).Build()
+					},
+				},
+			},
+			want:    "4.10.38",
+			wantErr: false,
+		},
+		{
+			name: "should return error when failed to get cluster from OCM",
+			fields: fields{
+				ocmClient: &ocm.ClientMock{
+					GetClusterFunc: func(clusterID string) (*clustersmgmtv1.Cluster, error) {
+						return nil, errors.Errorf("failed to get cluster")
+					},
+				},
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		test := testcase
+		t.Run(test.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			p := newOCMProvider(test.fields.ocmClient, nil, &ocm.OCMConfig{})
+			version, err := p.GetClusterVersion(spec)
+			g.Expect(version).To(gomega.Equal(test.want))
+			g.Expect(err != nil).To(gomega.Equal(test.wantErr))
+		})
+	}
+}
+
 func TestOCMProvider_Delete(t *testing.T) {
 	type fields struct {
 		ocmClient ocm.Client
@@ -4,6 +4,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 
@@ -20,6 +21,8 @@ type Provider interface {
 	// It should set the status in the returned `ClusterSpec` to either `provisioning`, `ready` or `failed`.
 	// If there is additional data that needs to be preserved and passed between checks, add it to the returned `ClusterSpec` and it will be saved to the database and passed into this function again next time it is called.
 	CheckClusterStatus(spec *types.ClusterSpec) (*types.ClusterSpec, error)
+	// GetClusterVersion returns the OpenShift version of the cluster. An empty version is returned when the provider cannot determine it
+	GetClusterVersion(spec *types.ClusterSpec) (string, error)
 	// AddIdentityProvider add an identity provider to the cluster
 	AddIdentityProvider(clusterSpec *types.ClusterSpec, identityProvider types.IdentityProviderInfo) (*types.IdentityProviderInfo, error)
 	// ApplyResources apply openshift/k8s resources to the cluster
@@ -62,15 +65,20 @@ func NewDefaultProviderFactory(
 	awsConfig *config.AWSConfig,
 	gcpConfig *config.GCPConfig,
 	dataplaneClusterConfig *config.DataplaneClusterConfig,
+	eksConfig *config.EKSConfig,
+	eksClientFactory aws.EKSClientFactory,
+	awsClientFactory aws.ClientFactory,
 ) *DefaultProviderFactory {
 
 	clusterBuilder := NewClusterBuilder(awsConfig, gcpConfig, dataplaneClusterConfig)
 	ocmProvider := newOCMProvider(ocmClient, clusterBuilder, ocmConfig)
 	standaloneProvider := newStandaloneProvider(connectionFactory, dataplaneClusterConfig)
+	eksProvider := newEKSProvider(connectionFactory, eksClientFactory, awsClientFactory, awsConfig, eksConfig, dataplaneClusterConfig)
 	return &DefaultProviderFactory{
 		providerContainer: map[api.ClusterProviderType]Provider{
 			api.ClusterProviderStandalone: standaloneProvider,
 			api.ClusterProviderOCM:        ocmProvider,
+			api.ClusterProviderAwsEKS:     eksProvider,
 		},
 	}
 
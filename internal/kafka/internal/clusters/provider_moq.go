@@ -49,6 +49,9 @@ var _ Provider = &ProviderMock{}
 //			GetClusterResourceQuotaCostsFunc: func() ([]types.QuotaCost, error) {
 //				panic("mock out the GetClusterResourceQuotaCosts method")
 //			},
+//			GetClusterVersionFunc: func(spec *types.ClusterSpec) (string, error) {
+//				panic("mock out the GetClusterVersion method")
+//			},
 //			GetMachinePoolFunc: func(clusterID string, id string) (*types.MachinePoolInfo, error) {
 //				panic("mock out the GetMachinePool method")
 //			},
@@ -98,6 +101,9 @@ type ProviderMock struct {
 	// GetClusterResourceQuotaCostsFunc mocks the GetClusterResourceQuotaCosts method.
 	GetClusterResourceQuotaCostsFunc func() ([]types.QuotaCost, error)
 
+	// GetClusterVersionFunc mocks the GetClusterVersion method.
+	GetClusterVersionFunc func(spec *types.ClusterSpec) (string, error)
+
 	// GetMachinePoolFunc mocks the GetMachinePool method.
 	GetMachinePoolFunc func(clusterID string, id string) (*types.MachinePoolInfo, error)
 
@@ -162,6 +168,11 @@ type ProviderMock struct {
 		// GetClusterResourceQuotaCosts holds details about calls to the GetClusterResourceQuotaCosts method.
 		GetClusterResourceQuotaCosts []struct {
 		}
+		// GetClusterVersion holds details about calls to the GetClusterVersion method.
+		GetClusterVersion []struct {
+			// Spec is the spec argument value.
+			Spec *types.ClusterSpec
+		}
 		// GetMachinePool holds details about calls to the GetMachinePool method.
 		GetMachinePool []struct {
 			// ClusterID is the clusterID argument value.
@@ -199,6 +210,7 @@ type ProviderMock struct {
 	lockGetCloudProviders            sync.RWMutex
 	lockGetClusterDNS                sync.RWMutex
 	lockGetClusterResourceQuotaCosts sync.RWMutex
+	lockGetClusterVersion            sync.RWMutex
 	lockGetMachinePool               sync.RWMutex
 	lockInstallClusterLogging        sync.RWMutex
 	lockInstallKasFleetshard         sync.RWMutex
@@ -523,6 +535,38 @@ func (mock *ProviderMock) GetClusterResourceQuotaCostsCalls() []struct {
 	return calls
 }
 
+// GetClusterVersion calls GetClusterVersionFunc.
+func (mock *ProviderMock) GetClusterVersion(spec *types.ClusterSpec) (string, error) {
+	if mock.GetClusterVersionFunc == nil {
+		panic("ProviderMock.GetClusterVersionFunc: method is nil but Provider.GetClusterVersion was just called")
+	}
+	callInfo := struct {
+		Spec *types.ClusterSpec
+	}{
+		Spec: spec,
+	}
+	mock.lockGetClusterVersion.Lock()
+	mock.calls.GetClusterVersion = append(mock.calls.GetClusterVersion, callInfo)
+	mock.lockGetClusterVersion.Unlock()
+	return mock.GetClusterVersionFunc(spec)
+}
+
+// GetClusterVersionCalls gets all the calls that were made to GetClusterVersion.
+// Check the length with:
+//
+//	len(mockedProvider.GetClusterVersionCalls())
+func (mock *ProviderMock) GetClusterVersionCalls() []struct {
+	Spec *types.ClusterSpec
+} {
+	var calls []struct {
+		Spec *types.ClusterSpec
+	}
+	mock.lockGetClusterVersion.RLock()
+	calls = mock.calls.GetClusterVersion
+	mock.lockGetClusterVersion.RUnlock()
+	return calls
+}
+
 // GetMachinePool calls GetMachinePoolFunc.
 func (mock *ProviderMock) GetMachinePool(clusterID string, id string) (*types.MachinePoolInfo, error) {
 	if mock.GetMachinePoolFunc == nil {
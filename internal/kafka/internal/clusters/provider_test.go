@@ -5,6 +5,7 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/onsi/gomega"
@@ -18,6 +19,9 @@ func TestNewDefaultProviderFactory(t *testing.T) {
 		awsConfig              *config.AWSConfig
 		gcpConfig              *config.GCPConfig
 		dataplaneClusterConfig *config.DataplaneClusterConfig
+		eksConfig              *config.EKSConfig
+		eksClientFactory       aws.EKSClientFactory
+		awsClientFactory       aws.ClientFactory
 	}
 	tests := []struct {
 		name string
@@ -30,6 +34,10 @@ func TestNewDefaultProviderFactory(t *testing.T) {
 			want: &DefaultProviderFactory{
 				providerContainer: map[api.ClusterProviderType]Provider{
 					api.ClusterProviderStandalone: &StandaloneProvider{},
+					api.ClusterProviderAwsEKS: &EKSProvider{
+						manifests:       &StandaloneProvider{},
+						resourceApplier: &dynamicClientResourceApplier{},
+					},
 					api.ClusterProviderOCM: &OCMProvider{
 						clusterBuilder: &clusterBuilder{
 							idGenerator: ocm.NewIDGenerator("mk-"),
@@ -43,7 +51,7 @@ func TestNewDefaultProviderFactory(t *testing.T) {
 		tt := testcase
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			got := NewDefaultProviderFactory(tt.args.ocmClient, tt.args.connectionFactory, tt.args.ocmConfig, tt.args.awsConfig, tt.args.gcpConfig, tt.args.dataplaneClusterConfig)
+			got := NewDefaultProviderFactory(tt.args.ocmClient, tt.args.connectionFactory, tt.args.ocmConfig, tt.args.awsConfig, tt.args.gcpConfig, tt.args.dataplaneClusterConfig, tt.args.eksConfig, tt.args.eksClientFactory, tt.args.awsClientFactory)
 			g.Expect(got).To(gomega.Equal(tt.want))
 		})
 	}
@@ -68,7 +76,11 @@ func TestDefaultProviderFactory_GetProvider(t *testing.T) {
 			fields: fields{
 				providerContainer: map[api.ClusterProviderType]Provider{
 					api.ClusterProviderStandalone: &StandaloneProvider{},
-					api.ClusterProviderOCM:        &OCMProvider{},
+					api.ClusterProviderAwsEKS: &EKSProvider{
+						manifests:       &StandaloneProvider{},
+						resourceApplier: &dynamicClientResourceApplier{},
+					},
+					api.ClusterProviderOCM: &OCMProvider{},
 				},
 			},
 			args: args{
@@ -81,7 +93,11 @@ func TestDefaultProviderFactory_GetProvider(t *testing.T) {
 			fields: fields{
 				providerContainer: map[api.ClusterProviderType]Provider{
 					api.ClusterProviderStandalone: &StandaloneProvider{},
-					api.ClusterProviderOCM:        &OCMProvider{},
+					api.ClusterProviderAwsEKS: &EKSProvider{
+						manifests:       &StandaloneProvider{},
+						resourceApplier: &dynamicClientResourceApplier{},
+					},
+					api.ClusterProviderOCM: &OCMProvider{},
 				},
 			},
 			args: args{
@@ -94,7 +110,11 @@ func TestDefaultProviderFactory_GetProvider(t *testing.T) {
 			fields: fields{
 				providerContainer: map[api.ClusterProviderType]Provider{
 					api.ClusterProviderStandalone: &StandaloneProvider{},
-					api.ClusterProviderOCM:        &OCMProvider{},
+					api.ClusterProviderAwsEKS: &EKSProvider{
+						manifests:       &StandaloneProvider{},
+						resourceApplier: &dynamicClientResourceApplier{},
+					},
+					api.ClusterProviderOCM: &OCMProvider{},
 				},
 			},
 			args: args{
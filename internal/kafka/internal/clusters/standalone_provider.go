@@ -21,6 +21,7 @@ import (
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
 )
@@ -74,17 +75,22 @@ func (s *StandaloneProvider) Delete(spec *types.ClusterSpec) (bool, error) {
 
 func (s *StandaloneProvider) InstallStrimzi(clusterSpec *types.ClusterSpec) (bool, error) {
 	_, err := s.ApplyResources(clusterSpec, types.ResourceSet{
-		Resources: []interface{}{
-			s.buildStrimziOperatorNamespace(),
-			s.buildStrimziOperatorCatalogSource(),
-			s.buildStrimziOperatorOperatorGroup(),
-			s.buildStrimziOperatorSubscription(),
-		},
+		Resources: s.buildStrimziOperatorResources(),
 	})
 
 	return true, err
 }
 
+// buildStrimziOperatorResources builds the OLM resources needed to install the strimzi operator
+func (s *StandaloneProvider) buildStrimziOperatorResources() []interface{} {
+	return []interface{}{
+		s.buildStrimziOperatorNamespace(),
+		s.buildStrimziOperatorCatalogSource(),
+		s.buildStrimziOperatorOperatorGroup(),
+		s.buildStrimziOperatorSubscription(),
+	}
+}
+
 func StrimziOperatorCommonLabels() map[string]string {
 	return map[string]string{
 		"app.kubernetes.io/component": "strimzi-bundle",
@@ -173,18 +179,23 @@ func (s *StandaloneProvider) buildStrimziOperatorSubscription() *operatorsv1alph
 
 func (s *StandaloneProvider) InstallKasFleetshard(clusterSpec *types.ClusterSpec, params []types.Parameter) (bool, error) {
 	_, err := s.ApplyResources(clusterSpec, types.ResourceSet{
-		Resources: []interface{}{
-			s.buildKASFleetShardOperatorNamespace(),
-			s.buildKASFleetShardSyncSecret(params),
-			s.buildKASFleetShardOperatorCatalogSource(),
-			s.buildKASFleetShardOperatorOperatorGroup(),
-			s.buildKASFleetShardOperatorSubscription(),
-		},
+		Resources: s.buildKASFleetShardOperatorResources(params),
 	})
 
 	return true, err
 }
 
+// buildKASFleetShardOperatorResources builds the OLM resources needed to install the kas-fleetshard operator
+func (s *StandaloneProvider) buildKASFleetShardOperatorResources(params []types.Parameter) []interface{} {
+	return []interface{}{
+		s.buildKASFleetShardOperatorNamespace(),
+		s.buildKASFleetShardSyncSecret(params),
+		s.buildKASFleetShardOperatorCatalogSource(),
+		s.buildKASFleetShardOperatorOperatorGroup(),
+		s.buildKASFleetShardOperatorSubscription(),
+	}
+}
+
 func (s *StandaloneProvider) buildKASFleetShardOperatorNamespace() *v1.Namespace {
 	kasFleetshardOLMConfig := s.dataplaneClusterConfig.KasFleetshardOperatorOLMConfig
 	return &v1.Namespace{
@@ -290,6 +301,10 @@ func (s *StandaloneProvider) CheckClusterStatus(spec *types.ClusterSpec) (*types
 	return spec, nil
 }
 
+func (s *StandaloneProvider) GetClusterVersion(spec *types.ClusterSpec) (string, error) {
+	return "", nil // NOOP for now
+}
+
 func (s *StandaloneProvider) GetClusterDNS(clusterSpec *types.ClusterSpec) (string, error) {
 	return "", nil // NOOP for now
 }
@@ -375,6 +390,11 @@ func (s *StandaloneProvider) ApplyResources(clusterSpec *types.ClusterSpec, reso
 		return nil, err
 	}
 
+	return applyResourcesWithRestConfig(restConfig, resources)
+}
+
+// applyResourcesWithRestConfig applies the given resources to the cluster targeted by the given rest config
+func applyResourcesWithRestConfig(restConfig *rest.Config, resources types.ResourceSet) (*types.ResourceSet, error) {
 	dynamicClient, err := dynamic.NewForConfig(restConfig)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type ClusterHealthConfig struct {
+	// QuarantineEnabled controls whether unhealthy data plane clusters are quarantined. Health metrics are exposed regardless
+	QuarantineEnabled bool
+	// HeartbeatTimeout is the time after which a data plane cluster that stopped reporting its status is unhealthy
+	HeartbeatTimeout time.Duration
+	// FailedStatusReportsThreshold is the number of consecutive failed status reports after which a data plane cluster is unhealthy
+	FailedStatusReportsThreshold int
+	// HealthyStatusReportsThreshold is the number of consecutive healthy status reports after which a quarantined data plane cluster has recovered
+	HealthyStatusReportsThreshold int
+}
+
+func NewClusterHealthConfig() ClusterHealthConfig {
+	return ClusterHealthConfig{
+		QuarantineEnabled:             false,
+		HeartbeatTimeout:              5 * time.Minute,
+		FailedStatusReportsThreshold:  3,
+		HealthyStatusReportsThreshold: 3,
+	}
+}
+
+func (c *ClusterHealthConfig) validate() error {
+	if c.HeartbeatTimeout <= 0 {
+		return errors.Errorf("cluster health heartbeat timeout must be positive")
+	}
+
+	if c.FailedStatusReportsThreshold < 1 || c.HealthyStatusReportsThreshold < 1 {
+		return errors.Errorf("cluster health status reports thresholds must be at least 1")
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestClusterHealthConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name                string
+		clusterHealthConfig func() ClusterHealthConfig
+		wantErr             bool
+	}{
+		{
+			name:                "should accept the default configuration",
+			clusterHealthConfig: NewClusterHealthConfig,
+			wantErr:             false,
+		},
+		{
+			name: "should return an error when the heartbeat timeout is not positive",
+			clusterHealthConfig: func() ClusterHealthConfig {
+				c := NewClusterHealthConfig()
+				c.HeartbeatTimeout = 0
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when a status reports threshold is lower than 1",
+			clusterHealthConfig: func() ClusterHealthConfig {
+				c := NewClusterHealthConfig()
+				c.HealthyStatusReportsThreshold = 0
+				return c
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			c := tt.clusterHealthConfig()
+			g.Expect(c.validate() != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/pkg/errors"
+)
+
+const (
+	// FirstFitClusterPlacementStrategy places kafkas on the first data plane cluster able to accept them
+	FirstFitClusterPlacementStrategy string = "first_fit"
+	// WeightedClusterPlacementStrategy places kafkas on the data plane cluster with the highest placement score
+	WeightedClusterPlacementStrategy string = "weighted"
+)
+
+const (
+	// BinPackClusterPlacementMode favours the data plane clusters with the least remaining streaming units
+	BinPackClusterPlacementMode string = "bin_pack"
+	// SpreadClusterPlacementMode favours the data plane clusters with the most remaining streaming units
+	SpreadClusterPlacementMode string = "spread"
+)
+
+type ClusterPlacementConfig struct {
+	// Strategy is the strategy used to place kafkas on data plane clusters. Either 'first_fit' or 'weighted'
+	Strategy string
+	// Mode is whether the weighted strategy bin-packs or spreads kafkas across data plane clusters. Either 'bin_pack' or 'spread'
+	Mode string
+	// OrganisationAntiAffinityWeight is the score penalty of a data plane cluster for each kafka of the same organisation already placed on it
+	OrganisationAntiAffinityWeight int
+	// LabelAffinityWeight is the score bonus of a data plane cluster for each of its labels matching the kafka
+	LabelAffinityWeight int
+}
+
+func NewClusterPlacementConfig() ClusterPlacementConfig {
+	return ClusterPlacementConfig{
+		Strategy:                       FirstFitClusterPlacementStrategy,
+		Mode:                           BinPackClusterPlacementMode,
+		OrganisationAntiAffinityWeight: 10,
+		LabelAffinityWeight:            50,
+	}
+}
+
+func (c *ClusterPlacementConfig) IsWeightedStrategyEnabled() bool {
+	return c.Strategy == WeightedClusterPlacementStrategy
+}
+
+func (c *ClusterPlacementConfig) validate() error {
+	if !arrays.Contains([]string{FirstFitClusterPlacementStrategy, WeightedClusterPlacementStrategy}, c.Strategy) {
+		return errors.Errorf("invalid cluster placement strategy %q: it should be either %q or %q", c.Strategy, FirstFitClusterPlacementStrategy, WeightedClusterPlacementStrategy)
+	}
+
+	if !arrays.Contains([]string{BinPackClusterPlacementMode, SpreadClusterPlacementMode}, c.Mode) {
+		return errors.Errorf("invalid cluster placement mode %q: it should be either %q or %q", c.Mode, BinPackClusterPlacementMode, SpreadClusterPlacementMode)
+	}
+
+	if c.OrganisationAntiAffinityWeight < 0 || c.LabelAffinityWeight < 0 {
+		return errors.Errorf("cluster placement weights must not be negative")
+	}
+
+	return nil
+}
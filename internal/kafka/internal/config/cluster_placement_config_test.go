@@ -0,0 +1,68 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestClusterPlacementConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name                   string
+		clusterPlacementConfig func() ClusterPlacementConfig
+		wantErr                bool
+	}{
+		{
+			name:                   "should accept the default configuration",
+			clusterPlacementConfig: NewClusterPlacementConfig,
+			wantErr:                false,
+		},
+		{
+			name: "should accept the weighted strategy spreading kafkas",
+			clusterPlacementConfig: func() ClusterPlacementConfig {
+				c := NewClusterPlacementConfig()
+				c.Strategy = WeightedClusterPlacementStrategy
+				c.Mode = SpreadClusterPlacementMode
+				return c
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error when the strategy is unknown",
+			clusterPlacementConfig: func() ClusterPlacementConfig {
+				c := NewClusterPlacementConfig()
+				c.Strategy = "best_fit"
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the mode is unknown",
+			clusterPlacementConfig: func() ClusterPlacementConfig {
+				c := NewClusterPlacementConfig()
+				c.Mode = "random"
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when a weight is negative",
+			clusterPlacementConfig: func() ClusterPlacementConfig {
+				c := NewClusterPlacementConfig()
+				c.OrganisationAntiAffinityWeight = -1
+				return c
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			c := tt.clusterPlacementConfig()
+			g.Expect(c.validate() != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"time"
+
+	semver "github.com/blang/semver/v4"
+	"github.com/pkg/errors"
+)
+
+type ClusterRotationConfig struct {
+	// Enabled controls whether outdated data plane clusters are replaced by newly provisioned ones
+	Enabled bool
+	// Paused stops the start of new rotations and holds the rotations in progress before their kafkas are drained
+	Paused bool
+	// MaxClusterAge is the age after which a data plane cluster is rotated. A value of 0 disables the age criterion
+	MaxClusterAge time.Duration
+	// MinOpenShiftVersion is the OpenShift version below which a data plane cluster is rotated. An empty value disables the version criterion
+	MinOpenShiftVersion string
+	// MaxConcurrentRotations is the maximum number of data plane clusters being rotated at the same time
+	MaxConcurrentRotations int
+	// FailedRotationBackoff is the time during which a data plane cluster whose rotation failed is not rotated again
+	FailedRotationBackoff time.Duration
+}
+
+func NewClusterRotationConfig() ClusterRotationConfig {
+	return ClusterRotationConfig{
+		Enabled:                false,
+		Paused:                 false,
+		MaxClusterAge:          0,
+		MinOpenShiftVersion:    "",
+		MaxConcurrentRotations: 1,
+		FailedRotationBackoff:  24 * time.Hour,
+	}
+}
+
+func (c *ClusterRotationConfig) validate() error {
+	if c.MaxClusterAge < 0 {
+		return errors.Errorf("cluster rotation max cluster age must not be negative")
+	}
+
+	if c.MinOpenShiftVersion != "" {
+		if _, err := semver.ParseTolerant(c.MinOpenShiftVersion); err != nil {
+			return errors.Wrapf(err, "cluster rotation min OpenShift version %q is not a valid version", c.MinOpenShiftVersion)
+		}
+	}
+
+	if c.MaxConcurrentRotations < 1 {
+		return errors.Errorf("cluster rotation max concurrent rotations must be at least 1")
+	}
+
+	if c.FailedRotationBackoff < 0 {
+		return errors.Errorf("cluster rotation failed rotation backoff must not be negative")
+	}
+
+	if c.Enabled && c.MaxClusterAge == 0 && c.MinOpenShiftVersion == "" {
+		return errors.Errorf("cluster rotation requires either a max cluster age or a min OpenShift version when enabled")
+	}
+
+	return nil
+}
+
+// IsOpenShiftVersionOutdated returns whether the given OpenShift version is below the configured minimum version.
+// Unknown or unparsable versions are never considered outdated
+func (c *ClusterRotationConfig) IsOpenShiftVersionOutdated(version string) bool {
+	if c.MinOpenShiftVersion == "" || version == "" {
+		return false
+	}
+
+	minVersion, err := semver.ParseTolerant(c.MinOpenShiftVersion)
+	if err != nil {
+		return false
+	}
+
+	v, err := semver.ParseTolerant(version)
+	if err != nil {
+		return false
+	}
+
+	return v.LT(minVersion)
+}
@@ -0,0 +1,132 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func TestClusterRotationConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name                  string
+		clusterRotationConfig func() ClusterRotationConfig
+		wantErr               bool
+	}{
+		{
+			name:                  "should accept the default configuration",
+			clusterRotationConfig: NewClusterRotationConfig,
+			wantErr:               false,
+		},
+		{
+			name: "should accept an enabled configuration with a max cluster age",
+			clusterRotationConfig: func() ClusterRotationConfig {
+				c := NewClusterRotationConfig()
+				c.Enabled = true
+				c.MaxClusterAge = 24 * time.Hour
+				return c
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error when enabled without any rotation criterion",
+			clusterRotationConfig: func() ClusterRotationConfig {
+				c := NewClusterRotationConfig()
+				c.Enabled = true
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the min OpenShift version is not a valid version",
+			clusterRotationConfig: func() ClusterRotationConfig {
+				c := NewClusterRotationConfig()
+				c.MinOpenShiftVersion = "not-a-version"
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the max cluster age is negative",
+			clusterRotationConfig: func() ClusterRotationConfig {
+				c := NewClusterRotationConfig()
+				c.MaxClusterAge = -time.Hour
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the max concurrent rotations is lower than 1",
+			clusterRotationConfig: func() ClusterRotationConfig {
+				c := NewClusterRotationConfig()
+				c.MaxConcurrentRotations = 0
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the failed rotation backoff is negative",
+			clusterRotationConfig: func() ClusterRotationConfig {
+				c := NewClusterRotationConfig()
+				c.FailedRotationBackoff = -time.Hour
+				return c
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			c := tt.clusterRotationConfig()
+			g.Expect(c.validate() != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func TestClusterRotationConfig_IsOpenShiftVersionOutdated(t *testing.T) {
+	tests := []struct {
+		name                string
+		minOpenShiftVersion string
+		version             string
+		want                bool
+	}{
+		{
+			name:                "should return false when no min OpenShift version is configured",
+			minOpenShiftVersion: "",
+			version:             "4.10.1",
+			want:                false,
+		},
+		{
+			name:                "should return false when the version is unknown",
+			minOpenShiftVersion: "4.11.0",
+			version:             "",
+			want:                false,
+		},
+		{
+			name:                "should return true when the version is below the min OpenShift version",
+			minOpenShiftVersion: "4.11.0",
+			version:             "4.10.38",
+			want:                true,
+		},
+		{
+			name:                "should return false when the version is equal to the min OpenShift version",
+			minOpenShiftVersion: "4.11.0",
+			version:             "4.11.0",
+			want:                false,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			c := NewClusterRotationConfig()
+			c.MinOpenShiftVersion = tt.minOpenShiftVersion
+			g.Expect(c.IsOpenShiftVersionOutdated(tt.version)).To(gomega.Equal(tt.want))
+		})
+	}
+}
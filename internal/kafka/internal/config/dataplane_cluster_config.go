@@ -55,6 +55,9 @@ type DataplaneClusterConfig struct {
 	ObservabilityOperatorOLMConfig              OperatorInstallationConfig
 	DynamicScalingConfig                        DynamicScalingConfig
 	NodePrewarmingConfig                        NodePrewarmingConfig
+	ClusterPlacementConfig                      ClusterPlacementConfig
+	ClusterHealthConfig                         ClusterHealthConfig
+	ClusterRotationConfig                       ClusterRotationConfig
 }
 
 type OperatorInstallationConfig struct {
@@ -136,8 +139,11 @@ func NewDataplaneClusterConfig() *DataplaneClusterConfig {
 			IndexImage:              defaultObservabilityOperatorIndexImage,
 			SubscriptionStartingCSV: defaultObservabilityOperatorStartingCSV,
 		},
-		DynamicScalingConfig: NewDynamicScalingConfig(),
-		NodePrewarmingConfig: NewNodePrewarmingConfig(),
+		DynamicScalingConfig:   NewDynamicScalingConfig(),
+		NodePrewarmingConfig:   NewNodePrewarmingConfig(),
+		ClusterPlacementConfig: NewClusterPlacementConfig(),
+		ClusterHealthConfig:    NewClusterHealthConfig(),
+		ClusterRotationConfig:  NewClusterRotationConfig(),
 	}
 }
 
@@ -154,6 +160,8 @@ type ManualCluster struct {
 	ProviderType          api.ClusterProviderType `yaml:"provider_type"`
 	ClusterDNS            string                  `yaml:"cluster_dns"`
 	SupportedInstanceType string                  `yaml:"supported_instance_type"`
+	Labels                map[string]string       `yaml:"labels"`
+	Taints                []api.ClusterTaint      `yaml:"taints"`
 }
 
 func (c *ManualCluster) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -248,6 +256,15 @@ func (conf *ClusterConfig) IsNumberOfKafkaWithinClusterLimit(clusterId string, c
 	return true
 }
 
+// GetKafkaInstanceLimit returns the kafka instance limit of the given cluster. -1 is returned when the cluster has no
+// limit or is not in the configuration
+func (conf *ClusterConfig) GetKafkaInstanceLimit(clusterId string) int {
+	if manualCluster, exist := conf.clusterConfigMap[clusterId]; exist {
+		return manualCluster.KafkaInstanceLimit
+	}
+	return -1
+}
+
 func (conf *ClusterConfig) IsClusterSchedulable(clusterId string) bool {
 	if _, exist := conf.clusterConfigMap[clusterId]; exist {
 		return conf.clusterConfigMap[clusterId].Schedulable
@@ -335,6 +352,20 @@ func (c *DataplaneClusterConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.ObservabilityOperatorOLMConfig.SubscriptionStartingCSV, "observability-operator-starting-csv", c.ObservabilityOperatorOLMConfig.SubscriptionStartingCSV, "Observability operator subscription starting CSV")
 	fs.StringVar(&c.DynamicScalingConfig.filePath, "dynamic-scaling-config-file", c.DynamicScalingConfig.filePath, "File path to a file containing the dynamic scaling configuration")
 	fs.StringVar(&c.NodePrewarmingConfig.filePath, "node-prewarming-config-file", c.NodePrewarmingConfig.filePath, "File path to a file containing the node prewarming configuration")
+	fs.StringVar(&c.ClusterPlacementConfig.Strategy, "dataplane-cluster-placement-strategy", c.ClusterPlacementConfig.Strategy, "Strategy used to place kafkas on data plane clusters. Its value should be either 'first_fit' or 'weighted'")
+	fs.StringVar(&c.ClusterPlacementConfig.Mode, "dataplane-cluster-placement-mode", c.ClusterPlacementConfig.Mode, "Whether the 'weighted' placement strategy bin-packs or spreads kafkas across data plane clusters. Its value should be either 'bin_pack' or 'spread'")
+	fs.IntVar(&c.ClusterPlacementConfig.OrganisationAntiAffinityWeight, "dataplane-cluster-placement-organisation-anti-affinity-weight", c.ClusterPlacementConfig.OrganisationAntiAffinityWeight, "Score penalty of a data plane cluster for each kafka of the same organisation already placed on it when using the 'weighted' placement strategy")
+	fs.IntVar(&c.ClusterPlacementConfig.LabelAffinityWeight, "dataplane-cluster-placement-label-affinity-weight", c.ClusterPlacementConfig.LabelAffinityWeight, "Score bonus of a data plane cluster for each of its labels matching the kafka when using the 'weighted' placement strategy")
+	fs.BoolVar(&c.ClusterHealthConfig.QuarantineEnabled, "dataplane-cluster-quarantine-enabled", c.ClusterHealthConfig.QuarantineEnabled, "Quarantine the data plane clusters that stop reporting their status or report failures. Kafkas are not placed on quarantined clusters")
+	fs.DurationVar(&c.ClusterHealthConfig.HeartbeatTimeout, "dataplane-cluster-health-heartbeat-timeout", c.ClusterHealthConfig.HeartbeatTimeout, "Time after which a data plane cluster that stopped reporting its status is unhealthy")
+	fs.IntVar(&c.ClusterHealthConfig.FailedStatusReportsThreshold, "dataplane-cluster-health-failed-status-reports-threshold", c.ClusterHealthConfig.FailedStatusReportsThreshold, "Number of consecutive status reports with the kas-fleetshard operator not ready after which a data plane cluster is unhealthy")
+	fs.IntVar(&c.ClusterHealthConfig.HealthyStatusReportsThreshold, "dataplane-cluster-health-healthy-status-reports-threshold", c.ClusterHealthConfig.HealthyStatusReportsThreshold, "Number of consecutive status reports with the kas-fleetshard operator ready after which a quarantined data plane cluster is released")
+	fs.BoolVar(&c.ClusterRotationConfig.Enabled, "dataplane-cluster-rotation-enabled", c.ClusterRotationConfig.Enabled, "Replace the outdated data plane clusters by newly provisioned ones and drain their kafkas to them. The clusters holding kafkas with data are not rotated as the data of the kafkas is not migrated. Only applies when the data plane cluster scaling type is 'auto'")
+	fs.BoolVar(&c.ClusterRotationConfig.Paused, "dataplane-cluster-rotation-paused", c.ClusterRotationConfig.Paused, "Pause the data plane cluster rotations: no new rotation is started and the rotations in progress do not start draining kafkas")
+	fs.DurationVar(&c.ClusterRotationConfig.MaxClusterAge, "dataplane-cluster-rotation-max-age", c.ClusterRotationConfig.MaxClusterAge, "Age after which a data plane cluster is rotated. A value of 0 disables the age criterion")
+	fs.StringVar(&c.ClusterRotationConfig.MinOpenShiftVersion, "dataplane-cluster-rotation-min-openshift-version", c.ClusterRotationConfig.MinOpenShiftVersion, "OpenShift version below which a data plane cluster is rotated. An empty value disables the version criterion")
+	fs.IntVar(&c.ClusterRotationConfig.MaxConcurrentRotations, "dataplane-cluster-rotation-max-concurrent", c.ClusterRotationConfig.MaxConcurrentRotations, "Maximum number of data plane clusters being rotated at the same time")
+	fs.DurationVar(&c.ClusterRotationConfig.FailedRotationBackoff, "dataplane-cluster-rotation-failed-backoff", c.ClusterRotationConfig.FailedRotationBackoff, "Time during which a data plane cluster whose rotation failed is not rotated again")
 }
 
 func (c *DataplaneClusterConfig) Validate(env *environments.Env) error {
@@ -349,6 +380,18 @@ func (c *DataplaneClusterConfig) Validate(env *environments.Env) error {
 		}
 	}
 
+	if err := c.ClusterPlacementConfig.validate(); err != nil {
+		return err
+	}
+
+	if err := c.ClusterHealthConfig.validate(); err != nil {
+		return err
+	}
+
+	if err := c.ClusterRotationConfig.validate(); err != nil {
+		return err
+	}
+
 	return c.NodePrewarmingConfig.validate(kafkaConfig)
 }
 
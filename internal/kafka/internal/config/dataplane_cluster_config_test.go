@@ -221,6 +221,36 @@ func TestDataplaneClusterConfig_IsClusterSchedulable(t *testing.T) {
 	}
 }
 
+func TestDataplaneClusterConfig_GetKafkaInstanceLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		clusterId string
+		want      int
+	}{
+		{
+			name:      "should return the limit of the cluster",
+			clusterId: "test01",
+			want:      3,
+		},
+		{
+			name:      "should return -1 if clusterId not in the config",
+			clusterId: "test02",
+			want:      -1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			conf := NewClusterConfig(ClusterList{
+				ManualCluster{ClusterId: "test01", KafkaInstanceLimit: 3},
+			})
+			g.Expect(conf.GetKafkaInstanceLimit(tt.clusterId)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
 func TestDataplaneClusterConfig_MissingClusters(t *testing.T) {
 	type fields struct {
 		ClusterList ClusterList
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
 	"github.com/pkg/errors"
@@ -15,6 +16,18 @@ type DynamicScalingConfig struct {
 	EnableDynamicScaleUpManagerScaleUpTrigger     bool                                                     `yaml:"enable_dynamic_data_plane_scale_up"`
 	EnableDynamicScaleDownManagerScaleDownTrigger bool                                                     `yaml:"enable_dynamic_data_plane_scale_down"`
 	NewDataPlaneOpenShiftVersion                  string                                                   `yaml:"new_data_plane_openshift_version"`
+	PredictiveScaleUp                             PredictiveScaleUpConfig                                  `yaml:"predictive_scale_up"`
+}
+
+// PredictiveScaleUpConfig configures the predictive dynamic scale up evaluation, which projects the recent kafka creation
+// rate of an instance type in a region to scale up ahead of demand
+type PredictiveScaleUpConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// ObservationWindow is the period over which the kafka creation rate is computed
+	ObservationWindow time.Duration `yaml:"observation_window"`
+	// Lookahead is the period over which the kafka creation rate is projected. It should be at least the time it takes
+	// to provision a new data plane cluster
+	Lookahead time.Duration `yaml:"lookahead"`
 }
 
 func NewDynamicScalingConfig() DynamicScalingConfig {
@@ -24,6 +37,11 @@ func NewDynamicScalingConfig() DynamicScalingConfig {
 		EnableDynamicScaleUpManagerScaleUpTrigger:     true,
 		EnableDynamicScaleDownManagerScaleDownTrigger: true,
 		NewDataPlaneOpenShiftVersion:                  "",
+		PredictiveScaleUp: PredictiveScaleUpConfig{
+			Enabled:           false,
+			ObservationWindow: time.Hour,
+			Lookahead:         45 * time.Minute,
+		},
 	}
 }
 
@@ -35,12 +53,20 @@ func (c *DynamicScalingConfig) IsDataplaneScaleDownTriggerEnabled() bool {
 	return c.EnableDynamicScaleDownManagerScaleDownTrigger
 }
 
+func (c *DynamicScalingConfig) IsPredictiveScaleUpEnabled() bool {
+	return c.PredictiveScaleUp.Enabled
+}
+
 func (c *DynamicScalingConfig) validate() error {
 	err := validate.Struct(c)
 	if err != nil {
 		return errors.Wrap(err, "error validating dynamic scaling configuration")
 	}
 
+	if c.PredictiveScaleUp.Enabled && (c.PredictiveScaleUp.ObservationWindow <= 0 || c.PredictiveScaleUp.Lookahead <= 0) {
+		return errors.Errorf("'observation_window' and 'lookahead' of 'predictive_scale_up' must be positive durations in the %q dynamic scaling file", c.filePath)
+	}
+
 	for k, v := range c.ComputeMachinePerCloudProvider {
 		err := v.validate(k)
 		if err != nil {
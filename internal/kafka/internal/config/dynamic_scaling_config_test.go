@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
 	"github.com/onsi/gomega"
@@ -424,6 +425,7 @@ func TestDynamicScalingConfig_validate(t *testing.T) {
 	t.Parallel()
 	type fields struct {
 		ComputeMachinePerCloudProvider map[cloudproviders.CloudProviderID]ComputeMachinesConfig
+		PredictiveScaleUp              PredictiveScaleUpConfig
 	}
 	tests := []struct {
 		name    string
@@ -463,6 +465,36 @@ func TestDynamicScalingConfig_validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "return an error when predictive scale up is enabled without a lookahead",
+			fields: fields{
+				ComputeMachinePerCloudProvider: map[cloudproviders.CloudProviderID]ComputeMachinesConfig{
+					cloudproviders.AWS: {
+						ClusterWideWorkload: &ComputeMachineConfig{
+							ComputeMachineType: "some-type",
+							ComputeNodesAutoscaling: &ComputeNodesAutoscalingConfig{
+								MinComputeNodes: 3,
+								MaxComputeNodes: 3,
+							},
+						},
+						KafkaWorkloadPerInstanceType: map[string]ComputeMachineConfig{
+							"type": {
+								ComputeMachineType: "some-type",
+								ComputeNodesAutoscaling: &ComputeNodesAutoscalingConfig{
+									MinComputeNodes: 3,
+									MaxComputeNodes: 9,
+								},
+							},
+						},
+					},
+				},
+				PredictiveScaleUp: PredictiveScaleUpConfig{
+					Enabled:           true,
+					ObservationWindow: time.Hour,
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "should not return an error when the configuration is valid",
 			fields: fields{
@@ -497,6 +529,7 @@ func TestDynamicScalingConfig_validate(t *testing.T) {
 			g := gomega.NewWithT(t)
 			c := &DynamicScalingConfig{
 				ComputeMachinePerCloudProvider: testcase.fields.ComputeMachinePerCloudProvider,
+				PredictiveScaleUp:              testcase.fields.PredictiveScaleUp,
 			}
 			err := c.validate()
 			g.Expect(err != nil).To(gomega.Equal(testcase.wantErr))
@@ -0,0 +1,137 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/spf13/pflag"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const (
+	defaultEKSKubernetesVersion  = "1.24"
+	defaultEKSRegionsConfigFile  = "config/eks-regions-configuration.yaml"
+	defaultEKSNodeInstanceType   = "m5.2xlarge"
+	defaultEKSNodeGroupNodeCount = 3
+	// defaultEKSIngressServiceNamespace and defaultEKSIngressServiceName identify the service of the ingress-nginx
+	// controller when installed with its default manifests
+	defaultEKSIngressServiceNamespace = "ingress-nginx"
+	defaultEKSIngressServiceName      = "ingress-nginx-controller"
+)
+
+// EKSConfig contains the settings used by the EKS cluster provider to create data plane clusters in AWS EKS
+type EKSConfig struct {
+	// ClusterRoleARN is the IAM role assumed by the EKS control plane
+	ClusterRoleARN string
+	// NodeRoleARN is the IAM role assumed by the worker nodes of the EKS node groups
+	NodeRoleARN string
+	// KubernetesVersion is the kubernetes version of the created EKS clusters
+	KubernetesVersion string
+	// BaseDomain is the domain under which the DNS of each EKS cluster is created, i.e. <cluster-name>.<base-domain>.
+	// A Route53 hosted zone has to exist for it
+	BaseDomain string
+	// IngressServiceNamespace and IngressServiceName identify the LoadBalancer service of the ingress controller of the
+	// clusters, which the DNS of each EKS cluster points to
+	IngressServiceNamespace string
+	IngressServiceName      string
+	// NodeInstanceType is the EC2 instance type of the default node group of a cluster
+	NodeInstanceType string
+	// NodeGroupNodeCount is the number of nodes of the default node group of a cluster
+	NodeGroupNodeCount int
+	// Regions contains the networking configuration of each AWS region EKS clusters can be created in
+	Regions           []EKSRegionConfig
+	RegionsConfigFile string
+	// OLMResources contains the OLM manifests applied to the clusters before installing the strimzi and kas-fleetshard
+	// operators. When empty, OLM is expected to be already installed in the clusters
+	OLMResources     []interface{}
+	OLMManifestsFile string
+}
+
+// EKSRegionConfig contains the networking configuration used to create EKS clusters in a given AWS region
+type EKSRegionConfig struct {
+	Name             string   `yaml:"name"`
+	SubnetIDs        []string `yaml:"subnet_ids"`
+	SecurityGroupIDs []string `yaml:"security_group_ids"`
+	SupportsMultiAZ  bool     `yaml:"supports_multi_az"`
+}
+
+func NewEKSConfig() *EKSConfig {
+	return &EKSConfig{
+		KubernetesVersion:       defaultEKSKubernetesVersion,
+		NodeInstanceType:        defaultEKSNodeInstanceType,
+		NodeGroupNodeCount:      defaultEKSNodeGroupNodeCount,
+		RegionsConfigFile:       defaultEKSRegionsConfigFile,
+		IngressServiceNamespace: defaultEKSIngressServiceNamespace,
+		IngressServiceName:      defaultEKSIngressServiceName,
+	}
+}
+
+func (c *EKSConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.ClusterRoleARN, "eks-cluster-role-arn", c.ClusterRoleARN, "ARN of the IAM role assumed by the control plane of the EKS clusters")
+	fs.StringVar(&c.NodeRoleARN, "eks-node-role-arn", c.NodeRoleARN, "ARN of the IAM role assumed by the worker nodes of the EKS clusters")
+	fs.StringVar(&c.KubernetesVersion, "eks-kubernetes-version", c.KubernetesVersion, "Kubernetes version of the EKS clusters")
+	fs.StringVar(&c.BaseDomain, "eks-base-domain", c.BaseDomain, "Base domain under which the DNS of the EKS clusters is created")
+	fs.StringVar(&c.IngressServiceNamespace, "eks-ingress-service-namespace", c.IngressServiceNamespace, "Namespace of the LoadBalancer service of the ingress controller of the EKS clusters")
+	fs.StringVar(&c.IngressServiceName, "eks-ingress-service-name", c.IngressServiceName, "Name of the LoadBalancer service of the ingress controller of the EKS clusters")
+	fs.StringVar(&c.NodeInstanceType, "eks-node-instance-type", c.NodeInstanceType, "EC2 instance type of the default node group of the EKS clusters")
+	fs.IntVar(&c.NodeGroupNodeCount, "eks-node-group-node-count", c.NodeGroupNodeCount, "Number of nodes of the default node group of the EKS clusters")
+	fs.StringVar(&c.RegionsConfigFile, "eks-regions-config-file", c.RegionsConfigFile, "File containing the networking configuration of the AWS regions EKS clusters can be created in")
+	fs.StringVar(&c.OLMManifestsFile, "eks-olm-manifests-file", c.OLMManifestsFile, "File containing the OLM manifests installed in the EKS clusters. When not set, OLM has to be installed in the clusters beforehand")
+}
+
+func (c *EKSConfig) ReadFiles() error {
+	var regions []EKSRegionConfig
+	err := shared.ReadYamlFile(c.RegionsConfigFile, &regions)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error reading file %q: %v", c.RegionsConfigFile, err)
+	}
+
+	c.Regions = regions
+
+	if c.OLMManifestsFile == "" {
+		return nil
+	}
+	olmResources, err := readManifestsFile(c.OLMManifestsFile)
+	if err != nil {
+		return fmt.Errorf("error reading file %q: %v", c.OLMManifestsFile, err)
+	}
+	c.OLMResources = olmResources
+	return nil
+}
+
+// readManifestsFile reads the kubernetes resources of a multi-document YAML file
+func readManifestsFile(file string) ([]interface{}, error) {
+	contents, err := shared.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []interface{}
+	decoder := utilyaml.NewYAMLOrJSONDecoder(strings.NewReader(contents), len(contents))
+	for {
+		var resource map[string]interface{}
+		if err := decoder.Decode(&resource); err != nil {
+			if err == io.EOF {
+				return resources, nil
+			}
+			return nil, err
+		}
+		// skip empty documents
+		if len(resource) > 0 {
+			resources = append(resources, resource)
+		}
+	}
+}
+
+// GetRegion returns the configuration of the given region and whether it was found or not
+func (c *EKSConfig) GetRegion(name string) (EKSRegionConfig, bool) {
+	for _, region := range c.Regions {
+		if region.Name == name {
+			return region, true
+		}
+	}
+	return EKSRegionConfig{}, false
+}
@@ -0,0 +1,133 @@
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/onsi/gomega"
+)
+
+func Test_EKSConfig_ReadFiles(t *testing.T) {
+	testTempFilePrefix := "test_eksconfig_readfiles"
+	type fields struct {
+		EKSConfigFactory func() EKSConfig
+	}
+
+	tests := []struct {
+		name             string
+		fields           fields
+		wantErr          bool
+		wantRegions      []EKSRegionConfig
+		wantOLMResources []interface{}
+	}{
+		{
+			name: "When file exists and it is a valid YAML the regions are read",
+			fields: fields{
+				EKSConfigFactory: func() EKSConfig {
+					regions := "- name: us-east-1\n  subnet_ids: [subnet-a, subnet-b]\n  security_group_ids: [sg-a]\n  supports_multi_az: true\n"
+					regionsFile, err := shared.CreateTempFileFromStringData(testTempFilePrefix, regions)
+					if err != nil {
+						panic(fmt.Errorf("test error: %v", err))
+					}
+					return EKSConfig{
+						RegionsConfigFile: regionsFile,
+					}
+				},
+			},
+			wantRegions: []EKSRegionConfig{
+				{
+					Name:             "us-east-1",
+					SubnetIDs:        []string{"subnet-a", "subnet-b"},
+					SecurityGroupIDs: []string{"sg-a"},
+					SupportsMultiAZ:  true,
+				},
+			},
+		},
+		{
+			name: "When file does not exist no error is returned",
+			fields: fields{
+				EKSConfigFactory: func() EKSConfig {
+					return EKSConfig{
+						RegionsConfigFile: "unexistingfilename",
+					}
+				},
+			},
+		},
+		{
+			name: "When file exists but it is not a valid YAML an error is returned",
+			fields: fields{
+				EKSConfigFactory: func() EKSConfig {
+					regionsFile, err := shared.CreateTempFileFromStringData(testTempFilePrefix, "name: [us-east-1")
+					if err != nil {
+						panic(fmt.Errorf("test error: %v", err))
+					}
+					return EKSConfig{
+						RegionsConfigFile: regionsFile,
+					}
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "When the OLM manifests file is set its resources are read",
+			fields: fields{
+				EKSConfigFactory: func() EKSConfig {
+					manifests := "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: olm\n---\n---\napiVersion: v1\nkind: Namespace\nmetadata:\n  name: operators\n"
+					manifestsFile, err := shared.CreateTempFileFromStringData(testTempFilePrefix, manifests)
+					if err != nil {
+						panic(fmt.Errorf("test error: %v", err))
+					}
+					return EKSConfig{
+						RegionsConfigFile: "unexistingfilename",
+						OLMManifestsFile:  manifestsFile,
+					}
+				},
+			},
+			wantOLMResources: []interface{}{
+				map[string]interface{}{"apiVersion": "v1", "kind": "Namespace", "metadata": map[string]interface{}{"name": "olm"}},
+				map[string]interface{}{"apiVersion": "v1", "kind": "Namespace", "metadata": map[string]interface{}{"name": "operators"}},
+			},
+		},
+		{
+			name: "When the OLM manifests file does not exist an error is returned",
+			fields: fields{
+				EKSConfigFactory: func() EKSConfig {
+					return EKSConfig{
+						RegionsConfigFile: "unexistingfilename",
+						OLMManifestsFile:  "unexistingfilename",
+					}
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			c := tt.fields.EKSConfigFactory()
+			err := c.ReadFiles()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(c.Regions).To(gomega.Equal(tt.wantRegions))
+				g.Expect(c.OLMResources).To(gomega.Equal(tt.wantOLMResources))
+			}
+		})
+	}
+}
+
+func Test_EKSConfig_GetRegion(t *testing.T) {
+	g := gomega.NewWithT(t)
+	c := EKSConfig{
+		Regions: []EKSRegionConfig{{Name: "us-east-1"}, {Name: "eu-west-1"}},
+	}
+
+	region, ok := c.GetRegion("eu-west-1")
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(region.Name).To(gomega.Equal("eu-west-1"))
+
+	_, ok = c.GetRegion("af-south-1")
+	g.Expect(ok).To(gomega.BeFalse())
+}
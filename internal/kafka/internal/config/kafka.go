@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
@@ -25,6 +26,9 @@ type KafkaConfig struct {
 	EnableKafkaOwnerConfig bool
 	KafkaOwnerList         []string
 	KafkaOwnerListFile     string
+	// DeletionGracePeriod is the period of time during which a deleted Kafka instance is kept suspended and can be
+	// restored before it is deprovisioned. Kafka instances are deprovisioned right away when it is zero.
+	DeletionGracePeriod time.Duration
 }
 
 func NewKafkaConfig() *KafkaConfig {
@@ -49,6 +53,7 @@ func (c *KafkaConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&c.EnableKafkaExternalCertificate, "enable-kafka-external-certificate", c.EnableKafkaExternalCertificate, "Enable custom certificate for Kafka TLS")
 	fs.BoolVar(&c.EnableKafkaCNAMERegistration, "enable-kafka-cname-registration", c.EnableKafkaCNAMERegistration, "Enable custom CNAME registration for Kafka instances")
 	fs.BoolVar(&c.KafkaLifespan.EnableDeletionOfExpiredKafka, "enable-deletion-of-expired-kafka", c.KafkaLifespan.EnableDeletionOfExpiredKafka, "Enable the deletion of kafkas when its life span has expired")
+	fs.DurationSliceVar(&c.KafkaLifespan.ExpirationNotificationThresholds, "kafka-expiration-notification-thresholds", c.KafkaLifespan.ExpirationNotificationThresholds, "Comma separated list of periods of time before the expiration of a kafka at which its owners are notified, in golang duration format. No notifications are sent when empty")
 	fs.StringVar(&c.KafkaDomainName, "kafka-domain-name", c.KafkaDomainName, "The domain name to use for Kafka instances")
 	fs.StringVar(&c.Quota.Type, "quota-type", c.Quota.Type, "The type of the quota service to be used. The available options are: 'ams' for AMS backed implementation and 'quota-management-list' for quota list backed implementation (default).")
 	fs.BoolVar(&c.Quota.AllowDeveloperInstance, "allow-developer-instance", c.Quota.AllowDeveloperInstance, "Allow the creation of kafka developer instances")
@@ -56,6 +61,7 @@ func (c *KafkaConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.BrowserUrl, "browser-url", c.BrowserUrl, "Browser url to kafka admin UI")
 	fs.BoolVar(&c.EnableKafkaOwnerConfig, "enable-kafka-owner-config", c.EnableKafkaOwnerConfig, "Enable configuration for setting kafka owners")
 	fs.StringVar(&c.KafkaOwnerListFile, "kafka-owner-list-file", c.KafkaOwnerListFile, "File containing list of kafka owners")
+	fs.DurationVar(&c.DeletionGracePeriod, "kafka-deletion-grace-period", c.DeletionGracePeriod, "The period of time during which a deleted Kafka instance is suspended and can be restored before being deprovisioned, in golang duration format. Kafka instances are deprovisioned right away when set to 0")
 	fs.IntVar(&c.Quota.MaxAllowedDeveloperInstances, "max-allowed-developer-instances", c.Quota.MaxAllowedDeveloperInstances, "As a user, one can create up to N defined max developer instances if they do not have quota to create standard instances")
 }
 
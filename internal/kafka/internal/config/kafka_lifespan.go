@@ -1,11 +1,17 @@
 package config
 
+import "time"
+
 type KafkaLifespanConfig struct {
 	EnableDeletionOfExpiredKafka bool
+	// ExpirationNotificationThresholds are the periods of time before the expiration of a Kafka instance at which its
+	// owners are notified of the upcoming expiration
+	ExpirationNotificationThresholds []time.Duration
 }
 
 func NewKafkaLifespanConfig() *KafkaLifespanConfig {
 	return &KafkaLifespanConfig{
-		EnableDeletionOfExpiredKafka: true,
+		EnableDeletionOfExpiredKafka:     true,
+		ExpirationNotificationThresholds: []time.Duration{72 * time.Hour, 24 * time.Hour, time.Hour},
 	}
 }
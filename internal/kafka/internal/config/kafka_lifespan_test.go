@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/onsi/gomega"
 )
@@ -14,7 +15,8 @@ func Test_NewKafkaLifespanConfig(t *testing.T) {
 		{
 			name: "should return new KafkaLifespanConfig",
 			want: &KafkaLifespanConfig{
-				EnableDeletionOfExpiredKafka: true,
+				EnableDeletionOfExpiredKafka:     true,
+				ExpirationNotificationThresholds: []time.Duration{72 * time.Hour, 24 * time.Hour, time.Hour},
 			},
 		},
 	}
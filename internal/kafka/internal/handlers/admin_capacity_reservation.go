@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+)
+
+type adminCapacityReservationHandler struct {
+	capacityReservationService services.CapacityReservationService
+}
+
+func NewAdminCapacityReservationHandler(capacityReservationService services.CapacityReservationService) *adminCapacityReservationHandler {
+	return &adminCapacityReservationHandler{
+		capacityReservationService: capacityReservationService,
+	}
+}
+
+func (h adminCapacityReservationHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var capacityReservationRequest private.CapacityReservationRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &capacityReservationRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateMinLength(&capacityReservationRequest.OrganisationId, "organisation_id", 1),
+			handlers.ValidateMinLength(&capacityReservationRequest.CloudProvider, "cloud_provider", 1),
+			handlers.ValidateMinLength(&capacityReservationRequest.Region, "region", 1),
+			handlers.ValidateMinLength(&capacityReservationRequest.InstanceType, "instance_type", 1),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			reservation := presenters.ConvertCapacityReservationRequest(capacityReservationRequest)
+			if err := h.capacityReservationService.Create(reservation); err != nil {
+				return nil, err
+			}
+			return h.presentCapacityReservation(reservation)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h adminCapacityReservationHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			reservation, err := h.capacityReservationService.Get(id)
+			if err != nil {
+				return nil, err
+			}
+			return h.presentCapacityReservation(reservation)
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h adminCapacityReservationHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			reservations, paging, err := h.capacityReservationService.List(listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			usages, err := h.capacityReservationService.GetUsages(reservations)
+			if err != nil {
+				return nil, err
+			}
+
+			reservationList := private.CapacityReservationList{
+				Kind:  "CapacityReservationList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []private.CapacityReservation{},
+			}
+
+			for _, usage := range usages {
+				reservationList.Items = append(reservationList.Items, presenters.PresentCapacityReservation(usage))
+			}
+
+			return reservationList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h adminCapacityReservationHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			return nil, h.capacityReservationService.Delete(id)
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// presentCapacityReservation presents the given reservation along with the streaming units consumed by its organisation
+func (h adminCapacityReservationHandler) presentCapacityReservation(reservation *dbapi.CapacityReservation) (interface{}, *errors.ServiceError) {
+	usages, err := h.capacityReservationService.GetUsages(dbapi.CapacityReservationList{reservation})
+	if err != nil {
+		return nil, err
+	}
+	return presenters.PresentCapacityReservation(usages[0]), nil
+}
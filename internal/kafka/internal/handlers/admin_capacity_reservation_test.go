@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+)
+
+const (
+	capacityReservationsUrl = "/capacity_reservations"
+	capacityReservationUrl  = "/capacity_reservations/{id}"
+)
+
+func buildCapacityReservation() *dbapi.CapacityReservation {
+	return &dbapi.CapacityReservation{
+		Meta:           api.Meta{ID: "reservation-id"},
+		OrganisationId: "org-1",
+		CloudProvider:  "aws",
+		Region:         "us-east-1",
+		InstanceType:   "standard",
+		StreamingUnits: 5,
+		ExpiresAt:      time.Now().Add(time.Hour),
+	}
+}
+
+func getCapacityReservationUsages(reservations dbapi.CapacityReservationList) (services.CapacityReservationUsageList, *errors.ServiceError) {
+	usages := services.CapacityReservationUsageList{}
+	for _, reservation := range reservations {
+		usages = append(usages, services.CapacityReservationUsage{Reservation: reservation, ConsumedStreamingUnits: 2})
+	}
+	return usages, nil
+}
+
+func Test_adminCapacityReservationHandler_Create(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           []byte
+		createErr      *errors.ServiceError
+		wantStatusCode int
+		wantCalls      int
+	}{
+		{
+			name:           "should create the capacity reservation",
+			body:           []byte(`{"organisation_id": "org-1", "cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "streaming_units": 5, "expires_at": "2099-01-01T00:00:00Z"}`),
+			wantStatusCode: http.StatusCreated,
+			wantCalls:      1,
+		},
+		{
+			name:           "should return a bad request if the organisation is missing",
+			body:           []byte(`{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "streaming_units": 5, "expires_at": "2099-01-01T00:00:00Z"}`),
+			wantStatusCode: http.StatusBadRequest,
+			wantCalls:      0,
+		},
+		{
+			name:           "should return a conflict if the capacity left in the region cannot accommodate the reservation",
+			body:           []byte(`{"organisation_id": "org-1", "cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "streaming_units": 500, "expires_at": "2099-01-01T00:00:00Z"}`),
+			createErr:      errors.Conflict("not enough capacity left"),
+			wantStatusCode: http.StatusConflict,
+			wantCalls:      1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			capacityReservationService := &services.CapacityReservationServiceMock{
+				CreateFunc: func(reservation *dbapi.CapacityReservation) *errors.ServiceError {
+					return tt.createErr
+				},
+				GetUsagesFunc: getCapacityReservationUsages,
+			}
+			h := NewAdminCapacityReservationHandler(capacityReservationService)
+			req, rw := GetHandlerParams("POST", capacityReservationsUrl, bytes.NewBuffer(tt.body), t)
+			h.Create(rw, req)
+			resp := rw.Result()
+			resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(capacityReservationService.CreateCalls()).To(gomega.HaveLen(tt.wantCalls))
+		})
+	}
+}
+
+func Test_adminCapacityReservationHandler_Get(t *testing.T) {
+	tests := []struct {
+		name           string
+		getErr         *errors.ServiceError
+		wantStatusCode int
+	}{
+		{
+			name:           "should return the capacity reservation with its usage",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return not found if the capacity reservation does not exist",
+			getErr:         errors.NotFound("capacity reservation not found"),
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			capacityReservationService := &services.CapacityReservationServiceMock{
+				GetFunc: func(id string) (*dbapi.CapacityReservation, *errors.ServiceError) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return buildCapacityReservation(), nil
+				},
+				GetUsagesFunc: getCapacityReservationUsages,
+			}
+			h := NewAdminCapacityReservationHandler(capacityReservationService)
+			req, rw := GetHandlerParams("GET", capacityReservationUrl, nil, t)
+			h.Get(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				var reservation private.CapacityReservation
+				g.Expect(json.NewDecoder(resp.Body).Decode(&reservation)).To(gomega.Succeed())
+				g.Expect(reservation.Kind).To(gomega.Equal("CapacityReservation"))
+				g.Expect(reservation.StreamingUnits).To(gomega.Equal(int32(5)))
+				g.Expect(reservation.ConsumedStreamingUnits).To(gomega.Equal(int32(2)))
+				g.Expect(reservation.Expired).To(gomega.BeFalse())
+			}
+		})
+	}
+}
+
+func Test_adminCapacityReservationHandler_List(t *testing.T) {
+	g := gomega.NewWithT(t)
+	capacityReservationService := &services.CapacityReservationServiceMock{
+		ListFunc: func(listArgs *coreServices.ListArguments) (dbapi.CapacityReservationList, *api.PagingMeta, *errors.ServiceError) {
+			return dbapi.CapacityReservationList{buildCapacityReservation()}, &api.PagingMeta{Page: 1, Size: 1, Total: 1}, nil
+		},
+		GetUsagesFunc: getCapacityReservationUsages,
+	}
+	h := NewAdminCapacityReservationHandler(capacityReservationService)
+	req, rw := GetHandlerParams("GET", capacityReservationsUrl, nil, t)
+	h.List(rw, req)
+	resp := rw.Result()
+	defer resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+	var reservationList private.CapacityReservationList
+	g.Expect(json.NewDecoder(resp.Body).Decode(&reservationList)).To(gomega.Succeed())
+	g.Expect(reservationList.Kind).To(gomega.Equal("CapacityReservationList"))
+	g.Expect(reservationList.Items).To(gomega.HaveLen(1))
+}
+
+func Test_adminCapacityReservationHandler_Delete(t *testing.T) {
+	tests := []struct {
+		name           string
+		deleteErr      *errors.ServiceError
+		wantStatusCode int
+	}{
+		{
+			name:           "should delete the capacity reservation",
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name:           "should return not found if the capacity reservation does not exist",
+			deleteErr:      errors.NotFound("capacity reservation not found"),
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			capacityReservationService := &services.CapacityReservationServiceMock{
+				DeleteFunc: func(id string) *errors.ServiceError {
+					return tt.deleteErr
+				},
+			}
+			h := NewAdminCapacityReservationHandler(capacityReservationService)
+			req, rw := GetHandlerParams("DELETE", capacityReservationUrl, nil, t)
+			h.Delete(rw, req)
+			resp := rw.Result()
+			resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+)
+
+// clusterStatusesWithResources are the statuses of the data plane clusters the resources managed by kas-fleet-manager
+// can be applied to
+var clusterStatusesWithResources = []api.ClusterStatus{
+	api.ClusterProvisioned,
+	api.ClusterWaitingForKasFleetShardOperator,
+	api.ClusterReady,
+}
+
+type adminClusterHandler struct {
+	clusterService             services.ClusterService
+	clusterDrainService        services.ClusterDrainService
+	clusterResourcesReconciler services.ClusterResourcesReconciler
+}
+
+func NewAdminClusterHandler(clusterService services.ClusterService, clusterDrainService services.ClusterDrainService,
+	clusterResourcesReconciler services.ClusterResourcesReconciler) *adminClusterHandler {
+	return &adminClusterHandler{
+		clusterService:             clusterService,
+		clusterDrainService:        clusterDrainService,
+		clusterResourcesReconciler: clusterResourcesReconciler,
+	}
+}
+
+func (h adminClusterHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			clusters, paging, err := h.clusterService.List(listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			clusterList := private.ClusterList{
+				Kind:  "ClusterList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []private.Cluster{},
+			}
+			if len(clusters) == 0 {
+				return clusterList, nil
+			}
+
+			clusterIDs := make([]string, 0, len(clusters))
+			for _, cluster := range clusters {
+				clusterIDs = append(clusterIDs, cluster.ClusterID)
+			}
+			kafkaCounts, err := h.findKafkaInstanceCounts(clusterIDs)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, cluster := range clusters {
+				clusterList.Items = append(clusterList.Items, presenters.PresentCluster(cluster, kafkaCounts[cluster.ClusterID]))
+			}
+
+			return clusterList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h adminClusterHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			cluster, err := h.getCluster(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return h.presentCluster(cluster)
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Cordon marks the data plane cluster unschedulable so that no new kafka is placed on it
+func (h adminClusterHandler) Cordon(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			cluster, err := h.getCluster(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			if err := h.clusterService.UpdateUnschedulable(cluster.ClusterID, true); err != nil {
+				return nil, err
+			}
+			cluster.Unschedulable = true
+
+			return h.presentCluster(cluster)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Uncordon makes the data plane cluster schedulable again. Clusters being drained cannot be uncordoned
+func (h adminClusterHandler) Uncordon(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			cluster, err := h.getCluster(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			drain, err := h.clusterDrainService.GetByClusterID(cluster.ClusterID)
+			if err != nil && !err.Is404() {
+				return nil, err
+			}
+			if drain != nil && drain.Status == dbapi.ClusterDrainStatusInProgress {
+				return nil, errors.Conflict("cluster %q cannot be uncordoned as it is being drained", cluster.ClusterID)
+			}
+
+			if err := h.clusterService.UpdateUnschedulable(cluster.ClusterID, false); err != nil {
+				return nil, err
+			}
+			cluster.Unschedulable = false
+
+			return h.presentCluster(cluster)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// ApplyResources applies again the resources managed by kas-fleet-manager to the data plane cluster
+func (h adminClusterHandler) ApplyResources(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			cluster, err := h.getCluster(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			if cluster.ClusterType == api.Enterprise.String() {
+				return nil, errors.BadRequest("resources of %s cluster %q are not managed by the service", api.Enterprise.String(), cluster.ClusterID)
+			}
+			if !isClusterStatusWithResources(cluster.Status) {
+				return nil, errors.BadRequest("resources of cluster %q cannot be applied in %q status", cluster.ClusterID, cluster.Status)
+			}
+
+			if err := h.clusterResourcesReconciler.ReconcileClusterResources(*cluster); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to apply resources of cluster %q", cluster.ClusterID)
+			}
+
+			return h.presentCluster(cluster)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// UpdateStatus forces the status of the data plane cluster. The cluster is then reconciled by the cluster workers from
+// its new status
+func (h adminClusterHandler) UpdateStatus(w http.ResponseWriter, r *http.Request) {
+	var clusterStatusUpdateRequest private.ClusterStatusUpdateRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &clusterStatusUpdateRequest,
+		Validate: []handlers.Validate{
+			ValidateClusterStatus(&clusterStatusUpdateRequest.Status),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			cluster, err := h.getCluster(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			status := api.ClusterStatus(clusterStatusUpdateRequest.Status)
+			if err := h.clusterService.UpdateStatus(*cluster, status); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to update status of cluster %q", cluster.ClusterID)
+			}
+			cluster.Status = status
+
+			return h.presentCluster(cluster)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// UpdatePlacementConstraints replaces the labels and taints of the data plane cluster used to place kafkas on it
+func (h adminClusterHandler) UpdatePlacementConstraints(w http.ResponseWriter, r *http.Request) {
+	var placementConstraints private.ClusterPlacementConstraints
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &placementConstraints,
+		Validate: []handlers.Validate{
+			ValidateClusterPlacementConstraints(&placementConstraints),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			cluster, err := h.getCluster(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			taints := make([]api.ClusterTaint, 0, len(placementConstraints.Taints))
+			for _, taint := range placementConstraints.Taints {
+				taints = append(taints, api.ClusterTaint{Key: taint.Key, Value: taint.Value})
+			}
+
+			update := api.Cluster{Meta: api.Meta{ID: cluster.ID}}
+			if err := update.SetLabels(placementConstraints.Labels); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to set labels of cluster %q", cluster.ClusterID)
+			}
+			if err := update.SetTaints(taints); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to set taints of cluster %q", cluster.ClusterID)
+			}
+			if err := h.clusterService.Update(update); err != nil {
+				return nil, err
+			}
+			cluster.Labels = update.Labels
+			cluster.Taints = update.Taints
+
+			return h.presentCluster(cluster)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+func (h adminClusterHandler) getCluster(clusterID string) (*api.Cluster, *errors.ServiceError) {
+	cluster, err := h.clusterService.FindClusterByID(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if cluster == nil {
+		return nil, errors.NotFound("cluster with cluster_id='%v' not found", clusterID)
+	}
+	return cluster, nil
+}
+
+func (h adminClusterHandler) presentCluster(cluster *api.Cluster) (interface{}, *errors.ServiceError) {
+	kafkaCounts, err := h.findKafkaInstanceCounts([]string{cluster.ClusterID})
+	if err != nil {
+		return nil, err
+	}
+	return presenters.PresentCluster(cluster, kafkaCounts[cluster.ClusterID]), nil
+}
+
+func (h adminClusterHandler) findKafkaInstanceCounts(clusterIDs []string) (map[string]int, *errors.ServiceError) {
+	counts, err := h.clusterService.FindKafkaInstanceCount(clusterIDs)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to count kafkas of clusters")
+	}
+
+	kafkaCounts := make(map[string]int, len(counts))
+	for _, count := range counts {
+		kafkaCounts[count.Clusterid] = count.Count
+	}
+	return kafkaCounts, nil
+}
+
+func isClusterStatusWithResources(status api.ClusterStatus) bool {
+	for _, s := range clusterStatusesWithResources {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+)
+
+type adminClusterCapacitySimulationHandler struct {
+	clusterCapacitySimulator services.ClusterCapacitySimulator
+	kafkaConfig              *config.KafkaConfig
+}
+
+func NewAdminClusterCapacitySimulationHandler(clusterCapacitySimulator services.ClusterCapacitySimulator, kafkaConfig *config.KafkaConfig) *adminClusterCapacitySimulationHandler {
+	return &adminClusterCapacitySimulationHandler{
+		clusterCapacitySimulator: clusterCapacitySimulator,
+		kafkaConfig:              kafkaConfig,
+	}
+}
+
+// Simulate returns where the kafkas of the request would be placed, which of them would be rejected and which data
+// plane cluster scale ups they would trigger. Nothing is written.
+func (h adminClusterCapacitySimulationHandler) Simulate(w http.ResponseWriter, r *http.Request) {
+	var simulationRequest private.ClusterCapacitySimulationRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &simulationRequest,
+		Validate: []handlers.Validate{
+			ValidateClusterCapacitySimulationRequest(h.kafkaConfig, &simulationRequest),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			simulation, err := h.clusterCapacitySimulator.SimulateClusterCapacity(presenters.ConvertClusterCapacitySimulationRequest(simulationRequest))
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentClusterCapacitySimulation(simulation), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
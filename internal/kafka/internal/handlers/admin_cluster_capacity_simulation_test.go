@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+const adminClusterCapacitySimulationUrl = "/clusters/capacity_simulation"
+
+func Test_adminClusterCapacitySimulationHandler_Simulate(t *testing.T) {
+	simulation := &services.ClusterCapacitySimulation{
+		Placements: []services.ClusterCapacitySimulationPlacement{{Kafka: 1, ClusterID: "cluster-1"}},
+		Rejections: []services.ClusterCapacitySimulationRejection{{Kafka: 2, Reason: "no data plane cluster has enough capacity left to place the kafka"}},
+		ScaleUps: []services.ClusterCapacitySimulationScaleUp{
+			{AfterKafka: 1, CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard"},
+		},
+		ConsumptionBefore: services.ClusterCapacityConsumption{MaxStreamingUnits: 10, ConsumedStreamingUnits: 9, FreeStreamingUnits: 1},
+		ConsumptionAfter:  services.ClusterCapacityConsumption{MaxStreamingUnits: 10, ConsumedStreamingUnits: 10, OngoingScaleUp: true},
+	}
+
+	tests := []struct {
+		name           string
+		body           []byte
+		simulator      *services.ClusterCapacitySimulatorMock
+		wantStatusCode int
+	}{
+		{
+			name: "should return the outcome of the simulation",
+			body: []byte(`{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "size_id": "x1", "count": 2}`),
+			simulator: &services.ClusterCapacitySimulatorMock{
+				SimulateClusterCapacityFunc: func(request services.ClusterCapacitySimulationRequest) (*services.ClusterCapacitySimulation, *errors.ServiceError) {
+					return simulation, nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "should return the error of the simulation",
+			body: []byte(`{"cloud_provider": "aws", "region": "unsupported", "instance_type": "standard", "size_id": "x1", "count": 2}`),
+			simulator: &services.ClusterCapacitySimulatorMock{
+				SimulateClusterCapacityFunc: func(request services.ClusterCapacitySimulationRequest) (*services.ClusterCapacitySimulation, *errors.ServiceError) {
+					return nil, errors.BadRequest("region %q is not supported by cloud provider %q", request.Region, request.CloudProvider)
+				},
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request if the count is out of range",
+			body:           []byte(`{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "size_id": "x1", "count": 1001}`),
+			simulator:      &services.ClusterCapacitySimulatorMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request if the kafka size is not supported",
+			body:           []byte(`{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "size_id": "x100", "count": 1}`),
+			simulator:      &services.ClusterCapacitySimulatorMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminClusterCapacitySimulationHandler(tt.simulator, buildClusterPlacementKafkaConfig())
+			req, rw := GetHandlerParams("POST", adminClusterCapacitySimulationUrl, bytes.NewBuffer(tt.body), t)
+			h.Simulate(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				var result private.ClusterCapacitySimulation
+				g.Expect(json.NewDecoder(resp.Body).Decode(&result)).To(gomega.Succeed())
+				g.Expect(result.Kind).To(gomega.Equal("ClusterCapacitySimulation"))
+				g.Expect(result.Placements).To(gomega.Equal([]private.ClusterCapacitySimulationPlacement{{Kafka: 1, ClusterId: "cluster-1"}}))
+				g.Expect(result.Rejections).To(gomega.HaveLen(1))
+				g.Expect(result.ScaleUps).To(gomega.HaveLen(1))
+				g.Expect(result.ScaleUps[0].AfterKafka).To(gomega.Equal(int32(1)))
+				g.Expect(result.ConsumptionAfter.OngoingScaleUp).To(gomega.BeTrue())
+
+				g.Expect(tt.simulator.SimulateClusterCapacityCalls()).To(gomega.HaveLen(1))
+				g.Expect(tt.simulator.SimulateClusterCapacityCalls()[0].Request.Count).To(gomega.Equal(2))
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+type adminClusterDrainHandler struct {
+	clusterDrainService services.ClusterDrainService
+}
+
+func NewAdminClusterDrainHandler(clusterDrainService services.ClusterDrainService) *adminClusterDrainHandler {
+	return &adminClusterDrainHandler{
+		clusterDrainService: clusterDrainService,
+	}
+}
+
+// Create marks the data plane cluster unschedulable and starts migrating its kafkas to other data plane clusters
+func (h adminClusterDrainHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var clusterDrainRequest private.ClusterDrainRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &clusterDrainRequest,
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			clusterID := mux.Vars(r)["id"]
+			drain := presenters.ConvertClusterDrainRequest(clusterID, clusterDrainRequest)
+			if err := h.clusterDrainService.Create(drain); err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterDrain(drain), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// Get returns the progress of the latest drain of the data plane cluster
+func (h adminClusterDrainHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			clusterID := mux.Vars(r)["id"]
+			drain, err := h.clusterDrainService.GetByClusterID(clusterID)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterDrain(drain), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+const clusterDrainUrl = "/clusters/{id}/drain"
+
+func buildClusterDrainWithKafkas() *dbapi.ClusterDrain {
+	return &dbapi.ClusterDrain{
+		Meta:      api.Meta{ID: "drain-id"},
+		ClusterID: "cluster-id",
+		Status:    dbapi.ClusterDrainStatusInProgress,
+		Kafkas: []dbapi.ClusterDrainKafka{
+			{KafkaID: "kafka-1", Status: dbapi.ClusterDrainKafkaStatusCompleted, TargetClusterID: "target-cluster-id"},
+			{KafkaID: "kafka-2", Status: dbapi.ClusterDrainKafkaStatusMigrating, TargetClusterID: "target-cluster-id"},
+			{KafkaID: "kafka-3", Status: dbapi.ClusterDrainKafkaStatusPending},
+		},
+	}
+}
+
+func Test_adminClusterDrainHandler_Create(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           []byte
+		createErr      *errors.ServiceError
+		wantStatusCode int
+	}{
+		{
+			name:           "should start the drain of the cluster",
+			body:           []byte(`{"batch_size": 2}`),
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "should return a conflict if the cluster is already being drained",
+			body:           []byte(`{}`),
+			createErr:      errors.Conflict("cluster \"cluster-id\" is already being drained"),
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "should return not found if the cluster does not exist",
+			body:           []byte(`{}`),
+			createErr:      errors.NotFound("cluster with cluster_id='cluster-id' not found"),
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			clusterDrainService := &services.ClusterDrainServiceMock{
+				CreateFunc: func(drain *dbapi.ClusterDrain) *errors.ServiceError {
+					drain.ID = "drain-id"
+					drain.Status = dbapi.ClusterDrainStatusInProgress
+					return tt.createErr
+				},
+			}
+			h := NewAdminClusterDrainHandler(clusterDrainService)
+			req, rw := GetHandlerParams("POST", clusterDrainUrl, bytes.NewBuffer(tt.body), t)
+			req = mux.SetURLVars(req, map[string]string{"id": "cluster-id"})
+			h.Create(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(clusterDrainService.CreateCalls()).To(gomega.HaveLen(1))
+			g.Expect(clusterDrainService.CreateCalls()[0].Drain.ClusterID).To(gomega.Equal("cluster-id"))
+			if tt.wantStatusCode == http.StatusCreated {
+				var drain private.ClusterDrain
+				g.Expect(json.NewDecoder(resp.Body).Decode(&drain)).To(gomega.Succeed())
+				g.Expect(drain.Kind).To(gomega.Equal("ClusterDrain"))
+				g.Expect(drain.Href).To(gomega.Equal("/api/kafkas_mgmt/v1/admin/clusters/cluster-id/drain"))
+				g.Expect(drain.BatchSize).To(gomega.Equal(int32(2)))
+			}
+		})
+	}
+}
+
+func Test_adminClusterDrainHandler_Get(t *testing.T) {
+	tests := []struct {
+		name           string
+		getErr         *errors.ServiceError
+		wantStatusCode int
+	}{
+		{
+			name:           "should return the drain of the cluster with its progress",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return not found if the cluster has never been drained",
+			getErr:         errors.NotFound("no drain found for cluster with cluster_id='cluster-id'"),
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			clusterDrainService := &services.ClusterDrainServiceMock{
+				GetByClusterIDFunc: func(clusterID string) (*dbapi.ClusterDrain, *errors.ServiceError) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return buildClusterDrainWithKafkas(), nil
+				},
+			}
+			h := NewAdminClusterDrainHandler(clusterDrainService)
+			req, rw := GetHandlerParams("GET", clusterDrainUrl, nil, t)
+			h.Get(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				var drain private.ClusterDrain
+				g.Expect(json.NewDecoder(resp.Body).Decode(&drain)).To(gomega.Succeed())
+				g.Expect(drain.ClusterId).To(gomega.Equal("cluster-id"))
+				g.Expect(drain.Progress).To(gomega.Equal(private.ClusterDrainProgress{Total: 3, Pending: 1, Migrating: 1, Completed: 1}))
+				g.Expect(drain.Kafkas).To(gomega.HaveLen(3))
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+)
+
+type adminClusterPlacementHandler struct {
+	clusterPlacementStrategy services.ClusterPlacementStrategy
+	kafkaConfig              *config.KafkaConfig
+}
+
+func NewAdminClusterPlacementHandler(clusterPlacementStrategy services.ClusterPlacementStrategy, kafkaConfig *config.KafkaConfig) *adminClusterPlacementHandler {
+	return &adminClusterPlacementHandler{
+		clusterPlacementStrategy: clusterPlacementStrategy,
+		kafkaConfig:              kafkaConfig,
+	}
+}
+
+// Explain returns the data plane cluster a kafka with the given properties would be placed on, along with the scores
+// of all the clusters considered. Nothing is placed.
+func (h adminClusterPlacementHandler) Explain(w http.ResponseWriter, r *http.Request) {
+	var placementRequest private.ClusterPlacementRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &placementRequest,
+		Validate: []handlers.Validate{
+			ValidateClusterPlacementRequest(h.kafkaConfig, &placementRequest),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			explainer, ok := h.clusterPlacementStrategy.(services.ClusterPlacementExplainer)
+			if !ok {
+				return nil, errors.BadRequest("placement dry-run is only supported by the %q placement strategy", config.WeightedClusterPlacementStrategy)
+			}
+
+			kafka := &dbapi.KafkaRequest{
+				CloudProvider:  placementRequest.CloudProvider,
+				Region:         placementRequest.Region,
+				MultiAZ:        placementRequest.MultiAz,
+				InstanceType:   placementRequest.InstanceType,
+				SizeId:         placementRequest.SizeId,
+				OrganisationId: placementRequest.OrganisationId,
+			}
+			decision, err := explainer.ExplainPlacement(kafka)
+			if err != nil {
+				return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to explain placement of kafka")
+			}
+
+			return presenters.PresentClusterPlacementDecision(decision), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
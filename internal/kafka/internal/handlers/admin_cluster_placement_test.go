@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+const adminClusterPlacementUrl = "/clusters/placement"
+
+// clusterPlacementExplainerStrategy is a placement strategy supporting the placement dry-run
+type clusterPlacementExplainerStrategy struct {
+	*services.ClusterPlacementStrategyMock
+	*services.ClusterPlacementExplainerMock
+}
+
+func buildClusterPlacementKafkaConfig() *config.KafkaConfig {
+	return &config.KafkaConfig{
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+					{
+						Id:    "standard",
+						Sizes: []config.KafkaInstanceSize{{Id: "x1", CapacityConsumed: 1}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_adminClusterPlacementHandler_Explain(t *testing.T) {
+	remaining := 4
+	decision := &services.ClusterPlacementDecision{
+		Cluster: &api.Cluster{ClusterID: "cluster-1"},
+		Candidates: []services.ClusterPlacementCandidate{
+			{
+				Cluster:                 &api.Cluster{ClusterID: "cluster-1"},
+				Eligible:                true,
+				Score:                   60,
+				RemainingStreamingUnits: &remaining,
+				Reasons:                 []string{"4 of 10 streaming units left once placed (+60)"},
+			},
+			{
+				Cluster:  &api.Cluster{ClusterID: "cluster-2"},
+				Eligible: false,
+				Reasons:  []string{"kafka does not tolerate taint organisation_id=123"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		body           []byte
+		strategy       services.ClusterPlacementStrategy
+		wantStatusCode int
+	}{
+		{
+			name: "should return the placement decision of the kafka",
+			body: []byte(`{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "size_id": "x1", "organisation_id": "13640203"}`),
+			strategy: clusterPlacementExplainerStrategy{
+				ClusterPlacementStrategyMock: &services.ClusterPlacementStrategyMock{},
+				ClusterPlacementExplainerMock: &services.ClusterPlacementExplainerMock{
+					ExplainPlacementFunc: func(kafka *dbapi.KafkaRequest) (*services.ClusterPlacementDecision, error) {
+						return decision, nil
+					},
+				},
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return bad request if the placement strategy does not support the dry-run",
+			body:           []byte(`{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "size_id": "x1"}`),
+			strategy:       &services.ClusterPlacementStrategyMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request if the kafka size is not supported",
+			body:           []byte(`{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "size_id": "x100"}`),
+			strategy:       &services.ClusterPlacementStrategyMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminClusterPlacementHandler(tt.strategy, buildClusterPlacementKafkaConfig())
+			req, rw := GetHandlerParams("POST", adminClusterPlacementUrl, bytes.NewBuffer(tt.body), t)
+			h.Explain(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				var result private.ClusterPlacementDecision
+				g.Expect(json.NewDecoder(resp.Body).Decode(&result)).To(gomega.Succeed())
+				g.Expect(result.Kind).To(gomega.Equal("ClusterPlacementDecision"))
+				g.Expect(result.ClusterId).To(gomega.Equal("cluster-1"))
+				g.Expect(result.Candidates).To(gomega.HaveLen(2))
+				g.Expect(*result.Candidates[0].RemainingStreamingUnits).To(gomega.Equal(int32(4)))
+				g.Expect(result.Candidates[1].RemainingStreamingUnits).To(gomega.BeNil())
+
+				explainer := tt.strategy.(clusterPlacementExplainerStrategy)
+				g.Expect(explainer.ExplainPlacementCalls()).To(gomega.HaveLen(1))
+				g.Expect(explainer.ExplainPlacementCalls()[0].Kafka.OrganisationId).To(gomega.Equal("13640203"))
+			}
+		})
+	}
+}
@@ -0,0 +1,337 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+const (
+	adminClustersUrl = "/clusters"
+	adminClusterUrl  = "/clusters/{id}"
+)
+
+func buildAdminClusterService(cluster *api.Cluster) *services.ClusterServiceMock {
+	return &services.ClusterServiceMock{
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+			if cluster == nil || cluster.ClusterID != clusterID {
+				return nil, nil
+			}
+			return cluster, nil
+		},
+		FindKafkaInstanceCountFunc: func(clusterIDs []string) ([]services.ResKafkaInstanceCount, error) {
+			counts := []services.ResKafkaInstanceCount{}
+			for _, clusterID := range clusterIDs {
+				counts = append(counts, services.ResKafkaInstanceCount{Clusterid: clusterID, Count: 3})
+			}
+			return counts, nil
+		},
+		UpdateUnschedulableFunc: func(clusterID string, unschedulable bool) *errors.ServiceError {
+			return nil
+		},
+		UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
+			return nil
+		},
+		UpdateFunc: func(cluster api.Cluster) *errors.ServiceError {
+			return nil
+		},
+	}
+}
+
+func decodeAdminCluster(g *gomega.WithT, resp *http.Response) private.Cluster {
+	var cluster private.Cluster
+	g.Expect(json.NewDecoder(resp.Body).Decode(&cluster)).To(gomega.Succeed())
+	return cluster
+}
+
+func Test_adminClusterHandler_List(t *testing.T) {
+	g := gomega.NewWithT(t)
+	clusterService := buildAdminClusterService(nil)
+	clusterService.ListFunc = func(listArgs *coreServices.ListArguments) ([]*api.Cluster, *api.PagingMeta, *errors.ServiceError) {
+		return []*api.Cluster{
+			{ClusterID: "cluster-1", Status: api.ClusterReady},
+			{ClusterID: "cluster-2", Status: api.ClusterReady, Unschedulable: true},
+		}, &api.PagingMeta{Page: 1, Size: 2, Total: 2}, nil
+	}
+
+	h := NewAdminClusterHandler(clusterService, &services.ClusterDrainServiceMock{}, &services.ClusterResourcesReconcilerMock{})
+	req, rw := GetHandlerParams("GET", adminClustersUrl, nil, t)
+	h.List(rw, req)
+	resp := rw.Result()
+	defer resp.Body.Close()
+
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+	var clusterList private.ClusterList
+	g.Expect(json.NewDecoder(resp.Body).Decode(&clusterList)).To(gomega.Succeed())
+	g.Expect(clusterList.Kind).To(gomega.Equal("ClusterList"))
+	g.Expect(clusterList.Total).To(gomega.Equal(int32(2)))
+	g.Expect(clusterList.Items).To(gomega.HaveLen(2))
+	g.Expect(clusterList.Items[0].Href).To(gomega.Equal("/api/kafkas_mgmt/v1/admin/clusters/cluster-1"))
+	g.Expect(clusterList.Items[0].KafkaCount).To(gomega.Equal(int32(3)))
+	g.Expect(clusterList.Items[1].Unschedulable).To(gomega.BeTrue())
+	g.Expect(clusterService.FindKafkaInstanceCountCalls()).To(gomega.HaveLen(1))
+}
+
+func Test_adminClusterHandler_Get(t *testing.T) {
+	tests := []struct {
+		name           string
+		cluster        *api.Cluster
+		wantStatusCode int
+	}{
+		{
+			name:           "should return the cluster with its kafka count and dynamic capacity info",
+			cluster:        &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady, DynamicCapacityInfo: api.JSON(`{"standard":{"max_nodes":30,"max_units":10,"remaining_units":7}}`)},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return not found if the cluster does not exist",
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminClusterHandler(buildAdminClusterService(tt.cluster), &services.ClusterDrainServiceMock{}, &services.ClusterResourcesReconcilerMock{})
+			req, rw := GetHandlerParams("GET", adminClusterUrl, nil, t)
+			req = mux.SetURLVars(req, map[string]string{"id": "cluster-id"})
+			h.Get(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				cluster := decodeAdminCluster(g, resp)
+				g.Expect(cluster.Kind).To(gomega.Equal("Cluster"))
+				g.Expect(cluster.KafkaCount).To(gomega.Equal(int32(3)))
+				g.Expect(cluster.DynamicCapacityInfo).To(gomega.Equal([]private.ClusterDynamicCapacityInfo{
+					{InstanceType: "standard", MaxNodes: 30, MaxUnits: 10, RemainingUnits: 7},
+				}))
+			}
+		})
+	}
+}
+
+func Test_adminClusterHandler_Cordon(t *testing.T) {
+	g := gomega.NewWithT(t)
+	clusterService := buildAdminClusterService(&api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady})
+	h := NewAdminClusterHandler(clusterService, &services.ClusterDrainServiceMock{}, &services.ClusterResourcesReconcilerMock{})
+	req, rw := GetHandlerParams("POST", adminClusterUrl+"/cordon", bytes.NewBuffer([]byte(`{}`)), t)
+	req = mux.SetURLVars(req, map[string]string{"id": "cluster-id"})
+	h.Cordon(rw, req)
+	resp := rw.Result()
+	defer resp.Body.Close()
+
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+	g.Expect(decodeAdminCluster(g, resp).Unschedulable).To(gomega.BeTrue())
+	g.Expect(clusterService.UpdateUnschedulableCalls()).To(gomega.HaveLen(1))
+	g.Expect(clusterService.UpdateUnschedulableCalls()[0].Unschedulable).To(gomega.BeTrue())
+}
+
+func Test_adminClusterHandler_Uncordon(t *testing.T) {
+	tests := []struct {
+		name           string
+		drain          *dbapi.ClusterDrain
+		wantStatusCode int
+	}{
+		{
+			name:           "should uncordon a cluster that has never been drained",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should uncordon a cluster whose drain failed",
+			drain:          &dbapi.ClusterDrain{ClusterID: "cluster-id", Status: dbapi.ClusterDrainStatusFailed},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return a conflict if the cluster is being drained",
+			drain:          &dbapi.ClusterDrain{ClusterID: "cluster-id", Status: dbapi.ClusterDrainStatusInProgress},
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			clusterService := buildAdminClusterService(&api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady, Unschedulable: true})
+			clusterDrainService := &services.ClusterDrainServiceMock{
+				GetByClusterIDFunc: func(clusterID string) (*dbapi.ClusterDrain, *errors.ServiceError) {
+					if tt.drain == nil {
+						return nil, errors.NotFound("no drain found for cluster with cluster_id='%v'", clusterID)
+					}
+					return tt.drain, nil
+				},
+			}
+			h := NewAdminClusterHandler(clusterService, clusterDrainService, &services.ClusterResourcesReconcilerMock{})
+			req, rw := GetHandlerParams("POST", adminClusterUrl+"/uncordon", bytes.NewBuffer([]byte(`{}`)), t)
+			req = mux.SetURLVars(req, map[string]string{"id": "cluster-id"})
+			h.Uncordon(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				g.Expect(decodeAdminCluster(g, resp).Unschedulable).To(gomega.BeFalse())
+				g.Expect(clusterService.UpdateUnschedulableCalls()).To(gomega.HaveLen(1))
+			} else {
+				g.Expect(clusterService.UpdateUnschedulableCalls()).To(gomega.BeEmpty())
+			}
+		})
+	}
+}
+
+func Test_adminClusterHandler_ApplyResources(t *testing.T) {
+	tests := []struct {
+		name           string
+		cluster        *api.Cluster
+		wantStatusCode int
+		wantApplied    bool
+	}{
+		{
+			name:           "should apply the resources of a ready cluster",
+			cluster:        &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady},
+			wantStatusCode: http.StatusOK,
+			wantApplied:    true,
+		},
+		{
+			name:           "should return bad request if the cluster is still being provisioned",
+			cluster:        &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterProvisioning},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request for enterprise clusters",
+			cluster:        &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady, ClusterType: api.Enterprise.String()},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			reconciler := &services.ClusterResourcesReconcilerMock{
+				ReconcileClusterResourcesFunc: func(cluster api.Cluster) error {
+					return nil
+				},
+			}
+			h := NewAdminClusterHandler(buildAdminClusterService(tt.cluster), &services.ClusterDrainServiceMock{}, reconciler)
+			req, rw := GetHandlerParams("POST", adminClusterUrl+"/resources", bytes.NewBuffer([]byte(`{}`)), t)
+			req = mux.SetURLVars(req, map[string]string{"id": "cluster-id"})
+			h.ApplyResources(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(len(reconciler.ReconcileClusterResourcesCalls()) == 1).To(gomega.Equal(tt.wantApplied))
+		})
+	}
+}
+
+func Test_adminClusterHandler_UpdateStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           []byte
+		wantStatusCode int
+		wantStatus     api.ClusterStatus
+	}{
+		{
+			name:           "should force the status of the cluster",
+			body:           []byte(`{"status": "deprovisioning"}`),
+			wantStatusCode: http.StatusOK,
+			wantStatus:     api.ClusterDeprovisioning,
+		},
+		{
+			name:           "should return bad request if the status cannot be forced",
+			body:           []byte(`{"status": "cluster_accepted"}`),
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			clusterService := buildAdminClusterService(&api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady})
+			h := NewAdminClusterHandler(clusterService, &services.ClusterDrainServiceMock{}, &services.ClusterResourcesReconcilerMock{})
+			req, rw := GetHandlerParams("PUT", adminClusterUrl+"/status", bytes.NewBuffer(tt.body), t)
+			req = mux.SetURLVars(req, map[string]string{"id": "cluster-id"})
+			h.UpdateStatus(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				g.Expect(decodeAdminCluster(g, resp).Status).To(gomega.Equal(tt.wantStatus.String()))
+				g.Expect(clusterService.UpdateStatusCalls()).To(gomega.HaveLen(1))
+				g.Expect(clusterService.UpdateStatusCalls()[0].Status).To(gomega.Equal(tt.wantStatus))
+			} else {
+				g.Expect(clusterService.UpdateStatusCalls()).To(gomega.BeEmpty())
+			}
+		})
+	}
+}
+
+func Test_adminClusterHandler_UpdatePlacementConstraints(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           []byte
+		wantStatusCode int
+		wantLabels     map[string]string
+		wantTaints     []private.ClusterTaint
+	}{
+		{
+			name:           "should replace the labels and taints of the cluster",
+			body:           []byte(`{"labels": {"organisation_id": "13640203"}, "taints": [{"key": "organisation_id", "value": "13640203"}]}`),
+			wantStatusCode: http.StatusOK,
+			wantLabels:     map[string]string{"organisation_id": "13640203"},
+			wantTaints:     []private.ClusterTaint{{Key: "organisation_id", Value: "13640203"}},
+		},
+		{
+			name:           "should remove the labels and taints of the cluster",
+			body:           []byte(`{}`),
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return bad request if a taint is not set on a placement attribute",
+			body:           []byte(`{"taints": [{"key": "owner", "value": "user"}]}`),
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			clusterService := buildAdminClusterService(&api.Cluster{Meta: api.Meta{ID: "id"}, ClusterID: "cluster-id", Status: api.ClusterReady})
+			h := NewAdminClusterHandler(clusterService, &services.ClusterDrainServiceMock{}, &services.ClusterResourcesReconcilerMock{})
+			req, rw := GetHandlerParams("PUT", adminClusterUrl+"/placement_constraints", bytes.NewBuffer(tt.body), t)
+			req = mux.SetURLVars(req, map[string]string{"id": "cluster-id"})
+			h.UpdatePlacementConstraints(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				cluster := decodeAdminCluster(g, resp)
+				g.Expect(cluster.Labels).To(gomega.Equal(tt.wantLabels))
+				g.Expect(cluster.Taints).To(gomega.Equal(tt.wantTaints))
+				g.Expect(clusterService.UpdateCalls()).To(gomega.HaveLen(1))
+				g.Expect(clusterService.UpdateCalls()[0].Cluster.ID).To(gomega.Equal("id"))
+			} else {
+				g.Expect(clusterService.UpdateCalls()).To(gomega.BeEmpty())
+			}
+		})
+	}
+}
@@ -21,18 +21,20 @@ import (
 )
 
 type adminKafkaHandler struct {
-	kafkaService   services.KafkaService
-	accountService account.AccountService
-	providerConfig *config.ProviderConfig
-	clusterService services.ClusterService
+	kafkaService             services.KafkaService
+	accountService           account.AccountService
+	providerConfig           *config.ProviderConfig
+	clusterService           services.ClusterService
+	maintenanceWindowService services.MaintenanceWindowService
 }
 
-func NewAdminKafkaHandler(kafkaService services.KafkaService, accountService account.AccountService, providerConfig *config.ProviderConfig, clusterService services.ClusterService) *adminKafkaHandler {
+func NewAdminKafkaHandler(kafkaService services.KafkaService, accountService account.AccountService, providerConfig *config.ProviderConfig, clusterService services.ClusterService, maintenanceWindowService services.MaintenanceWindowService) *adminKafkaHandler {
 	return &adminKafkaHandler{
-		kafkaService:   kafkaService,
-		accountService: accountService,
-		providerConfig: providerConfig,
-		clusterService: clusterService,
+		kafkaService:             kafkaService,
+		accountService:           accountService,
+		providerConfig:           providerConfig,
+		clusterService:           clusterService,
+		maintenanceWindowService: maintenanceWindowService,
 	}
 }
 
@@ -98,8 +100,10 @@ func (h adminKafkaHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
 			id := mux.Vars(r)["id"]
 			ctx := r.Context()
+			// admins have to explicitly ask for the deletion protection of the kafka to be bypassed
+			ignoreDeletionProtection := r.URL.Query().Get("ignore_deletion_protection") == "true"
 
-			err := h.kafkaService.RegisterKafkaDeprovisionJob(ctx, id)
+			err := h.kafkaService.RegisterKafkaDeprovisionJob(ctx, id, ignoreDeletionProtection)
 			return nil, err
 		},
 	}
@@ -202,11 +206,42 @@ func (h *adminKafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 				return kafka.Status
 			}
 
+			forceUpgrade := !shared.IsNil(kafkaUpdateReq.ForceUpgrade) && *kafkaUpdateReq.ForceUpgrade
+			versionsRequested := kafkaUpdateReq.KafkaVersion != "" || kafkaUpdateReq.StrimziVersion != "" || kafkaUpdateReq.KafkaIbpVersion != ""
+
+			// upgrades are deferred to the maintenance window of the kafka unless they are forced
+			deferUpgrade := false
+			if versionsRequested && !forceUpgrade {
+				canUpgradeNow, err := h.maintenanceWindowService.CanUpgradeNow(kafkaRequest)
+				if err != nil {
+					return nil, err
+				}
+				deferUpgrade = !canUpgradeNow
+			}
+
+			// upgradeVersion either sets the desired version right away or stores it as pending until the maintenance window.
+			// Forcing an upgrade also rolls out the pending version right away when no version is requested.
+			upgradeVersion := func(desired *string, pending *string, requested string) bool {
+				if requested == "" {
+					if !forceUpgrade || *pending == "" {
+						return false
+					}
+					requested = *pending
+				}
+				if deferUpgrade && *desired != requested {
+					return update(pending, requested)
+				}
+				updated := *desired != requested || *pending != ""
+				*desired = requested
+				*pending = ""
+				return updated
+			}
+
 			requestedStorageSize, _ := arrays.FirstNonEmpty(kafkaUpdateReq.MaxDataRetentionSize, kafkaUpdateReq.DeprecatedKafkaStorageSize)
 
-			updateRequired := update(&kafkaRequest.DesiredKafkaVersion, kafkaUpdateReq.KafkaVersion)
-			updateRequired = update(&kafkaRequest.DesiredStrimziVersion, kafkaUpdateReq.StrimziVersion) || updateRequired
-			updateRequired = update(&kafkaRequest.DesiredKafkaIBPVersion, kafkaUpdateReq.KafkaIbpVersion) || updateRequired
+			updateRequired := upgradeVersion(&kafkaRequest.DesiredKafkaVersion, &kafkaRequest.PendingKafkaVersion, kafkaUpdateReq.KafkaVersion)
+			updateRequired = upgradeVersion(&kafkaRequest.DesiredStrimziVersion, &kafkaRequest.PendingStrimziVersion, kafkaUpdateReq.StrimziVersion) || updateRequired
+			updateRequired = upgradeVersion(&kafkaRequest.DesiredKafkaIBPVersion, &kafkaRequest.PendingKafkaIBPVersion, kafkaUpdateReq.KafkaIbpVersion) || updateRequired
 			updateRequired = update(&kafkaRequest.KafkaStorageSize, requestedStorageSize) || updateRequired
 
 			newStatus := getStatusBasedOnSuspendedParam(kafkaUpdateReq.Suspended, kafkaRequest)
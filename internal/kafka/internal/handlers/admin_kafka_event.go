@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+)
+
+type adminKafkaEventHandler struct {
+	kafkaService      services.KafkaService
+	kafkaEventService services.KafkaEventService
+}
+
+func NewAdminKafkaEventHandler(kafkaService services.KafkaService, kafkaEventService services.KafkaEventService) *adminKafkaEventHandler {
+	return &adminKafkaEventHandler{
+		kafkaService:      kafkaService,
+		kafkaEventService: kafkaEventService,
+	}
+}
+
+// List returns the full history of a kafka request including its placement changes
+func (h adminKafkaEventHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			kafkaRequest, err := h.kafkaService.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			events, paging, err := h.kafkaEventService.List(kafkaRequest.ID, nil, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			eventList := private.KafkaEventList{
+				Kind:  "KafkaEventList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []private.KafkaEvent{},
+			}
+
+			for _, event := range events {
+				eventList.Items = append(eventList.Items, presenters.PresentKafkaEventAdminEndpoint(event))
+			}
+
+			return eventList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminKafkaEventHandler_List(t *testing.T) {
+	g := gomega.NewWithT(t)
+	kafkaService := &services.KafkaServiceMock{
+		GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+			return &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-id"}}, nil
+		},
+	}
+	kafkaEventService := &services.KafkaEventServiceMock{
+		ListFunc: func(kafkaID string, fields []string, listArgs *coreServices.ListArguments) (dbapi.KafkaEventList, *api.PagingMeta, *errors.ServiceError) {
+			return buildKafkaEvents(), &api.PagingMeta{Page: 1, Size: 1, Total: 1}, nil
+		},
+	}
+	h := NewAdminKafkaEventHandler(kafkaService, kafkaEventService)
+	req, rw := GetHandlerParams("GET", kafkaEventsUrl, nil, t)
+	h.List(rw, req)
+	resp := rw.Result()
+	defer resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+	g.Expect(kafkaEventService.ListCalls()).To(gomega.HaveLen(1))
+	g.Expect(kafkaEventService.ListCalls()[0].Fields).To(gomega.BeEmpty())
+	var eventList private.KafkaEventList
+	g.Expect(json.NewDecoder(resp.Body).Decode(&eventList)).To(gomega.Succeed())
+	g.Expect(eventList.Items).To(gomega.HaveLen(1))
+	g.Expect(eventList.Items[0].Field).To(gomega.Equal("status"))
+	g.Expect(eventList.Items[0].ClusterId).To(gomega.Equal("cluster-id"))
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+type adminKafkaExpirationHandler struct {
+	kafkaService services.KafkaService
+}
+
+func NewAdminKafkaExpirationHandler(kafkaService services.KafkaService) *adminKafkaExpirationHandler {
+	return &adminKafkaExpirationHandler{
+		kafkaService: kafkaService,
+	}
+}
+
+func (h adminKafkaExpirationHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			kafkaRequest, err := h.kafkaService.Get(r.Context(), id)
+			if err != nil {
+				return nil, err
+			}
+
+			expiresAt, err := h.kafkaService.GetExpirationTime(kafkaRequest)
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentKafkaExpirationAdminEndpoint(kafkaRequest, expiresAt, time.Now()), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Update extends the expiration of a kafka request
+func (h adminKafkaExpirationHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var expirationRequest private.KafkaExpirationRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &expirationRequest,
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				if expirationRequest.ExpiresAt.IsZero() {
+					return errors.FieldValidationError("expires_at is required")
+				}
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			kafkaRequest, err := h.kafkaService.Get(r.Context(), id)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := h.kafkaService.ExtendExpiration(kafkaRequest, expirationRequest.ExpiresAt); err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentKafkaExpirationAdminEndpoint(kafkaRequest, &expirationRequest.ExpiresAt, time.Now()), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminKafkaExpirationHandler_Update(t *testing.T) {
+	expiresAt := time.Now().Add(72 * time.Hour).UTC().Truncate(time.Second)
+
+	tests := []struct {
+		name           string
+		body           []byte
+		extendErr      *errors.ServiceError
+		wantStatusCode int
+		wantExtend     int
+	}{
+		{
+			name:           "should extend the expiration of the kafka",
+			body:           []byte(`{"expires_at": "` + expiresAt.Format(time.RFC3339) + `"}`),
+			wantStatusCode: http.StatusOK,
+			wantExtend:     1,
+		},
+		{
+			name:           "should return bad request when the expiration time is missing",
+			body:           []byte(`{}`),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when the expiration cannot be extended",
+			body:           []byte(`{"expires_at": "` + expiresAt.Format(time.RFC3339) + `"}`),
+			extendErr:      errors.New(errors.ErrorValidation, "kafka does not expire"),
+			wantStatusCode: http.StatusBadRequest,
+			wantExtend:     1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			kafkaService := &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-id"}}, nil
+				},
+				ExtendExpirationFunc: func(kafkaRequest *dbapi.KafkaRequest, expiresAt time.Time) *errors.ServiceError {
+					return tt.extendErr
+				},
+			}
+			h := NewAdminKafkaExpirationHandler(kafkaService)
+			req, rw := GetHandlerParams("PUT", kafkaExpirationUrl, bytes.NewBuffer(tt.body), t)
+			h.Update(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(kafkaService.ExtendExpirationCalls()).To(gomega.HaveLen(tt.wantExtend))
+			if tt.wantStatusCode == http.StatusOK {
+				g.Expect(kafkaService.ExtendExpirationCalls()[0].ExpiresAt.Equal(expiresAt)).To(gomega.BeTrue())
+				var expiration private.KafkaExpiration
+				g.Expect(json.NewDecoder(resp.Body).Decode(&expiration)).To(gomega.Succeed())
+				g.Expect(expiration.ExpiresAt.Equal(expiresAt)).To(gomega.BeTrue())
+				g.Expect(expiration.RemainingSeconds).NotTo(gomega.BeNil())
+			}
+		})
+	}
+}
@@ -36,10 +36,11 @@ func GetHandlerParams(method string, url string, body io.Reader, t *testing.T) (
 
 func Test_Get(t *testing.T) {
 	type fields struct {
-		kafkaService   services.KafkaService
-		accountService account.AccountService
-		providerConfig *config.ProviderConfig
-		clusterService services.ClusterService
+		kafkaService             services.KafkaService
+		accountService           account.AccountService
+		providerConfig           *config.ProviderConfig
+		clusterService           services.ClusterService
+		maintenanceWindowService services.MaintenanceWindowService
 	}
 
 	tests := []struct {
@@ -79,7 +80,7 @@ func Test_Get(t *testing.T) {
 		tt := testcase
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			h := NewAdminKafkaHandler(tt.fields.kafkaService, tt.fields.accountService, tt.fields.providerConfig, tt.fields.clusterService)
+			h := NewAdminKafkaHandler(tt.fields.kafkaService, tt.fields.accountService, tt.fields.providerConfig, tt.fields.clusterService, tt.fields.maintenanceWindowService)
 			req, rw := GetHandlerParams("GET", "/{id}", nil, t)
 			h.Get(rw, req)
 			resp := rw.Result()
@@ -91,10 +92,11 @@ func Test_Get(t *testing.T) {
 
 func Test_List(t *testing.T) {
 	type fields struct {
-		kafkaService   services.KafkaService
-		accountService account.AccountService
-		providerConfig *config.ProviderConfig
-		clusterService services.ClusterService
+		kafkaService             services.KafkaService
+		accountService           account.AccountService
+		providerConfig           *config.ProviderConfig
+		clusterService           services.ClusterService
+		maintenanceWindowService services.MaintenanceWindowService
 	}
 
 	type args struct {
@@ -168,7 +170,7 @@ func Test_List(t *testing.T) {
 		tt := testcase
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			h := NewAdminKafkaHandler(tt.fields.kafkaService, tt.fields.accountService, tt.fields.providerConfig, tt.fields.clusterService)
+			h := NewAdminKafkaHandler(tt.fields.kafkaService, tt.fields.accountService, tt.fields.providerConfig, tt.fields.clusterService, tt.fields.maintenanceWindowService)
 			req, rw := GetHandlerParams("GET", tt.args.url, nil, t)
 			h.List(rw, req)
 			resp := rw.Result()
@@ -180,10 +182,11 @@ func Test_List(t *testing.T) {
 
 func Test_Delete(t *testing.T) {
 	type fields struct {
-		kafkaService   services.KafkaService
-		accountService account.AccountService
-		providerConfig *config.ProviderConfig
-		clusterService services.ClusterService
+		kafkaService             services.KafkaService
+		accountService           account.AccountService
+		providerConfig           *config.ProviderConfig
+		clusterService           services.ClusterService
+		maintenanceWindowService services.MaintenanceWindowService
 	}
 
 	type args struct {
@@ -200,7 +203,7 @@ func Test_Delete(t *testing.T) {
 			name: "should successfully accept kafka deletion request",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
-					RegisterKafkaDeprovisionJobFunc: func(ctx context.Context, id string) *errors.ServiceError {
+					RegisterKafkaDeprovisionJobFunc: func(ctx context.Context, id string, ignoreDeletionProtection bool) *errors.ServiceError {
 						return nil
 					},
 				},
@@ -210,6 +213,40 @@ func Test_Delete(t *testing.T) {
 			},
 			wantStatusCode: http.StatusAccepted,
 		},
+		{
+			name: "should ignore the deletion protection of the kafka when requested",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					RegisterKafkaDeprovisionJobFunc: func(ctx context.Context, id string, ignoreDeletionProtection bool) *errors.ServiceError {
+						if !ignoreDeletionProtection {
+							return errors.KafkaDeletionProtected("kafka %q is protected against deletion", id)
+						}
+						return nil
+					},
+				},
+			},
+			args: args{
+				url: "/kafkas/{id}?async=true&ignore_deletion_protection=true",
+			},
+			wantStatusCode: http.StatusAccepted,
+		},
+		{
+			name: "should return a conflict if the kafka is protected against deletion",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					RegisterKafkaDeprovisionJobFunc: func(ctx context.Context, id string, ignoreDeletionProtection bool) *errors.ServiceError {
+						if !ignoreDeletionProtection {
+							return errors.KafkaDeletionProtected("kafka %q is protected against deletion", id)
+						}
+						return nil
+					},
+				},
+			},
+			args: args{
+				url: "/kafkas/{id}?async=true",
+			},
+			wantStatusCode: http.StatusConflict,
+		},
 		{
 			name: "should return an error if async flag is not set to true when deleting kafka",
 			args: args{
@@ -223,7 +260,7 @@ func Test_Delete(t *testing.T) {
 		tt := testcase
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			h := NewAdminKafkaHandler(tt.fields.kafkaService, tt.fields.accountService, tt.fields.providerConfig, tt.fields.clusterService)
+			h := NewAdminKafkaHandler(tt.fields.kafkaService, tt.fields.accountService, tt.fields.providerConfig, tt.fields.clusterService, tt.fields.maintenanceWindowService)
 			req, rw := GetHandlerParams("DELETE", tt.args.url, nil, t)
 			h.Delete(rw, req)
 			resp := rw.Result()
@@ -235,10 +272,11 @@ func Test_Delete(t *testing.T) {
 
 func Test_adminKafkaHandler_Update(t *testing.T) {
 	type fields struct {
-		kafkaService   services.KafkaService
-		accountService account.AccountService
-		providerConfig *config.ProviderConfig
-		clusterService services.ClusterService
+		kafkaService             services.KafkaService
+		accountService           account.AccountService
+		providerConfig           *config.ProviderConfig
+		clusterService           services.ClusterService
+		maintenanceWindowService services.MaintenanceWindowService
 	}
 	type args struct {
 		url  string
@@ -435,6 +473,11 @@ func Test_adminKafkaHandler_Update(t *testing.T) {
 					},
 				},
 				accountService: account.NewMockAccountService(),
+				maintenanceWindowService: &services.MaintenanceWindowServiceMock{
+					CanUpgradeNowFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *errors.ServiceError) {
+						return true, nil
+					},
+				},
 			},
 			args: args{
 				url:  kafkaByIdUrl,
@@ -482,6 +525,11 @@ func Test_adminKafkaHandler_Update(t *testing.T) {
 					},
 				},
 				accountService: account.NewMockAccountService(),
+				maintenanceWindowService: &services.MaintenanceWindowServiceMock{
+					CanUpgradeNowFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *errors.ServiceError) {
+						return true, nil
+					},
+				},
 			},
 			args: args{
 				url:  kafkaByIdUrl,
@@ -769,6 +817,11 @@ func Test_adminKafkaHandler_Update(t *testing.T) {
 					},
 				},
 				accountService: account.NewMockAccountService(),
+				maintenanceWindowService: &services.MaintenanceWindowServiceMock{
+					CanUpgradeNowFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *errors.ServiceError) {
+						return true, nil
+					},
+				},
 			},
 			args: args{
 				url:  kafkaByIdUrl,
@@ -879,7 +932,7 @@ func Test_adminKafkaHandler_Update(t *testing.T) {
 		tt := testcase
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			h := NewAdminKafkaHandler(tt.fields.kafkaService, tt.fields.accountService, tt.fields.providerConfig, tt.fields.clusterService)
+			h := NewAdminKafkaHandler(tt.fields.kafkaService, tt.fields.accountService, tt.fields.providerConfig, tt.fields.clusterService, tt.fields.maintenanceWindowService)
 			req, rw := GetHandlerParams("PATCH", tt.args.url, bytes.NewBuffer(tt.args.body), t)
 			h.Update(rw, req)
 			resp := rw.Result()
@@ -894,3 +947,137 @@ func Test_adminKafkaHandler_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_adminKafkaHandler_Update_MaintenanceWindow(t *testing.T) {
+	buildKafka := func() *dbapi.KafkaRequest {
+		return &dbapi.KafkaRequest{
+			Status: constants.KafkaRequestStatusReady.String(),
+			Meta: api.Meta{
+				ID: "id",
+			},
+			ClusterID:              "cluster-id",
+			ActualKafkaIBPVersion:  "2.7",
+			DesiredKafkaIBPVersion: "2.7",
+			ActualKafkaVersion:     "2.8",
+			DesiredKafkaVersion:    "2.8",
+			DesiredStrimziVersion:  "2.7",
+			KafkaStorageSize:       "100",
+			PendingKafkaIBPVersion: "2.8",
+		}
+	}
+
+	type args struct {
+		body          []byte
+		canUpgradeNow bool
+	}
+	tests := []struct {
+		name                       string
+		args                       args
+		wantDesiredKafkaIbpVersion string
+		wantPendingKafkaIbpVersion string
+		wantDesiredKafkaVersion    string
+		wantPendingKafkaVersion    string
+	}{
+		{
+			name: "should defer the upgrade to the maintenance window if the kafka is outside of it",
+			args: args{
+				body:          []byte(`{"kafka_version": "2.9"}`),
+				canUpgradeNow: false,
+			},
+			wantDesiredKafkaIbpVersion: "2.7",
+			wantPendingKafkaIbpVersion: "2.8",
+			wantDesiredKafkaVersion:    "2.8",
+			wantPendingKafkaVersion:    "2.9",
+		},
+		{
+			name: "should upgrade right away and clear the pending version if the kafka is within its maintenance window",
+			args: args{
+				body:          []byte(`{"kafka_ibp_version": "2.8"}`),
+				canUpgradeNow: true,
+			},
+			wantDesiredKafkaIbpVersion: "2.8",
+			wantPendingKafkaIbpVersion: "",
+			wantDesiredKafkaVersion:    "2.8",
+			wantPendingKafkaVersion:    "",
+		},
+		{
+			name: "should roll out the requested and pending versions right away if the upgrade is forced",
+			args: args{
+				body:          []byte(`{"kafka_version": "2.9", "force_upgrade": true}`),
+				canUpgradeNow: false,
+			},
+			wantDesiredKafkaIbpVersion: "2.8",
+			wantPendingKafkaIbpVersion: "",
+			wantDesiredKafkaVersion:    "2.9",
+			wantPendingKafkaVersion:    "",
+		},
+		{
+			name: "should roll out the pending versions right away if the upgrade is forced without any version",
+			args: args{
+				body:          []byte(`{"force_upgrade": true}`),
+				canUpgradeNow: false,
+			},
+			wantDesiredKafkaIbpVersion: "2.8",
+			wantPendingKafkaIbpVersion: "",
+			wantDesiredKafkaVersion:    "2.8",
+			wantPendingKafkaVersion:    "",
+		},
+		{
+			name: "should clear the pending version if the requested version is already the desired one",
+			args: args{
+				body:          []byte(`{"kafka_ibp_version": "2.7"}`),
+				canUpgradeNow: false,
+			},
+			wantDesiredKafkaIbpVersion: "2.7",
+			wantPendingKafkaIbpVersion: "",
+			wantDesiredKafkaVersion:    "2.8",
+			wantPendingKafkaVersion:    "",
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			kafkaService := &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return buildKafka(), nil
+				},
+				VerifyAndUpdateKafkaAdminFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+					return nil
+				},
+			}
+			clusterService := &services.ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return &api.Cluster{ClusterID: clusterID}, nil
+				},
+				IsStrimziKafkaVersionAvailableInClusterFunc: func(cluster *api.Cluster, strimziVersion, kafkaVersion, ibpVersion string) (bool, error) {
+					return true, nil
+				},
+				CheckStrimziVersionReadyFunc: func(cluster *api.Cluster, strimziVersion string) (bool, error) {
+					return true, nil
+				},
+			}
+			maintenanceWindowService := &services.MaintenanceWindowServiceMock{
+				CanUpgradeNowFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *errors.ServiceError) {
+					return tt.args.canUpgradeNow, nil
+				},
+			}
+
+			h := NewAdminKafkaHandler(kafkaService, account.NewMockAccountService(), nil, clusterService, maintenanceWindowService)
+			req, rw := GetHandlerParams("PATCH", kafkaByIdUrl, bytes.NewBuffer(tt.args.body), t)
+			h.Update(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+
+			kafka := &private.Kafka{}
+			g.Expect(json.NewDecoder(resp.Body).Decode(&kafka)).To(gomega.Succeed())
+			g.Expect(kafkaService.VerifyAndUpdateKafkaAdminCalls()).To(gomega.HaveLen(1))
+			g.Expect(kafka.DesiredKafkaIbpVersion).To(gomega.Equal(tt.wantDesiredKafkaIbpVersion))
+			g.Expect(kafka.PendingKafkaIbpVersion).To(gomega.Equal(tt.wantPendingKafkaIbpVersion))
+			g.Expect(kafka.DesiredKafkaVersion).To(gomega.Equal(tt.wantDesiredKafkaVersion))
+			g.Expect(kafka.PendingKafkaVersion).To(gomega.Equal(tt.wantPendingKafkaVersion))
+		})
+	}
+}
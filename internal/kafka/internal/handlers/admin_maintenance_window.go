@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+type adminMaintenanceWindowHandler struct {
+	kafkaService             services.KafkaService
+	maintenanceWindowService services.MaintenanceWindowService
+}
+
+func NewAdminMaintenanceWindowHandler(kafkaService services.KafkaService, maintenanceWindowService services.MaintenanceWindowService) *adminMaintenanceWindowHandler {
+	return &adminMaintenanceWindowHandler{
+		kafkaService:             kafkaService,
+		maintenanceWindowService: maintenanceWindowService,
+	}
+}
+
+func (h adminMaintenanceWindowHandler) GetKafkaMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			kafkaRequest, err := h.kafkaService.Get(r.Context(), id)
+			if err != nil {
+				return nil, err
+			}
+
+			maintenanceWindow, err := h.maintenanceWindowService.GetEffectiveMaintenanceWindow(kafkaRequest)
+			if err != nil {
+				return nil, err
+			}
+			if maintenanceWindow == nil {
+				return nil, errors.NotFound("no maintenance window applies to kafka with id '%s'", id)
+			}
+
+			return presenters.PresentMaintenanceWindow(*maintenanceWindow), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h adminMaintenanceWindowHandler) UpdateKafkaMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	var maintenanceWindow private.MaintenanceWindow
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &maintenanceWindow,
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			kafkaRequest, err := h.kafkaService.Get(r.Context(), id)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := h.maintenanceWindowService.SetKafkaMaintenanceWindow(kafkaRequest, presenters.ConvertMaintenanceWindow(maintenanceWindow)); err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentMaintenanceWindow(kafkaRequest.MaintenanceWindow), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+func (h adminMaintenanceWindowHandler) DeleteKafkaMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			kafkaRequest, err := h.kafkaService.Get(r.Context(), id)
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, h.maintenanceWindowService.SetKafkaMaintenanceWindow(kafkaRequest, dbapi.MaintenanceWindow{})
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+func (h adminMaintenanceWindowHandler) GetOrganisationMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			maintenanceWindow, err := h.maintenanceWindowService.GetOrganisationMaintenanceWindow(id)
+			if err != nil {
+				return nil, err
+			}
+			if maintenanceWindow == nil {
+				return nil, errors.NotFound("organisation '%s' does not have a maintenance window", id)
+			}
+
+			return presenters.PresentMaintenanceWindow(*maintenanceWindow), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h adminMaintenanceWindowHandler) UpdateOrganisationMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	var maintenanceWindow private.MaintenanceWindow
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &maintenanceWindow,
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			window := presenters.ConvertMaintenanceWindow(maintenanceWindow)
+			if err := h.maintenanceWindowService.SetOrganisationMaintenanceWindow(id, window); err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentMaintenanceWindow(window), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+func (h adminMaintenanceWindowHandler) DeleteOrganisationMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			return nil, h.maintenanceWindowService.DeleteOrganisationMaintenanceWindow(id)
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+func (h adminMaintenanceWindowHandler) ListPendingUpgrades(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			kafkas, err := h.maintenanceWindowService.ListKafkasWithPendingUpgrades()
+			if err != nil {
+				return nil, err
+			}
+
+			pendingUpgradeList := private.KafkaPendingUpgradeList{
+				Kind:  "KafkaPendingUpgradeList",
+				Items: []private.KafkaPendingUpgrade{},
+			}
+
+			now := time.Now()
+			for _, kafka := range kafkas {
+				maintenanceWindow, err := h.maintenanceWindowService.GetEffectiveMaintenanceWindow(kafka)
+				if err != nil {
+					return nil, err
+				}
+				pendingUpgradeList.Items = append(pendingUpgradeList.Items, presenters.PresentKafkaPendingUpgrade(kafka, maintenanceWindow, now))
+			}
+
+			return pendingUpgradeList, nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
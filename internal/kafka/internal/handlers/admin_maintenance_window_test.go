@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+const (
+	kafkaMaintenanceWindowUrl        = "/kafkas/{id}/maintenance_window"
+	organisationMaintenanceWindowUrl = "/organisations/{id}/maintenance_window"
+	pendingUpgradesUrl               = "/pending_upgrades"
+)
+
+func Test_adminMaintenanceWindowHandler_GetKafkaMaintenanceWindow(t *testing.T) {
+	window := &dbapi.MaintenanceWindow{DayOfWeek: "monday", StartHour: 2, DurationHours: 4}
+
+	tests := []struct {
+		name                     string
+		kafkaService             services.KafkaService
+		maintenanceWindowService services.MaintenanceWindowService
+		wantStatusCode           int
+		wantWindow               private.MaintenanceWindow
+	}{
+		{
+			name: "should return an error if the kafka cannot be found",
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return nil, errors.NotFound("not found")
+				},
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "should return not found if no maintenance window applies to the kafka",
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return &dbapi.KafkaRequest{}, nil
+				},
+			},
+			maintenanceWindowService: &services.MaintenanceWindowServiceMock{
+				GetEffectiveMaintenanceWindowFunc: func(kafkaRequest *dbapi.KafkaRequest) (*dbapi.MaintenanceWindow, *errors.ServiceError) {
+					return nil, nil
+				},
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "should return the maintenance window that applies to the kafka",
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return &dbapi.KafkaRequest{}, nil
+				},
+			},
+			maintenanceWindowService: &services.MaintenanceWindowServiceMock{
+				GetEffectiveMaintenanceWindowFunc: func(kafkaRequest *dbapi.KafkaRequest) (*dbapi.MaintenanceWindow, *errors.ServiceError) {
+					return window, nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantWindow:     private.MaintenanceWindow{DayOfWeek: "monday", StartHour: 2, DurationHours: 4},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminMaintenanceWindowHandler(tt.kafkaService, tt.maintenanceWindowService)
+			req, rw := GetHandlerParams("GET", kafkaMaintenanceWindowUrl, nil, t)
+			h.GetKafkaMaintenanceWindow(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				var window private.MaintenanceWindow
+				g.Expect(json.NewDecoder(resp.Body).Decode(&window)).To(gomega.Succeed())
+				g.Expect(window).To(gomega.Equal(tt.wantWindow))
+			}
+		})
+	}
+}
+
+func Test_adminMaintenanceWindowHandler_UpdateOrganisationMaintenanceWindow(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           []byte
+		setErr         *errors.ServiceError
+		wantStatusCode int
+	}{
+		{
+			name:           "should set the maintenance window of the organisation",
+			body:           []byte(`{"day_of_week": "monday", "start_hour": 2, "duration_hours": 4}`),
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return a bad request if the maintenance window is not valid",
+			body:           []byte(`{"day_of_week": "someday", "start_hour": 2, "duration_hours": 4}`),
+			setErr:         errors.Validation("day_of_week is not valid"),
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			maintenanceWindowService := &services.MaintenanceWindowServiceMock{
+				SetOrganisationMaintenanceWindowFunc: func(organisationId string, window dbapi.MaintenanceWindow) *errors.ServiceError {
+					return tt.setErr
+				},
+			}
+			h := NewAdminMaintenanceWindowHandler(nil, maintenanceWindowService)
+			req, rw := GetHandlerParams("PUT", organisationMaintenanceWindowUrl, bytes.NewBuffer(tt.body), t)
+			h.UpdateOrganisationMaintenanceWindow(rw, req)
+			resp := rw.Result()
+			resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(maintenanceWindowService.SetOrganisationMaintenanceWindowCalls()).To(gomega.HaveLen(1))
+		})
+	}
+}
+
+func Test_adminMaintenanceWindowHandler_ListPendingUpgrades(t *testing.T) {
+	g := gomega.NewWithT(t)
+	window := dbapi.MaintenanceWindow{DayOfWeek: "monday", StartHour: 2, DurationHours: 4}
+	maintenanceWindowService := &services.MaintenanceWindowServiceMock{
+		ListKafkasWithPendingUpgradesFunc: func() (dbapi.KafkaList, *errors.ServiceError) {
+			return dbapi.KafkaList{
+				{OrganisationId: "org-1", PendingKafkaVersion: "3.0.0"},
+				{OrganisationId: "org-2", PendingStrimziVersion: "strimzi-cluster-operator.v0.24.0"},
+			}, nil
+		},
+		GetEffectiveMaintenanceWindowFunc: func(kafkaRequest *dbapi.KafkaRequest) (*dbapi.MaintenanceWindow, *errors.ServiceError) {
+			if kafkaRequest.OrganisationId == "org-1" {
+				return &window, nil
+			}
+			return nil, nil
+		},
+	}
+
+	h := NewAdminMaintenanceWindowHandler(nil, maintenanceWindowService)
+	req, rw := GetHandlerParams("GET", pendingUpgradesUrl, nil, t)
+	before := time.Now().UTC().Truncate(time.Second)
+	h.ListPendingUpgrades(rw, req)
+	resp := rw.Result()
+	defer resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+
+	var pendingUpgrades private.KafkaPendingUpgradeList
+	g.Expect(json.NewDecoder(resp.Body).Decode(&pendingUpgrades)).To(gomega.Succeed())
+	g.Expect(pendingUpgrades.Kind).To(gomega.Equal("KafkaPendingUpgradeList"))
+	g.Expect(pendingUpgrades.Items).To(gomega.HaveLen(2))
+	g.Expect(pendingUpgrades.Items[0].PendingKafkaVersion).To(gomega.Equal("3.0.0"))
+	g.Expect(pendingUpgrades.Items[0].MaintenanceWindow).To(gomega.Equal(private.MaintenanceWindow{DayOfWeek: "monday", StartHour: 2, DurationHours: 4}))
+	g.Expect(pendingUpgrades.Items[0].ScheduledAt.Weekday()).To(gomega.Equal(time.Monday))
+	g.Expect(pendingUpgrades.Items[0].ScheduledAt.Hour()).To(gomega.Equal(2))
+	g.Expect(pendingUpgrades.Items[1].PendingStrimziVersion).To(gomega.Equal("strimzi-cluster-operator.v0.24.0"))
+	g.Expect(pendingUpgrades.Items[1].ScheduledAt).To(gomega.BeTemporally(">=", before))
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+)
+
+type adminUpgradeCampaignHandler struct {
+	upgradeCampaignService services.UpgradeCampaignService
+}
+
+func NewAdminUpgradeCampaignHandler(upgradeCampaignService services.UpgradeCampaignService) *adminUpgradeCampaignHandler {
+	return &adminUpgradeCampaignHandler{
+		upgradeCampaignService: upgradeCampaignService,
+	}
+}
+
+func (h adminUpgradeCampaignHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var upgradeCampaignRequest private.UpgradeCampaignRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &upgradeCampaignRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateMinLength(&upgradeCampaignRequest.KafkaVersion, "kafka_version", 1),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			campaign := presenters.ConvertUpgradeCampaignRequest(upgradeCampaignRequest)
+			if err := h.upgradeCampaignService.Create(campaign); err != nil {
+				return nil, err
+			}
+			return presenters.PresentUpgradeCampaign(campaign), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h adminUpgradeCampaignHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			campaign, err := h.upgradeCampaignService.Get(id)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentUpgradeCampaign(campaign), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h adminUpgradeCampaignHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			campaigns, paging, err := h.upgradeCampaignService.List(listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			campaignList := private.UpgradeCampaignList{
+				Kind:  "UpgradeCampaignList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []private.UpgradeCampaign{},
+			}
+
+			for _, campaign := range campaigns {
+				campaignList.Items = append(campaignList.Items, presenters.PresentUpgradeCampaign(campaign))
+			}
+
+			return campaignList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Update pauses, resumes or cancels an upgrade campaign
+func (h adminUpgradeCampaignHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var upgradeCampaignUpdateRequest private.UpgradeCampaignUpdateRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &upgradeCampaignUpdateRequest,
+		Validate: []handlers.Validate{
+			ValidateUpgradeCampaignStatus(&upgradeCampaignUpdateRequest.Status),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			campaign, err := h.upgradeCampaignService.Get(id)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := h.upgradeCampaignService.UpdateStatus(campaign, dbapi.UpgradeCampaignStatus(upgradeCampaignUpdateRequest.Status), "updated by admin"); err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentUpgradeCampaign(campaign), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+)
+
+const (
+	upgradeCampaignsUrl = "/upgrade_campaigns"
+	upgradeCampaignUrl  = "/upgrade_campaigns/{id}"
+)
+
+func buildUpgradeCampaignWithKafkas() *dbapi.UpgradeCampaign {
+	return &dbapi.UpgradeCampaign{
+		Meta:         api.Meta{ID: "campaign-id"},
+		Status:       dbapi.UpgradeCampaignStatusInProgress,
+		KafkaVersion: "3.0.0",
+		Kafkas: []dbapi.UpgradeCampaignKafka{
+			{KafkaID: "kafka-1", Status: dbapi.UpgradeCampaignKafkaStatusCompleted},
+			{KafkaID: "kafka-2", Status: dbapi.UpgradeCampaignKafkaStatusUpgrading},
+			{KafkaID: "kafka-3", Status: dbapi.UpgradeCampaignKafkaStatusPending},
+		},
+	}
+}
+
+func Test_adminUpgradeCampaignHandler_Create(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           []byte
+		createErr      *errors.ServiceError
+		wantStatusCode int
+		wantCalls      int
+	}{
+		{
+			name:           "should create the upgrade campaign",
+			body:           []byte(`{"kafka_version": "3.0.0", "filter": {"region": "us-east-1"}, "batch_size": 5}`),
+			wantStatusCode: http.StatusCreated,
+			wantCalls:      1,
+		},
+		{
+			name:           "should return a bad request if the target kafka version is missing",
+			body:           []byte(`{"filter": {"region": "us-east-1"}}`),
+			wantStatusCode: http.StatusBadRequest,
+			wantCalls:      0,
+		},
+		{
+			name:           "should return a bad request if no kafka matches the filter",
+			body:           []byte(`{"kafka_version": "3.0.0"}`),
+			createErr:      errors.BadRequest("no kafka matches the filter of the upgrade campaign"),
+			wantStatusCode: http.StatusBadRequest,
+			wantCalls:      1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			upgradeCampaignService := &services.UpgradeCampaignServiceMock{
+				CreateFunc: func(campaign *dbapi.UpgradeCampaign) *errors.ServiceError {
+					campaign.Status = dbapi.UpgradeCampaignStatusInProgress
+					return tt.createErr
+				},
+			}
+			h := NewAdminUpgradeCampaignHandler(upgradeCampaignService)
+			req, rw := GetHandlerParams("POST", upgradeCampaignsUrl, bytes.NewBuffer(tt.body), t)
+			h.Create(rw, req)
+			resp := rw.Result()
+			resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(upgradeCampaignService.CreateCalls()).To(gomega.HaveLen(tt.wantCalls))
+		})
+	}
+}
+
+func Test_adminUpgradeCampaignHandler_Get(t *testing.T) {
+	tests := []struct {
+		name           string
+		getErr         *errors.ServiceError
+		wantStatusCode int
+	}{
+		{
+			name:           "should return the upgrade campaign with its progress",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return not found if the upgrade campaign does not exist",
+			getErr:         errors.NotFound("upgrade campaign not found"),
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			upgradeCampaignService := &services.UpgradeCampaignServiceMock{
+				GetFunc: func(id string) (*dbapi.UpgradeCampaign, *errors.ServiceError) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return buildUpgradeCampaignWithKafkas(), nil
+				},
+			}
+			h := NewAdminUpgradeCampaignHandler(upgradeCampaignService)
+			req, rw := GetHandlerParams("GET", upgradeCampaignUrl, nil, t)
+			h.Get(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				var campaign private.UpgradeCampaign
+				g.Expect(json.NewDecoder(resp.Body).Decode(&campaign)).To(gomega.Succeed())
+				g.Expect(campaign.Kind).To(gomega.Equal("UpgradeCampaign"))
+				g.Expect(campaign.Progress).To(gomega.Equal(private.UpgradeCampaignProgress{Total: 3, Pending: 1, Upgrading: 1, Completed: 1}))
+				g.Expect(campaign.Kafkas).To(gomega.HaveLen(3))
+			}
+		})
+	}
+}
+
+func Test_adminUpgradeCampaignHandler_List(t *testing.T) {
+	g := gomega.NewWithT(t)
+	upgradeCampaignService := &services.UpgradeCampaignServiceMock{
+		ListFunc: func(listArgs *coreServices.ListArguments) (dbapi.UpgradeCampaignList, *api.PagingMeta, *errors.ServiceError) {
+			return dbapi.UpgradeCampaignList{buildUpgradeCampaignWithKafkas()}, &api.PagingMeta{Page: 1, Size: 1, Total: 1}, nil
+		},
+	}
+	h := NewAdminUpgradeCampaignHandler(upgradeCampaignService)
+	req, rw := GetHandlerParams("GET", upgradeCampaignsUrl, nil, t)
+	h.List(rw, req)
+	resp := rw.Result()
+	defer resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+	var campaignList private.UpgradeCampaignList
+	g.Expect(json.NewDecoder(resp.Body).Decode(&campaignList)).To(gomega.Succeed())
+	g.Expect(campaignList.Kind).To(gomega.Equal("UpgradeCampaignList"))
+	g.Expect(campaignList.Items).To(gomega.HaveLen(1))
+}
+
+func Test_adminUpgradeCampaignHandler_Update(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           []byte
+		updateErr      *errors.ServiceError
+		wantStatusCode int
+		wantStatus     dbapi.UpgradeCampaignStatus
+	}{
+		{
+			name:           "should pause the upgrade campaign",
+			body:           []byte(`{"status": "paused"}`),
+			wantStatusCode: http.StatusOK,
+			wantStatus:     dbapi.UpgradeCampaignStatusPaused,
+		},
+		{
+			name:           "should return a bad request if the requested status is not valid",
+			body:           []byte(`{"status": "completed"}`),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return a bad request if the status transition is not allowed",
+			body:           []byte(`{"status": "in_progress"}`),
+			updateErr:      errors.BadRequest("upgrade campaign cannot be moved from status in_progress to status in_progress"),
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			upgradeCampaignService := &services.UpgradeCampaignServiceMock{
+				GetFunc: func(id string) (*dbapi.UpgradeCampaign, *errors.ServiceError) {
+					return buildUpgradeCampaignWithKafkas(), nil
+				},
+				UpdateStatusFunc: func(campaign *dbapi.UpgradeCampaign, status dbapi.UpgradeCampaignStatus, reason string) *errors.ServiceError {
+					if tt.updateErr != nil {
+						return tt.updateErr
+					}
+					campaign.Status = status
+					return nil
+				},
+			}
+			h := NewAdminUpgradeCampaignHandler(upgradeCampaignService)
+			req, rw := GetHandlerParams("PATCH", upgradeCampaignUrl, bytes.NewBuffer(tt.body), t)
+			h.Update(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				var campaign private.UpgradeCampaign
+				g.Expect(json.NewDecoder(resp.Body).Decode(&campaign)).To(gomega.Succeed())
+				g.Expect(campaign.Status).To(gomega.Equal(tt.wantStatus.String()))
+			}
+		})
+	}
+}
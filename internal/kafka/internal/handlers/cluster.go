@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
@@ -9,6 +10,8 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
 )
 
 type clusterHandler struct {
@@ -43,16 +46,10 @@ func (h clusterHandler) RegisterEnterpriseCluster(w http.ResponseWriter, r *http
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 
-			claims, claimsErr := getClaims(ctx)
+			orgId, orgIdErr := getOrgIdFromClaims(ctx)
 
-			if claimsErr != nil {
-				return nil, claimsErr
-			}
-
-			orgId, getOrgIdErr := claims.GetOrgId()
-
-			if getOrgIdErr != nil {
-				return nil, errors.GeneralError(getOrgIdErr.Error())
+			if orgIdErr != nil {
+				return nil, orgIdErr
 			}
 
 			clusterRequest := &api.Cluster{
@@ -87,3 +84,142 @@ func (h clusterHandler) RegisterEnterpriseCluster(w http.ResponseWriter, r *http
 	// return 200 status ok
 	handlers.Handle(w, r, cfg, http.StatusOK)
 }
+
+// List returns the enterprise clusters registered by the organisation of the user
+func (h clusterHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			orgId, err := getOrgIdFromClaims(r.Context())
+			if err != nil {
+				return nil, err
+			}
+
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			clusters, paging, err := h.clusterService.ListEnterpriseClustersByOrganization(orgId, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			clusterList := public.EnterpriseClusterList{
+				Kind:  "EnterpriseClusterList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []public.EnterpriseCluster{},
+			}
+
+			for _, cluster := range clusters {
+				// the fleetshard parameters contain the credentials of the fleetshard operator and are not listed
+				enterpriseCluster, err := presenters.PresentEnterpriseCluster(*cluster, nil)
+				if err != nil {
+					return nil, err
+				}
+				clusterList.Items = append(clusterList.Items, enterpriseCluster)
+			}
+
+			return clusterList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Get returns an enterprise cluster registered by the organisation of the user
+func (h clusterHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			cluster, err := h.getEnterpriseCluster(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentEnterpriseCluster(*cluster, nil)
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// GetAddonParameters returns the parameters required to install the fleetshard operator on an enterprise cluster
+// registered by the organisation of the user, as returned on registration
+func (h clusterHandler) GetAddonParameters(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			cluster, err := h.getEnterpriseCluster(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			fsoParams, err := h.kasFleetshardOperatorAddon.GetAddonParams(cluster)
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentEnterpriseCluster(*cluster, fsoParams)
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Delete deregisters an enterprise cluster registered by the organisation of the user. Clusters on which Kafka instances
+// are still placed cannot be deregistered.
+func (h clusterHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			cluster, err := h.getEnterpriseCluster(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			nonEmptyCluster, err := h.clusterService.FindNonEmptyClusterByID(cluster.ClusterID)
+			if err != nil {
+				return nil, err
+			}
+			if nonEmptyCluster != nil {
+				return nil, errors.Conflict("enterprise cluster %q still has kafka instances, delete them before deregistering the cluster", cluster.ClusterID)
+			}
+
+			// enterprise clusters are not provisioned by the fleet manager, only the resources created for them
+			// such as the fleetshard operator service account are cleaned up
+			if err := h.clusterService.UpdateStatus(*cluster, api.ClusterCleanup); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to deregister enterprise cluster %q", cluster.ClusterID)
+			}
+
+			return nil, nil
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// getEnterpriseCluster returns the enterprise cluster with the given cluster id if it has been registered by the
+// organisation of the user. A not found error is returned otherwise, so that the clusters of other organisations
+// cannot be discovered.
+func (h clusterHandler) getEnterpriseCluster(ctx context.Context, clusterID string) (*api.Cluster, *errors.ServiceError) {
+	orgId, err := getOrgIdFromClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := h.clusterService.FindClusterByID(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster == nil || cluster.ClusterType != api.Enterprise.String() || cluster.OrganizationID != orgId {
+		return nil, errors.NotFound("enterprise cluster with id %q not found", clusterID)
+	}
+
+	return cluster, nil
+}
+
+func getOrgIdFromClaims(ctx context.Context) (string, *errors.ServiceError) {
+	claims, claimsErr := getClaims(ctx)
+	if claimsErr != nil {
+		return "", claimsErr
+	}
+
+	orgId, getOrgIdErr := claims.GetOrgId()
+	if getOrgIdErr != nil {
+		return "", errors.GeneralError(getOrgIdErr.Error())
+	}
+
+	return orgId, nil
+}
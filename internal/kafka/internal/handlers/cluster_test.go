@@ -14,6 +14,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/onsi/gomega"
 )
@@ -291,3 +292,175 @@ func Test_RegisterEnterpriseCluster(t *testing.T) {
 		})
 	}
 }
+
+func buildEnterpriseCluster(organizationID string) *api.Cluster {
+	return &api.Cluster{
+		ClusterID:      validLengthClusterId,
+		ClusterType:    api.Enterprise.String(),
+		OrganizationID: organizationID,
+		Status:         api.ClusterReady,
+	}
+}
+
+func Test_clusterHandler_Get(t *testing.T) {
+	tests := []struct {
+		name           string
+		cluster        *api.Cluster
+		wantStatusCode int
+	}{
+		{
+			name:           "should return the enterprise cluster registered by the organisation of the user",
+			cluster:        buildEnterpriseCluster(mocks.DefaultOrganisationId),
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return not found if the cluster has been registered by another organisation",
+			cluster:        buildEnterpriseCluster("another-organisation"),
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "should return not found if the cluster is not an enterprise cluster",
+			cluster: &api.Cluster{
+				ClusterID:      validLengthClusterId,
+				OrganizationID: mocks.DefaultOrganisationId,
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "should return not found if the cluster does not exist",
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			clusterService := &services.ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return tt.cluster, nil
+				},
+			}
+			h := NewClusterHandler(nil, clusterService)
+			req, rw := GetHandlerParams("GET", "/clusters/{id}", nil, t)
+			req = req.WithContext(ctxWithClaims)
+			h.Get(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				var cluster public.EnterpriseCluster
+				g.Expect(json.NewDecoder(resp.Body).Decode(&cluster)).To(gomega.Succeed())
+				g.Expect(cluster.ClusterId).To(gomega.Equal(validLengthClusterId))
+				g.Expect(cluster.FleetshardParameters).To(gomega.BeEmpty())
+			}
+		})
+	}
+}
+
+func Test_clusterHandler_List(t *testing.T) {
+	g := gomega.NewWithT(t)
+	clusterService := &services.ClusterServiceMock{
+		ListEnterpriseClustersByOrganizationFunc: func(organizationID string, listArgs *coreServices.ListArguments) ([]*api.Cluster, *api.PagingMeta, *errors.ServiceError) {
+			return []*api.Cluster{buildEnterpriseCluster(organizationID)}, &api.PagingMeta{Page: 1, Size: 1, Total: 1}, nil
+		},
+	}
+	h := NewClusterHandler(nil, clusterService)
+	req, rw := GetHandlerParams("GET", "/clusters", nil, t)
+	req = req.WithContext(ctxWithClaims)
+	h.List(rw, req)
+	resp := rw.Result()
+	defer resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+	g.Expect(clusterService.ListEnterpriseClustersByOrganizationCalls()).To(gomega.HaveLen(1))
+	g.Expect(clusterService.ListEnterpriseClustersByOrganizationCalls()[0].OrganizationID).To(gomega.Equal(mocks.DefaultOrganisationId))
+	var clusterList public.EnterpriseClusterList
+	g.Expect(json.NewDecoder(resp.Body).Decode(&clusterList)).To(gomega.Succeed())
+	g.Expect(clusterList.Kind).To(gomega.Equal("EnterpriseClusterList"))
+	g.Expect(clusterList.Items).To(gomega.HaveLen(1))
+	g.Expect(clusterList.Items[0].ClusterId).To(gomega.Equal(validLengthClusterId))
+}
+
+func Test_clusterHandler_GetAddonParameters(t *testing.T) {
+	g := gomega.NewWithT(t)
+	clusterService := &services.ClusterServiceMock{
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+			return buildEnterpriseCluster(mocks.DefaultOrganisationId), nil
+		},
+	}
+	kasFleetshardOperatorAddon := &services.KasFleetshardOperatorAddonMock{
+		GetAddonParamsFunc: func(cluster *api.Cluster) (services.ParameterList, *errors.ServiceError) {
+			return services.ParameterList{{Id: "some-id", Value: "value"}}, nil
+		},
+	}
+	h := NewClusterHandler(kasFleetshardOperatorAddon, clusterService)
+	req, rw := GetHandlerParams("GET", "/clusters/{id}/addon_parameters", nil, t)
+	req = req.WithContext(ctxWithClaims)
+	h.GetAddonParameters(rw, req)
+	resp := rw.Result()
+	defer resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+	var cluster public.EnterpriseCluster
+	g.Expect(json.NewDecoder(resp.Body).Decode(&cluster)).To(gomega.Succeed())
+	g.Expect(cluster.FleetshardParameters).To(gomega.Equal([]public.FleetshardParameter{{Id: "some-id", Value: "value"}}))
+}
+
+func Test_clusterHandler_Delete(t *testing.T) {
+	tests := []struct {
+		name            string
+		cluster         *api.Cluster
+		nonEmpty        bool
+		wantStatusCode  int
+		wantStatusCalls int
+	}{
+		{
+			name:            "should mark the enterprise cluster for cleanup when no kafka is placed on it",
+			cluster:         buildEnterpriseCluster(mocks.DefaultOrganisationId),
+			wantStatusCode:  http.StatusNoContent,
+			wantStatusCalls: 1,
+		},
+		{
+			name:           "should return a conflict when kafkas are placed on the enterprise cluster",
+			cluster:        buildEnterpriseCluster(mocks.DefaultOrganisationId),
+			nonEmpty:       true,
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "should return not found if the cluster has been registered by another organisation",
+			cluster:        buildEnterpriseCluster("another-organisation"),
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			clusterService := &services.ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return tt.cluster, nil
+				},
+				FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					if tt.nonEmpty {
+						return tt.cluster, nil
+					}
+					return nil, nil
+				},
+				UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
+					return nil
+				},
+			}
+			h := NewClusterHandler(nil, clusterService)
+			req, rw := GetHandlerParams("DELETE", "/clusters/{id}", nil, t)
+			req = req.WithContext(ctxWithClaims)
+			h.Delete(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(clusterService.UpdateStatusCalls()).To(gomega.HaveLen(tt.wantStatusCalls))
+			if tt.wantStatusCalls > 0 {
+				g.Expect(clusterService.UpdateStatusCalls()[0].Status).To(gomega.Equal(api.ClusterCleanup))
+			}
+		})
+	}
+}
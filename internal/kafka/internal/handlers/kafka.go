@@ -3,6 +3,7 @@ package handlers
 import (
 	"net/http"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
 	config "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
@@ -52,6 +53,7 @@ func (h kafkaHandler) Create(w http.ResponseWriter, r *http.Request) {
 			ValidateKafkaPlan(ctx, h.service, h.kafkaConfig, &kafkaRequestPayload),
 			ValidateBillingCloudAccountIdAndMarketplace(ctx, h.service, &kafkaRequestPayload),
 			ValidateBillingModel(&kafkaRequestPayload),
+			ValidateKafkaLabels(&kafkaRequestPayload.Labels),
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			convKafka := presenters.ConvertKafkaRequest(kafkaRequestPayload)
@@ -102,7 +104,7 @@ func (h kafkaHandler) Delete(w http.ResponseWriter, r *http.Request) {
 			id := mux.Vars(r)["id"]
 			ctx := r.Context()
 
-			err := h.service.RegisterKafkaDeprovisionJob(ctx, id)
+			err := h.service.RegisterKafkaDeprovisionJob(ctx, id, false)
 			return nil, err
 		},
 	}
@@ -166,6 +168,12 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 			ValidateKafkaUserFacingUpdateFields(ctx, h.authService, kafkaRequest, &kafkaUpdateReq),
 		},
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			if kafkaUpdateReq.SizeId != nil {
+				if resizeErr := h.service.ResizeKafka(kafkaRequest, *kafkaUpdateReq.SizeId); resizeErr != nil {
+					return nil, resizeErr
+				}
+			}
+
 			updatedNeeded := false
 			if kafkaUpdateReq.ReauthenticationEnabled != nil && kafkaRequest.ReauthenticationEnabled != *kafkaUpdateReq.ReauthenticationEnabled {
 				kafkaRequest.ReauthenticationEnabled = *kafkaUpdateReq.ReauthenticationEnabled
@@ -177,10 +185,16 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 				updatedNeeded = true
 			}
 
+			if kafkaUpdateReq.DeletionProtection != nil && kafkaRequest.DeletionProtection != *kafkaUpdateReq.DeletionProtection {
+				kafkaRequest.DeletionProtection = *kafkaUpdateReq.DeletionProtection
+				updatedNeeded = true
+			}
+
 			if updatedNeeded {
 				updateErr := h.service.Updates(kafkaRequest, map[string]interface{}{
 					"reauthentication_enabled": kafkaRequest.ReauthenticationEnabled,
 					"owner":                    kafkaRequest.Owner,
+					"deletion_protection":      kafkaRequest.DeletionProtection,
 				})
 
 				if updateErr != nil {
@@ -188,8 +202,52 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 				}
 			}
 
+			if kafkaUpdateReq.Labels != nil {
+				if labelsErr := h.service.UpdateLabels(kafkaRequest, dbapi.NewKafkaLabels(*kafkaUpdateReq.Labels)); labelsErr != nil {
+					return nil, labelsErr
+				}
+			}
+
 			return presenters.PresentKafkaRequest(kafkaRequest, h.kafkaConfig)
 		},
 	}
 	handlers.Handle(w, r, cfg, http.StatusOK)
 }
+
+// Suspend is the handler for suspending a kafka request
+func (h kafkaHandler) Suspend(w http.ResponseWriter, r *http.Request) {
+	h.changeSuspendedState(w, r, h.service.SuspendKafka)
+}
+
+// Resume is the handler for resuming a suspended kafka request
+func (h kafkaHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	h.changeSuspendedState(w, r, h.service.ResumeKafka)
+}
+
+// Restore is the handler for restoring a kafka request pending deletion
+func (h kafkaHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	h.changeSuspendedState(w, r, h.service.RestoreKafka)
+}
+
+func (h kafkaHandler) changeSuspendedState(w http.ResponseWriter, r *http.Request, action func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError) {
+	id := mux.Vars(r)["id"]
+	ctx := r.Context()
+	kafkaRequest, kafkaGetError := h.service.Get(ctx, id)
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				return kafkaGetError
+			},
+			ValidateKafkaOwnerOrOrgAdmin(ctx, kafkaRequest),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			if err := action(kafkaRequest); err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaRequest(kafkaRequest, h.kafkaConfig)
+		},
+	}
+
+	// return 202 status accepted
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
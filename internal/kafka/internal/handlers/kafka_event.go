@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+)
+
+type kafkaEventHandler struct {
+	kafkaService      services.KafkaService
+	kafkaEventService services.KafkaEventService
+}
+
+func NewKafkaEventHandler(kafkaService services.KafkaService, kafkaEventService services.KafkaEventService) *kafkaEventHandler {
+	return &kafkaEventHandler{
+		kafkaService:      kafkaService,
+		kafkaEventService: kafkaEventService,
+	}
+}
+
+// List returns the history of a kafka request. Only the events visible to the owners of the kafka are returned.
+func (h kafkaEventHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			// the kafka is retrieved first to make sure that the user is allowed to access it
+			kafkaRequest, err := h.kafkaService.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			events, paging, err := h.kafkaEventService.List(kafkaRequest.ID, services.PublicKafkaEventFields, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			eventList := public.KafkaEventList{
+				Kind:  "KafkaEventList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []public.KafkaEvent{},
+			}
+
+			for _, event := range events {
+				eventList.Items = append(eventList.Items, presenters.PresentKafkaEvent(event))
+			}
+
+			return eventList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
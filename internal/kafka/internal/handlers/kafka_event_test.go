@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+)
+
+const kafkaEventsUrl = "/kafkas/{id}/events"
+
+func buildKafkaEvents() dbapi.KafkaEventList {
+	return dbapi.KafkaEventList{
+		{
+			Meta:          api.Meta{ID: "event-1"},
+			KafkaID:       "kafka-id",
+			EventType:     dbapi.KafkaEventTypeStatusChange,
+			Field:         "status",
+			PreviousValue: "provisioning",
+			Value:         "ready",
+			ClusterID:     "cluster-id",
+			Message:       `status changed from "provisioning" to "ready"`,
+		},
+	}
+}
+
+func Test_kafkaEventHandler_List(t *testing.T) {
+	tests := []struct {
+		name           string
+		getErr         *errors.ServiceError
+		wantStatusCode int
+		wantListCalls  int
+	}{
+		{
+			name:           "should return the events of the kafka visible to its owners",
+			wantStatusCode: http.StatusOK,
+			wantListCalls:  1,
+		},
+		{
+			name:           "should return not found if the kafka does not exist or the user is not allowed to access it",
+			getErr:         errors.NotFound("Kafka Resource not found"),
+			wantStatusCode: http.StatusNotFound,
+			wantListCalls:  0,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			kafkaService := &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-id"}}, nil
+				},
+			}
+			kafkaEventService := &services.KafkaEventServiceMock{
+				ListFunc: func(kafkaID string, fields []string, listArgs *coreServices.ListArguments) (dbapi.KafkaEventList, *api.PagingMeta, *errors.ServiceError) {
+					return buildKafkaEvents(), &api.PagingMeta{Page: 1, Size: 1, Total: 1}, nil
+				},
+			}
+			h := NewKafkaEventHandler(kafkaService, kafkaEventService)
+			req, rw := GetHandlerParams("GET", kafkaEventsUrl, nil, t)
+			h.List(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(kafkaEventService.ListCalls()).To(gomega.HaveLen(tt.wantListCalls))
+			if tt.wantStatusCode == http.StatusOK {
+				g.Expect(kafkaEventService.ListCalls()[0].Fields).To(gomega.Equal(services.PublicKafkaEventFields))
+				var eventList public.KafkaEventList
+				g.Expect(json.NewDecoder(resp.Body).Decode(&eventList)).To(gomega.Succeed())
+				g.Expect(eventList.Kind).To(gomega.Equal("KafkaEventList"))
+				g.Expect(eventList.Items).To(gomega.HaveLen(1))
+				g.Expect(eventList.Items[0].Kind).To(gomega.Equal("KafkaEvent"))
+				g.Expect(eventList.Items[0].Value).To(gomega.Equal("ready"))
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+type kafkaExpirationHandler struct {
+	kafkaService services.KafkaService
+}
+
+func NewKafkaExpirationHandler(kafkaService services.KafkaService) *kafkaExpirationHandler {
+	return &kafkaExpirationHandler{
+		kafkaService: kafkaService,
+	}
+}
+
+// Get returns when a kafka request expires, along with the time left before it expires
+func (h kafkaExpirationHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			// the kafka is retrieved first to make sure that the user is allowed to access it
+			kafkaRequest, err := h.kafkaService.Get(r.Context(), id)
+			if err != nil {
+				return nil, err
+			}
+
+			expiresAt, err := h.kafkaService.GetExpirationTime(kafkaRequest)
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentKafkaExpiration(kafkaRequest, expiresAt, time.Now()), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+const kafkaExpirationUrl = "/kafkas/{id}/expiration"
+
+func Test_kafkaExpirationHandler_Get(t *testing.T) {
+	expiresAt := time.Now().Add(48 * time.Hour)
+
+	tests := []struct {
+		name                 string
+		getErr               *errors.ServiceError
+		expiresAt            *time.Time
+		wantStatusCode       int
+		wantRemainingSeconds bool
+	}{
+		{
+			name:                 "should return the expiration of the kafka",
+			expiresAt:            &expiresAt,
+			wantStatusCode:       http.StatusOK,
+			wantRemainingSeconds: true,
+		},
+		{
+			name:           "should return an empty expiration when the kafka does not expire",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return not found if the kafka does not exist or the user is not allowed to access it",
+			getErr:         errors.NotFound("Kafka Resource not found"),
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			kafkaService := &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-id"}}, nil
+				},
+				GetExpirationTimeFunc: func(kafkaRequest *dbapi.KafkaRequest) (*time.Time, *errors.ServiceError) {
+					return tt.expiresAt, nil
+				},
+			}
+			h := NewKafkaExpirationHandler(kafkaService)
+			req, rw := GetHandlerParams("GET", kafkaExpirationUrl, nil, t)
+			h.Get(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				var expiration public.KafkaExpiration
+				g.Expect(json.NewDecoder(resp.Body).Decode(&expiration)).To(gomega.Succeed())
+				g.Expect(expiration.Kind).To(gomega.Equal("KafkaExpiration"))
+				g.Expect(expiration.Id).To(gomega.Equal("kafka-id"))
+				g.Expect(expiration.ExpiresAt != nil).To(gomega.Equal(tt.expiresAt != nil))
+				g.Expect(expiration.RemainingSeconds != nil).To(gomega.Equal(tt.wantRemainingSeconds))
+			}
+		})
+	}
+}
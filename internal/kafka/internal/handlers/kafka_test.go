@@ -114,7 +114,7 @@ func Test_KafkaHandler_Delete(t *testing.T) {
 			name: "fails if RegisterKafkaDeprovisionJob fails in kafka service",
 			fields: fields{
 				service: &services.KafkaServiceMock{
-					RegisterKafkaDeprovisionJobFunc: func(ctx context.Context, id string) *errors.ServiceError {
+					RegisterKafkaDeprovisionJobFunc: func(ctx context.Context, id string, ignoreDeletionProtection bool) *errors.ServiceError {
 						return errors.GeneralError("register kafka deprovision job failed")
 					},
 				},
@@ -128,7 +128,7 @@ func Test_KafkaHandler_Delete(t *testing.T) {
 			name: "fails if RegisterKafkaDeprovisionJob fails in kafka service",
 			fields: fields{
 				service: &services.KafkaServiceMock{
-					RegisterKafkaDeprovisionJobFunc: func(ctx context.Context, id string) *errors.ServiceError {
+					RegisterKafkaDeprovisionJobFunc: func(ctx context.Context, id string, ignoreDeletionProtection bool) *errors.ServiceError {
 						return nil
 					},
 				},
@@ -344,6 +344,119 @@ func Test_KafkaHandler_Update(t *testing.T) {
 			},
 			wantStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name: "succeeds if the size_id value is set",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+					ResizeKafkaFunc: func(kafkaRequest *dbapi.KafkaRequest, sizeId string) *errors.ServiceError {
+						kafkaRequest.SizeId = sizeId
+						return nil
+					},
+				},
+				kafkaConfig: &fullKafkaConfig,
+			},
+			args: args{
+				body: []byte(`{"size_id": "x2"}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "fails if the size_id value is empty",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+				},
+				kafkaConfig: &fullKafkaConfig,
+			},
+			args: args{
+				body: []byte(`{"size_id": ""}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fails if ResizeKafka in the kafka service returns an error",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+					ResizeKafkaFunc: func(kafkaRequest *dbapi.KafkaRequest, sizeId string) *errors.ServiceError {
+						return errors.TooManyKafkaInstancesReached("region cannot accept the size")
+					},
+				},
+				kafkaConfig: &fullKafkaConfig,
+			},
+			args: args{
+				body: []byte(`{"size_id": "x2"}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "succeeds if the labels are set",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+					UpdateLabelsFunc: func(kafkaRequest *dbapi.KafkaRequest, labels dbapi.KafkaLabels) *errors.ServiceError {
+						kafkaRequest.Labels = labels
+						return nil
+					},
+				},
+				kafkaConfig: &fullKafkaConfig,
+			},
+			args: args{
+				body: []byte(`{"labels": {"team": "payments"}}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "succeeds if the deletion protection is enabled",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+					UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+						if values["deletion_protection"] != true {
+							return errors.GeneralError("deletion protection not enabled")
+						}
+						return nil
+					},
+				},
+				kafkaConfig: &fullKafkaConfig,
+			},
+			args: args{
+				body: []byte(`{"deletion_protection": true}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "fails if a label is not valid",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+				},
+				kafkaConfig: &fullKafkaConfig,
+			},
+			args: args{
+				body: []byte(`{"labels": {"team name": "payments"}}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, testcase := range tests {
@@ -362,6 +475,122 @@ func Test_KafkaHandler_Update(t *testing.T) {
 	}
 }
 
+func Test_KafkaHandler_SuspendResumeAndRestore(t *testing.T) {
+	type fields struct {
+		service services.KafkaService
+	}
+
+	type args struct {
+		ctx context.Context
+	}
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+	}{
+		{
+			name: "should return 404 if the kafka is not found",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return nil, errors.NotFound("not found")
+					},
+				},
+			},
+			args: args{
+				ctx: ctx,
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "should return 403 if the user is neither the owner of the kafka nor an org admin",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+				},
+			},
+			args: args{
+				ctx: auth.SetTokenInContext(context.TODO(), &jwt.Token{
+					Claims: jwt.MapClaims{
+						"username":     "another-user",
+						"org_id":       mocks.DefaultOrganisationId,
+						"is_org_admin": false,
+					},
+				}),
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "should return the error returned by the kafka service",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+					SuspendKafkaFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						return errors.Validation("invalid status")
+					},
+					ResumeKafkaFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						return errors.Validation("invalid status")
+					},
+					RestoreKafkaFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						return errors.Validation("invalid status")
+					},
+				},
+			},
+			args: args{
+				ctx: ctx,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return 202 if the action has been accepted",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+					SuspendKafkaFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						return nil
+					},
+					ResumeKafkaFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						return nil
+					},
+					RestoreKafkaFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: ctx,
+			},
+			wantStatusCode: http.StatusAccepted,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			h := NewKafkaHandler(tt.fields.service, nil, nil, &fullKafkaConfig)
+			for _, handlerFunc := range []http.HandlerFunc{h.Suspend, h.Resume, h.Restore} {
+				req, rw := GetHandlerParams("POST", "/{id}", nil, t)
+				req = req.WithContext(tt.args.ctx)
+				req = mux.SetURLVars(req, map[string]string{"id": id})
+				handlerFunc(rw, req)
+				resp := rw.Result()
+				resp.Body.Close()
+				g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			}
+		})
+	}
+}
+
 func Test_KafkaHandler_Create(t *testing.T) {
 	type fields struct {
 		service        services.KafkaService
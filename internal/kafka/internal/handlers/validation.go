@@ -32,6 +32,14 @@ var MaxKafkaNameLength = 32
 
 var ClusterIdLength = 32
 
+var ValidKafkaLabelRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([-_.a-zA-Z0-9]*[a-zA-Z0-9])?$`)
+
+var MaxKafkaLabelLength = 63
+
+var MaxKafkaLabels = 20
+
+var MaxSimulatedKafkas = 1000
+
 func ValidateBillingModel(kafkaRequestPayload *public.KafkaRequestPayload) handlers.Validate {
 	return func() *errors.ServiceError {
 		// the billing model can only be either standard or marketplace
@@ -73,6 +81,27 @@ func ValidateBillingCloudAccountIdAndMarketplace(ctx context.Context, kafkaServi
 	}
 }
 
+// ValidateKafkaLabels validates the number of labels as well as the format of their keys and values
+func ValidateKafkaLabels(labels *map[string]string) handlers.Validate {
+	return func() *errors.ServiceError {
+		if labels == nil {
+			return nil
+		}
+		if len(*labels) > MaxKafkaLabels {
+			return errors.Validation("a kafka instance can have at most %d labels", MaxKafkaLabels)
+		}
+		for key, value := range *labels {
+			if len(key) > MaxKafkaLabelLength || !ValidKafkaLabelRegexp.MatchString(key) {
+				return errors.Validation("label key %q must be at most %d characters long and match %s", key, MaxKafkaLabelLength, ValidKafkaLabelRegexp.String())
+			}
+			if value != "" && (len(value) > MaxKafkaLabelLength || !ValidKafkaLabelRegexp.MatchString(value)) {
+				return errors.Validation("value of label %q must be at most %d characters long and match %s", key, MaxKafkaLabelLength, ValidKafkaLabelRegexp.String())
+			}
+		}
+		return nil
+	}
+}
+
 func ValidKafkaClusterName(value *string, field string) handlers.Validate {
 	return func() *errors.ServiceError {
 		if !ValidKafkaClusterNameRegexp.MatchString(*value) {
@@ -118,9 +147,10 @@ func ValidateClusterIdIsUnique(clusterId *string, clusterService services.Cluste
 
 func validateVersionsCompatibility(h *adminKafkaHandler, kafkaRequest *dbapi.KafkaRequest, kafkaUpdateReq *private.KafkaUpdateRequest) handlers.Validate {
 	return func() *errors.ServiceError { // Validate strimzi, kafka, and kafka IBP version
-		desiredStrimziVersion := arrays.FirstNonEmptyOrDefault(kafkaRequest.DesiredStrimziVersion, kafkaUpdateReq.StrimziVersion)
-		desiredKafkaVersion := arrays.FirstNonEmptyOrDefault(kafkaRequest.DesiredKafkaVersion, kafkaUpdateReq.KafkaVersion)
-		desiredKafkaIBPVersion := arrays.FirstNonEmptyOrDefault(kafkaRequest.DesiredKafkaIBPVersion, kafkaUpdateReq.KafkaIbpVersion)
+		// versions waiting for the maintenance window of the kafka are taken into account as they will eventually be rolled out
+		desiredStrimziVersion := arrays.FirstNonEmptyOrDefault(kafkaRequest.DesiredStrimziVersion, kafkaUpdateReq.StrimziVersion, kafkaRequest.PendingStrimziVersion)
+		desiredKafkaVersion := arrays.FirstNonEmptyOrDefault(kafkaRequest.DesiredKafkaVersion, kafkaUpdateReq.KafkaVersion, kafkaRequest.PendingKafkaVersion)
+		desiredKafkaIBPVersion := arrays.FirstNonEmptyOrDefault(kafkaRequest.DesiredKafkaIBPVersion, kafkaUpdateReq.KafkaIbpVersion, kafkaRequest.PendingKafkaIBPVersion)
 
 		cluster, err := h.clusterService.FindClusterByID(kafkaRequest.ClusterID)
 		if err != nil {
@@ -281,8 +311,83 @@ func ValidateKafkaUpdateFields(kafkaUpdateRequest *private.KafkaUpdateRequest) h
 			stringSet(&kafkaUpdateRequest.KafkaIbpVersion) ||
 			stringSet(&kafkaUpdateRequest.DeprecatedKafkaStorageSize) ||
 			stringSet(&kafkaUpdateRequest.MaxDataRetentionSize) ||
-			shared.IsNotNil(kafkaUpdateRequest.Suspended)) {
-			return errors.FieldValidationError("failed to update Kafka Request. Expecting at least one of the following fields: strimzi_version, kafka_version, kafka_ibp_version, kafka_storage_size, max_data_retention_size or suspended to be provided")
+			shared.IsNotNil(kafkaUpdateRequest.Suspended) ||
+			(shared.IsNotNil(kafkaUpdateRequest.ForceUpgrade) && *kafkaUpdateRequest.ForceUpgrade)) {
+			return errors.FieldValidationError("failed to update Kafka Request. Expecting at least one of the following fields: strimzi_version, kafka_version, kafka_ibp_version, kafka_storage_size, max_data_retention_size, suspended or force_upgrade to be provided")
+		}
+		return nil
+	}
+}
+
+// ValidateUpgradeCampaignStatus validates that an upgrade campaign can be requested to move to the given status
+func ValidateUpgradeCampaignStatus(status *string) handlers.Validate {
+	return func() *errors.ServiceError {
+		switch dbapi.UpgradeCampaignStatus(*status) {
+		case dbapi.UpgradeCampaignStatusInProgress, dbapi.UpgradeCampaignStatusPaused, dbapi.UpgradeCampaignStatusCancelled:
+			return nil
+		default:
+			return errors.FieldValidationError("status %q is not valid. Accepted values are: [%s, %s, %s]", *status,
+				dbapi.UpgradeCampaignStatusInProgress, dbapi.UpgradeCampaignStatusPaused, dbapi.UpgradeCampaignStatusCancelled)
+		}
+	}
+}
+
+// ValidateClusterStatus validates that a data plane cluster can be forced to the given status
+func ValidateClusterStatus(status *string) handlers.Validate {
+	return func() *errors.ServiceError {
+		switch api.ClusterStatus(*status) {
+		case api.ClusterProvisioned, api.ClusterWaitingForKasFleetShardOperator, api.ClusterReady, api.ClusterFailed, api.ClusterDeprovisioning:
+			return nil
+		default:
+			return errors.FieldValidationError("status %q is not valid. Accepted values are: [%s, %s, %s, %s, %s]", *status,
+				api.ClusterProvisioned, api.ClusterWaitingForKasFleetShardOperator, api.ClusterReady, api.ClusterFailed, api.ClusterDeprovisioning)
+		}
+	}
+}
+
+// ValidateClusterPlacementConstraints validates that the taints of the placement constraints are set on the placement
+// attributes of the kafkas, and that the taints and labels have a key
+func ValidateClusterPlacementConstraints(constraints *private.ClusterPlacementConstraints) handlers.Validate {
+	return func() *errors.ServiceError {
+		for key := range constraints.Labels {
+			if key == "" {
+				return errors.FieldValidationError("label keys must not be empty")
+			}
+		}
+
+		placementAttributes := []string{services.PlacementAttributeOrganisationID, services.PlacementAttributeInstanceType}
+		for _, taint := range constraints.Taints {
+			if !arrays.Contains(placementAttributes, taint.Key) {
+				return errors.FieldValidationError("taint key %q is not valid. Accepted values are: [%s]", taint.Key, strings.Join(placementAttributes, ", "))
+			}
+		}
+		return nil
+	}
+}
+
+// ValidateClusterPlacementRequest validates that the instance type and size of the kafka to place are supported
+func ValidateClusterPlacementRequest(kafkaConfig *config.KafkaConfig, placementRequest *private.ClusterPlacementRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if placementRequest.CloudProvider == "" || placementRequest.Region == "" {
+			return errors.FieldValidationError("cloud_provider and region are required")
+		}
+		if _, err := kafkaConfig.GetKafkaInstanceSize(placementRequest.InstanceType, placementRequest.SizeId); err != nil {
+			return errors.FieldValidationError("size %q of instance type %q is not supported", placementRequest.SizeId, placementRequest.InstanceType)
+		}
+		return nil
+	}
+}
+
+func ValidateClusterCapacitySimulationRequest(kafkaConfig *config.KafkaConfig, simulationRequest *private.ClusterCapacitySimulationRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if simulationRequest.CloudProvider == "" || simulationRequest.Region == "" {
+			return errors.FieldValidationError("cloud_provider and region are required")
+		}
+		if simulationRequest.Count < 1 || int(simulationRequest.Count) > MaxSimulatedKafkas {
+			return errors.FieldValidationError("count must be between 1 and %d", MaxSimulatedKafkas)
+		}
+		if _, err := kafkaConfig.GetKafkaInstanceSize(simulationRequest.InstanceType, simulationRequest.SizeId); err != nil {
+			return errors.FieldValidationError("size %q of instance type %q is not supported", simulationRequest.SizeId, simulationRequest.InstanceType)
 		}
 		return nil
 	}
@@ -292,7 +397,9 @@ func stringSet(value *string) bool {
 	return value != nil && len(strings.Trim(*value, " ")) > 0
 }
 
-func ValidateKafkaUserFacingUpdateFields(ctx context.Context, authService authorization.Authorization, kafkaRequest *dbapi.KafkaRequest, kafkaUpdateReq *public.KafkaUpdateRequest) handlers.Validate {
+// ValidateKafkaOwnerOrOrgAdmin validates that the user in the context is either the owner of the given
+// kafka request or an admin of the organisation the kafka request belongs to
+func ValidateKafkaOwnerOrOrgAdmin(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) handlers.Validate {
 	return func() *errors.ServiceError {
 		claims, claimsErr := getClaims(ctx)
 		if claimsErr != nil {
@@ -308,7 +415,43 @@ func ValidateKafkaUserFacingUpdateFields(ctx context.Context, authService author
 			return errors.New(errors.ErrorUnauthorized, "user not authorized to perform this action")
 		}
 
+		return nil
+	}
+}
+
+// ValidateWebhookSubscriptionOwnerOrOrgAdmin only allows the owner of the webhook subscription or an admin of its
+// organisation to perform the action
+func ValidateWebhookSubscriptionOwnerOrOrgAdmin(ctx context.Context, subscription *dbapi.WebhookSubscription) handlers.Validate {
+	return func() *errors.ServiceError {
+		claims, claimsErr := getClaims(ctx)
+		if claimsErr != nil {
+			return claimsErr
+		}
+
+		username, _ := claims.GetUsername()
+		orgId, _ := claims.GetOrgId()
+		isOwner := (claims.IsOrgAdmin() || subscription.Owner == username) && subscription.OrganisationId == orgId
+		if !isOwner {
+			return errors.New(errors.ErrorUnauthorized, "user not authorized to perform this action")
+		}
+
+		return nil
+	}
+}
+
+func ValidateKafkaUserFacingUpdateFields(ctx context.Context, authService authorization.Authorization, kafkaRequest *dbapi.KafkaRequest, kafkaUpdateReq *public.KafkaUpdateRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if err := ValidateKafkaOwnerOrOrgAdmin(ctx, kafkaRequest)(); err != nil {
+			return err
+		}
+
 		if kafkaUpdateReq.Owner != nil {
+			claims, claimsErr := getClaims(ctx)
+			if claimsErr != nil {
+				return claimsErr
+			}
+			orgId, _ := claims.GetOrgId()
+
 			validationError := handlers.ValidateMinLength(kafkaUpdateReq.Owner, "owner", 1)()
 			if validationError != nil {
 				return validationError
@@ -323,6 +466,16 @@ func ValidateKafkaUserFacingUpdateFields(ctx context.Context, authService author
 			}
 		}
 
+		if kafkaUpdateReq.SizeId != nil {
+			if validationError := handlers.ValidateMinLength(kafkaUpdateReq.SizeId, "size_id", 1)(); validationError != nil {
+				return validationError
+			}
+		}
+
+		if validationError := ValidateKafkaLabels(kafkaUpdateReq.Labels)(); validationError != nil {
+			return validationError
+		}
+
 		return nil
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
@@ -969,7 +970,7 @@ func TestValidateKafkaUpdateFields(t *testing.T) {
 					DeprecatedKafkaStorageSize: "",
 				},
 			},
-			want: errors.FieldValidationError("failed to update Kafka Request. Expecting at least one of the following fields: strimzi_version, kafka_version, kafka_ibp_version, kafka_storage_size, max_data_retention_size or suspended to be provided"),
+			want: errors.FieldValidationError("failed to update Kafka Request. Expecting at least one of the following fields: strimzi_version, kafka_version, kafka_ibp_version, kafka_storage_size, max_data_retention_size, suspended or force_upgrade to be provided"),
 		},
 	}
 	for _, testcase := range tests {
@@ -1178,6 +1179,59 @@ func Test_Validation_validateBillingModel(t *testing.T) {
 	}
 }
 
+func Test_Validation_validateKafkaLabels(t *testing.T) {
+	tooManyLabels := map[string]string{}
+	for i := 0; i <= MaxKafkaLabels; i++ {
+		tooManyLabels[fmt.Sprintf("label-%d", i)] = "value"
+	}
+
+	tests := []struct {
+		name    string
+		labels  *map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "do not throw an error when labels are not provided",
+			labels:  nil,
+			wantErr: false,
+		},
+		{
+			name:    "do not throw an error when labels are valid",
+			labels:  &map[string]string{"team": "payments", "cost.center": "cc-1234", "empty": ""},
+			wantErr: false,
+		},
+		{
+			name:    "throw an error when a label key is not valid",
+			labels:  &map[string]string{"team name": "payments"},
+			wantErr: true,
+		},
+		{
+			name:    "throw an error when a label key is too long",
+			labels:  &map[string]string{strings.Repeat("a", MaxKafkaLabelLength+1): "payments"},
+			wantErr: true,
+		},
+		{
+			name:    "throw an error when a label value is not valid",
+			labels:  &map[string]string{"team": "'payments'"},
+			wantErr: true,
+		},
+		{
+			name:    "throw an error when there are too many labels",
+			labels:  &tooManyLabels,
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			err := ValidateKafkaLabels(tt.labels)()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
 func Test_validateVersionsCompatibility(t *testing.T) {
 	type args struct {
 		h              *adminKafkaHandler
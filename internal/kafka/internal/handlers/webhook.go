@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+)
+
+type webhookHandler struct {
+	webhookService services.WebhookService
+}
+
+func NewWebhookHandler(webhookService services.WebhookService) *webhookHandler {
+	return &webhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+func (h webhookHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var request public.WebhookSubscriptionRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			handlers.ValidateMinLength(&request.Url, "url", handlers.MinRequiredFieldLength),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			subscription := presenters.ConvertWebhookSubscriptionRequest(request)
+			if err := h.webhookService.Create(r.Context(), subscription); err != nil {
+				return nil, err
+			}
+			// the secret of the subscription is only returned once, when the subscription is created
+			return presenters.PresentWebhookSubscription(subscription, true), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h webhookHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			subscription, err := h.webhookService.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentWebhookSubscription(subscription, false), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h webhookHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			subscriptions, paging, err := h.webhookService.List(r.Context(), listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			subscriptionList := public.WebhookSubscriptionList{
+				Kind:  "WebhookSubscriptionList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []public.WebhookSubscription{},
+			}
+
+			for _, subscription := range subscriptions {
+				subscriptionList.Items = append(subscriptionList.Items, presenters.PresentWebhookSubscription(subscription, false))
+			}
+
+			return subscriptionList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Update updates the webhook subscription. Only its owner or an admin of its organisation is allowed to update it.
+func (h webhookHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var request public.WebhookSubscriptionUpdateRequest
+	ctx := r.Context()
+	subscription, getErr := h.webhookService.Get(ctx, mux.Vars(r)["id"])
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				return getErr
+			},
+			ValidateWebhookSubscriptionOwnerOrOrgAdmin(ctx, subscription),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			presenters.ConvertWebhookSubscriptionUpdateRequest(request, subscription)
+			if err := h.webhookService.Update(subscription); err != nil {
+				return nil, err
+			}
+			return presenters.PresentWebhookSubscription(subscription, false), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Delete deletes the webhook subscription. Only its owner or an admin of its organisation is allowed to delete it.
+func (h webhookHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	subscription, getErr := h.webhookService.Get(ctx, mux.Vars(r)["id"])
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				return getErr
+			},
+			ValidateWebhookSubscriptionOwnerOrOrgAdmin(ctx, subscription),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			return nil, h.webhookService.Delete(ctx, subscription.ID)
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// ListDeliveries returns the delivery log of a webhook subscription
+func (h webhookHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			// the subscription is retrieved first to make sure that the user is allowed to access it
+			subscription, err := h.webhookService.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			deliveries, paging, err := h.webhookService.ListDeliveries(subscription.ID, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			deliveryList := public.WebhookDeliveryList{
+				Kind:  "WebhookDeliveryList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []public.WebhookDelivery{},
+			}
+
+			for _, delivery := range deliveries {
+				deliveryList.Items = append(deliveryList.Items, presenters.PresentWebhookDelivery(delivery))
+			}
+
+			return deliveryList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
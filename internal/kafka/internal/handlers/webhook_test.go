@@ -0,0 +1,324 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/onsi/gomega"
+)
+
+const webhooksUrl = "/webhooks"
+
+func buildWebhookSubscription() *dbapi.WebhookSubscription {
+	return &dbapi.WebhookSubscription{
+		Meta:           api.Meta{ID: "subscription-id"},
+		OrganisationId: "org-id",
+		Owner:          "test-user",
+		Url:            "https://example.com/hook",
+		Secret:         "secret",
+		EventTypes:     "kafka.ready",
+		Enabled:        true,
+	}
+}
+
+func buildWebhookContext(username string, isOrgAdmin bool) context.Context {
+	return auth.SetTokenInContext(context.TODO(), &jwt.Token{
+		Claims: jwt.MapClaims{
+			"username":     username,
+			"org_id":       "org-id",
+			"is_org_admin": isOrgAdmin,
+		},
+	})
+}
+
+func Test_webhookHandler_Create(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		createErr      *errors.ServiceError
+		wantStatusCode int
+		wantSecret     string
+	}{
+		{
+			name:           "should create the subscription and return its secret",
+			body:           `{"url": "https://example.com/hook", "event_types": ["kafka.ready"]}`,
+			wantStatusCode: http.StatusCreated,
+			wantSecret:     "secret",
+		},
+		{
+			name:           "should return bad request if the url is missing",
+			body:           `{"event_types": ["kafka.ready"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request if the subscription is not valid",
+			body:           `{"url": "https://example.com/hook", "event_types": ["kafka.created"]}`,
+			createErr:      errors.Validation("event type \"kafka.created\" is not valid"),
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			webhookService := &services.WebhookServiceMock{
+				CreateFunc: func(ctx context.Context, subscription *dbapi.WebhookSubscription) *errors.ServiceError {
+					if tt.createErr != nil {
+						return tt.createErr
+					}
+					subscription.ID = "subscription-id"
+					subscription.Secret = "secret"
+					return nil
+				},
+			}
+			h := NewWebhookHandler(webhookService)
+			req, rw := GetHandlerParams("POST", webhooksUrl, bytes.NewBufferString(tt.body), t)
+			h.Create(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusCreated {
+				var subscription public.WebhookSubscription
+				g.Expect(json.NewDecoder(resp.Body).Decode(&subscription)).To(gomega.Succeed())
+				g.Expect(subscription.Secret).To(gomega.Equal(tt.wantSecret))
+				g.Expect(subscription.Enabled).To(gomega.BeTrue())
+			}
+		})
+	}
+}
+
+func Test_webhookHandler_Get(t *testing.T) {
+	g := gomega.NewWithT(t)
+	webhookService := &services.WebhookServiceMock{
+		GetFunc: func(ctx context.Context, id string) (*dbapi.WebhookSubscription, *errors.ServiceError) {
+			return buildWebhookSubscription(), nil
+		},
+	}
+	h := NewWebhookHandler(webhookService)
+	req, rw := GetHandlerParams("GET", webhooksUrl+"/subscription-id", nil, t)
+	h.Get(rw, req)
+	resp := rw.Result()
+	defer resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+	var subscription public.WebhookSubscription
+	g.Expect(json.NewDecoder(resp.Body).Decode(&subscription)).To(gomega.Succeed())
+	g.Expect(subscription.Secret).To(gomega.BeEmpty())
+}
+
+func Test_webhookHandler_Update(t *testing.T) {
+	tests := []struct {
+		name            string
+		body            string
+		ctx             context.Context
+		getErr          *errors.ServiceError
+		wantStatusCode  int
+		wantUpdateCalls int
+		wantEnabled     bool
+		wantEventTypes  string
+	}{
+		{
+			name:            "should only update the fields set in the request",
+			body:            `{"enabled": false}`,
+			wantStatusCode:  http.StatusOK,
+			wantUpdateCalls: 1,
+			wantEnabled:     false,
+			wantEventTypes:  "kafka.ready",
+		},
+		{
+			name:            "should update the event types of the subscription",
+			body:            `{"event_types": ["kafka.failed", "kafka.deleted"]}`,
+			wantStatusCode:  http.StatusOK,
+			wantUpdateCalls: 1,
+			wantEnabled:     true,
+			wantEventTypes:  "kafka.failed,kafka.deleted",
+		},
+		{
+			name:            "should allow an organisation admin to update the subscription of another user",
+			body:            `{"enabled": false}`,
+			ctx:             buildWebhookContext("another-user", true),
+			wantStatusCode:  http.StatusOK,
+			wantUpdateCalls: 1,
+			wantEnabled:     false,
+			wantEventTypes:  "kafka.ready",
+		},
+		{
+			name:           "should return forbidden if the user is neither the owner of the subscription nor an organisation admin",
+			body:           `{"enabled": false}`,
+			ctx:            buildWebhookContext("another-user", false),
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "should return not found if the subscription does not exist",
+			body:           `{"enabled": false}`,
+			getErr:         errors.NotFound("webhook subscription not found"),
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			webhookService := &services.WebhookServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.WebhookSubscription, *errors.ServiceError) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return buildWebhookSubscription(), nil
+				},
+				UpdateFunc: func(subscription *dbapi.WebhookSubscription) *errors.ServiceError {
+					return nil
+				},
+			}
+			h := NewWebhookHandler(webhookService)
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = buildWebhookContext("test-user", false)
+			}
+			req, rw := GetHandlerParams("PATCH", webhooksUrl+"/subscription-id", bytes.NewBufferString(tt.body), t)
+			h.Update(rw, req.WithContext(ctx))
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(webhookService.UpdateCalls()).To(gomega.HaveLen(tt.wantUpdateCalls))
+			if tt.wantUpdateCalls > 0 {
+				updated := webhookService.UpdateCalls()[0].Subscription
+				g.Expect(updated.Enabled).To(gomega.Equal(tt.wantEnabled))
+				g.Expect(updated.EventTypes).To(gomega.Equal(tt.wantEventTypes))
+			}
+		})
+	}
+}
+
+func Test_webhookHandler_Delete(t *testing.T) {
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		getErr          *errors.ServiceError
+		wantStatusCode  int
+		wantDeleteCalls int
+	}{
+		{
+			name:            "should allow the owner to delete the subscription",
+			ctx:             buildWebhookContext("test-user", false),
+			wantStatusCode:  http.StatusNoContent,
+			wantDeleteCalls: 1,
+		},
+		{
+			name:            "should allow an organisation admin to delete the subscription of another user",
+			ctx:             buildWebhookContext("another-user", true),
+			wantStatusCode:  http.StatusNoContent,
+			wantDeleteCalls: 1,
+		},
+		{
+			name:           "should return forbidden if the user is neither the owner of the subscription nor an organisation admin",
+			ctx:            buildWebhookContext("another-user", false),
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "should return not found if the subscription does not exist",
+			ctx:            buildWebhookContext("test-user", false),
+			getErr:         errors.NotFound("webhook subscription not found"),
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			webhookService := &services.WebhookServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.WebhookSubscription, *errors.ServiceError) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return buildWebhookSubscription(), nil
+				},
+				DeleteFunc: func(ctx context.Context, id string) *errors.ServiceError {
+					return nil
+				},
+			}
+			h := NewWebhookHandler(webhookService)
+			req, rw := GetHandlerParams("DELETE", webhooksUrl+"/subscription-id", nil, t)
+			h.Delete(rw, req.WithContext(tt.ctx))
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(webhookService.DeleteCalls()).To(gomega.HaveLen(tt.wantDeleteCalls))
+		})
+	}
+}
+
+func Test_webhookHandler_ListDeliveries(t *testing.T) {
+	tests := []struct {
+		name                    string
+		getErr                  *errors.ServiceError
+		wantStatusCode          int
+		wantListDeliveriesCalls int
+	}{
+		{
+			name:                    "should return the deliveries of the subscription",
+			wantStatusCode:          http.StatusOK,
+			wantListDeliveriesCalls: 1,
+		},
+		{
+			name:                    "should return not found if the user is not allowed to access the subscription",
+			getErr:                  errors.NotFound("webhook subscription not found"),
+			wantStatusCode:          http.StatusNotFound,
+			wantListDeliveriesCalls: 0,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			webhookService := &services.WebhookServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.WebhookSubscription, *errors.ServiceError) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return buildWebhookSubscription(), nil
+				},
+				ListDeliveriesFunc: func(subscriptionID string, listArgs *coreServices.ListArguments) (dbapi.WebhookDeliveryList, *api.PagingMeta, *errors.ServiceError) {
+					return dbapi.WebhookDeliveryList{
+						{
+							Meta:           api.Meta{ID: "delivery-id"},
+							SubscriptionID: subscriptionID,
+							KafkaID:        "kafka-id",
+							EventType:      dbapi.WebhookEventTypeKafkaReady,
+							Status:         dbapi.WebhookDeliveryStatusSucceeded,
+							Attempts:       1,
+						},
+					}, &api.PagingMeta{Page: 1, Size: 1, Total: 1}, nil
+				},
+			}
+			h := NewWebhookHandler(webhookService)
+			req, rw := GetHandlerParams("GET", webhooksUrl+"/subscription-id/deliveries", nil, t)
+			h.ListDeliveries(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(webhookService.ListDeliveriesCalls()).To(gomega.HaveLen(tt.wantListDeliveriesCalls))
+			if tt.wantStatusCode == http.StatusOK {
+				var deliveries public.WebhookDeliveryList
+				g.Expect(json.NewDecoder(resp.Body).Decode(&deliveries)).To(gomega.Succeed())
+				g.Expect(deliveries.Kind).To(gomega.Equal("WebhookDeliveryList"))
+				g.Expect(deliveries.Items).To(gomega.HaveLen(1))
+				g.Expect(deliveries.Items[0].Status).To(gomega.Equal("succeeded"))
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaMaintenanceWindows() *gormigrate.Migration {
+	type MaintenanceWindow struct {
+		DayOfWeek     string `gorm:"default:''"`
+		StartHour     int    `gorm:"default:0"`
+		DurationHours int    `gorm:"default:0"`
+	}
+
+	type KafkaRequest struct {
+		MaintenanceWindow      MaintenanceWindow `gorm:"embedded;embeddedPrefix:maintenance_window_"`
+		PendingKafkaVersion    string            `gorm:"default:''"`
+		PendingStrimziVersion  string            `gorm:"default:''"`
+		PendingKafkaIBPVersion string            `gorm:"default:''"`
+	}
+
+	type OrganisationMaintenanceWindow struct {
+		db.Model
+		OrganisationId    string            `gorm:"index"`
+		MaintenanceWindow MaintenanceWindow `gorm:"embedded;embeddedPrefix:maintenance_window_"`
+	}
+
+	kafkaRequestColumns := []string{
+		"maintenance_window_day_of_week",
+		"maintenance_window_start_hour",
+		"maintenance_window_duration_hours",
+		"pending_kafka_version",
+		"pending_strimzi_version",
+		"pending_kafka_ibp_version",
+	}
+
+	return &gormigrate.Migration{
+		ID: "20221215120000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&KafkaRequest{}); err != nil {
+				return err
+			}
+			return tx.AutoMigrate(&OrganisationMaintenanceWindow{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range kafkaRequestColumns {
+				if err := tx.Migrator().DropColumn(&KafkaRequest{}, column); err != nil {
+					return err
+				}
+			}
+			return tx.Migrator().DropTable(&OrganisationMaintenanceWindow{})
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addUpgradeCampaigns() *gormigrate.Migration {
+	type UpgradeCampaignFilter struct {
+		InstanceType       string `gorm:"default:''"`
+		Region             string `gorm:"default:''"`
+		ClusterID          string `gorm:"default:''"`
+		ActualKafkaVersion string `gorm:"default:''"`
+	}
+
+	type UpgradeCampaign struct {
+		db.Model
+		Name                     string
+		Status                   string                `gorm:"index"`
+		StatusReason             string                `gorm:"default:''"`
+		Filter                   UpgradeCampaignFilter `gorm:"embedded;embeddedPrefix:filter_"`
+		KafkaVersion             string
+		StrimziVersion           string `gorm:"default:''"`
+		KafkaIBPVersion          string `gorm:"default:''"`
+		BatchSize                int
+		MaxConcurrentUpgrades    int
+		StallTimeoutMinutes      int
+		IgnoreMaintenanceWindows bool `gorm:"default:false"`
+	}
+
+	type UpgradeCampaignKafka struct {
+		db.Model
+		UpgradeCampaignID string `gorm:"index"`
+		KafkaID           string `gorm:"index"`
+		Status            string
+		FailedReason      string `gorm:"default:''"`
+		StartedAt         *time.Time
+		FinishedAt        *time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "20221220120000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&UpgradeCampaign{}); err != nil {
+				return err
+			}
+			return tx.AutoMigrate(&UpgradeCampaignKafka{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&UpgradeCampaignKafka{}); err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable(&UpgradeCampaign{})
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaEvents() *gormigrate.Migration {
+	type KafkaEvent struct {
+		db.Model
+		KafkaID       string `gorm:"index"`
+		EventType     string
+		Field         string
+		PreviousValue string `gorm:"default:''"`
+		Value         string `gorm:"default:''"`
+		ClusterID     string `gorm:"default:''"`
+		Message       string `gorm:"default:''"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20221222120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaEvent{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&KafkaEvent{})
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addWebhooks() *gormigrate.Migration {
+	type WebhookSubscription struct {
+		db.Model
+		OrganisationId string `gorm:"index"`
+		Owner          string
+		Url            string
+		Secret         string
+		EventTypes     string
+		Enabled        bool
+	}
+
+	type WebhookDelivery struct {
+		db.Model
+		SubscriptionID     string `gorm:"index"`
+		KafkaID            string
+		EventType          string
+		Payload            string
+		Status             string `gorm:"index"`
+		Attempts           int
+		NextAttemptAt      *time.Time
+		LastAttemptAt      *time.Time
+		ResponseStatusCode int
+		LastError          string `gorm:"default:''"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20221223120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&WebhookSubscription{}, &WebhookDelivery{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&WebhookDelivery{}, &WebhookSubscription{})
+		},
+	}
+}
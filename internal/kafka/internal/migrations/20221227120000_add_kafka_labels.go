@@ -0,0 +1,26 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaLabels() *gormigrate.Migration {
+	type KafkaLabel struct {
+		db.Model
+		KafkaID string `gorm:"index"`
+		Key     string `gorm:"index"`
+		Value   string `gorm:"default:''"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20221227120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaLabel{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&KafkaLabel{})
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaDeletionProtection() *gormigrate.Migration {
+	type KafkaRequest struct {
+		DeletionProtection bool `gorm:"default:false"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20221228120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaRequest{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn(&KafkaRequest{}, "deletion_protection")
+		},
+	}
+}
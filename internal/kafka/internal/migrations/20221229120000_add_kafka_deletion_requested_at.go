@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaDeletionRequestedAt() *gormigrate.Migration {
+	type KafkaRequest struct {
+		DeletionRequestedAt *time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "20221229120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaRequest{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn(&KafkaRequest{}, "deletion_requested_at")
+		},
+	}
+}
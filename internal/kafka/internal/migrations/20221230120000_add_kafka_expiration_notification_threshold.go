@@ -0,0 +1,22 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaExpirationNotificationThreshold() *gormigrate.Migration {
+	type KafkaRequest struct {
+		ExpirationNotificationThresholdSeconds int64 `gorm:"default:0"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20221230120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaRequest{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn(&KafkaRequest{}, "expiration_notification_threshold_seconds")
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addClusterUnschedulable() *gormigrate.Migration {
+	type Cluster struct {
+		Unschedulable bool `gorm:"default:false"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230102120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&Cluster{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn(&Cluster{}, "unschedulable")
+		},
+	}
+}
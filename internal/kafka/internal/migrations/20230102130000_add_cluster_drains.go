@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addClusterDrains() *gormigrate.Migration {
+	type ClusterDrain struct {
+		db.Model
+		ClusterID           string `gorm:"index"`
+		Status              string `gorm:"index"`
+		StatusReason        string `gorm:"default:''"`
+		BatchSize           int
+		StallTimeoutMinutes int
+	}
+
+	type ClusterDrainKafka struct {
+		db.Model
+		ClusterDrainID  string `gorm:"index"`
+		KafkaID         string `gorm:"index"`
+		Status          string
+		TargetClusterID string `gorm:"default:''"`
+		FailedReason    string `gorm:"default:''"`
+		StartedAt       *time.Time
+		FinishedAt      *time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230102130000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&ClusterDrain{}); err != nil {
+				return err
+			}
+			return tx.AutoMigrate(&ClusterDrainKafka{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&ClusterDrainKafka{}); err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable(&ClusterDrain{})
+		},
+	}
+}
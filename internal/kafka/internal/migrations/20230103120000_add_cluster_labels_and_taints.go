@@ -0,0 +1,26 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addClusterLabelsAndTaints() *gormigrate.Migration {
+	type Cluster struct {
+		Labels string `json:"labels" gorm:"type:jsonb"`
+		Taints string `json:"taints" gorm:"type:jsonb"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230103120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&Cluster{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&Cluster{}, "labels"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropColumn(&Cluster{}, "taints")
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addClusterHealth() *gormigrate.Migration {
+	type Cluster struct {
+		StatusReportedAt     *time.Time `json:"status_reported_at"`
+		FailedStatusReports  int        `json:"failed_status_reports"`
+		HealthyStatusReports int        `json:"healthy_status_reports"`
+		QuarantineReason     string     `json:"quarantine_reason"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230104120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&Cluster{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{"status_reported_at", "failed_status_reports", "healthy_status_reports", "quarantine_reason"} {
+				if err := tx.Migrator().DropColumn(&Cluster{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addCapacityReservations() *gormigrate.Migration {
+	type CapacityReservation struct {
+		db.Model
+		OrganisationId string `gorm:"index"`
+		CloudProvider  string
+		Region         string
+		InstanceType   string
+		StreamingUnits int
+		ExpiresAt      time.Time `gorm:"index"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230105120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&CapacityReservation{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&CapacityReservation{})
+		},
+	}
+}
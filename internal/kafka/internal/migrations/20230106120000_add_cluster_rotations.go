@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addClusterRotations() *gormigrate.Migration {
+	type ClusterRotation struct {
+		db.Model
+		ClusterID            string `gorm:"index"`
+		ReplacementID        string `gorm:"index"`
+		ReplacementClusterID string `gorm:"index"`
+		Status               string `gorm:"index"`
+		StatusReason         string
+		Reason               string
+	}
+
+	type ClusterDrain struct {
+		TargetClusterID string
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230106120000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&ClusterRotation{}); err != nil {
+				return err
+			}
+			return tx.AutoMigrate(&ClusterDrain{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&ClusterDrain{}, "target_cluster_id"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable(&ClusterRotation{})
+		},
+	}
+}
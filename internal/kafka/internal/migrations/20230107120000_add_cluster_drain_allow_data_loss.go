@@ -0,0 +1,22 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addClusterDrainAllowDataLoss() *gormigrate.Migration {
+	type ClusterDrain struct {
+		AllowDataLoss bool `gorm:"default:false"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230107120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&ClusterDrain{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn(&ClusterDrain{}, "allow_data_loss")
+		},
+	}
+}
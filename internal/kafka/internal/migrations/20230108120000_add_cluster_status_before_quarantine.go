@@ -0,0 +1,22 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addClusterStatusBeforeQuarantine() *gormigrate.Migration {
+	type Cluster struct {
+		StatusBeforeQuarantine string `json:"status_before_quarantine"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230108120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&Cluster{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn(&Cluster{}, "status_before_quarantine")
+		},
+	}
+}
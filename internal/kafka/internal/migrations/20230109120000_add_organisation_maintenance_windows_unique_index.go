@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addOrganisationMaintenanceWindowsUniqueIndex() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20230109120000",
+		Migrate: func(tx *gorm.DB) error {
+			// keep only the most recent maintenance window of an organisation so that the unique index can be created
+			if err := tx.Exec(`UPDATE organisation_maintenance_windows SET deleted_at = NOW()
+				WHERE deleted_at IS NULL AND id NOT IN (
+					SELECT DISTINCT ON (organisation_id) id FROM organisation_maintenance_windows
+					WHERE deleted_at IS NULL ORDER BY organisation_id, updated_at DESC
+				)`).Error; err != nil {
+				return err
+			}
+			return tx.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS uix_organisation_maintenance_windows_organisation_id
+				ON organisation_maintenance_windows (organisation_id) WHERE deleted_at IS NULL`).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec(`DROP INDEX IF EXISTS uix_organisation_maintenance_windows_organisation_id`).Error
+		},
+	}
+}
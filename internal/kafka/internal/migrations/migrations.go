@@ -90,6 +90,29 @@ var migrations = []*gormigrate.Migration{
 	renameKafkaBillingModelColumn(),
 	addExpiresAtToKafkaRequest(),
 	addClusterOrgIdClusterTypeColumns(),
+	addKafkaMaintenanceWindows(),
+	addMaintenanceWindowUpgradeWorkerToLeaderLeases(),
+	addUpgradeCampaigns(),
+	addUpgradeCampaignWorkerToLeaderLeases(),
+	addKafkaEvents(),
+	addWebhooks(),
+	addWebhookDeliveryWorkerToLeaderLeases(),
+	addKafkaLabels(),
+	addKafkaDeletionProtection(),
+	addKafkaDeletionRequestedAt(),
+	addKafkaExpirationNotificationThreshold(),
+	addClusterUnschedulable(),
+	addClusterDrains(),
+	addClusterDrainWorkerToLeaderLeases(),
+	addClusterLabelsAndTaints(),
+	addClusterHealth(),
+	addClusterHealthWorkerToLeaderLeases(),
+	addCapacityReservations(),
+	addClusterRotations(),
+	addClusterRotationWorkerToLeaderLeases(),
+	addClusterDrainAllowDataLoss(),
+	addClusterStatusBeforeQuarantine(),
+	addOrganisationMaintenanceWindowsUniqueIndex(),
 }
 
 func New(dbConfig *db.DatabaseConfig) (*db.Migration, func(), error) {
@@ -0,0 +1,61 @@
+package presenters
+
+import (
+	"sort"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+func PresentCluster(cluster *api.Cluster, kafkaCount int) private.Cluster {
+	reference := PresentReference(cluster.ClusterID, cluster)
+
+	dynamicCapacityInfo := []private.ClusterDynamicCapacityInfo{}
+	for instanceType, capacity := range cluster.RetrieveDynamicCapacityInfo() {
+		dynamicCapacityInfo = append(dynamicCapacityInfo, private.ClusterDynamicCapacityInfo{
+			InstanceType:   instanceType,
+			MaxNodes:       capacity.MaxNodes,
+			MaxUnits:       capacity.MaxUnits,
+			RemainingUnits: capacity.RemainingUnits,
+		})
+	}
+	sort.Slice(dynamicCapacityInfo, func(i, j int) bool {
+		return dynamicCapacityInfo[i].InstanceType < dynamicCapacityInfo[j].InstanceType
+	})
+
+	taints := []private.ClusterTaint{}
+	for _, taint := range cluster.RetrieveTaints() {
+		taints = append(taints, private.ClusterTaint{Key: taint.Key, Value: taint.Value})
+	}
+
+	var statusReportedAt time.Time
+	if cluster.StatusReportedAt != nil {
+		statusReportedAt = *cluster.StatusReportedAt
+	}
+
+	return private.Cluster{
+		Id:                    reference.Id,
+		Kind:                  reference.Kind,
+		Href:                  reference.Href,
+		ClusterId:             cluster.ClusterID,
+		CloudProvider:         cluster.CloudProvider,
+		Region:                cluster.Region,
+		MultiAz:               cluster.MultiAZ,
+		Status:                cluster.Status.String(),
+		ProviderType:          cluster.ProviderType.String(),
+		ClusterType:           cluster.ClusterType,
+		OrganizationId:        cluster.OrganizationID,
+		ClusterDns:            cluster.ClusterDNS,
+		SupportedInstanceType: cluster.SupportedInstanceType,
+		Unschedulable:         cluster.Unschedulable,
+		StatusReportedAt:      statusReportedAt,
+		QuarantineReason:      cluster.QuarantineReason,
+		KafkaCount:            int32(kafkaCount),
+		DynamicCapacityInfo:   dynamicCapacityInfo,
+		Labels:                cluster.RetrieveLabels(),
+		Taints:                taints,
+		CreatedAt:             cluster.CreatedAt,
+		UpdatedAt:             cluster.UpdatedAt,
+	}
+}
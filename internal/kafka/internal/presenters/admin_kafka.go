@@ -68,6 +68,11 @@ func PresentKafkaRequestAdminEndpoint(kafkaRequest *dbapi.KafkaRequest, accountS
 		MaxDataRetentionSize: private.SupportedKafkaSizeBytesValueItem{
 			Bytes: maxDataRetentionSizeBytes,
 		},
+		PendingKafkaVersion:    kafkaRequest.PendingKafkaVersion,
+		PendingStrimziVersion:  kafkaRequest.PendingStrimziVersion,
+		PendingKafkaIbpVersion: kafkaRequest.PendingKafkaIBPVersion,
+		MaintenanceWindow:      PresentMaintenanceWindow(kafkaRequest.MaintenanceWindow),
+		DeletionProtection:     kafkaRequest.DeletionProtection,
 	}, nil
 }
 
@@ -0,0 +1,41 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+)
+
+func ConvertCapacityReservationRequest(request private.CapacityReservationRequest) *dbapi.CapacityReservation {
+	return &dbapi.CapacityReservation{
+		OrganisationId: request.OrganisationId,
+		CloudProvider:  request.CloudProvider,
+		Region:         request.Region,
+		InstanceType:   request.InstanceType,
+		StreamingUnits: int(request.StreamingUnits),
+		ExpiresAt:      request.ExpiresAt,
+	}
+}
+
+func PresentCapacityReservation(usage services.CapacityReservationUsage) private.CapacityReservation {
+	reservation := usage.Reservation
+	reference := PresentReference(reservation.ID, reservation)
+
+	return private.CapacityReservation{
+		Id:                     reference.Id,
+		Kind:                   reference.Kind,
+		Href:                   reference.Href,
+		OrganisationId:         reservation.OrganisationId,
+		CloudProvider:          reservation.CloudProvider,
+		Region:                 reservation.Region,
+		InstanceType:           reservation.InstanceType,
+		StreamingUnits:         int32(reservation.StreamingUnits),
+		ExpiresAt:              reservation.ExpiresAt,
+		ConsumedStreamingUnits: int32(usage.ConsumedStreamingUnits),
+		Expired:                reservation.IsExpired(time.Now()),
+		CreatedAt:              reservation.CreatedAt,
+		UpdatedAt:              reservation.UpdatedAt,
+	}
+}
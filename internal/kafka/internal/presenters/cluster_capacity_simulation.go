@@ -0,0 +1,60 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+)
+
+func ConvertClusterCapacitySimulationRequest(request private.ClusterCapacitySimulationRequest) services.ClusterCapacitySimulationRequest {
+	return services.ClusterCapacitySimulationRequest{
+		CloudProvider:  request.CloudProvider,
+		Region:         request.Region,
+		InstanceType:   request.InstanceType,
+		SizeId:         request.SizeId,
+		OrganisationId: request.OrganisationId,
+		Count:          int(request.Count),
+	}
+}
+
+func PresentClusterCapacitySimulation(simulation *services.ClusterCapacitySimulation) private.ClusterCapacitySimulation {
+	result := private.ClusterCapacitySimulation{
+		Kind:              "ClusterCapacitySimulation",
+		Placements:        []private.ClusterCapacitySimulationPlacement{},
+		Rejections:        []private.ClusterCapacitySimulationRejection{},
+		ScaleUps:          []private.ClusterCapacitySimulationScaleUp{},
+		ConsumptionBefore: presentClusterCapacityConsumption(simulation.ConsumptionBefore),
+		ConsumptionAfter:  presentClusterCapacityConsumption(simulation.ConsumptionAfter),
+	}
+
+	for _, placement := range simulation.Placements {
+		result.Placements = append(result.Placements, private.ClusterCapacitySimulationPlacement{
+			Kafka:     int32(placement.Kafka),
+			ClusterId: placement.ClusterID,
+		})
+	}
+	for _, rejection := range simulation.Rejections {
+		result.Rejections = append(result.Rejections, private.ClusterCapacitySimulationRejection{
+			Kafka:  int32(rejection.Kafka),
+			Reason: rejection.Reason,
+		})
+	}
+	for _, scaleUp := range simulation.ScaleUps {
+		result.ScaleUps = append(result.ScaleUps, private.ClusterCapacitySimulationScaleUp{
+			AfterKafka:    int32(scaleUp.AfterKafka),
+			CloudProvider: scaleUp.CloudProvider,
+			Region:        scaleUp.Region,
+			InstanceType:  scaleUp.InstanceType,
+		})
+	}
+
+	return result
+}
+
+func presentClusterCapacityConsumption(consumption services.ClusterCapacityConsumption) private.ClusterCapacityConsumption {
+	return private.ClusterCapacityConsumption{
+		MaxStreamingUnits:      int32(consumption.MaxStreamingUnits),
+		ConsumedStreamingUnits: int32(consumption.ConsumedStreamingUnits),
+		FreeStreamingUnits:     int32(consumption.FreeStreamingUnits),
+		OngoingScaleUp:         consumption.OngoingScaleUp,
+	}
+}
@@ -0,0 +1,55 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+)
+
+func ConvertClusterDrainRequest(clusterID string, request private.ClusterDrainRequest) *dbapi.ClusterDrain {
+	return &dbapi.ClusterDrain{
+		ClusterID:           clusterID,
+		BatchSize:           int(request.BatchSize),
+		StallTimeoutMinutes: int(request.StallTimeoutMinutes),
+		AllowDataLoss:       request.AllowDataLoss,
+	}
+}
+
+func PresentClusterDrain(drain *dbapi.ClusterDrain) private.ClusterDrain {
+	reference := PresentReference(drain.ID, drain)
+	counts := drain.CountKafkasByStatus()
+
+	kafkas := make([]private.ClusterDrainKafka, 0, len(drain.Kafkas))
+	for _, kafka := range drain.Kafkas {
+		kafkas = append(kafkas, private.ClusterDrainKafka{
+			KafkaId:         kafka.KafkaID,
+			Status:          kafka.Status.String(),
+			TargetClusterId: kafka.TargetClusterID,
+			FailedReason:    kafka.FailedReason,
+			StartedAt:       timeOrZero(kafka.StartedAt),
+			FinishedAt:      timeOrZero(kafka.FinishedAt),
+		})
+	}
+
+	return private.ClusterDrain{
+		Id:                  reference.Id,
+		Kind:                reference.Kind,
+		Href:                reference.Href,
+		ClusterId:           drain.ClusterID,
+		Status:              drain.Status.String(),
+		StatusReason:        drain.StatusReason,
+		BatchSize:           int32(drain.BatchSize),
+		StallTimeoutMinutes: int32(drain.StallTimeoutMinutes),
+		AllowDataLoss:       drain.AllowDataLoss,
+		CreatedAt:           drain.CreatedAt,
+		UpdatedAt:           drain.UpdatedAt,
+		Progress: private.ClusterDrainProgress{
+			Total:     int32(len(drain.Kafkas)),
+			Pending:   int32(counts[dbapi.ClusterDrainKafkaStatusPending]),
+			Migrating: int32(counts[dbapi.ClusterDrainKafkaStatusMigrating]),
+			Completed: int32(counts[dbapi.ClusterDrainKafkaStatusCompleted]),
+			Failed:    int32(counts[dbapi.ClusterDrainKafkaStatusFailed]),
+			Skipped:   int32(counts[dbapi.ClusterDrainKafkaStatusSkipped]),
+		},
+		Kafkas: kafkas,
+	}
+}
@@ -0,0 +1,32 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+)
+
+func PresentClusterPlacementDecision(decision *services.ClusterPlacementDecision) private.ClusterPlacementDecision {
+	result := private.ClusterPlacementDecision{
+		Kind:       "ClusterPlacementDecision",
+		Candidates: []private.ClusterPlacementCandidate{},
+	}
+	if decision.Cluster != nil {
+		result.ClusterId = decision.Cluster.ClusterID
+	}
+
+	for _, candidate := range decision.Candidates {
+		c := private.ClusterPlacementCandidate{
+			ClusterId: candidate.Cluster.ClusterID,
+			Eligible:  candidate.Eligible,
+			Score:     int32(candidate.Score),
+			Reasons:   candidate.Reasons,
+		}
+		if candidate.RemainingStreamingUnits != nil {
+			remaining := int32(*candidate.RemainingStreamingUnits)
+			c.RemainingStreamingUnits = &remaining
+		}
+		result.Candidates = append(result.Candidates, c)
+	}
+
+	return result
+}
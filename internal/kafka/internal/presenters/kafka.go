@@ -37,6 +37,14 @@ func ConvertKafkaRequest(kafkaRequestPayload public.KafkaRequestPayload, dbKafka
 		kafka.ReauthenticationEnabled = true // true by default
 	}
 
+	if kafkaRequestPayload.DeletionProtection != nil {
+		kafka.DeletionProtection = *kafkaRequestPayload.DeletionProtection
+	}
+
+	if len(kafkaRequestPayload.Labels) > 0 {
+		kafka.Labels = dbapi.NewKafkaLabels(kafkaRequestPayload.Labels)
+	}
+
 	return kafka
 }
 
@@ -111,6 +119,9 @@ func PresentKafkaRequest(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 		BillingCloudAccountId:                 kafkaRequest.BillingCloudAccountId,
 		Marketplace:                           kafkaRequest.Marketplace,
 		BillingModel:                          kafkaRequest.ActualKafkaBillingModel,
+		DeletionProtection:                    kafkaRequest.DeletionProtection,
+		DeletionRequestedAt:                   kafkaRequest.DeletionRequestedAt,
+		Labels:                                kafkaRequest.Labels.ToMap(),
 	}, nil
 }
 
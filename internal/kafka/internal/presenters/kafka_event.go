@@ -0,0 +1,36 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+)
+
+func PresentKafkaEvent(event *dbapi.KafkaEvent) public.KafkaEvent {
+	reference := PresentReference(event.ID, event)
+	return public.KafkaEvent{
+		Id:            reference.Id,
+		Kind:          reference.Kind,
+		EventType:     event.EventType.String(),
+		PreviousValue: event.PreviousValue,
+		Value:         event.Value,
+		Message:       event.Message,
+		CreatedAt:     event.CreatedAt,
+	}
+}
+
+func PresentKafkaEventAdminEndpoint(event *dbapi.KafkaEvent) private.KafkaEvent {
+	reference := PresentReference(event.ID, event)
+	return private.KafkaEvent{
+		Id:            reference.Id,
+		Kind:          reference.Kind,
+		KafkaId:       event.KafkaID,
+		EventType:     event.EventType.String(),
+		Field:         event.Field,
+		PreviousValue: event.PreviousValue,
+		Value:         event.Value,
+		ClusterId:     event.ClusterID,
+		Message:       event.Message,
+		CreatedAt:     event.CreatedAt,
+	}
+}
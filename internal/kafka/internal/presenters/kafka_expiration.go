@@ -0,0 +1,40 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+)
+
+// PresentKafkaExpiration presents when the Kafka instance expires. expiresAt is nil when the Kafka instance does not expire.
+func PresentKafkaExpiration(kafkaRequest *dbapi.KafkaRequest, expiresAt *time.Time, now time.Time) public.KafkaExpiration {
+	return public.KafkaExpiration{
+		Id:               kafkaRequest.ID,
+		Kind:             "KafkaExpiration",
+		ExpiresAt:        expiresAt,
+		RemainingSeconds: remainingSecondsBeforeExpiration(expiresAt, now),
+	}
+}
+
+func PresentKafkaExpirationAdminEndpoint(kafkaRequest *dbapi.KafkaRequest, expiresAt *time.Time, now time.Time) private.KafkaExpiration {
+	return private.KafkaExpiration{
+		Id:               kafkaRequest.ID,
+		ExpiresAt:        expiresAt,
+		RemainingSeconds: remainingSecondsBeforeExpiration(expiresAt, now),
+	}
+}
+
+// remainingSecondsBeforeExpiration returns 0 for Kafka instances that have expired but have not been deleted yet
+func remainingSecondsBeforeExpiration(expiresAt *time.Time, now time.Time) *int64 {
+	if expiresAt == nil {
+		return nil
+	}
+
+	remainingSeconds := int64(expiresAt.Sub(now).Seconds())
+	if remainingSeconds < 0 {
+		remainingSeconds = 0
+	}
+	return &remainingSeconds
+}
@@ -0,0 +1,48 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+)
+
+func ConvertMaintenanceWindow(maintenanceWindow private.MaintenanceWindow) dbapi.MaintenanceWindow {
+	return dbapi.MaintenanceWindow{
+		DayOfWeek:     maintenanceWindow.DayOfWeek,
+		StartHour:     int(maintenanceWindow.StartHour),
+		DurationHours: int(maintenanceWindow.DurationHours),
+	}
+}
+
+func PresentMaintenanceWindow(maintenanceWindow dbapi.MaintenanceWindow) private.MaintenanceWindow {
+	return private.MaintenanceWindow{
+		DayOfWeek:     maintenanceWindow.DayOfWeek,
+		StartHour:     int32(maintenanceWindow.StartHour),
+		DurationHours: int32(maintenanceWindow.DurationHours),
+	}
+}
+
+// PresentKafkaPendingUpgrade presents the pending upgrades of a Kafka instance. The upgrades are scheduled at the start of
+// the next occurrence of the given maintenance window, or right away if there is no maintenance window.
+func PresentKafkaPendingUpgrade(kafkaRequest *dbapi.KafkaRequest, maintenanceWindow *dbapi.MaintenanceWindow, now time.Time) private.KafkaPendingUpgrade {
+	pendingUpgrade := private.KafkaPendingUpgrade{
+		Id:                     kafkaRequest.ID,
+		OrganisationId:         kafkaRequest.OrganisationId,
+		Status:                 kafkaRequest.Status,
+		DesiredKafkaVersion:    kafkaRequest.DesiredKafkaVersion,
+		DesiredStrimziVersion:  kafkaRequest.DesiredStrimziVersion,
+		DesiredKafkaIbpVersion: kafkaRequest.DesiredKafkaIBPVersion,
+		PendingKafkaVersion:    kafkaRequest.PendingKafkaVersion,
+		PendingStrimziVersion:  kafkaRequest.PendingStrimziVersion,
+		PendingKafkaIbpVersion: kafkaRequest.PendingKafkaIBPVersion,
+		ScheduledAt:            now.UTC(),
+	}
+
+	if maintenanceWindow != nil {
+		pendingUpgrade.MaintenanceWindow = PresentMaintenanceWindow(*maintenanceWindow)
+		pendingUpgrade.ScheduledAt = maintenanceWindow.NextStart(now)
+	}
+
+	return pendingUpgrade
+}
@@ -21,6 +21,20 @@ const (
 	KindError = "Error"
 	// KindServiceAccount is a string identifier for the type api.ServiceAccount
 	KindServiceAccount = "ServiceAccount"
+	// KindUpgradeCampaign is a string identifier for the type dbapi.UpgradeCampaign
+	KindUpgradeCampaign = "UpgradeCampaign"
+	// KindCluster is a string identifier for the type api.Cluster
+	KindCluster = "Cluster"
+	// KindClusterDrain is a string identifier for the type dbapi.ClusterDrain
+	KindClusterDrain = "ClusterDrain"
+	// KindKafkaEvent is a string identifier for the type dbapi.KafkaEvent
+	KindKafkaEvent = "KafkaEvent"
+	// KindWebhookSubscription is a string identifier for the type dbapi.WebhookSubscription
+	KindWebhookSubscription = "WebhookSubscription"
+	// KindWebhookDelivery is a string identifier for the type dbapi.WebhookDelivery
+	KindWebhookDelivery = "WebhookDelivery"
+	// KindCapacityReservation is a string identifier for the type dbapi.CapacityReservation
+	KindCapacityReservation = "CapacityReservation"
 
 	BasePath = "/api/kafkas_mgmt/v1"
 )
@@ -41,19 +55,45 @@ func objectKind(i interface{}) string {
 		return KindError
 	case api.ServiceAccount, *api.ServiceAccount:
 		return KindServiceAccount
+	case dbapi.UpgradeCampaign, *dbapi.UpgradeCampaign:
+		return KindUpgradeCampaign
+	case api.Cluster, *api.Cluster:
+		return KindCluster
+	case dbapi.ClusterDrain, *dbapi.ClusterDrain:
+		return KindClusterDrain
+	case dbapi.KafkaEvent, *dbapi.KafkaEvent:
+		return KindKafkaEvent
+	case dbapi.WebhookSubscription, *dbapi.WebhookSubscription:
+		return KindWebhookSubscription
+	case dbapi.WebhookDelivery, *dbapi.WebhookDelivery:
+		return KindWebhookDelivery
+	case dbapi.CapacityReservation, *dbapi.CapacityReservation:
+		return KindCapacityReservation
 	default:
 		return ""
 	}
 }
 
 func objectPath(id string, obj interface{}) string {
-	switch obj.(type) {
+	switch o := obj.(type) {
 	case dbapi.KafkaRequest, *dbapi.KafkaRequest:
 		return fmt.Sprintf("%s/kafkas/%s", BasePath, id)
 	case errors.ServiceError, *errors.ServiceError:
 		return fmt.Sprintf("%s/errors/%s", BasePath, id)
 	case api.ServiceAccount, *api.ServiceAccount:
 		return fmt.Sprintf("%s/service_accounts/%s", BasePath, id)
+	case dbapi.UpgradeCampaign, *dbapi.UpgradeCampaign:
+		return fmt.Sprintf("%s/admin/upgrade_campaigns/%s", BasePath, id)
+	case api.Cluster, *api.Cluster:
+		return fmt.Sprintf("%s/admin/clusters/%s", BasePath, id)
+	case dbapi.ClusterDrain:
+		return fmt.Sprintf("%s/admin/clusters/%s/drain", BasePath, o.ClusterID)
+	case *dbapi.ClusterDrain:
+		return fmt.Sprintf("%s/admin/clusters/%s/drain", BasePath, o.ClusterID)
+	case dbapi.WebhookSubscription, *dbapi.WebhookSubscription:
+		return fmt.Sprintf("%s/webhooks/%s", BasePath, id)
+	case dbapi.CapacityReservation, *dbapi.CapacityReservation:
+		return fmt.Sprintf("%s/admin/capacity_reservations/%s", BasePath, id)
 	default:
 		return ""
 	}
@@ -0,0 +1,87 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+)
+
+func ConvertUpgradeCampaignRequest(request private.UpgradeCampaignRequest) *dbapi.UpgradeCampaign {
+	return &dbapi.UpgradeCampaign{
+		Name: request.Name,
+		Filter: dbapi.UpgradeCampaignFilter{
+			InstanceType:       request.Filter.InstanceType,
+			Region:             request.Filter.Region,
+			ClusterID:          request.Filter.ClusterId,
+			ActualKafkaVersion: request.Filter.ActualKafkaVersion,
+		},
+		KafkaVersion:             request.KafkaVersion,
+		StrimziVersion:           request.StrimziVersion,
+		KafkaIBPVersion:          request.KafkaIbpVersion,
+		BatchSize:                int(request.BatchSize),
+		MaxConcurrentUpgrades:    int(request.MaxConcurrentUpgrades),
+		StallTimeoutMinutes:      int(request.StallTimeoutMinutes),
+		IgnoreMaintenanceWindows: request.IgnoreMaintenanceWindows,
+	}
+}
+
+func PresentUpgradeCampaign(campaign *dbapi.UpgradeCampaign) private.UpgradeCampaign {
+	reference := PresentReference(campaign.ID, campaign)
+	counts := campaign.CountKafkasByStatus()
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+
+	kafkas := make([]private.UpgradeCampaignKafka, 0, len(campaign.Kafkas))
+	for _, kafka := range campaign.Kafkas {
+		kafkas = append(kafkas, private.UpgradeCampaignKafka{
+			KafkaId:      kafka.KafkaID,
+			Status:       kafka.Status.String(),
+			FailedReason: kafka.FailedReason,
+			StartedAt:    timeOrZero(kafka.StartedAt),
+			FinishedAt:   timeOrZero(kafka.FinishedAt),
+		})
+	}
+
+	return private.UpgradeCampaign{
+		Id:           reference.Id,
+		Kind:         reference.Kind,
+		Href:         reference.Href,
+		Name:         campaign.Name,
+		Status:       campaign.Status.String(),
+		StatusReason: campaign.StatusReason,
+		Filter: private.UpgradeCampaignFilter{
+			InstanceType:       campaign.Filter.InstanceType,
+			Region:             campaign.Filter.Region,
+			ClusterId:          campaign.Filter.ClusterID,
+			ActualKafkaVersion: campaign.Filter.ActualKafkaVersion,
+		},
+		KafkaVersion:             campaign.KafkaVersion,
+		StrimziVersion:           campaign.StrimziVersion,
+		KafkaIbpVersion:          campaign.KafkaIBPVersion,
+		BatchSize:                int32(campaign.BatchSize),
+		MaxConcurrentUpgrades:    int32(campaign.MaxConcurrentUpgrades),
+		StallTimeoutMinutes:      int32(campaign.StallTimeoutMinutes),
+		IgnoreMaintenanceWindows: campaign.IgnoreMaintenanceWindows,
+		CreatedAt:                campaign.CreatedAt,
+		UpdatedAt:                campaign.UpdatedAt,
+		Progress: private.UpgradeCampaignProgress{
+			Total:     int32(total),
+			Pending:   int32(counts[dbapi.UpgradeCampaignKafkaStatusPending]),
+			Upgrading: int32(counts[dbapi.UpgradeCampaignKafkaStatusUpgrading]),
+			Completed: int32(counts[dbapi.UpgradeCampaignKafkaStatusCompleted]),
+			Failed:    int32(counts[dbapi.UpgradeCampaignKafkaStatusFailed]),
+			Skipped:   int32(counts[dbapi.UpgradeCampaignKafkaStatusSkipped]),
+		},
+		Kafkas: kafkas,
+	}
+}
+
+func timeOrZero(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
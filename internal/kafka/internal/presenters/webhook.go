@@ -0,0 +1,83 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+)
+
+func ConvertWebhookSubscriptionRequest(request public.WebhookSubscriptionRequest) *dbapi.WebhookSubscription {
+	subscription := &dbapi.WebhookSubscription{
+		Url:     request.Url,
+		Enabled: true,
+	}
+	if request.Enabled != nil {
+		subscription.Enabled = *request.Enabled
+	}
+	subscription.SetEventTypes(convertWebhookEventTypes(request.EventTypes))
+	return subscription
+}
+
+// ConvertWebhookSubscriptionUpdateRequest applies the fields set in the update request to the webhook subscription
+func ConvertWebhookSubscriptionUpdateRequest(request public.WebhookSubscriptionUpdateRequest, subscription *dbapi.WebhookSubscription) {
+	if request.Url != nil {
+		subscription.Url = *request.Url
+	}
+	if request.EventTypes != nil {
+		subscription.SetEventTypes(convertWebhookEventTypes(*request.EventTypes))
+	}
+	if request.Enabled != nil {
+		subscription.Enabled = *request.Enabled
+	}
+}
+
+// PresentWebhookSubscription presents the webhook subscription. The secret of the subscription is only presented when
+// withSecret is true i.e. when the subscription has just been created.
+func PresentWebhookSubscription(subscription *dbapi.WebhookSubscription, withSecret bool) public.WebhookSubscription {
+	reference := PresentReference(subscription.ID, subscription)
+
+	eventTypes := []string{}
+	for _, eventType := range subscription.GetEventTypes() {
+		eventTypes = append(eventTypes, eventType.String())
+	}
+
+	result := public.WebhookSubscription{
+		Id:         reference.Id,
+		Kind:       reference.Kind,
+		Href:       reference.Href,
+		Url:        subscription.Url,
+		EventTypes: eventTypes,
+		Enabled:    subscription.Enabled,
+		Owner:      subscription.Owner,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}
+	if withSecret {
+		result.Secret = subscription.Secret
+	}
+	return result
+}
+
+func PresentWebhookDelivery(delivery *dbapi.WebhookDelivery) public.WebhookDelivery {
+	reference := PresentReference(delivery.ID, delivery)
+	return public.WebhookDelivery{
+		Id:                 reference.Id,
+		Kind:               reference.Kind,
+		KafkaId:            delivery.KafkaID,
+		EventType:          delivery.EventType.String(),
+		Status:             delivery.Status.String(),
+		Attempts:           int32(delivery.Attempts),
+		NextAttemptAt:      delivery.NextAttemptAt,
+		LastAttemptAt:      delivery.LastAttemptAt,
+		ResponseStatusCode: int32(delivery.ResponseStatusCode),
+		LastError:          delivery.LastError,
+		CreatedAt:          delivery.CreatedAt,
+	}
+}
+
+func convertWebhookEventTypes(eventTypes []string) []dbapi.WebhookEventType {
+	result := make([]dbapi.WebhookEventType, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		result = append(result, dbapi.WebhookEventType(eventType))
+	}
+	return result
+}
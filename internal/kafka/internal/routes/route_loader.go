@@ -54,6 +54,14 @@ type options struct {
 	ClusterPlacementStrategy    services.ClusterPlacementStrategy
 	ClusterService              services.ClusterService
 	SupportedKafkaInstanceTypes services.SupportedKafkaInstanceTypesService
+	MaintenanceWindowService    services.MaintenanceWindowService
+	UpgradeCampaignService      services.UpgradeCampaignService
+	ClusterDrainService         services.ClusterDrainService
+	ClusterResourcesReconciler  services.ClusterResourcesReconciler
+	ClusterCapacitySimulator    services.ClusterCapacitySimulator
+	KafkaEventService           services.KafkaEventService
+	WebhookService              services.WebhookService
+	CapacityReservationService  services.CapacityReservationService
 
 	AccessControlListMiddleware                       *acl.AccessControlListMiddleware
 	AccessControlListConfig                           *acl.AccessControlListConfig
@@ -129,6 +137,23 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	apiV1KafkasRouter.HandleFunc("/{id}", kafkaHandler.Update).
 		Name(logger.NewLogEvent("update-kafka", "update a kafka instance").ToString()).
 		Methods(http.MethodPatch)
+	apiV1KafkasRouter.HandleFunc("/{id}/suspend", kafkaHandler.Suspend).
+		Name(logger.NewLogEvent("suspend-kafka", "suspend a kafka instance").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkasRouter.HandleFunc("/{id}/resume", kafkaHandler.Resume).
+		Name(logger.NewLogEvent("resume-kafka", "resume a suspended kafka instance").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkasRouter.HandleFunc("/{id}/restore", kafkaHandler.Restore).
+		Name(logger.NewLogEvent("restore-kafka", "restore a kafka instance pending deletion").ToString()).
+		Methods(http.MethodPost)
+	kafkaEventHandler := handlers.NewKafkaEventHandler(s.Kafka, s.KafkaEventService)
+	apiV1KafkasRouter.HandleFunc("/{id}/events", kafkaEventHandler.List).
+		Name(logger.NewLogEvent("list-kafka-events", "list the events of a kafka instance").ToString()).
+		Methods(http.MethodGet)
+	kafkaExpirationHandler := handlers.NewKafkaExpirationHandler(s.Kafka)
+	apiV1KafkasRouter.HandleFunc("/{id}/expiration", kafkaExpirationHandler.Get).
+		Name(logger.NewLogEvent("get-kafka-expiration", "get the expiration of a kafka instance").ToString()).
+		Methods(http.MethodGet)
 	apiV1KafkasRouter.HandleFunc("", kafkaHandler.List).
 		Name(logger.NewLogEvent("list-kafka", "list all kafkas").ToString()).
 		Methods(http.MethodGet)
@@ -193,6 +218,36 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	apiV1ServiceAccountsRouter.Use(requireOrgID)
 	apiV1ServiceAccountsRouter.Use(authorizeMiddleware)
 
+	//  /webhooks
+	v1Collections = append(v1Collections, api.CollectionMetadata{
+		ID:   "webhooks",
+		Kind: "WebhookSubscriptionList",
+	})
+	webhookHandler := handlers.NewWebhookHandler(s.WebhookService)
+	apiV1WebhooksRouter := apiV1Router.PathPrefix("/webhooks").Subrouter()
+	apiV1WebhooksRouter.HandleFunc("", webhookHandler.List).
+		Name(logger.NewLogEvent("list-webhooks", "list webhook subscriptions").ToString()).
+		Methods(http.MethodGet)
+	apiV1WebhooksRouter.HandleFunc("", webhookHandler.Create).
+		Name(logger.NewLogEvent("create-webhook", "create a webhook subscription").ToString()).
+		Methods(http.MethodPost)
+	apiV1WebhooksRouter.HandleFunc("/{id}", webhookHandler.Get).
+		Name(logger.NewLogEvent("get-webhook", "get a webhook subscription").ToString()).
+		Methods(http.MethodGet)
+	apiV1WebhooksRouter.HandleFunc("/{id}", webhookHandler.Update).
+		Name(logger.NewLogEvent("update-webhook", "update a webhook subscription").ToString()).
+		Methods(http.MethodPatch)
+	apiV1WebhooksRouter.HandleFunc("/{id}", webhookHandler.Delete).
+		Name(logger.NewLogEvent("delete-webhook", "delete a webhook subscription").ToString()).
+		Methods(http.MethodDelete)
+	apiV1WebhooksRouter.HandleFunc("/{id}/deliveries", webhookHandler.ListDeliveries).
+		Name(logger.NewLogEvent("list-webhook-deliveries", "list the deliveries of a webhook subscription").ToString()).
+		Methods(http.MethodGet)
+
+	apiV1WebhooksRouter.Use(requireIssuer)
+	apiV1WebhooksRouter.Use(requireOrgID)
+	apiV1WebhooksRouter.Use(authorizeMiddleware)
+
 	//  /cloud_providers
 	v1Collections = append(v1Collections, api.CollectionMetadata{
 		ID:   "cloud_providers",
@@ -251,7 +306,7 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	// deliberately returns 404 here if the request doesn't have the required role, so that it will appear as if the endpoint doesn't exist
 	auth.UseOperatorAuthorisationMiddleware(apiV1DataPlaneRequestsRouter, s.Keycloak.GetRealmConfig().ValidIssuerURI, "id", s.ClusterService)
 
-	adminKafkaHandler := handlers.NewAdminKafkaHandler(s.Kafka, s.AccountService, s.ProviderConfig, s.ClusterService)
+	adminKafkaHandler := handlers.NewAdminKafkaHandler(s.Kafka, s.AccountService, s.ProviderConfig, s.ClusterService, s.MaintenanceWindowService)
 	adminRouter := apiV1Router.PathPrefix("/admin").Subrouter()
 	adminRouter.Use(auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.Keycloak.GetConfig().AdminAPISSORealm.ValidIssuerURI}, errors.ErrorNotFound))
 	adminRouter.Use(auth.NewRolesAuthzMiddleware(s.AdminRoleAuthZConfig).RequireRolesForMethods(errors.ErrorNotFound))
@@ -269,6 +324,111 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 		Name(logger.NewLogEvent("admin-update-kafka", "[admin] update kafka by id").ToString()).
 		Methods(http.MethodPatch)
 
+	adminKafkaEventHandler := handlers.NewAdminKafkaEventHandler(s.Kafka, s.KafkaEventService)
+	adminRouter.HandleFunc("/kafkas/{id}/events", adminKafkaEventHandler.List).
+		Name(logger.NewLogEvent("admin-list-kafka-events", "[admin] list events of kafka by id").ToString()).
+		Methods(http.MethodGet)
+
+	adminKafkaExpirationHandler := handlers.NewAdminKafkaExpirationHandler(s.Kafka)
+	adminRouter.HandleFunc("/kafkas/{id}/expiration", adminKafkaExpirationHandler.Get).
+		Name(logger.NewLogEvent("admin-get-kafka-expiration", "[admin] get expiration of kafka by id").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/kafkas/{id}/expiration", adminKafkaExpirationHandler.Update).
+		Name(logger.NewLogEvent("admin-update-kafka-expiration", "[admin] extend expiration of kafka by id").ToString()).
+		Methods(http.MethodPut)
+
+	adminMaintenanceWindowHandler := handlers.NewAdminMaintenanceWindowHandler(s.Kafka, s.MaintenanceWindowService)
+	adminRouter.HandleFunc("/kafkas/{id}/maintenance_window", adminMaintenanceWindowHandler.GetKafkaMaintenanceWindow).
+		Name(logger.NewLogEvent("admin-get-kafka-maintenance-window", "[admin] get maintenance window of kafka by id").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/kafkas/{id}/maintenance_window", adminMaintenanceWindowHandler.UpdateKafkaMaintenanceWindow).
+		Name(logger.NewLogEvent("admin-update-kafka-maintenance-window", "[admin] update maintenance window of kafka by id").ToString()).
+		Methods(http.MethodPut)
+	adminRouter.HandleFunc("/kafkas/{id}/maintenance_window", adminMaintenanceWindowHandler.DeleteKafkaMaintenanceWindow).
+		Name(logger.NewLogEvent("admin-delete-kafka-maintenance-window", "[admin] delete maintenance window of kafka by id").ToString()).
+		Methods(http.MethodDelete)
+	adminRouter.HandleFunc("/organisations/{id}/maintenance_window", adminMaintenanceWindowHandler.GetOrganisationMaintenanceWindow).
+		Name(logger.NewLogEvent("admin-get-organisation-maintenance-window", "[admin] get maintenance window of organisation by id").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/organisations/{id}/maintenance_window", adminMaintenanceWindowHandler.UpdateOrganisationMaintenanceWindow).
+		Name(logger.NewLogEvent("admin-update-organisation-maintenance-window", "[admin] update maintenance window of organisation by id").ToString()).
+		Methods(http.MethodPut)
+	adminRouter.HandleFunc("/organisations/{id}/maintenance_window", adminMaintenanceWindowHandler.DeleteOrganisationMaintenanceWindow).
+		Name(logger.NewLogEvent("admin-delete-organisation-maintenance-window", "[admin] delete maintenance window of organisation by id").ToString()).
+		Methods(http.MethodDelete)
+	adminRouter.HandleFunc("/pending_upgrades", adminMaintenanceWindowHandler.ListPendingUpgrades).
+		Name(logger.NewLogEvent("admin-list-pending-upgrades", "[admin] list kafkas with pending upgrades").ToString()).
+		Methods(http.MethodGet)
+
+	adminUpgradeCampaignHandler := handlers.NewAdminUpgradeCampaignHandler(s.UpgradeCampaignService)
+	adminRouter.HandleFunc("/upgrade_campaigns", adminUpgradeCampaignHandler.List).
+		Name(logger.NewLogEvent("admin-list-upgrade-campaigns", "[admin] list upgrade campaigns").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/upgrade_campaigns", adminUpgradeCampaignHandler.Create).
+		Name(logger.NewLogEvent("admin-create-upgrade-campaign", "[admin] create upgrade campaign").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/upgrade_campaigns/{id}", adminUpgradeCampaignHandler.Get).
+		Name(logger.NewLogEvent("admin-get-upgrade-campaign", "[admin] get upgrade campaign by id").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/upgrade_campaigns/{id}", adminUpgradeCampaignHandler.Update).
+		Name(logger.NewLogEvent("admin-update-upgrade-campaign", "[admin] update upgrade campaign by id").ToString()).
+		Methods(http.MethodPatch)
+
+	adminCapacityReservationHandler := handlers.NewAdminCapacityReservationHandler(s.CapacityReservationService)
+	adminRouter.HandleFunc("/capacity_reservations", adminCapacityReservationHandler.List).
+		Name(logger.NewLogEvent("admin-list-capacity-reservations", "[admin] list capacity reservations").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/capacity_reservations", adminCapacityReservationHandler.Create).
+		Name(logger.NewLogEvent("admin-create-capacity-reservation", "[admin] create capacity reservation").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/capacity_reservations/{id}", adminCapacityReservationHandler.Get).
+		Name(logger.NewLogEvent("admin-get-capacity-reservation", "[admin] get capacity reservation by id").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/capacity_reservations/{id}", adminCapacityReservationHandler.Delete).
+		Name(logger.NewLogEvent("admin-delete-capacity-reservation", "[admin] delete capacity reservation by id").ToString()).
+		Methods(http.MethodDelete)
+
+	adminClusterHandler := handlers.NewAdminClusterHandler(s.ClusterService, s.ClusterDrainService, s.ClusterResourcesReconciler)
+	adminRouter.HandleFunc("/clusters", adminClusterHandler.List).
+		Name(logger.NewLogEvent("admin-list-clusters", "[admin] list data plane clusters").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/clusters/{id}", adminClusterHandler.Get).
+		Name(logger.NewLogEvent("admin-get-cluster", "[admin] get data plane cluster by id").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/clusters/{id}/cordon", adminClusterHandler.Cordon).
+		Name(logger.NewLogEvent("admin-cordon-cluster", "[admin] cordon data plane cluster by id").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/clusters/{id}/uncordon", adminClusterHandler.Uncordon).
+		Name(logger.NewLogEvent("admin-uncordon-cluster", "[admin] uncordon data plane cluster by id").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/clusters/{id}/resources", adminClusterHandler.ApplyResources).
+		Name(logger.NewLogEvent("admin-apply-cluster-resources", "[admin] apply resources of data plane cluster by id").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/clusters/{id}/status", adminClusterHandler.UpdateStatus).
+		Name(logger.NewLogEvent("admin-update-cluster-status", "[admin] update status of data plane cluster by id").ToString()).
+		Methods(http.MethodPut)
+	adminRouter.HandleFunc("/clusters/{id}/placement_constraints", adminClusterHandler.UpdatePlacementConstraints).
+		Name(logger.NewLogEvent("admin-update-cluster-placement-constraints", "[admin] update placement constraints of data plane cluster by id").ToString()).
+		Methods(http.MethodPut)
+
+	adminClusterPlacementHandler := handlers.NewAdminClusterPlacementHandler(s.ClusterPlacementStrategy, s.KafkaConfig)
+	adminRouter.HandleFunc("/clusters/placement", adminClusterPlacementHandler.Explain).
+		Name(logger.NewLogEvent("admin-explain-cluster-placement", "[admin] explain placement of kafka on data plane clusters").ToString()).
+		Methods(http.MethodPost)
+
+	adminClusterCapacitySimulationHandler := handlers.NewAdminClusterCapacitySimulationHandler(s.ClusterCapacitySimulator, s.KafkaConfig)
+	adminRouter.HandleFunc("/clusters/capacity_simulation", adminClusterCapacitySimulationHandler.Simulate).
+		Name(logger.NewLogEvent("admin-simulate-cluster-capacity", "[admin] simulate placement of kafkas and scale up of data plane clusters").ToString()).
+		Methods(http.MethodPost)
+
+	adminClusterDrainHandler := handlers.NewAdminClusterDrainHandler(s.ClusterDrainService)
+	adminRouter.HandleFunc("/clusters/{id}/drain", adminClusterDrainHandler.Get).
+		Name(logger.NewLogEvent("admin-get-cluster-drain", "[admin] get drain of cluster by id").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/clusters/{id}/drain", adminClusterDrainHandler.Create).
+		Name(logger.NewLogEvent("admin-drain-cluster", "[admin] drain cluster by id").ToString()).
+		Methods(http.MethodPost)
+
 	clusterHandler := handlers.NewClusterHandler(s.KasFleetshardOperatorAddon, s.ClusterService)
 	clusterRouter := apiV1Router.PathPrefix("/clusters").Subrouter()
 	clusterRouter.Use(enterpriseClusterMiddleware)
@@ -276,6 +436,18 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	clusterRouter.HandleFunc("", clusterHandler.RegisterEnterpriseCluster).
 		Name(logger.NewLogEvent("register-enterprise-cluster", "register enterprise cluster").ToString()).
 		Methods(http.MethodPost)
+	clusterRouter.HandleFunc("", clusterHandler.List).
+		Name(logger.NewLogEvent("list-enterprise-clusters", "list enterprise clusters").ToString()).
+		Methods(http.MethodGet)
+	clusterRouter.HandleFunc("/{id}", clusterHandler.Get).
+		Name(logger.NewLogEvent("get-enterprise-cluster", "get enterprise cluster").ToString()).
+		Methods(http.MethodGet)
+	clusterRouter.HandleFunc("/{id}", clusterHandler.Delete).
+		Name(logger.NewLogEvent("delete-enterprise-cluster", "deregister enterprise cluster").ToString()).
+		Methods(http.MethodDelete)
+	clusterRouter.HandleFunc("/{id}/addon_parameters", clusterHandler.GetAddonParameters).
+		Name(logger.NewLogEvent("get-enterprise-cluster-addon-parameters", "get enterprise cluster addon parameters").ToString()).
+		Methods(http.MethodGet)
 
 	return nil
 }
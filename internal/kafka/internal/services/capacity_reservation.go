@@ -0,0 +1,311 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+//go:generate moq -out capacity_reservation_service_moq.go . CapacityReservationService
+type CapacityReservationService interface {
+	// Create creates the given capacity reservation. An error is returned if the capacity left in the region for the
+	// instance type cannot accommodate the reservation. The reservations of a region and instance type are created one
+	// at a time so that concurrent reservations cannot exceed the capacity of the region.
+	Create(reservation *dbapi.CapacityReservation) *apiErrors.ServiceError
+	// Get returns the capacity reservation with the given id
+	Get(id string) (*dbapi.CapacityReservation, *apiErrors.ServiceError)
+	// List returns the capacity reservations, most recent first
+	List(listArgs *coreServices.ListArguments) (dbapi.CapacityReservationList, *api.PagingMeta, *apiErrors.ServiceError)
+	// Delete deletes the capacity reservation with the given id, releasing the streaming units it reserves
+	Delete(id string) *apiErrors.ServiceError
+	// GetUsages returns the streaming units consumed by the kafkas of the organisation of each of the given reservations
+	GetUsages(reservations dbapi.CapacityReservationList) (CapacityReservationUsageList, *apiErrors.ServiceError)
+	// ListActiveUsages returns the usages of all the reservations that have not expired yet
+	ListActiveUsages() (CapacityReservationUsageList, *apiErrors.ServiceError)
+}
+
+// CapacityReservationUsage is a capacity reservation along with the streaming units consumed by the kafkas of its
+// organisation in its region for its instance type
+type CapacityReservationUsage struct {
+	Reservation *dbapi.CapacityReservation
+	// ConsumedStreamingUnits are the streaming units consumed by all the kafkas of the organisation in the region for
+	// the instance type of the reservation. They are shared by all the reservations of the organisation for that region
+	// and instance type
+	ConsumedStreamingUnits int
+}
+
+type CapacityReservationUsageList []CapacityReservationUsage
+
+// UnusedStreamingUnits returns the streaming units reserved in the given region for the given instance type that are
+// not consumed by the kafkas of the organisations holding the reservations. The reservations of the given organisation
+// are considered to be consumed by additionalStreamingUnits more streaming units, which allows checking whether a new
+// kafka of that organisation fits in its reservations. An empty organisationID matches no organisation.
+func (l CapacityReservationUsageList) UnusedStreamingUnits(cloudProvider, region, instanceType, organisationID string, additionalStreamingUnits int) int {
+	reserved := map[string]int{}
+	consumed := map[string]int{}
+	for _, usage := range l {
+		reservation := usage.Reservation
+		if reservation.CloudProvider != cloudProvider || reservation.Region != region || reservation.InstanceType != instanceType {
+			continue
+		}
+		reserved[reservation.OrganisationId] += reservation.StreamingUnits
+		consumed[reservation.OrganisationId] = usage.ConsumedStreamingUnits
+	}
+
+	unused := 0
+	for organisation, streamingUnits := range reserved {
+		used := consumed[organisation]
+		if organisationID != "" && organisation == organisationID {
+			used += additionalStreamingUnits
+		}
+		if streamingUnits > used {
+			unused += streamingUnits - used
+		}
+	}
+
+	return unused
+}
+
+var _ CapacityReservationService = &capacityReservationService{}
+
+type capacityReservationService struct {
+	connectionFactory *db.ConnectionFactory
+	kafkaConfig       *config.KafkaConfig
+	providerConfig    *config.ProviderConfig
+}
+
+func NewCapacityReservationService(connectionFactory *db.ConnectionFactory, kafkaConfig *config.KafkaConfig, providerConfig *config.ProviderConfig) CapacityReservationService {
+	return &capacityReservationService{
+		connectionFactory: connectionFactory,
+		kafkaConfig:       kafkaConfig,
+		providerConfig:    providerConfig,
+	}
+}
+
+func (c *capacityReservationService) Create(reservation *dbapi.CapacityReservation) *apiErrors.ServiceError {
+	if reservation.OrganisationId == "" {
+		return apiErrors.Validation("organisation_id is required")
+	}
+	if reservation.StreamingUnits <= 0 {
+		return apiErrors.Validation("streaming_units must be greater than 0")
+	}
+	if reservation.IsExpired(time.Now()) {
+		return apiErrors.Validation("expires_at must be in the future")
+	}
+
+	limit, serviceErr := c.providerConfig.GetInstanceLimit(reservation.Region, reservation.CloudProvider, reservation.InstanceType)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	err := c.connectionFactory.New().Transaction(func(dbConn *gorm.DB) error {
+		// the lock is held until the end of the transaction so that the capacity checked is still left when the
+		// reservation is inserted
+		lockKey := fmt.Sprintf("capacity_reservations/%s/%s/%s", reservation.CloudProvider, reservation.Region, reservation.InstanceType)
+		rows, err := dbConn.Raw("SELECT pg_advisory_xact_lock(hashtext(?))", lockKey).Rows()
+		if err != nil {
+			serviceErr = apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to lock capacity reservations in region %q for instance type %q", reservation.Region, reservation.InstanceType)
+			return err
+		}
+		rows.Close()
+
+		if limit != nil {
+			if serviceErr = c.checkRegionCapacity(dbConn, reservation, *limit); serviceErr != nil {
+				return serviceErr
+			}
+		}
+
+		if err := dbConn.Create(reservation).Error; err != nil {
+			serviceErr = apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to create capacity reservation")
+			return err
+		}
+		return nil
+	})
+	if serviceErr != nil {
+		return serviceErr
+	}
+	if err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to create capacity reservation")
+	}
+
+	return nil
+}
+
+// checkRegionCapacity returns an error if the capacity left in the region for the instance type of the given reservation
+// cannot accommodate it along with the kafkas and the unused streaming units of the active reservations
+func (c *capacityReservationService) checkRegionCapacity(dbConn *gorm.DB, reservation *dbapi.CapacityReservation, limit int) *apiErrors.ServiceError {
+	active, serviceErr := c.listActive(dbConn)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	usages, serviceErr := c.getUsages(dbConn, append(active, reservation))
+	if serviceErr != nil {
+		return serviceErr
+	}
+	consumed, serviceErr := c.findConsumedStreamingUnitsByOrganisation(dbConn, reservation.CloudProvider, reservation.Region, reservation.InstanceType)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	total := usages.UnusedStreamingUnits(reservation.CloudProvider, reservation.Region, reservation.InstanceType, "", 0)
+	for _, streamingUnits := range consumed {
+		total += streamingUnits
+	}
+	if total > limit {
+		return apiErrors.Conflict("not enough capacity left in region %q for instance type %q to reserve %d streaming units", reservation.Region, reservation.InstanceType, reservation.StreamingUnits)
+	}
+
+	return nil
+}
+
+func (c *capacityReservationService) Get(id string) (*dbapi.CapacityReservation, *apiErrors.ServiceError) {
+	if id == "" {
+		return nil, apiErrors.Validation("id is undefined")
+	}
+
+	var reservation dbapi.CapacityReservation
+	if err := c.connectionFactory.New().Where("id = ?", id).First(&reservation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apiErrors.NotFound("capacity reservation with id='%v' not found", id)
+		}
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get capacity reservation %q", id)
+	}
+
+	return &reservation, nil
+}
+
+func (c *capacityReservationService) List(listArgs *coreServices.ListArguments) (dbapi.CapacityReservationList, *api.PagingMeta, *apiErrors.ServiceError) {
+	var reservations dbapi.CapacityReservationList
+	dbConn := c.connectionFactory.New()
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Model(&reservations).Count(&total).Error; err != nil {
+		return reservations, pagingMeta, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "unable to count capacity reservations")
+	}
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	dbConn = dbConn.Order("created_at desc").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size)
+
+	if err := dbConn.Find(&reservations).Error; err != nil {
+		return reservations, pagingMeta, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "unable to list capacity reservations")
+	}
+
+	return reservations, pagingMeta, nil
+}
+
+func (c *capacityReservationService) Delete(id string) *apiErrors.ServiceError {
+	if id == "" {
+		return apiErrors.Validation("id is undefined")
+	}
+
+	result := c.connectionFactory.New().Where("id = ?", id).Delete(&dbapi.CapacityReservation{})
+	if err := result.Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to delete capacity reservation %q", id)
+	}
+	if result.RowsAffected == 0 {
+		return apiErrors.NotFound("capacity reservation with id='%v' not found", id)
+	}
+
+	return nil
+}
+
+func (c *capacityReservationService) GetUsages(reservations dbapi.CapacityReservationList) (CapacityReservationUsageList, *apiErrors.ServiceError) {
+	return c.getUsages(c.connectionFactory.New(), reservations)
+}
+
+func (c *capacityReservationService) getUsages(dbConn *gorm.DB, reservations dbapi.CapacityReservationList) (CapacityReservationUsageList, *apiErrors.ServiceError) {
+	type locator struct {
+		cloudProvider, region, instanceType string
+	}
+	consumedByLocator := map[locator]map[string]int{}
+
+	usages := make(CapacityReservationUsageList, 0, len(reservations))
+	for _, reservation := range reservations {
+		l := locator{cloudProvider: reservation.CloudProvider, region: reservation.Region, instanceType: reservation.InstanceType}
+		consumed, ok := consumedByLocator[l]
+		if !ok {
+			var serviceErr *apiErrors.ServiceError
+			consumed, serviceErr = c.findConsumedStreamingUnitsByOrganisation(dbConn, l.cloudProvider, l.region, l.instanceType)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			consumedByLocator[l] = consumed
+		}
+
+		usages = append(usages, CapacityReservationUsage{
+			Reservation:            reservation,
+			ConsumedStreamingUnits: consumed[reservation.OrganisationId],
+		})
+	}
+
+	return usages, nil
+}
+
+func (c *capacityReservationService) ListActiveUsages() (CapacityReservationUsageList, *apiErrors.ServiceError) {
+	dbConn := c.connectionFactory.New()
+	reservations, serviceErr := c.listActive(dbConn)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	return c.getUsages(dbConn, reservations)
+}
+
+func (c *capacityReservationService) listActive(dbConn *gorm.DB) (dbapi.CapacityReservationList, *apiErrors.ServiceError) {
+	var reservations dbapi.CapacityReservationList
+	if err := dbConn.
+		Where("expires_at > ?", time.Now()).
+		Find(&reservations).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list active capacity reservations")
+	}
+	return reservations, nil
+}
+
+// findConsumedStreamingUnitsByOrganisation returns the streaming units consumed by the kafkas of each organisation in
+// the given region for the given instance type. Kafkas are counted the same way the region capacity limits are checked
+func (c *capacityReservationService) findConsumedStreamingUnitsByOrganisation(dbConn *gorm.DB, cloudProvider, region, instanceType string) (map[string]int, *apiErrors.ServiceError) {
+	type kafkaCount struct {
+		OrganisationId string
+		SizeId         string
+		Count          int
+	}
+	var counts []kafkaCount
+
+	if err := dbConn.
+		Model(&dbapi.KafkaRequest{}).
+		Select("organisation_id, size_id, count(1) as count").
+		Where("cloud_provider = ?", cloudProvider).
+		Where("region = ?", region).
+		Where("instance_type = ?", instanceType).
+		Group("organisation_id, size_id").
+		Scan(&counts).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to count streaming units consumed in region %q for instance type %q", region, instanceType)
+	}
+
+	consumed := map[string]int{}
+	for _, count := range counts {
+		size, err := c.kafkaConfig.GetKafkaInstanceSize(instanceType, count.SizeId)
+		if err != nil {
+			return nil, apiErrors.NewWithCause(apiErrors.ErrorInstancePlanNotSupported, err, "failed to count streaming units consumed in region %q for instance type %q", region, instanceType)
+		}
+		consumed[count.OrganisationId] += count.Count * size.CapacityConsumed
+	}
+
+	return consumed, nil
+}
@@ -0,0 +1,279 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_CapacityReservationUsageList_UnusedStreamingUnits(t *testing.T) {
+	usage := func(organisationID, region string, streamingUnits, consumed int) CapacityReservationUsage {
+		return CapacityReservationUsage{
+			Reservation: &dbapi.CapacityReservation{
+				OrganisationId: organisationID,
+				CloudProvider:  "aws",
+				Region:         region,
+				InstanceType:   types.STANDARD.String(),
+				StreamingUnits: streamingUnits,
+			},
+			ConsumedStreamingUnits: consumed,
+		}
+	}
+
+	tests := []struct {
+		name                     string
+		usages                   CapacityReservationUsageList
+		organisationID           string
+		additionalStreamingUnits int
+		want                     int
+	}{
+		{
+			name: "should return 0 when there are no reservations",
+			want: 0,
+		},
+		{
+			name:   "should subtract the streaming units consumed by the organisation from its reservations",
+			usages: CapacityReservationUsageList{usage("org-1", "us-east-1", 5, 2), usage("org-1", "us-east-1", 3, 2), usage("org-2", "us-east-1", 4, 1)},
+			want:   9,
+		},
+		{
+			name:   "should not count the reservations already exceeded by the organisation",
+			usages: CapacityReservationUsageList{usage("org-1", "us-east-1", 2, 5), usage("org-2", "us-east-1", 4, 0)},
+			want:   4,
+		},
+		{
+			name:   "should ignore the reservations in other regions",
+			usages: CapacityReservationUsageList{usage("org-1", "eu-west-1", 5, 0), usage("org-2", "us-east-1", 4, 0)},
+			want:   4,
+		},
+		{
+			name:                     "should consume the reservations of the given organisation with the additional streaming units",
+			usages:                   CapacityReservationUsageList{usage("org-1", "us-east-1", 5, 2), usage("org-2", "us-east-1", 4, 0)},
+			organisationID:           "org-1",
+			additionalStreamingUnits: 2,
+			want:                     5,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(tt.usages.UnusedStreamingUnits("aws", "us-east-1", types.STANDARD.String(), tt.organisationID, tt.additionalStreamingUnits)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_capacityReservationService_Create(t *testing.T) {
+	buildReservation := func(modifyFn func(reservation *dbapi.CapacityReservation)) *dbapi.CapacityReservation {
+		reservation := &dbapi.CapacityReservation{
+			OrganisationId: "org-1",
+			CloudProvider:  "aws",
+			Region:         testKafkaRequestRegion,
+			InstanceType:   types.STANDARD.String(),
+			StreamingUnits: 1,
+			ExpiresAt:      time.Now().Add(time.Hour),
+		}
+		if modifyFn != nil {
+			modifyFn(reservation)
+		}
+		return reservation
+	}
+	mockRegionUsage := func() {
+		mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "capacity_reservations" WHERE expires_at >`).
+			WithReply([]map[string]interface{}{{
+				"id":              "reservation-1",
+				"organisation_id": "org-2",
+				"cloud_provider":  "aws",
+				"region":          testKafkaRequestRegion,
+				"instance_type":   types.STANDARD.String(),
+				"streaming_units": 3,
+				"expires_at":      time.Now().Add(time.Hour),
+			}})
+		mocket.Catcher.NewMock().WithQuery(`SELECT organisation_id, size_id, count(1) as count FROM "kafka_requests"`).
+			WithReply([]map[string]interface{}{{"organisation_id": "org-3", "size_id": "x1", "count": 6}})
+	}
+
+	tests := []struct {
+		name        string
+		reservation *dbapi.CapacityReservation
+		setupFn     func()
+		wantErr     *errors.ServiceError
+	}{
+		{
+			name:        "should return a validation error if the organisation is not provided",
+			reservation: buildReservation(func(reservation *dbapi.CapacityReservation) { reservation.OrganisationId = "" }),
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: errors.Validation("organisation_id is required"),
+		},
+		{
+			name:        "should return a validation error if no streaming units are reserved",
+			reservation: buildReservation(func(reservation *dbapi.CapacityReservation) { reservation.StreamingUnits = 0 }),
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: errors.Validation("streaming_units must be greater than 0"),
+		},
+		{
+			name:        "should return a validation error if the reservation has already expired",
+			reservation: buildReservation(func(reservation *dbapi.CapacityReservation) { reservation.ExpiresAt = time.Now().Add(-time.Hour) }),
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: errors.Validation("expires_at must be in the future"),
+		},
+		{
+			name:        "should return an error if the region is not supported",
+			reservation: buildReservation(func(reservation *dbapi.CapacityReservation) { reservation.Region = "unsupported" }),
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: errors.RegionNotSupported("unsupported region"),
+		},
+		{
+			name:        "should return an error if the capacity left in the region cannot accommodate the reservation",
+			reservation: buildReservation(func(reservation *dbapi.CapacityReservation) { reservation.StreamingUnits = 2 }),
+			setupFn:     mockRegionUsage,
+			wantErr:     errors.Conflict("not enough capacity left"),
+		},
+		{
+			name:        "should create the reservation when the capacity left in the region accommodates it",
+			reservation: buildReservation(nil),
+			setupFn: func() {
+				mockRegionUsage()
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "capacity_reservations"`)
+			},
+		},
+		{
+			name:        "should return an error if the capacity reservations of the region cannot be locked",
+			reservation: buildReservation(nil),
+			setupFn: func() {
+				mockRegionUsage()
+				mocket.Catcher.NewMock().WithQuery(`SELECT pg_advisory_xact_lock(hashtext($1))`).WithQueryException()
+			},
+			wantErr: errors.GeneralError("failed to lock capacity reservations"),
+		},
+		{
+			name:        "should return an error if creating the reservation fails",
+			reservation: buildReservation(nil),
+			setupFn: func() {
+				mockRegionUsage()
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "capacity_reservations"`).WithQueryException().WithExecException()
+			},
+			wantErr: errors.GeneralError("failed to create capacity reservation"),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := NewCapacityReservationService(db.NewMockConnectionFactory(nil), buildWeightedPlacementKafkaConfig(), buildProviderConfiguration(testKafkaRequestRegion, 10, 10, false))
+			err := c.Create(tt.reservation)
+			if tt.wantErr != nil {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErr.Code))
+			} else {
+				g.Expect(err).To(gomega.BeNil())
+			}
+		})
+	}
+}
+
+func Test_capacityReservationService_List(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupFn   func()
+		wantTotal int
+		wantErr   *errors.ServiceError
+	}{
+		{
+			name: "should list the reservations",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1) FROM "capacity_reservations"`).WithReply([]map[string]interface{}{{"count": 1}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "capacity_reservations"`).WithReply([]map[string]interface{}{{"id": "reservation-1"}})
+			},
+			wantTotal: 1,
+		},
+		{
+			name: "should return an error if the reservations cannot be counted",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1) FROM "capacity_reservations"`).WithQueryException()
+			},
+			wantErr: errors.GeneralError("unable to count capacity reservations"),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := NewCapacityReservationService(db.NewMockConnectionFactory(nil), buildWeightedPlacementKafkaConfig(), buildProviderConfiguration(testKafkaRequestRegion, 10, 10, false))
+			reservations, pagingMeta, err := c.List(&coreServices.ListArguments{Page: 1, Size: 10})
+			if tt.wantErr != nil {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErr.Code))
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(pagingMeta.Total).To(gomega.Equal(tt.wantTotal))
+			g.Expect(reservations).To(gomega.HaveLen(tt.wantTotal))
+		})
+	}
+}
+
+func Test_capacityReservationService_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantErr *errors.ServiceError
+	}{
+		{
+			name: "should delete the reservation",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "capacity_reservations" SET "deleted_at"`).WithRowsNum(1)
+			},
+		},
+		{
+			name: "should return a not found error if the reservation does not exist",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "capacity_reservations" SET "deleted_at"`).WithRowsNum(0)
+			},
+			wantErr: errors.NotFound("capacity reservation not found"),
+		},
+		{
+			name: "should return an error if deleting the reservation fails",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "capacity_reservations" SET "deleted_at"`).WithExecException()
+			},
+			wantErr: errors.GeneralError("failed to delete capacity reservation"),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := NewCapacityReservationService(db.NewMockConnectionFactory(nil), buildWeightedPlacementKafkaConfig(), buildProviderConfiguration(testKafkaRequestRegion, 10, 10, false))
+			err := c.Delete("reservation-1")
+			if tt.wantErr != nil {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErr.Code))
+			} else {
+				g.Expect(err).To(gomega.BeNil())
+			}
+		})
+	}
+}
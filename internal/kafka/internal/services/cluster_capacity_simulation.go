@@ -0,0 +1,65 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+// ClusterCapacitySimulationRequest describes a burst of kafkas of the same size arriving in a region
+type ClusterCapacitySimulationRequest struct {
+	CloudProvider  string
+	Region         string
+	InstanceType   string
+	SizeId         string
+	OrganisationId string
+	// Count is the number of kafkas placed one after the other
+	Count int
+}
+
+// ClusterCapacitySimulationPlacement is a simulated kafka placed on a data plane cluster
+type ClusterCapacitySimulationPlacement struct {
+	// Kafka is the position of the kafka in the burst, starting from 1
+	Kafka     int
+	ClusterID string
+}
+
+// ClusterCapacitySimulationRejection is a simulated kafka that could not be placed on any data plane cluster
+type ClusterCapacitySimulationRejection struct {
+	// Kafka is the position of the kafka in the burst, starting from 1
+	Kafka  int
+	Reason string
+}
+
+// ClusterCapacitySimulationScaleUp is a data plane cluster scale up triggered during the simulation
+type ClusterCapacitySimulationScaleUp struct {
+	// AfterKafka is the position of the last kafka handled before the scale up was triggered. It is 0 when the scale up
+	// is triggered before the first kafka arrives
+	AfterKafka    int
+	CloudProvider string
+	Region        string
+	InstanceType  string
+}
+
+// ClusterCapacityConsumption is the streaming units consumption of an instance type in a region
+type ClusterCapacityConsumption struct {
+	MaxStreamingUnits      int
+	ConsumedStreamingUnits int
+	FreeStreamingUnits     int
+	// OngoingScaleUp is true when a data plane cluster supporting the instance type is being created in the region
+	OngoingScaleUp bool
+}
+
+// ClusterCapacitySimulation is the outcome of a ClusterCapacitySimulationRequest
+type ClusterCapacitySimulation struct {
+	Placements        []ClusterCapacitySimulationPlacement
+	Rejections        []ClusterCapacitySimulationRejection
+	ScaleUps          []ClusterCapacitySimulationScaleUp
+	ConsumptionBefore ClusterCapacityConsumption
+	ConsumptionAfter  ClusterCapacityConsumption
+}
+
+//go:generate moq -out cluster_capacity_simulator_moq.go . ClusterCapacitySimulator
+type ClusterCapacitySimulator interface {
+	// SimulateClusterCapacity runs the kafka placement and the dynamic scale up evaluation for the kafkas of the request
+	// against an in-memory snapshot of the data plane clusters. Nothing is written.
+	SimulateClusterCapacity(request ClusterCapacitySimulationRequest) (*ClusterCapacitySimulation, *errors.ServiceError)
+}
@@ -0,0 +1,306 @@
+package services
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultClusterDrainBatchSize           = 5
+	defaultClusterDrainStallTimeoutMinutes = 60
+)
+
+// kafkaStatusesLeavingCluster are the statuses of the kafkas that are being removed from their data plane cluster and
+// therefore do not have to be migrated when the cluster is drained
+var kafkaStatusesLeavingCluster = []string{
+	constants.KafkaRequestStatusDeprovision.String(),
+	constants.KafkaRequestStatusDeleting.String(),
+}
+
+// KafkaStatusesHoldingData are the statuses of the kafkas that may hold data in their data plane cluster. The data is
+// not migrated along with the kafkas: they are provisioned again, empty, on their new data plane cluster. These kafkas
+// are therefore only migrated by the drains allowing data loss.
+var KafkaStatusesHoldingData = []string{
+	constants.KafkaRequestStatusReady.String(),
+	constants.KafkaRequestStatusSuspending.String(),
+	constants.KafkaRequestStatusSuspended.String(),
+	constants.KafkaRequestStatusResuming.String(),
+}
+
+// kafkaStatusesNotPrepared are the statuses of the kafkas whose bootstrap server host has not been assigned yet. These
+// kafkas keep their status when migrated, so that they are prepared for their new data plane cluster.
+var kafkaStatusesNotPrepared = []string{
+	constants.KafkaRequestStatusAccepted.String(),
+	constants.KafkaRequestStatusPreparing.String(),
+}
+
+//go:generate moq -out cluster_drain_service_moq.go . ClusterDrainService
+type ClusterDrainService interface {
+	// Create marks the data plane cluster of the drain unschedulable and creates the drain targeting all the kafkas
+	// assigned to the cluster that are not being deleted. A conflict error is returned if the cluster holds kafkas whose
+	// data would be lost by their migration and the drain does not allow data loss.
+	Create(drain *dbapi.ClusterDrain) *apiErrors.ServiceError
+	// GetByClusterID returns the latest drain of the given data plane cluster along with its kafkas
+	GetByClusterID(clusterID string) (*dbapi.ClusterDrain, *apiErrors.ServiceError)
+	// ListInProgress returns all the drains in progress along with their kafkas
+	ListInProgress() ([]*dbapi.ClusterDrain, *apiErrors.ServiceError)
+	// UpdateStatus moves a drain in progress to the given status
+	UpdateStatus(drain *dbapi.ClusterDrain, status dbapi.ClusterDrainStatus, reason string) *apiErrors.ServiceError
+	// UpdateDrainKafka persists the progress of the migration of a kafka of a drain
+	UpdateDrainKafka(drainKafka *dbapi.ClusterDrainKafka) *apiErrors.ServiceError
+	// MigrateKafka assigns the kafka to the target data plane cluster with a new placement id and the given bootstrap
+	// server host so that it gets provisioned on it, and marks it as migrating. The data of the kafka is not migrated.
+	// A conflict error is returned if the kafka has been updated concurrently.
+	MigrateKafka(drainKafka *dbapi.ClusterDrainKafka, kafka *dbapi.KafkaRequest, target *api.Cluster, bootstrapServerHost string) *apiErrors.ServiceError
+}
+
+var _ ClusterDrainService = &clusterDrainService{}
+
+type clusterDrainService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewClusterDrainService(connectionFactory *db.ConnectionFactory) ClusterDrainService {
+	return &clusterDrainService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (c *clusterDrainService) Create(drain *dbapi.ClusterDrain) *apiErrors.ServiceError {
+	if drain.ClusterID == "" {
+		return apiErrors.Validation("cluster_id is required")
+	}
+	if drain.TargetClusterID == drain.ClusterID {
+		return apiErrors.Validation("target_cluster_id must be different from cluster_id")
+	}
+	if drain.BatchSize < 0 || drain.StallTimeoutMinutes < 0 {
+		return apiErrors.Validation("batch_size and stall_timeout_minutes must be positive")
+	}
+	if drain.BatchSize == 0 {
+		drain.BatchSize = defaultClusterDrainBatchSize
+	}
+	if drain.StallTimeoutMinutes == 0 {
+		drain.StallTimeoutMinutes = defaultClusterDrainStallTimeoutMinutes
+	}
+
+	dbConn := c.connectionFactory.New()
+
+	var cluster api.Cluster
+	if err := dbConn.Where("cluster_id = ?", drain.ClusterID).First(&cluster).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apiErrors.NotFound("cluster with cluster_id='%v' not found", drain.ClusterID)
+		}
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to find cluster %q", drain.ClusterID)
+	}
+
+	for _, status := range api.ClusterDeletionStatuses {
+		if cluster.Status.String() == status {
+			return apiErrors.BadRequest("cluster %q cannot be drained as it is in %q status", drain.ClusterID, cluster.Status)
+		}
+	}
+
+	var drainsInProgress int64
+	if err := dbConn.Model(&dbapi.ClusterDrain{}).
+		Where("cluster_id = ?", drain.ClusterID).
+		Where("status = ?", dbapi.ClusterDrainStatusInProgress.String()).
+		Count(&drainsInProgress).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to find drains of cluster %q", drain.ClusterID)
+	}
+	if drainsInProgress > 0 {
+		return apiErrors.Conflict("cluster %q is already being drained", drain.ClusterID)
+	}
+
+	if !drain.AllowDataLoss {
+		var kafkasHoldingData int64
+		if err := dbConn.Model(&dbapi.KafkaRequest{}).
+			Where("cluster_id = ?", drain.ClusterID).
+			Where("status IN (?)", KafkaStatusesHoldingData).
+			Count(&kafkasHoldingData).Error; err != nil {
+			return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to find kafkas of cluster %q", drain.ClusterID)
+		}
+		if kafkasHoldingData > 0 {
+			return apiErrors.Conflict("cluster %q holds %d kafka instances whose data would be lost by their migration, allow_data_loss must be set to drain it", drain.ClusterID, kafkasHoldingData)
+		}
+	}
+
+	var kafkaIDs []string
+	if err := dbConn.Model(&dbapi.KafkaRequest{}).
+		Where("cluster_id = ?", drain.ClusterID).
+		Where("status not IN (?)", kafkaStatusesLeavingCluster).
+		Order("created_at").
+		Pluck("id", &kafkaIDs).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to find kafkas of cluster %q", drain.ClusterID)
+	}
+
+	drain.Status = dbapi.ClusterDrainStatusInProgress
+	drain.Kafkas = make([]dbapi.ClusterDrainKafka, 0, len(kafkaIDs))
+	for _, kafkaID := range kafkaIDs {
+		drain.Kafkas = append(drain.Kafkas, dbapi.ClusterDrainKafka{
+			KafkaID: kafkaID,
+			Status:  dbapi.ClusterDrainKafkaStatusPending,
+		})
+	}
+
+	// the cluster is marked unschedulable within the same transaction so that no kafka can be placed on it once the
+	// kafkas to be migrated have been selected
+	err := dbConn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&api.Cluster{}).
+			Where("cluster_id = ?", drain.ClusterID).
+			Update("unschedulable", true).Error; err != nil {
+			return err
+		}
+		return tx.Create(drain).Error
+	})
+	if err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to create drain of cluster %q", drain.ClusterID)
+	}
+
+	return nil
+}
+
+func (c *clusterDrainService) GetByClusterID(clusterID string) (*dbapi.ClusterDrain, *apiErrors.ServiceError) {
+	if clusterID == "" {
+		return nil, apiErrors.Validation("cluster_id is undefined")
+	}
+
+	var drain dbapi.ClusterDrain
+	dbConn := c.connectionFactory.New()
+	if err := dbConn.Preload("Kafkas").Where("cluster_id = ?", clusterID).Order("created_at desc").First(&drain).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apiErrors.NotFound("no drain found for cluster with cluster_id='%v'", clusterID)
+		}
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get drain of cluster %q", clusterID)
+	}
+
+	return &drain, nil
+}
+
+func (c *clusterDrainService) ListInProgress() ([]*dbapi.ClusterDrain, *apiErrors.ServiceError) {
+	var drains []*dbapi.ClusterDrain
+	dbConn := c.connectionFactory.New().
+		Preload("Kafkas").
+		Where("status = ?", dbapi.ClusterDrainStatusInProgress.String())
+
+	if err := dbConn.Find(&drains).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list cluster drains in progress")
+	}
+
+	return drains, nil
+}
+
+func (c *clusterDrainService) UpdateStatus(drain *dbapi.ClusterDrain, status dbapi.ClusterDrainStatus, reason string) *apiErrors.ServiceError {
+	if drain.Status != dbapi.ClusterDrainStatusInProgress {
+		return apiErrors.BadRequest("drain %q cannot be moved from status %q to status %q", drain.ID, drain.Status, status)
+	}
+
+	// the drain is not passed to the query so that it is left unchanged if it has been updated concurrently
+	dbConn := c.connectionFactory.New().
+		Model(&dbapi.ClusterDrain{Meta: api.Meta{ID: drain.ID}}).
+		Where("status = ?", drain.Status.String())
+
+	result := dbConn.Updates(map[string]interface{}{
+		"status":        status.String(),
+		"status_reason": reason,
+	})
+	if err := result.Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update status of drain %q", drain.ID)
+	}
+	if result.RowsAffected == 0 {
+		return apiErrors.Conflict("drain %q has been updated concurrently", drain.ID)
+	}
+
+	drain.Status = status
+	drain.StatusReason = reason
+	return nil
+}
+
+func (c *clusterDrainService) UpdateDrainKafka(drainKafka *dbapi.ClusterDrainKafka) *apiErrors.ServiceError {
+	dbConn := c.connectionFactory.New().Model(drainKafka)
+
+	if err := dbConn.Updates(map[string]interface{}{
+		"status":            drainKafka.Status.String(),
+		"target_cluster_id": drainKafka.TargetClusterID,
+		"failed_reason":     drainKafka.FailedReason,
+		"started_at":        drainKafka.StartedAt,
+		"finished_at":       drainKafka.FinishedAt,
+	}).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update kafka %q of drain %q", drainKafka.KafkaID, drainKafka.ClusterDrainID)
+	}
+
+	return nil
+}
+
+func (c *clusterDrainService) MigrateKafka(drainKafka *dbapi.ClusterDrainKafka, kafka *dbapi.KafkaRequest, target *api.Cluster, bootstrapServerHost string) *apiErrors.ServiceError {
+	now := time.Now()
+	placementID := api.NewID()
+
+	// suspended kafkas do not run any pod in their data plane cluster and keep their status once migrated, as do the
+	// kafkas that have not been prepared yet. The other kafkas are provisioned again on the target cluster.
+	status := constants.KafkaRequestStatusProvisioning.String()
+	if kafka.Status == constants.KafkaRequestStatusSuspended.String() || arrays.Contains(kafkaStatusesNotPrepared, kafka.Status) {
+		status = kafka.Status
+	}
+
+	changes := map[string]interface{}{
+		"cluster_id":         target.ClusterID,
+		"placement_id":       placementID,
+		"status":             status,
+		"routes_created":     false,
+		"routes_creation_id": "",
+	}
+	if bootstrapServerHost != "" {
+		changes["bootstrap_server_host"] = bootstrapServerHost
+	}
+
+	err := c.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		previous := findKafkaEventColumns(tx, kafka.ID, changes)
+
+		result := tx.Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: kafka.ID}}).
+			Where("status = ?", kafka.Status).
+			Where("cluster_id = ?", kafka.ClusterID).
+			Updates(changes)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiErrors.Conflict("kafka %q has been updated concurrently", kafka.ID)
+		}
+
+		if previous != nil {
+			createKafkaEvents(tx, previous, changes)
+		}
+
+		return tx.Model(drainKafka).Updates(map[string]interface{}{
+			"status":            dbapi.ClusterDrainKafkaStatusMigrating.String(),
+			"target_cluster_id": target.ClusterID,
+			"started_at":        now,
+		}).Error
+	})
+	if err != nil {
+		var svcErr *apiErrors.ServiceError
+		if errors.As(err, &svcErr) {
+			return svcErr
+		}
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to migrate kafka %q to cluster %q", kafka.ID, target.ClusterID)
+	}
+
+	kafka.ClusterID = target.ClusterID
+	kafka.PlacementId = placementID
+	kafka.Status = status
+	kafka.RoutesCreated = false
+	if bootstrapServerHost != "" {
+		kafka.BootstrapServerHost = bootstrapServerHost
+	}
+	drainKafka.Status = dbapi.ClusterDrainKafkaStatusMigrating
+	drainKafka.TargetClusterID = target.ClusterID
+	drainKafka.StartedAt = &now
+	return nil
+}
@@ -0,0 +1,348 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_clusterDrainService_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		drain      *dbapi.ClusterDrain
+		setupFn    func()
+		wantErr    *errors.ServiceError
+		wantKafkas []string
+	}{
+		{
+			name:  "should return a validation error if the cluster id is not provided",
+			drain: &dbapi.ClusterDrain{},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: errors.Validation("cluster_id is required"),
+		},
+		{
+			name:  "should return a validation error if the target cluster is the drained cluster",
+			drain: &dbapi.ClusterDrain{ClusterID: "cluster-id", TargetClusterID: "cluster-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: errors.Validation("target_cluster_id must be different from cluster_id"),
+		},
+		{
+			name:  "should return a validation error if the batch size is negative",
+			drain: &dbapi.ClusterDrain{ClusterID: "cluster-id", BatchSize: -1},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: errors.Validation("batch_size and stall_timeout_minutes must be positive"),
+		},
+		{
+			name:  "should return a not found error if the cluster does not exist",
+			drain: &dbapi.ClusterDrain{ClusterID: "cluster-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: errors.NotFound("cluster with cluster_id='cluster-id' not found"),
+		},
+		{
+			name:  "should return a bad request error if the cluster is being deprovisioned",
+			drain: &dbapi.ClusterDrain{ClusterID: "cluster-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters"`).
+					WithReply([]map[string]interface{}{{"cluster_id": "cluster-id", "status": api.ClusterDeprovisioning.String()}})
+			},
+			wantErr: errors.BadRequest("cluster \"cluster-id\" cannot be drained as it is in \"deprovisioning\" status"),
+		},
+		{
+			name:  "should return a conflict error if the cluster is already being drained",
+			drain: &dbapi.ClusterDrain{ClusterID: "cluster-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters"`).
+					WithReply([]map[string]interface{}{{"cluster_id": "cluster-id", "status": api.ClusterReady.String()}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "cluster_drains"`).
+					WithReply([]map[string]interface{}{{"count": 1}})
+			},
+			wantErr: errors.Conflict("cluster \"cluster-id\" is already being drained"),
+		},
+		{
+			name:  "should return a conflict error if the cluster holds kafkas with data and the drain does not allow data loss",
+			drain: &dbapi.ClusterDrain{ClusterID: "cluster-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters"`).
+					WithReply([]map[string]interface{}{{"cluster_id": "cluster-id", "status": api.ClusterReady.String()}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "cluster_drains"`).
+					WithReply([]map[string]interface{}{{"count": 0}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{{"count": 2}})
+			},
+			wantErr: errors.Conflict("cluster \"cluster-id\" holds 2 kafka instances whose data would be lost by their migration, allow_data_loss must be set to drain it"),
+		},
+		{
+			name:  "should create the drain of a cluster holding kafkas with data if the drain allows data loss",
+			drain: &dbapi.ClusterDrain{ClusterID: "cluster-id", AllowDataLoss: true},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters"`).
+					WithReply([]map[string]interface{}{{"cluster_id": "cluster-id", "status": api.ClusterReady.String()}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "cluster_drains"`).
+					WithReply([]map[string]interface{}{{"count": 0}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{{"count": 2}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT "id" FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{{"id": "kafka-1"}, {"id": "kafka-2"}})
+			},
+			wantErr:    nil,
+			wantKafkas: []string{"kafka-1", "kafka-2"},
+		},
+		{
+			name:  "should mark the cluster unschedulable and create the drain with the kafkas of the cluster",
+			drain: &dbapi.ClusterDrain{ClusterID: "cluster-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters"`).
+					WithReply([]map[string]interface{}{{"cluster_id": "cluster-id", "status": api.ClusterReady.String()}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "cluster_drains"`).
+					WithReply([]map[string]interface{}{{"count": 0}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT "id" FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{{"id": "kafka-1"}, {"id": "kafka-2"}})
+			},
+			wantErr:    nil,
+			wantKafkas: []string{"kafka-1", "kafka-2"},
+		},
+		{
+			name:  "should return an error if marking the cluster unschedulable fails",
+			drain: &dbapi.ClusterDrain{ClusterID: "cluster-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters"`).
+					WithReply([]map[string]interface{}{{"cluster_id": "cluster-id", "status": api.ClusterReady.String()}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "cluster_drains"`).
+					WithReply([]map[string]interface{}{{"count": 0}})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "clusters" SET "unschedulable"`).WithExecException()
+			},
+			wantErr: errors.GeneralError("failed to create drain of cluster \"cluster-id\""),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := NewClusterDrainService(db.NewMockConnectionFactory(nil))
+			err := c.Create(tt.drain)
+			if tt.wantErr != nil {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErr.Code))
+				g.Expect(err.Reason).To(gomega.Equal(tt.wantErr.Reason))
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(tt.drain.Status).To(gomega.Equal(dbapi.ClusterDrainStatusInProgress))
+			g.Expect(tt.drain.BatchSize).To(gomega.Equal(defaultClusterDrainBatchSize))
+			g.Expect(tt.drain.StallTimeoutMinutes).To(gomega.Equal(defaultClusterDrainStallTimeoutMinutes))
+			var kafkaIDs []string
+			for _, kafka := range tt.drain.Kafkas {
+				g.Expect(kafka.Status).To(gomega.Equal(dbapi.ClusterDrainKafkaStatusPending))
+				kafkaIDs = append(kafkaIDs, kafka.KafkaID)
+			}
+			g.Expect(kafkaIDs).To(gomega.Equal(tt.wantKafkas))
+		})
+	}
+}
+
+func Test_clusterDrainService_GetByClusterID(t *testing.T) {
+	tests := []struct {
+		name      string
+		clusterID string
+		setupFn   func()
+		wantErr   bool
+	}{
+		{
+			name:      "should return an error if the cluster id is empty",
+			clusterID: "",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: true,
+		},
+		{
+			name:      "should return the latest drain of the cluster",
+			clusterID: "cluster-id",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "cluster_drains" WHERE cluster_id = $1`).
+					WithReply([]map[string]interface{}{{"id": "drain-id", "cluster_id": "cluster-id", "status": "in_progress"}})
+			},
+			wantErr: false,
+		},
+		{
+			name:      "should return a not found error if the cluster has never been drained",
+			clusterID: "cluster-id",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := NewClusterDrainService(db.NewMockConnectionFactory(nil))
+			got, err := c.GetByClusterID(tt.clusterID)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(got.ClusterID).To(gomega.Equal(tt.clusterID))
+			}
+		})
+	}
+}
+
+func Test_clusterDrainService_UpdateStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		drain      *dbapi.ClusterDrain
+		status     dbapi.ClusterDrainStatus
+		setupFn    func()
+		wantErr    bool
+		wantStatus dbapi.ClusterDrainStatus
+	}{
+		{
+			name:       "should complete a drain in progress",
+			drain:      &dbapi.ClusterDrain{Meta: api.Meta{ID: "drain-id"}, Status: dbapi.ClusterDrainStatusInProgress},
+			status:     dbapi.ClusterDrainStatusCompleted,
+			setupFn:    func() { mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "cluster_drains"`).WithRowsNum(1) },
+			wantErr:    false,
+			wantStatus: dbapi.ClusterDrainStatusCompleted,
+		},
+		{
+			name:       "should not update a failed drain",
+			drain:      &dbapi.ClusterDrain{Meta: api.Meta{ID: "drain-id"}, Status: dbapi.ClusterDrainStatusFailed},
+			status:     dbapi.ClusterDrainStatusCompleted,
+			setupFn:    func() { mocket.Catcher.Reset() },
+			wantErr:    true,
+			wantStatus: dbapi.ClusterDrainStatusFailed,
+		},
+		{
+			name:       "should return an error if the drain has been updated concurrently",
+			drain:      &dbapi.ClusterDrain{Meta: api.Meta{ID: "drain-id"}, Status: dbapi.ClusterDrainStatusInProgress},
+			status:     dbapi.ClusterDrainStatusFailed,
+			setupFn:    func() { mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "cluster_drains"`).WithRowsNum(0) },
+			wantErr:    true,
+			wantStatus: dbapi.ClusterDrainStatusInProgress,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := NewClusterDrainService(db.NewMockConnectionFactory(nil))
+			err := c.UpdateStatus(tt.drain, tt.status, "reason")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(tt.drain.Status).To(gomega.Equal(tt.wantStatus))
+		})
+	}
+}
+
+func Test_clusterDrainService_MigrateKafka(t *testing.T) {
+	tests := []struct {
+		name                    string
+		kafka                   *dbapi.KafkaRequest
+		bootstrapServerHost     string
+		setupFn                 func()
+		wantErr                 bool
+		wantStatus              string
+		wantBootstrapServerHost string
+	}{
+		{
+			name:                "should assign a ready kafka to the target cluster with the given bootstrap server host and provision it again",
+			kafka:               &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-1"}, ClusterID: "cluster-id", PlacementId: "placement-id", Status: constants.KafkaRequestStatusReady.String(), RoutesCreated: true, BootstrapServerHost: "kafka-1.cluster-id.example.com"},
+			bootstrapServerHost: "kafka-1.target-cluster-id.example.com",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests"`).WithRowsNum(1)
+			},
+			wantErr:                 false,
+			wantStatus:              constants.KafkaRequestStatusProvisioning.String(),
+			wantBootstrapServerHost: "kafka-1.target-cluster-id.example.com",
+		},
+		{
+			name:  "should keep the status of a suspended kafka",
+			kafka: &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-1"}, ClusterID: "cluster-id", PlacementId: "placement-id", Status: constants.KafkaRequestStatusSuspended.String()},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests"`).WithRowsNum(1)
+			},
+			wantErr:    false,
+			wantStatus: constants.KafkaRequestStatusSuspended.String(),
+		},
+		{
+			name:  "should keep the status of a kafka that has not been prepared yet",
+			kafka: &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-1"}, ClusterID: "cluster-id", PlacementId: "placement-id", Status: constants.KafkaRequestStatusAccepted.String()},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests"`).WithRowsNum(1)
+			},
+			wantErr:    false,
+			wantStatus: constants.KafkaRequestStatusAccepted.String(),
+		},
+		{
+			name:  "should return a conflict error if the kafka has been updated concurrently",
+			kafka: &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-1"}, ClusterID: "cluster-id", PlacementId: "placement-id", Status: constants.KafkaRequestStatusReady.String()},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests"`).WithRowsNum(0)
+			},
+			wantErr:    true,
+			wantStatus: constants.KafkaRequestStatusReady.String(),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := NewClusterDrainService(db.NewMockConnectionFactory(nil))
+			drainKafka := &dbapi.ClusterDrainKafka{Meta: api.Meta{ID: "drain-kafka-id"}, KafkaID: tt.kafka.ID, Status: dbapi.ClusterDrainKafkaStatusPending}
+			err := c.MigrateKafka(drainKafka, tt.kafka, &api.Cluster{ClusterID: "target-cluster-id"}, tt.bootstrapServerHost)
+			g.Expect(tt.kafka.Status).To(gomega.Equal(tt.wantStatus))
+			if tt.wantErr {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.IsConflict()).To(gomega.BeTrue())
+				g.Expect(tt.kafka.ClusterID).To(gomega.Equal("cluster-id"))
+				g.Expect(drainKafka.Status).To(gomega.Equal(dbapi.ClusterDrainKafkaStatusPending))
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(tt.kafka.ClusterID).To(gomega.Equal("target-cluster-id"))
+			g.Expect(tt.kafka.PlacementId).ToNot(gomega.Equal("placement-id"))
+			g.Expect(tt.kafka.RoutesCreated).To(gomega.BeFalse())
+			g.Expect(tt.kafka.BootstrapServerHost).To(gomega.Equal(tt.wantBootstrapServerHost))
+			g.Expect(drainKafka.Status).To(gomega.Equal(dbapi.ClusterDrainKafkaStatusMigrating))
+			g.Expect(drainKafka.TargetClusterID).To(gomega.Equal("target-cluster-id"))
+			g.Expect(drainKafka.StartedAt).ToNot(gomega.BeNil())
+		})
+	}
+}
+
+func Test_clusterDrainService_MigrateKafka_RecordsKafkaEvents(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery(`FOR UPDATE`).
+		WithReply([]map[string]interface{}{{"id": "kafka-1", "status": constants.KafkaRequestStatusReady.String(), "cluster_id": "cluster-id", "placement_id": "placement-id"}})
+	mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`).WithRowsNum(1)
+	insertEvents := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_events"`)
+
+	c := NewClusterDrainService(db.NewMockConnectionFactory(nil))
+	kafka := &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-1"}, ClusterID: "cluster-id", PlacementId: "placement-id", Status: constants.KafkaRequestStatusReady.String()}
+	drainKafka := &dbapi.ClusterDrainKafka{Meta: api.Meta{ID: "drain-kafka-id"}, KafkaID: kafka.ID, Status: dbapi.ClusterDrainKafkaStatusPending}
+	err := c.MigrateKafka(drainKafka, kafka, &api.Cluster{ClusterID: "target-cluster-id"}, "")
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(insertEvents.Triggered).To(gomega.BeTrue())
+}
@@ -1,9 +1,15 @@
 package services
 
 import (
+	"fmt"
+	"sync"
+	"time"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/golang/glog"
 	"github.com/pkg/errors"
 )
 
@@ -13,10 +19,28 @@ type ClusterPlacementStrategy interface {
 	FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster, error)
 }
 
-// NewClusterPlacementStrategy return a concrete strategy impl. depends on the placement configuration
-func NewClusterPlacementStrategy(clusterService ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig, kafkaConfig *config.KafkaConfig) ClusterPlacementStrategy {
+// capacityReservationUsagesCacheTTL is how long the usages of the capacity reservations are reused across placements.
+// It is shorter than the interval between two reconciles of the kafka workers so that each reconcile lists them at most
+// once or twice, whatever the number of kafkas it places.
+const capacityReservationUsagesCacheTTL = 10 * time.Second
+
+// NewClusterPlacementStrategy return a concrete strategy impl. depends on the placement configuration. The strategy
+// does not place kafkas on the streaming units reserved by the capacity reservations of other organisations
+func NewClusterPlacementStrategy(clusterService ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig, kafkaConfig *config.KafkaConfig, capacityReservationService CapacityReservationService) ClusterPlacementStrategy {
+	return newClusterPlacementStrategy(clusterService, dataplaneClusterConfig, kafkaConfig, capacityReservationService, &capacityReservationUsagesCache{ttl: capacityReservationUsagesCacheTTL})
+}
+
+// NewSimulatedClusterPlacementStrategy returns the same strategy as NewClusterPlacementStrategy without reusing the
+// usages of the capacity reservations across placements, as the placements of a simulation consume them
+func NewSimulatedClusterPlacementStrategy(clusterService ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig, kafkaConfig *config.KafkaConfig, capacityReservationService CapacityReservationService) ClusterPlacementStrategy {
+	return newClusterPlacementStrategy(clusterService, dataplaneClusterConfig, kafkaConfig, capacityReservationService, nil)
+}
+
+func newClusterPlacementStrategy(clusterService ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig, kafkaConfig *config.KafkaConfig, capacityReservationService CapacityReservationService, usagesCache *capacityReservationUsagesCache) ClusterPlacementStrategy {
 	var clusterSelection ClusterPlacementStrategy
 	switch {
+	case dataplaneClusterConfig.ClusterPlacementConfig.IsWeightedStrategyEnabled():
+		clusterSelection = &WeightedClusterPlacement{clusterService, dataplaneClusterConfig, kafkaConfig}
 	case dataplaneClusterConfig.IsDataPlaneManualScalingEnabled():
 		clusterSelection = &FirstSchedulableWithinLimit{dataplaneClusterConfig, clusterService, kafkaConfig}
 	case dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled():
@@ -24,7 +48,172 @@ func NewClusterPlacementStrategy(clusterService ClusterService, dataplaneCluster
 	default:
 		clusterSelection = &FirstReadyCluster{clusterService}
 	}
-	return clusterSelection
+
+	reservationPlacement := capacityReservationPlacement{
+		strategy:                   clusterSelection,
+		clusterService:             clusterService,
+		dataplaneClusterConfig:     dataplaneClusterConfig,
+		kafkaConfig:                kafkaConfig,
+		capacityReservationService: capacityReservationService,
+		usagesCache:                usagesCache,
+	}
+	// the placement of kafkas must still be explainable when the strategy supports it
+	if explainer, ok := clusterSelection.(ClusterPlacementExplainer); ok {
+		return &explainableCapacityReservationPlacement{capacityReservationPlacement: reservationPlacement, explainer: explainer}
+	}
+	return &reservationPlacement
+}
+
+// capacityReservationPlacement places kafkas with the given strategy as long as the streaming units left in the region
+// once the kafka is placed can still accommodate the capacity reservations not consumed yet. The streaming units reserved
+// by the organisation of the kafka are consumed first.
+type capacityReservationPlacement struct {
+	strategy                   ClusterPlacementStrategy
+	clusterService             ClusterService
+	dataplaneClusterConfig     *config.DataplaneClusterConfig
+	kafkaConfig                *config.KafkaConfig
+	capacityReservationService CapacityReservationService
+	// usagesCache holds the usages of the capacity reservations reused across placements. They are listed for every
+	// placement when nil
+	usagesCache *capacityReservationUsagesCache
+}
+
+// capacityReservationUsagesCache holds the usages of the active capacity reservations for the given ttl
+type capacityReservationUsagesCache struct {
+	ttl       time.Duration
+	mutex     sync.Mutex
+	usages    CapacityReservationUsageList
+	expiresAt time.Time
+}
+
+// get returns the cached usages, listing them with the given function if they have expired. Errors are not cached.
+func (u *capacityReservationUsagesCache) get(listActiveUsages func() (CapacityReservationUsageList, *apiErrors.ServiceError)) (CapacityReservationUsageList, *apiErrors.ServiceError) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	now := time.Now()
+	if now.Before(u.expiresAt) {
+		return u.usages, nil
+	}
+
+	usages, serviceErr := listActiveUsages()
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	u.usages = usages
+	u.expiresAt = now.Add(u.ttl)
+
+	return usages, nil
+}
+
+func (c *capacityReservationPlacement) listActiveUsages() (CapacityReservationUsageList, *apiErrors.ServiceError) {
+	if c.usagesCache == nil {
+		return c.capacityReservationService.ListActiveUsages()
+	}
+	return c.usagesCache.get(c.capacityReservationService.ListActiveUsages)
+}
+
+func (c *capacityReservationPlacement) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+	cluster, err := c.strategy.FindCluster(kafka)
+	if err != nil || cluster == nil {
+		return cluster, err
+	}
+
+	reason, err := c.checkCapacityReservations(kafka)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		glog.Infof("kafka %q of organisation %q cannot be placed in region %q: %s", kafka.ID, kafka.OrganisationId, kafka.Region, reason)
+		return nil, nil
+	}
+
+	return cluster, nil
+}
+
+// checkCapacityReservations returns the reason why the kafka cannot be placed without using the streaming units reserved
+// for other organisations. It is empty when the kafka can be placed.
+func (c *capacityReservationPlacement) checkCapacityReservations(kafka *dbapi.KafkaRequest) (string, error) {
+	instanceSize, err := c.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get kafka instance size of kafka %q", kafka.ID)
+	}
+
+	usages, serviceErr := c.listActiveUsages()
+	if serviceErr != nil {
+		return "", errors.Wrap(serviceErr, "failed to list active capacity reservations")
+	}
+
+	reserved := usages.UnusedStreamingUnits(kafka.CloudProvider, kafka.Region, kafka.InstanceType, kafka.OrganisationId, instanceSize.CapacityConsumed)
+	if reserved == 0 {
+		return "", nil
+	}
+
+	criteria := FindClusterCriteria{
+		Provider:              kafka.CloudProvider,
+		Region:                kafka.Region,
+		MultiAZ:               kafka.MultiAZ,
+		Status:                api.ClusterReady,
+		SupportedInstanceType: kafka.InstanceType,
+	}
+	clusters, err := c.clusterService.FindAllClusters(criteria)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to find all clusters with criteria '%v'", criteria)
+	}
+
+	capacities, err := findClusterCapacities(c.clusterService, c.dataplaneClusterConfig, clusters, kafka.InstanceType)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to find capacity of clusters with criteria '%v'", criteria)
+	}
+
+	available := 0
+	for _, capacity := range capacities {
+		// reservations cannot be exhausted by clusters with an unlimited capacity
+		if capacity.max < 0 {
+			return "", nil
+		}
+		if capacity.max > capacity.used {
+			available += capacity.max - capacity.used
+		}
+	}
+
+	if available-instanceSize.CapacityConsumed < reserved {
+		return fmt.Sprintf("%d of the %d streaming units left in the region are reserved by capacity reservations", reserved, available), nil
+	}
+
+	return "", nil
+}
+
+// explainableCapacityReservationPlacement is a capacityReservationPlacement whose strategy explains its placement decisions
+type explainableCapacityReservationPlacement struct {
+	capacityReservationPlacement
+	explainer ClusterPlacementExplainer
+}
+
+var _ ClusterPlacementExplainer = &explainableCapacityReservationPlacement{}
+
+func (e *explainableCapacityReservationPlacement) ExplainPlacement(kafka *dbapi.KafkaRequest) (*ClusterPlacementDecision, error) {
+	decision, err := e.explainer.ExplainPlacement(kafka)
+	if err != nil || decision.Cluster == nil {
+		return decision, err
+	}
+
+	reason, err := e.checkCapacityReservations(kafka)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		// the reservations apply to the whole region so none of the clusters is eligible
+		for i := range decision.Candidates {
+			if decision.Candidates[i].Eligible {
+				decision.Candidates[i].Eligible = false
+				decision.Candidates[i].Reasons = append(decision.Candidates[i].Reasons, reason)
+			}
+		}
+		decision.Cluster = nil
+	}
+
+	return decision, nil
 }
 
 // FirstReadyCluster finds and returns the first cluster with Ready status
@@ -11,6 +11,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 
 	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
@@ -574,3 +575,148 @@ func TestFirstReadyWithCapacity_FindCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestCapacityReservationPlacement_FindCluster(t *testing.T) {
+	kafka := mockkafkas.BuildKafkaRequest(
+		mockkafkas.With(mockkafkas.INSTANCE_TYPE, types.STANDARD.String()),
+		mockkafkas.With(mockkafkas.SIZE_ID, "x1"),
+		mockkafkas.With(mockkafkas.ORGANISATION_ID, "13640203"),
+	)
+	reservation := func(organisationID string, streamingUnits int) CapacityReservationUsage {
+		return CapacityReservationUsage{
+			Reservation: &dbapi.CapacityReservation{
+				OrganisationId: organisationID,
+				CloudProvider:  kafka.CloudProvider,
+				Region:         kafka.Region,
+				InstanceType:   kafka.InstanceType,
+				StreamingUnits: streamingUnits,
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		usages        CapacityReservationUsageList
+		usagesErr     *apiErrors.ServiceError
+		wantClusterID string
+		wantErr       bool
+	}{
+		{
+			name:          "should place the kafka when there are no capacity reservations",
+			wantClusterID: "cluster-2",
+		},
+		{
+			name:          "should place the kafka when the capacity left once placed accommodates the reservations of other organisations",
+			usages:        CapacityReservationUsageList{reservation("other-org", 12)},
+			wantClusterID: "cluster-2",
+		},
+		{
+			name:   "should not place the kafka when it would use the streaming units reserved by other organisations",
+			usages: CapacityReservationUsageList{reservation("other-org", 13)},
+		},
+		{
+			name:          "should place the kafka on the streaming units reserved by its own organisation",
+			usages:        CapacityReservationUsageList{reservation("13640203", 13)},
+			wantClusterID: "cluster-2",
+		},
+		{
+			name:      "should return an error when the capacity reservations cannot be listed",
+			usagesErr: apiErrors.GeneralError("failed to list capacity reservations"),
+			wantErr:   true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			clusterService := &ClusterServiceMock{
+				FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+					return buildWeightedPlacementClusters(), nil
+				},
+				FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+					return KafkaStreamingUnitCountPerClusterList{
+						{ClusterId: "cluster-1", InstanceType: types.STANDARD.String(), Count: 2},
+						{ClusterId: "cluster-2", InstanceType: types.STANDARD.String(), Count: 5},
+					}, nil
+				},
+				FindKafkaInstanceCountByOrganisationFunc: func(organisationID string) ([]ResKafkaInstanceCount, error) {
+					return nil, nil
+				},
+			}
+			capacityReservationService := &CapacityReservationServiceMock{
+				ListActiveUsagesFunc: func() (CapacityReservationUsageList, *apiErrors.ServiceError) {
+					return tt.usages, tt.usagesErr
+				},
+			}
+			strategy := NewClusterPlacementStrategy(clusterService, buildWeightedPlacementDataplaneClusterConfig(config.BinPackClusterPlacementMode), buildWeightedPlacementKafkaConfig(), capacityReservationService)
+
+			cluster, err := strategy.FindCluster(kafka)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantClusterID == "" {
+				g.Expect(cluster).To(gomega.BeNil())
+			} else {
+				g.Expect(cluster).ToNot(gomega.BeNil())
+				g.Expect(cluster.ClusterID).To(gomega.Equal(tt.wantClusterID))
+			}
+
+			explainer, ok := strategy.(ClusterPlacementExplainer)
+			g.Expect(ok).To(gomega.BeTrue())
+			decision, err := explainer.ExplainPlacement(kafka)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+			g.Expect(decision.Cluster).To(gomega.Equal(cluster))
+			for _, candidate := range decision.Candidates {
+				g.Expect(candidate.Eligible).To(gomega.Equal(tt.wantClusterID != ""), candidate.Reasons)
+			}
+		})
+	}
+}
+
+func TestCapacityReservationPlacement_ReusesUsages(t *testing.T) {
+	g := gomega.NewWithT(t)
+	kafka := mockkafkas.BuildKafkaRequest(
+		mockkafkas.With(mockkafkas.INSTANCE_TYPE, types.STANDARD.String()),
+		mockkafkas.With(mockkafkas.SIZE_ID, "x1"),
+	)
+	clusterService := &ClusterServiceMock{
+		FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+			return buildWeightedPlacementClusters(), nil
+		},
+		FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+			return KafkaStreamingUnitCountPerClusterList{}, nil
+		},
+		FindKafkaInstanceCountByOrganisationFunc: func(organisationID string) ([]ResKafkaInstanceCount, error) {
+			return nil, nil
+		},
+	}
+	usagesErr := apiErrors.GeneralError("failed to list capacity reservations")
+	capacityReservationService := &CapacityReservationServiceMock{
+		ListActiveUsagesFunc: func() (CapacityReservationUsageList, *apiErrors.ServiceError) {
+			return nil, usagesErr
+		},
+	}
+
+	strategy := NewClusterPlacementStrategy(clusterService, buildWeightedPlacementDataplaneClusterConfig(config.BinPackClusterPlacementMode), buildWeightedPlacementKafkaConfig(), capacityReservationService)
+	_, err := strategy.FindCluster(kafka)
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	// errors are not cached
+	usagesErr = nil
+	for i := 0; i < 3; i++ {
+		cluster, err := strategy.FindCluster(kafka)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(cluster).ToNot(gomega.BeNil())
+	}
+	g.Expect(capacityReservationService.ListActiveUsagesCalls()).To(gomega.HaveLen(2))
+
+	simulatedStrategy := NewSimulatedClusterPlacementStrategy(clusterService, buildWeightedPlacementDataplaneClusterConfig(config.BinPackClusterPlacementMode), buildWeightedPlacementKafkaConfig(), capacityReservationService)
+	for i := 0; i < 3; i++ {
+		_, err := simulatedStrategy.FindCluster(kafka)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+	}
+	g.Expect(capacityReservationService.ListActiveUsagesCalls()).To(gomega.HaveLen(5))
+}
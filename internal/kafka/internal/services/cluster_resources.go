@@ -0,0 +1,12 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+//go:generate moq -out cluster_resources_reconciler_moq.go . ClusterResourcesReconciler
+type ClusterResourcesReconciler interface {
+	// ReconcileClusterResources applies the resources managed by kas-fleet-manager, e.g. the observability stack and the
+	// image pull secrets, to the given data plane cluster
+	ReconcileClusterResources(cluster api.Cluster) error
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+//go:generate moq -out cluster_rotation_service_moq.go . ClusterRotationService
+type ClusterRotationService interface {
+	// Create creates the rotation of a data plane cluster. A conflict error is returned if the data plane cluster is
+	// already part of a rotation in progress
+	Create(rotation *dbapi.ClusterRotation) *apiErrors.ServiceError
+	// ListInProgress returns all the rotations that are provisioning their replacement data plane cluster or draining
+	// the rotated data plane cluster
+	ListInProgress() ([]*dbapi.ClusterRotation, *apiErrors.ServiceError)
+	// UpdateStatus moves a rotation in progress to the given status
+	UpdateStatus(rotation *dbapi.ClusterRotation, status dbapi.ClusterRotationStatus, reason string) *apiErrors.ServiceError
+	// FindReplacementCluster returns the replacement data plane cluster of the rotation. nil is returned if the
+	// replacement data plane cluster no longer exists
+	FindReplacementCluster(rotation *dbapi.ClusterRotation) (*api.Cluster, *apiErrors.ServiceError)
+	// UpdateReplacementClusterID persists the cluster id of the replacement data plane cluster of the rotation
+	UpdateReplacementClusterID(rotation *dbapi.ClusterRotation, replacementClusterID string) *apiErrors.ServiceError
+	// ListCandidates returns the ready and schedulable OCM data plane clusters that are not enterprise clusters, that
+	// are not part of a rotation in progress, whose rotation did not fail after the given time and that do not hold
+	// any kafka whose data would be lost by its migration, oldest first
+	ListCandidates(failedRotationsSince time.Time) ([]*api.Cluster, *apiErrors.ServiceError)
+}
+
+var _ ClusterRotationService = &clusterRotationService{}
+
+type clusterRotationService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewClusterRotationService(connectionFactory *db.ConnectionFactory) ClusterRotationService {
+	return &clusterRotationService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (c *clusterRotationService) Create(rotation *dbapi.ClusterRotation) *apiErrors.ServiceError {
+	if rotation.ClusterID == "" || rotation.ReplacementID == "" {
+		return apiErrors.Validation("cluster_id and replacement_id are required")
+	}
+
+	dbConn := c.connectionFactory.New()
+
+	var rotationsInProgress int64
+	if err := dbConn.Model(&dbapi.ClusterRotation{}).
+		Where("cluster_id = ?", rotation.ClusterID).
+		Where("status IN (?)", dbapi.ClusterRotationInProgressStatuses).
+		Count(&rotationsInProgress).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to find rotations of cluster %q", rotation.ClusterID)
+	}
+	if rotationsInProgress > 0 {
+		return apiErrors.Conflict("cluster %q is already being rotated", rotation.ClusterID)
+	}
+
+	rotation.Status = dbapi.ClusterRotationStatusProvisioning
+	if err := dbConn.Create(rotation).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to create rotation of cluster %q", rotation.ClusterID)
+	}
+
+	return nil
+}
+
+func (c *clusterRotationService) ListInProgress() ([]*dbapi.ClusterRotation, *apiErrors.ServiceError) {
+	var rotations []*dbapi.ClusterRotation
+	dbConn := c.connectionFactory.New().
+		Where("status IN (?)", dbapi.ClusterRotationInProgressStatuses).
+		Order("created_at")
+
+	if err := dbConn.Find(&rotations).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list cluster rotations in progress")
+	}
+
+	return rotations, nil
+}
+
+func (c *clusterRotationService) UpdateStatus(rotation *dbapi.ClusterRotation, status dbapi.ClusterRotationStatus, reason string) *apiErrors.ServiceError {
+	if rotation.Status != dbapi.ClusterRotationStatusProvisioning && rotation.Status != dbapi.ClusterRotationStatusDraining {
+		return apiErrors.BadRequest("rotation %q cannot be moved from status %q to status %q", rotation.ID, rotation.Status, status)
+	}
+
+	// the rotation is not passed to the query so that it is left unchanged if it has been updated concurrently
+	dbConn := c.connectionFactory.New().
+		Model(&dbapi.ClusterRotation{Meta: api.Meta{ID: rotation.ID}}).
+		Where("status = ?", rotation.Status.String())
+
+	result := dbConn.Updates(map[string]interface{}{
+		"status":        status.String(),
+		"status_reason": reason,
+	})
+	if err := result.Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update status of rotation %q", rotation.ID)
+	}
+	if result.RowsAffected == 0 {
+		return apiErrors.Conflict("rotation %q has been updated concurrently", rotation.ID)
+	}
+
+	rotation.Status = status
+	rotation.StatusReason = reason
+	return nil
+}
+
+func (c *clusterRotationService) FindReplacementCluster(rotation *dbapi.ClusterRotation) (*api.Cluster, *apiErrors.ServiceError) {
+	var cluster api.Cluster
+	dbConn := c.connectionFactory.New()
+	if err := dbConn.Where("id = ?", rotation.ReplacementID).First(&cluster).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to find replacement cluster of rotation %q", rotation.ID)
+	}
+
+	return &cluster, nil
+}
+
+func (c *clusterRotationService) UpdateReplacementClusterID(rotation *dbapi.ClusterRotation, replacementClusterID string) *apiErrors.ServiceError {
+	dbConn := c.connectionFactory.New().Model(&dbapi.ClusterRotation{Meta: api.Meta{ID: rotation.ID}})
+	if err := dbConn.Update("replacement_cluster_id", replacementClusterID).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update replacement cluster id of rotation %q", rotation.ID)
+	}
+
+	rotation.ReplacementClusterID = replacementClusterID
+	return nil
+}
+
+func (c *clusterRotationService) ListCandidates(failedRotationsSince time.Time) ([]*api.Cluster, *apiErrors.ServiceError) {
+	dbConn := c.connectionFactory.New()
+
+	rotatingClusters := dbConn.Model(&dbapi.ClusterRotation{}).
+		Select("cluster_id").
+		Where("status IN (?)", dbapi.ClusterRotationInProgressStatuses)
+	replacementClusters := dbConn.Model(&dbapi.ClusterRotation{}).
+		Select("replacement_cluster_id").
+		Where("status IN (?)", dbapi.ClusterRotationInProgressStatuses)
+	// clusters whose rotation failed recently are not rotated again straight away so that a rotation failing
+	// repeatedly does not keep provisioning replacement clusters
+	recentlyFailedClusters := dbConn.Model(&dbapi.ClusterRotation{}).
+		Select("cluster_id").
+		Where("status = ?", dbapi.ClusterRotationStatusFailed.String()).
+		Where("updated_at > ?", failedRotationsSince)
+	// the data of the kafkas is not migrated by the drains, the clusters holding kafkas with data are not rotated
+	clustersHoldingData := dbConn.Model(&dbapi.KafkaRequest{}).
+		Select("cluster_id").
+		Where("status IN (?)", KafkaStatusesHoldingData)
+
+	var clusters []*api.Cluster
+	if err := dbConn.Model(&api.Cluster{}).
+		Where("status = ?", api.ClusterReady.String()).
+		Where("provider_type = ?", api.ClusterProviderOCM.String()).
+		Where("cluster_type != ?", api.Enterprise.String()).
+		Where("unschedulable = ?", false).
+		Where("cluster_id NOT IN (?)", rotatingClusters).
+		Where("cluster_id NOT IN (?)", replacementClusters).
+		Where("cluster_id NOT IN (?)", recentlyFailedClusters).
+		Where("cluster_id NOT IN (?)", clustersHoldingData).
+		Order("created_at").
+		Find(&clusters).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list cluster rotation candidates")
+	}
+
+	return clusters, nil
+}
@@ -0,0 +1,306 @@
+package services
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_clusterRotationService_Create(t *testing.T) {
+	tests := []struct {
+		name     string
+		rotation *dbapi.ClusterRotation
+		setupFn  func()
+		wantErr  *errors.ServiceError
+	}{
+		{
+			name:     "should return a validation error if the replacement id is not provided",
+			rotation: &dbapi.ClusterRotation{ClusterID: "cluster-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: errors.Validation("cluster_id and replacement_id are required"),
+		},
+		{
+			name:     "should return a conflict error if the cluster is already being rotated",
+			rotation: &dbapi.ClusterRotation{ClusterID: "cluster-id", ReplacementID: "replacement-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1) FROM "cluster_rotations"`).
+					WithReply([]map[string]interface{}{{"count": 1}})
+			},
+			wantErr: errors.Conflict("cluster \"cluster-id\" is already being rotated"),
+		},
+		{
+			name:     "should create the rotation in provisioning status",
+			rotation: &dbapi.ClusterRotation{ClusterID: "cluster-id", ReplacementID: "replacement-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1) FROM "cluster_rotations"`).
+					WithReply([]map[string]interface{}{{"count": 0}})
+			},
+			wantErr: nil,
+		},
+		{
+			name:     "should return an error if creating the rotation fails",
+			rotation: &dbapi.ClusterRotation{ClusterID: "cluster-id", ReplacementID: "replacement-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1) FROM "cluster_rotations"`).
+					WithReply([]map[string]interface{}{{"count": 0}})
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "cluster_rotations"`).WithExecException()
+			},
+			wantErr: errors.GeneralError("failed to create rotation of cluster \"cluster-id\""),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := NewClusterRotationService(db.NewMockConnectionFactory(nil))
+			err := c.Create(tt.rotation)
+			if tt.wantErr != nil {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErr.Code))
+				g.Expect(err.Reason).To(gomega.Equal(tt.wantErr.Reason))
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(tt.rotation.Status).To(gomega.Equal(dbapi.ClusterRotationStatusProvisioning))
+		})
+	}
+}
+
+func Test_clusterRotationService_ListInProgress(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantErr bool
+		want    int
+	}{
+		{
+			name: "should return the rotations in progress",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "cluster_rotations" WHERE status IN ($1,$2)`).
+					WithReply([]map[string]interface{}{
+						{"id": "rotation-1", "cluster_id": "cluster-1", "status": dbapi.ClusterRotationStatusProvisioning.String()},
+						{"id": "rotation-2", "cluster_id": "cluster-2", "status": dbapi.ClusterRotationStatusDraining.String()},
+					})
+			},
+			wantErr: false,
+			want:    2,
+		},
+		{
+			name: "should return an error if listing the rotations fails",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "cluster_rotations"`).WithQueryException()
+			},
+			wantErr: true,
+			want:    0,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := NewClusterRotationService(db.NewMockConnectionFactory(nil))
+			rotations, err := c.ListInProgress()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(rotations).To(gomega.HaveLen(tt.want))
+		})
+	}
+}
+
+func Test_clusterRotationService_UpdateStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		rotation   *dbapi.ClusterRotation
+		status     dbapi.ClusterRotationStatus
+		setupFn    func()
+		wantErr    bool
+		wantStatus dbapi.ClusterRotationStatus
+	}{
+		{
+			name:       "should move a provisioning rotation to draining",
+			rotation:   &dbapi.ClusterRotation{Meta: api.Meta{ID: "rotation-id"}, Status: dbapi.ClusterRotationStatusProvisioning},
+			status:     dbapi.ClusterRotationStatusDraining,
+			setupFn:    func() { mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "cluster_rotations"`).WithRowsNum(1) },
+			wantErr:    false,
+			wantStatus: dbapi.ClusterRotationStatusDraining,
+		},
+		{
+			name:       "should not update a completed rotation",
+			rotation:   &dbapi.ClusterRotation{Meta: api.Meta{ID: "rotation-id"}, Status: dbapi.ClusterRotationStatusCompleted},
+			status:     dbapi.ClusterRotationStatusFailed,
+			setupFn:    func() { mocket.Catcher.Reset() },
+			wantErr:    true,
+			wantStatus: dbapi.ClusterRotationStatusCompleted,
+		},
+		{
+			name:       "should return an error if the rotation has been updated concurrently",
+			rotation:   &dbapi.ClusterRotation{Meta: api.Meta{ID: "rotation-id"}, Status: dbapi.ClusterRotationStatusDraining},
+			status:     dbapi.ClusterRotationStatusCompleted,
+			setupFn:    func() { mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "cluster_rotations"`).WithRowsNum(0) },
+			wantErr:    true,
+			wantStatus: dbapi.ClusterRotationStatusDraining,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := NewClusterRotationService(db.NewMockConnectionFactory(nil))
+			err := c.UpdateStatus(tt.rotation, tt.status, "reason")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(tt.rotation.Status).To(gomega.Equal(tt.wantStatus))
+		})
+	}
+}
+
+func Test_clusterRotationService_ListCandidates(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantErr bool
+		want    []string
+	}{
+		{
+			name: "should return the clusters that can be rotated",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters" WHERE status = $1 AND provider_type = $2 AND cluster_type != $3 AND unschedulable = $4`).
+					WithReply([]map[string]interface{}{{"cluster_id": "cluster-1"}, {"cluster_id": "cluster-2"}})
+			},
+			wantErr: false,
+			want:    []string{"cluster-1", "cluster-2"},
+		},
+		{
+			name: "should return an error if listing the clusters fails",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters"`).WithQueryException()
+			},
+			wantErr: true,
+			want:    nil,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := NewClusterRotationService(db.NewMockConnectionFactory(nil))
+			clusters, err := c.ListCandidates(time.Now().Add(-24 * time.Hour))
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			var clusterIDs []string
+			for _, cluster := range clusters {
+				clusterIDs = append(clusterIDs, cluster.ClusterID)
+			}
+			g.Expect(clusterIDs).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_clusterRotationService_ListCandidates_ExcludesRecentlyFailedRotations(t *testing.T) {
+	g := gomega.NewWithT(t)
+	failedRotationsSince := time.Now().Add(-24 * time.Hour)
+
+	var query string
+	var args []interface{}
+	mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters"`).
+		WithCallback(func(q string, namedArgs []driver.NamedValue) {
+			query = q
+			for _, arg := range namedArgs {
+				args = append(args, arg.Value)
+			}
+		})
+
+	c := NewClusterRotationService(db.NewMockConnectionFactory(nil))
+	_, err := c.ListCandidates(failedRotationsSince)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(query).To(gomega.ContainSubstring(`updated_at >`))
+	g.Expect(args).To(gomega.ContainElements(dbapi.ClusterRotationStatusFailed.String(), failedRotationsSince))
+}
+
+func Test_clusterRotationService_ListCandidates_ExcludesClustersHoldingData(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	var query string
+	var args []interface{}
+	mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters"`).
+		WithCallback(func(q string, namedArgs []driver.NamedValue) {
+			query = q
+			for _, arg := range namedArgs {
+				args = append(args, arg.Value)
+			}
+		})
+
+	c := NewClusterRotationService(db.NewMockConnectionFactory(nil))
+	_, err := c.ListCandidates(time.Now())
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(query).To(gomega.ContainSubstring(`SELECT "cluster_id" FROM "kafka_requests"`))
+	for _, status := range KafkaStatusesHoldingData {
+		g.Expect(args).To(gomega.ContainElement(status))
+	}
+}
+
+func Test_clusterRotationService_FindReplacementCluster(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantErr bool
+		want    *api.Cluster
+	}{
+		{
+			name: "should return the replacement cluster of the rotation",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters" WHERE id = $1`).
+					WithReply([]map[string]interface{}{{"id": "replacement-id", "cluster_id": "replacement-cluster-id"}})
+			},
+			wantErr: false,
+			want:    &api.Cluster{Meta: api.Meta{ID: "replacement-id"}, ClusterID: "replacement-cluster-id"},
+		},
+		{
+			name: "should return nil if the replacement cluster no longer exists",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: false,
+			want:    nil,
+		},
+		{
+			name: "should return an error if finding the replacement cluster fails",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters"`).WithQueryException()
+			},
+			wantErr: true,
+			want:    nil,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := NewClusterRotationService(db.NewMockConnectionFactory(nil))
+			cluster, err := c.FindReplacementCluster(&dbapi.ClusterRotation{Meta: api.Meta{ID: "rotation-id"}, ReplacementID: "replacement-id"})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.want == nil {
+				g.Expect(cluster).To(gomega.BeNil())
+				return
+			}
+			g.Expect(cluster.ID).To(gomega.Equal(tt.want.ID))
+			g.Expect(cluster.ClusterID).To(gomega.Equal(tt.want.ClusterID))
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
@@ -35,6 +37,7 @@ type ClusterService interface {
 	// Update updates a Cluster. Only fields whose value is different than the
 	// zero-value of their corresponding type will be updated
 	Update(cluster api.Cluster) *apiErrors.ServiceError
+	// FindCluster returns the first schedulable cluster matching the criteria
 	FindCluster(criteria FindClusterCriteria) (*api.Cluster, error)
 	// FindClusterByID returns the cluster corresponding to the provided clusterID.
 	// If the cluster has not been found nil is returned. If there has been an issue
@@ -52,16 +55,35 @@ type ClusterService interface {
 	FindNonEmptyClusterByID(clusterID string) (*api.Cluster, *apiErrors.ServiceError)
 	// ListNonEnterpriseClusterIDs returns all the valid cluster ids in array (except enterprise clusters)
 	ListNonEnterpriseClusterIDs() ([]api.Cluster, *apiErrors.ServiceError)
-	// FindAllClusters return all the valid clusters in array
+	// ListEnterpriseClustersByOrganization returns a page of the enterprise clusters registered by the given organisation,
+	// most recently registered first
+	ListEnterpriseClustersByOrganization(organizationID string, listArgs *coreServices.ListArguments) ([]*api.Cluster, *api.PagingMeta, *apiErrors.ServiceError)
+	// List returns a page of all the data plane clusters, most recently created first
+	List(listArgs *coreServices.ListArguments) ([]*api.Cluster, *api.PagingMeta, *apiErrors.ServiceError)
+	// UpdateUnschedulable cordons or uncordons the given data plane cluster. Kafkas are no longer placed on unschedulable clusters
+	UpdateUnschedulable(clusterID string, unschedulable bool) *apiErrors.ServiceError
+	// RecordStatusReport records the time of a status report of the kas fleetshard operator of the given cluster and
+	// whether the operator is ready, and updates the given cluster accordingly
+	RecordStatusReport(cluster *api.Cluster, fleetshardOperatorReady bool) *apiErrors.ServiceError
+	// UpdateQuarantine quarantines the given ready or full data plane cluster for the given reason, or releases the given
+	// quarantined data plane cluster back to the status it had before being quarantined. Clusters in any other status
+	// are left untouched
+	UpdateQuarantine(clusterID string, quarantined bool, reason string) *apiErrors.ServiceError
+	// FindAllClusters return all the valid clusters in array. Unschedulable clusters are not returned
 	FindAllClusters(criteria FindClusterCriteria) ([]*api.Cluster, error)
 	// FindKafkaInstanceCount returns the kafka instance counts associated with the list of clusters. If the list is empty, it will list all clusterIDs that have Kafka instances assigned.
 	// Kafkas that are in deleting state won't be included in the count as they no longer consume resources in the data plane cluster.
 	FindKafkaInstanceCount(clusterIDs []string) ([]ResKafkaInstanceCount, error)
+	// FindKafkaInstanceCountByOrganisation returns the number of kafkas of the given organisation assigned to each data plane cluster.
+	// Kafkas that are in deleting state won't be included in the count as they no longer consume resources in the data plane cluster.
+	FindKafkaInstanceCountByOrganisation(organisationID string) ([]ResKafkaInstanceCount, error)
 	// UpdateMultiClusterStatus updates a list of clusters' status to a status
 	UpdateMultiClusterStatus(clusterIDs []string, status api.ClusterStatus) *apiErrors.ServiceError
 	// CountByStatus returns the count of clusters for each given status in the database
 	CountByStatus([]api.ClusterStatus) ([]ClusterStatusCount, *apiErrors.ServiceError)
 	CheckClusterStatus(cluster *api.Cluster) (*api.Cluster, *apiErrors.ServiceError)
+	// GetClusterVersion returns the OpenShift version of the cluster as reported by its provider. An empty version is returned when the provider cannot determine it
+	GetClusterVersion(cluster *api.Cluster) (string, *apiErrors.ServiceError)
 	// Delete will delete the cluster from the provider
 	Delete(cluster *api.Cluster) (bool, *apiErrors.ServiceError)
 	ConfigureAndSaveIdentityProvider(cluster *api.Cluster, identityProviderInfo types.IdentityProviderInfo) (*api.Cluster, *apiErrors.ServiceError)
@@ -76,6 +98,9 @@ type ClusterService interface {
 	// Data Plane clusters that are in 'failed' state are not included in the response.
 	// Kafkas that are in deleting state won't be included in the count as they no longer consume resources in the data plane cluster.
 	FindStreamingUnitCountByClusterAndInstanceType() (KafkaStreamingUnitCountPerClusterList, error)
+	// FindStreamingUnitsCreatedSince returns the streaming units of the kafkas created since the given time per cloud provider, region and instance type.
+	// Kafkas deleted since then are included as they were part of the demand.
+	FindStreamingUnitsCreatedSince(since time.Time) (KafkaStreamingUnitCreationList, error)
 }
 
 var _ ClusterService = &clusterService{}
@@ -287,6 +312,9 @@ func (c clusterService) FindCluster(criteria FindClusterCriteria) (*api.Cluster,
 		dbConn = dbConn.Where("supported_instance_type like ?", fmt.Sprintf("%%%s%%", criteria.SupportedInstanceType))
 	}
 
+	// unschedulable clusters are cordoned or being drained and cannot accept new kafkas
+	dbConn = dbConn.Where("unschedulable = ?", false)
+
 	// we order them by "created_at" field instead of the default "id" field.
 	// They are mostly the same as the library we use (xid) does take the generation timestamp into consideration,
 	// However, it only down to the level of seconds. This means that if a few records are created at almost the same time,
@@ -387,6 +415,146 @@ func (c clusterService) ListNonEnterpriseClusterIDs() ([]api.Cluster, *apiErrors
 	return res, nil
 }
 
+func (c clusterService) ListEnterpriseClustersByOrganization(organizationID string, listArgs *coreServices.ListArguments) ([]*api.Cluster, *api.PagingMeta, *apiErrors.ServiceError) {
+	var clusters []*api.Cluster
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	dbConn := c.connectionFactory.New().
+		Model(&api.Cluster{}).
+		Where("cluster_type = ?", api.Enterprise.String()).
+		Where("organization_id = ?", organizationID)
+
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Count(&total).Error; err != nil {
+		return nil, pagingMeta, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to count enterprise clusters")
+	}
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	if err := dbConn.Order("created_at desc").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&clusters).Error; err != nil {
+		return nil, pagingMeta, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list enterprise clusters")
+	}
+
+	return clusters, pagingMeta, nil
+}
+
+func (c clusterService) List(listArgs *coreServices.ListArguments) ([]*api.Cluster, *api.PagingMeta, *apiErrors.ServiceError) {
+	var clusters []*api.Cluster
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	dbConn := c.connectionFactory.New().Model(&api.Cluster{})
+
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Count(&total).Error; err != nil {
+		return nil, pagingMeta, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to count clusters")
+	}
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	if err := dbConn.Order("created_at desc").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&clusters).Error; err != nil {
+		return nil, pagingMeta, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list clusters")
+	}
+
+	return clusters, pagingMeta, nil
+}
+
+func (c clusterService) UpdateUnschedulable(clusterID string, unschedulable bool) *apiErrors.ServiceError {
+	if clusterID == "" {
+		return apiErrors.Validation("cluster_id is undefined")
+	}
+
+	result := c.connectionFactory.New().
+		Model(&api.Cluster{}).
+		Where("cluster_id = ?", clusterID).
+		Update("unschedulable", unschedulable)
+	if err := result.Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update unschedulable flag of cluster %q", clusterID)
+	}
+	if result.RowsAffected == 0 {
+		return apiErrors.NotFound("cluster with cluster_id='%v' not found", clusterID)
+	}
+
+	return nil
+}
+
+func (c clusterService) RecordStatusReport(cluster *api.Cluster, fleetshardOperatorReady bool) *apiErrors.ServiceError {
+	if cluster.ID == "" {
+		return apiErrors.Validation("id is undefined")
+	}
+
+	reportedAt := time.Now()
+	failedStatusReports, healthyStatusReports := 0, cluster.HealthyStatusReports+1
+	if !fleetshardOperatorReady {
+		failedStatusReports, healthyStatusReports = cluster.FailedStatusReports+1, 0
+	}
+
+	// a map is used so that the counters are reset to zero
+	if err := c.connectionFactory.New().
+		Model(&api.Cluster{}).
+		Where("id = ?", cluster.ID).
+		Updates(map[string]interface{}{
+			"status_reported_at":     reportedAt,
+			"failed_status_reports":  failedStatusReports,
+			"healthy_status_reports": healthyStatusReports,
+		}).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to record status report of cluster %q", cluster.ClusterID)
+	}
+
+	cluster.StatusReportedAt = &reportedAt
+	cluster.FailedStatusReports = failedStatusReports
+	cluster.HealthyStatusReports = healthyStatusReports
+	return nil
+}
+
+func (c clusterService) UpdateQuarantine(clusterID string, quarantined bool, reason string) *apiErrors.ServiceError {
+	if clusterID == "" {
+		return apiErrors.Validation("cluster_id is undefined")
+	}
+
+	// the previous status is read in the same statement so that a concurrent status change cannot be lost
+	fromStatuses := []string{api.ClusterQuarantined.String()}
+	changes := map[string]interface{}{
+		// clusters quarantined before their previous status was recorded are released back to ready
+		"status":                   gorm.Expr("COALESCE(NULLIF(status_before_quarantine, ''), ?)", api.ClusterReady.String()),
+		"status_before_quarantine": "",
+		"quarantine_reason":        "",
+	}
+	if quarantined {
+		fromStatuses = []string{api.ClusterReady.String(), api.ClusterFull.String()}
+		changes = map[string]interface{}{
+			"status":                   api.ClusterQuarantined.String(),
+			"status_before_quarantine": gorm.Expr("status"),
+			"quarantine_reason":        reason,
+		}
+	}
+
+	if err := c.connectionFactory.New().
+		Model(&api.Cluster{}).
+		Where("cluster_id = ?", clusterID).
+		Where("status in (?)", fromStatuses).
+		Updates(changes).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update quarantine of cluster %q", clusterID)
+	}
+
+	return nil
+}
+
 type ResKafkaInstanceCount struct {
 	Clusterid string
 	Count     int
@@ -449,6 +617,23 @@ func (c clusterService) FindKafkaInstanceCount(clusterIDs []string) ([]ResKafkaI
 	return res, nil
 }
 
+func (c clusterService) FindKafkaInstanceCountByOrganisation(organisationID string) ([]ResKafkaInstanceCount, error) {
+	var res []ResKafkaInstanceCount
+
+	if err := c.connectionFactory.New().
+		Model(&dbapi.KafkaRequest{}).
+		Select("cluster_id as Clusterid, count(1) as Count").
+		Where("organisation_id = ?", organisationID).
+		Where("cluster_id != ''").
+		Where("status not in (?)", kafkaStatusesThatNoLongerConsumeResourcesInTheDataPlane).
+		Group("cluster_id").
+		Scan(&res).Error; err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func (c clusterService) FindAllClusters(criteria FindClusterCriteria) ([]*api.Cluster, error) {
 	dbConn := c.connectionFactory.New().
 		Model(&api.Cluster{})
@@ -466,6 +651,9 @@ func (c clusterService) FindAllClusters(criteria FindClusterCriteria) ([]*api.Cl
 	if criteria.SupportedInstanceType != "" {
 		dbConn.Where("supported_instance_type like ?", fmt.Sprintf("%%%s%%", criteria.SupportedInstanceType))
 	}
+
+	// unschedulable clusters are cordoned or being drained and cannot accept new kafkas
+	dbConn.Where("unschedulable = ?", false)
 	// we order them by "created_at" field instead of the default "id" field.
 	// They are mostly the same as the library we use (xid) does take the generation timestamp into consideration,
 	// However, it only down to the level of seconds. This means that if a few records are created at almost the same time,
@@ -565,6 +753,19 @@ func (c clusterService) CheckClusterStatus(cluster *api.Cluster) (*api.Cluster,
 	return cluster, nil
 }
 
+func (c clusterService) GetClusterVersion(cluster *api.Cluster) (string, *apiErrors.ServiceError) {
+	p, err := c.providerFactory.GetProvider(cluster.ProviderType)
+	if err != nil {
+		return "", apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get provider implementation")
+	}
+
+	version, err := p.GetClusterVersion(buildClusterSpec(cluster))
+	if err != nil {
+		return "", apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get cluster version")
+	}
+	return version, nil
+}
+
 func (c clusterService) Delete(cluster *api.Cluster) (bool, *apiErrors.ServiceError) {
 	p, err := c.providerFactory.GetProvider(cluster.ProviderType)
 	if err != nil {
@@ -722,6 +923,27 @@ type KafkaPerClusterCount struct {
 	SizeId        string
 }
 
+// KafkaStreamingUnitCreation contains the streaming units of the kafkas created in a region for an instance type
+type KafkaStreamingUnitCreation struct {
+	CloudProvider  string
+	Region         string
+	InstanceType   string
+	StreamingUnits int
+}
+
+type KafkaStreamingUnitCreationList []KafkaStreamingUnitCreation
+
+// GetStreamingUnits returns the streaming units of the kafkas created in the given region for the given instance type
+func (l KafkaStreamingUnitCreationList) GetStreamingUnits(cloudProvider, region, instanceType string) int {
+	streamingUnits := 0
+	for _, creation := range l {
+		if creation.CloudProvider == cloudProvider && creation.Region == region && creation.InstanceType == instanceType {
+			streamingUnits += creation.StreamingUnits
+		}
+	}
+	return streamingUnits
+}
+
 type ClusterSelection struct {
 	CloudProvider         string
 	ID                    string
@@ -806,3 +1028,33 @@ func (c *clusterService) FindStreamingUnitCountByClusterAndInstanceType() (Kafka
 
 	return streamingUnitsCountPerCluster, nil
 }
+
+func (c *clusterService) FindStreamingUnitsCreatedSince(since time.Time) (KafkaStreamingUnitCreationList, error) {
+	var kafkasPerRegion []*KafkaPerClusterCount
+	if err := c.connectionFactory.New().
+		Unscoped().
+		Model(&dbapi.KafkaRequest{}).
+		Select("cloud_provider, region, count(1) as Count, size_id, instance_type").
+		Where("created_at >= ?", since).
+		Group("size_id, cloud_provider, region, instance_type").
+		Scan(&kafkasPerRegion).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to count the kafkas created since %s", since)
+	}
+
+	creations := KafkaStreamingUnitCreationList{}
+	for _, kafkaCount := range kafkasPerRegion {
+		instSize, err := c.kafkaConfig.GetKafkaInstanceSize(kafkaCount.InstanceType, kafkaCount.SizeId)
+		if err != nil {
+			return nil, err
+		}
+
+		creations = append(creations, KafkaStreamingUnitCreation{
+			CloudProvider:  kafkaCount.CloudProvider,
+			Region:         kafkaCount.Region,
+			InstanceType:   kafkaCount.InstanceType,
+			StreamingUnits: instSize.CapacityConsumed * int(kafkaCount.Count),
+		})
+	}
+
+	return creations, nil
+}
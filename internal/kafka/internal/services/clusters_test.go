@@ -15,6 +15,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	mocket "github.com/selvatico/go-mocket"
@@ -1087,6 +1088,294 @@ func Test_clusterService_ListNonEnterpriseClusterIDs(t *testing.T) {
 	}
 }
 
+func Test_clusterService_ListEnterpriseClustersByOrganization(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupFn   func()
+		wantIDs   []string
+		wantTotal int
+		wantErr   bool
+	}{
+		{
+			name: "should return the enterprise clusters of the organisation",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "clusters" WHERE cluster_type = $1 AND (organization_id = $2)`).WithReply([]map[string]interface{}{{"count": 1}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "clusters" WHERE cluster_type = $1 AND (organization_id = $2)`).WithReply([]map[string]interface{}{{"cluster_id": "test01"}})
+				mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			},
+			wantIDs:   []string{"test01"},
+			wantTotal: 1,
+		},
+		{
+			name: "should return an error when the clusters cannot be counted",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "clusters"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := &clusterService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			clusters, paging, err := c.ListEnterpriseClustersByOrganization("org-id", &coreServices.ListArguments{Page: 1, Size: 100})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+			var ids []string
+			for _, cluster := range clusters {
+				ids = append(ids, cluster.ClusterID)
+			}
+			g.Expect(ids).To(gomega.Equal(tt.wantIDs))
+			g.Expect(paging.Total).To(gomega.Equal(tt.wantTotal))
+		})
+	}
+}
+
+func Test_clusterService_List(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupFn   func()
+		wantIDs   []string
+		wantTotal int
+		wantErr   bool
+	}{
+		{
+			name: "should return a page of all the clusters",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "clusters"`).WithReply([]map[string]interface{}{{"count": 2}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "clusters"`).WithReply([]map[string]interface{}{{"cluster_id": "test02"}, {"cluster_id": "test01"}})
+				mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			},
+			wantIDs:   []string{"test02", "test01"},
+			wantTotal: 2,
+		},
+		{
+			name: "should return an error when the clusters cannot be listed",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "clusters"`).WithReply([]map[string]interface{}{{"count": 2}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "clusters"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := &clusterService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			clusters, paging, err := c.List(&coreServices.ListArguments{Page: 1, Size: 100})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+			var ids []string
+			for _, cluster := range clusters {
+				ids = append(ids, cluster.ClusterID)
+			}
+			g.Expect(ids).To(gomega.Equal(tt.wantIDs))
+			g.Expect(paging.Total).To(gomega.Equal(tt.wantTotal))
+		})
+	}
+}
+
+func Test_clusterService_UpdateUnschedulable(t *testing.T) {
+	tests := []struct {
+		name      string
+		clusterID string
+		setupFn   func()
+		wantErr   *apiErrors.ServiceError
+	}{
+		{
+			name:      "should update the unschedulable flag of the cluster",
+			clusterID: "test01",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "clusters" SET "unschedulable"=$1`).WithRowsNum(1)
+			},
+		},
+		{
+			name:      "should return not found if the cluster does not exist",
+			clusterID: "test01",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "clusters" SET "unschedulable"=$1`).WithRowsNum(0)
+			},
+			wantErr: apiErrors.NotFound("cluster with cluster_id='test01' not found"),
+		},
+		{
+			name:      "should return an error when the cluster id is undefined",
+			clusterID: "",
+			setupFn:   func() { mocket.Catcher.Reset() },
+			wantErr:   apiErrors.Validation("cluster_id is undefined"),
+		},
+		{
+			name:      "should return an error when the update fails",
+			clusterID: "test01",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "clusters"`).WithExecException()
+			},
+			wantErr: apiErrors.GeneralError("failed to update unschedulable flag of cluster \"test01\""),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := &clusterService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			err := c.UpdateUnschedulable(tt.clusterID, true)
+			if tt.wantErr == nil {
+				g.Expect(err).To(gomega.BeNil())
+				return
+			}
+			g.Expect(err).ToNot(gomega.BeNil())
+			g.Expect(err.Code).To(gomega.Equal(tt.wantErr.Code))
+		})
+	}
+}
+
+func Test_clusterService_RecordStatusReport(t *testing.T) {
+	tests := []struct {
+		name                     string
+		cluster                  *api.Cluster
+		fleetshardOperatorReady  bool
+		setupFn                  func()
+		wantFailedStatusReports  int
+		wantHealthyStatusReports int
+		wantErr                  *apiErrors.ServiceError
+	}{
+		{
+			name:                     "should reset the failed status reports when the fleetshard operator is ready",
+			cluster:                  &api.Cluster{Meta: api.Meta{ID: "id"}, FailedStatusReports: 2, HealthyStatusReports: 1},
+			fleetshardOperatorReady:  true,
+			setupFn:                  func() { mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "clusters" SET`).WithRowsNum(1) },
+			wantFailedStatusReports:  0,
+			wantHealthyStatusReports: 2,
+		},
+		{
+			name:                     "should reset the healthy status reports when the fleetshard operator is not ready",
+			cluster:                  &api.Cluster{Meta: api.Meta{ID: "id"}, FailedStatusReports: 2, HealthyStatusReports: 1},
+			fleetshardOperatorReady:  false,
+			setupFn:                  func() { mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "clusters" SET`).WithRowsNum(1) },
+			wantFailedStatusReports:  3,
+			wantHealthyStatusReports: 0,
+		},
+		{
+			name:    "should return an error when the id is undefined",
+			cluster: &api.Cluster{},
+			setupFn: func() { mocket.Catcher.Reset() },
+			wantErr: apiErrors.Validation("id is undefined"),
+		},
+		{
+			name:    "should return an error when the update fails",
+			cluster: &api.Cluster{Meta: api.Meta{ID: "id"}, ClusterID: "test01"},
+			setupFn: func() { mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "clusters"`).WithExecException() },
+			wantErr: apiErrors.GeneralError("failed to record status report of cluster \"test01\""),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := &clusterService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			err := c.RecordStatusReport(tt.cluster, tt.fleetshardOperatorReady)
+			if tt.wantErr != nil {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErr.Code))
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(tt.cluster.StatusReportedAt).ToNot(gomega.BeNil())
+			g.Expect(tt.cluster.FailedStatusReports).To(gomega.Equal(tt.wantFailedStatusReports))
+			g.Expect(tt.cluster.HealthyStatusReports).To(gomega.Equal(tt.wantHealthyStatusReports))
+		})
+	}
+}
+
+func Test_clusterService_UpdateQuarantine(t *testing.T) {
+	tests := []struct {
+		name          string
+		clusterID     string
+		quarantined   bool
+		setupFn       func()
+		wantErr       *apiErrors.ServiceError
+		wantTriggered bool
+	}{
+		{
+			name:        "should quarantine the cluster and record its status",
+			clusterID:   "test01",
+			quarantined: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "clusters" SET "quarantine_reason"=$1,"status"=$2,"status_before_quarantine"=status`).WithRowsNum(1)
+			},
+			wantTriggered: true,
+		},
+		{
+			name:        "should release the cluster from quarantine to the status it had before",
+			clusterID:   "test01",
+			quarantined: false,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "clusters" SET "quarantine_reason"=$1,"status"=COALESCE(NULLIF(status_before_quarantine, ''), $2),"status_before_quarantine"=$3`).WithRowsNum(1)
+			},
+			wantTriggered: true,
+		},
+		{
+			name:      "should return an error when the cluster id is undefined",
+			clusterID: "",
+			setupFn:   func() { mocket.Catcher.Reset() },
+			wantErr:   apiErrors.Validation("cluster_id is undefined"),
+		},
+		{
+			name:        "should return an error when the update fails",
+			clusterID:   "test01",
+			quarantined: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "clusters"`).WithExecException()
+			},
+			wantErr: apiErrors.GeneralError("failed to update quarantine of cluster \"test01\""),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := &clusterService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			err := c.UpdateQuarantine(tt.clusterID, tt.quarantined, "heartbeat timed out")
+			if tt.wantErr == nil {
+				g.Expect(err).To(gomega.BeNil())
+				g.Expect(mocket.Catcher.Mocks[0].Triggered).To(gomega.Equal(tt.wantTriggered))
+				return
+			}
+			g.Expect(err).ToNot(gomega.BeNil())
+			g.Expect(err.Code).To(gomega.Equal(tt.wantErr.Code))
+		})
+	}
+}
+
 func Test_clusterService_FindKafkaInstanceCount(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -1171,6 +1460,56 @@ func Test_clusterService_FindKafkaInstanceCount(t *testing.T) {
 	}
 }
 
+func Test_clusterService_FindKafkaInstanceCountByOrganisation(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    []ResKafkaInstanceCount
+		wantErr bool
+		setupFn func()
+	}{
+		{
+			name: "should return the number of kafkas of the organisation per cluster",
+			want: []ResKafkaInstanceCount{
+				{
+					Clusterid: "test01",
+					Count:     2,
+				},
+			},
+			setupFn: func() {
+				counters := []map[string]interface{}{
+					{
+						"clusterid": "test01",
+						"count":     2,
+					},
+				}
+				mocket.Catcher.Reset().NewMock().WithQuery(`WHERE organisation_id = $1`).WithQuery(`GROUP BY "cluster_id"`).WithReply(counters)
+			},
+		},
+		{
+			name:    "should return an error when the query fails",
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT`).WithQueryException()
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			c := clusterService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			got, err := c.FindKafkaInstanceCountByOrganisation("organisation-id")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
 func Test_clusterService_FindAllClusters(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -1547,6 +1886,78 @@ func Test_clusterService_CheckClusterStatus(t *testing.T) {
 	}
 }
 
+func Test_clusterService_GetClusterVersion(t *testing.T) {
+	type fields struct {
+		clusterProviderFactory clusters.ProviderFactory
+	}
+
+	cluster := &api.Cluster{
+		ClusterID:    "test-internal-id",
+		ProviderType: api.ClusterProviderOCM,
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "should return the version of the cluster reported by the provider",
+			fields: fields{
+				clusterProviderFactory: &clusters.ProviderFactoryMock{GetProviderFunc: func(providerType api.ClusterProviderType) (clusters.Provider, error) {
+					return &clusters.ProviderMock{
+						GetClusterVersionFunc: func(spec *types.ClusterSpec) (string, error) {
+							return "4.11.9", nil
+						},
+					}, nil
+				}},
+			},
+			want:    "4.11.9",
+			wantErr: false,
+		},
+		{
+			name: "should return an error when the provider fails to get the version of the cluster",
+			fields: fields{
+				clusterProviderFactory: &clusters.ProviderFactoryMock{GetProviderFunc: func(providerType api.ClusterProviderType) (clusters.Provider, error) {
+					return &clusters.ProviderMock{
+						GetClusterVersionFunc: func(spec *types.ClusterSpec) (string, error) {
+							return "", errors.Errorf("failed to get cluster")
+						},
+					}, nil
+				}},
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the cloud provider cannot be obtained",
+			fields: fields{
+				clusterProviderFactory: &clusters.ProviderFactoryMock{GetProviderFunc: func(providerType api.ClusterProviderType) (clusters.Provider, error) {
+					return nil, errors.New("failed to get provider implementation")
+				}},
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			c := &clusterService{
+				providerFactory: tt.fields.clusterProviderFactory,
+			}
+
+			got, err := c.GetClusterVersion(cluster)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
 func Test_clusterService_RemoveClusterFromProvider(t *testing.T) {
 	type fields struct {
 		connectionFactory      *db.ConnectionFactory
@@ -2806,3 +3217,93 @@ func Test_clusterService_FindStreamingUnitCountByClusterAndInstanceType(t *testi
 		})
 	}
 }
+
+func Test_clusterService_FindStreamingUnitsCreatedSince(t *testing.T) {
+	supportedInstanceTypeConfig := config.KafkaSupportedInstanceTypesConfig{
+		Configuration: config.SupportedKafkaInstanceTypesConfig{
+			SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+				{
+					Id: "standard",
+					Sizes: []config.KafkaInstanceSize{
+						*instanceTypesMocks.BuildKafkaInstanceSize(func(kis *config.KafkaInstanceSize) {
+							kis.Id = "x1"
+							kis.CapacityConsumed = 1
+						}),
+						*instanceTypesMocks.BuildKafkaInstanceSize(func(kis *config.KafkaInstanceSize) {
+							kis.Id = "x2"
+							kis.CapacityConsumed = 2
+						}),
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		wantErr   bool
+		want      KafkaStreamingUnitCreationList
+		setupFunc func()
+	}{
+		{
+			name:    "return an error when the kafkas query fails",
+			wantErr: true,
+			setupFunc: func() {
+				mocket.Catcher.Reset().
+					NewMock().
+					WithQuery(`SELECT cloud_provider, region, count(1) as Count, size_id, instance_type FROM "kafka_requests"`).
+					WithQueryException().
+					WithExecException()
+			},
+		},
+		{
+			name:    "return an error when the size of the kafkas is not supported",
+			wantErr: true,
+			setupFunc: func() {
+				mocket.Catcher.Reset().
+					NewMock().
+					WithQuery(`SELECT cloud_provider, region, count(1) as Count, size_id, instance_type FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{
+						{"cloud_provider": "aws", "region": "us-east-1", "count": 1, "size_id": "unsupported", "instance_type": "standard"},
+					})
+			},
+		},
+		{
+			name: "return the streaming units of the kafkas created per region and instance type",
+			setupFunc: func() {
+				mocket.Catcher.Reset().
+					NewMock().
+					WithQuery(`SELECT cloud_provider, region, count(1) as Count, size_id, instance_type FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{
+						{"cloud_provider": "aws", "region": "us-east-1", "count": 3, "size_id": "x1", "instance_type": "standard"},
+						{"cloud_provider": "aws", "region": "us-east-1", "count": 2, "size_id": "x2", "instance_type": "standard"},
+					})
+			},
+			want: KafkaStreamingUnitCreationList{
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", StreamingUnits: 3},
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", StreamingUnits: 4},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFunc()
+			c := &clusterService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				kafkaConfig: &config.KafkaConfig{
+					SupportedInstanceTypes: &supportedInstanceTypeConfig,
+				},
+			}
+			creations, err := c.FindStreamingUnitsCreatedSince(time.Now().Add(-time.Hour))
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(creations).To(gomega.Equal(tt.want))
+				g.Expect(creations.GetStreamingUnits("aws", "us-east-1", "standard")).To(gomega.Equal(7))
+				g.Expect(creations.GetStreamingUnits("aws", "eu-west-1", "standard")).To(gomega.Equal(0))
+			}
+		})
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
 	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"sync"
+	"time"
 )
 
 // Ensure, that ClusterServiceMock does implement ClusterService.
@@ -57,18 +59,27 @@ var _ ClusterService = &ClusterServiceMock{}
 //			FindKafkaInstanceCountFunc: func(clusterIDs []string) ([]ResKafkaInstanceCount, error) {
 //				panic("mock out the FindKafkaInstanceCount method")
 //			},
+//			FindKafkaInstanceCountByOrganisationFunc: func(organisationID string) ([]ResKafkaInstanceCount, error) {
+//				panic("mock out the FindKafkaInstanceCountByOrganisation method")
+//			},
 //			FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
 //				panic("mock out the FindNonEmptyClusterByID method")
 //			},
 //			FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
 //				panic("mock out the FindStreamingUnitCountByClusterAndInstanceType method")
 //			},
+//			FindStreamingUnitsCreatedSinceFunc: func(since time.Time) (KafkaStreamingUnitCreationList, error) {
+//				panic("mock out the FindStreamingUnitsCreatedSince method")
+//			},
 //			GetClientIDFunc: func(clusterID string) (string, error) {
 //				panic("mock out the GetClientID method")
 //			},
 //			GetClusterDNSFunc: func(clusterID string) (string, *apiErrors.ServiceError) {
 //				panic("mock out the GetClusterDNS method")
 //			},
+//			GetClusterVersionFunc: func(cluster *api.Cluster) (string, *apiErrors.ServiceError) {
+//				panic("mock out the GetClusterVersion method")
+//			},
 //			GetExternalIDFunc: func(clusterID string) (string, *apiErrors.ServiceError) {
 //				panic("mock out the GetExternalID method")
 //			},
@@ -81,15 +92,24 @@ var _ ClusterService = &ClusterServiceMock{}
 //			IsStrimziKafkaVersionAvailableInClusterFunc: func(cluster *api.Cluster, strimziVersion string, kafkaVersion string, ibpVersion string) (bool, error) {
 //				panic("mock out the IsStrimziKafkaVersionAvailableInCluster method")
 //			},
+//			ListFunc: func(listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *apiErrors.ServiceError) {
+//				panic("mock out the List method")
+//			},
 //			ListByStatusFunc: func(state api.ClusterStatus) ([]api.Cluster, *apiErrors.ServiceError) {
 //				panic("mock out the ListByStatus method")
 //			},
+//			ListEnterpriseClustersByOrganizationFunc: func(organizationID string, listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *apiErrors.ServiceError) {
+//				panic("mock out the ListEnterpriseClustersByOrganization method")
+//			},
 //			ListGroupByProviderAndRegionFunc: func(providers []string, regions []string, status []string) ([]*ResGroupCPRegion, *apiErrors.ServiceError) {
 //				panic("mock out the ListGroupByProviderAndRegion method")
 //			},
 //			ListNonEnterpriseClusterIDsFunc: func() ([]api.Cluster, *apiErrors.ServiceError) {
 //				panic("mock out the ListNonEnterpriseClusterIDs method")
 //			},
+//			RecordStatusReportFunc: func(cluster *api.Cluster, fleetshardOperatorReady bool) *apiErrors.ServiceError {
+//				panic("mock out the RecordStatusReport method")
+//			},
 //			RegisterClusterJobFunc: func(clusterRequest *api.Cluster) *apiErrors.ServiceError {
 //				panic("mock out the RegisterClusterJob method")
 //			},
@@ -99,9 +119,15 @@ var _ ClusterService = &ClusterServiceMock{}
 //			UpdateMultiClusterStatusFunc: func(clusterIDs []string, status api.ClusterStatus) *apiErrors.ServiceError {
 //				panic("mock out the UpdateMultiClusterStatus method")
 //			},
+//			UpdateQuarantineFunc: func(clusterID string, quarantined bool, reason string) *apiErrors.ServiceError {
+//				panic("mock out the UpdateQuarantine method")
+//			},
 //			UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
 //				panic("mock out the UpdateStatus method")
 //			},
+//			UpdateUnschedulableFunc: func(clusterID string, unschedulable bool) *apiErrors.ServiceError {
+//				panic("mock out the UpdateUnschedulable method")
+//			},
 //		}
 //
 //		// use mockedClusterService in code that requires ClusterService
@@ -145,18 +171,27 @@ type ClusterServiceMock struct {
 	// FindKafkaInstanceCountFunc mocks the FindKafkaInstanceCount method.
 	FindKafkaInstanceCountFunc func(clusterIDs []string) ([]ResKafkaInstanceCount, error)
 
+	// FindKafkaInstanceCountByOrganisationFunc mocks the FindKafkaInstanceCountByOrganisation method.
+	FindKafkaInstanceCountByOrganisationFunc func(organisationID string) ([]ResKafkaInstanceCount, error)
+
 	// FindNonEmptyClusterByIDFunc mocks the FindNonEmptyClusterByID method.
 	FindNonEmptyClusterByIDFunc func(clusterID string) (*api.Cluster, *apiErrors.ServiceError)
 
 	// FindStreamingUnitCountByClusterAndInstanceTypeFunc mocks the FindStreamingUnitCountByClusterAndInstanceType method.
 	FindStreamingUnitCountByClusterAndInstanceTypeFunc func() (KafkaStreamingUnitCountPerClusterList, error)
 
+	// FindStreamingUnitsCreatedSinceFunc mocks the FindStreamingUnitsCreatedSince method.
+	FindStreamingUnitsCreatedSinceFunc func(since time.Time) (KafkaStreamingUnitCreationList, error)
+
 	// GetClientIDFunc mocks the GetClientID method.
 	GetClientIDFunc func(clusterID string) (string, error)
 
 	// GetClusterDNSFunc mocks the GetClusterDNS method.
 	GetClusterDNSFunc func(clusterID string) (string, *apiErrors.ServiceError)
 
+	// GetClusterVersionFunc mocks the GetClusterVersion method.
+	GetClusterVersionFunc func(cluster *api.Cluster) (string, *apiErrors.ServiceError)
+
 	// GetExternalIDFunc mocks the GetExternalID method.
 	GetExternalIDFunc func(clusterID string) (string, *apiErrors.ServiceError)
 
@@ -169,15 +204,24 @@ type ClusterServiceMock struct {
 	// IsStrimziKafkaVersionAvailableInClusterFunc mocks the IsStrimziKafkaVersionAvailableInCluster method.
 	IsStrimziKafkaVersionAvailableInClusterFunc func(cluster *api.Cluster, strimziVersion string, kafkaVersion string, ibpVersion string) (bool, error)
 
+	// ListFunc mocks the List method.
+	ListFunc func(listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *apiErrors.ServiceError)
+
 	// ListByStatusFunc mocks the ListByStatus method.
 	ListByStatusFunc func(state api.ClusterStatus) ([]api.Cluster, *apiErrors.ServiceError)
 
+	// ListEnterpriseClustersByOrganizationFunc mocks the ListEnterpriseClustersByOrganization method.
+	ListEnterpriseClustersByOrganizationFunc func(organizationID string, listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *apiErrors.ServiceError)
+
 	// ListGroupByProviderAndRegionFunc mocks the ListGroupByProviderAndRegion method.
 	ListGroupByProviderAndRegionFunc func(providers []string, regions []string, status []string) ([]*ResGroupCPRegion, *apiErrors.ServiceError)
 
 	// ListNonEnterpriseClusterIDsFunc mocks the ListNonEnterpriseClusterIDs method.
 	ListNonEnterpriseClusterIDsFunc func() ([]api.Cluster, *apiErrors.ServiceError)
 
+	// RecordStatusReportFunc mocks the RecordStatusReport method.
+	RecordStatusReportFunc func(cluster *api.Cluster, fleetshardOperatorReady bool) *apiErrors.ServiceError
+
 	// RegisterClusterJobFunc mocks the RegisterClusterJob method.
 	RegisterClusterJobFunc func(clusterRequest *api.Cluster) *apiErrors.ServiceError
 
@@ -187,9 +231,15 @@ type ClusterServiceMock struct {
 	// UpdateMultiClusterStatusFunc mocks the UpdateMultiClusterStatus method.
 	UpdateMultiClusterStatusFunc func(clusterIDs []string, status api.ClusterStatus) *apiErrors.ServiceError
 
+	// UpdateQuarantineFunc mocks the UpdateQuarantine method.
+	UpdateQuarantineFunc func(clusterID string, quarantined bool, reason string) *apiErrors.ServiceError
+
 	// UpdateStatusFunc mocks the UpdateStatus method.
 	UpdateStatusFunc func(cluster api.Cluster, status api.ClusterStatus) error
 
+	// UpdateUnschedulableFunc mocks the UpdateUnschedulable method.
+	UpdateUnschedulableFunc func(clusterID string, unschedulable bool) *apiErrors.ServiceError
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// ApplyResources holds details about calls to the ApplyResources method.
@@ -258,6 +308,11 @@ type ClusterServiceMock struct {
 			// ClusterIDs is the clusterIDs argument value.
 			ClusterIDs []string
 		}
+		// FindKafkaInstanceCountByOrganisation holds details about calls to the FindKafkaInstanceCountByOrganisation method.
+		FindKafkaInstanceCountByOrganisation []struct {
+			// OrganisationID is the organisationID argument value.
+			OrganisationID string
+		}
 		// FindNonEmptyClusterByID holds details about calls to the FindNonEmptyClusterByID method.
 		FindNonEmptyClusterByID []struct {
 			// ClusterID is the clusterID argument value.
@@ -266,6 +321,11 @@ type ClusterServiceMock struct {
 		// FindStreamingUnitCountByClusterAndInstanceType holds details about calls to the FindStreamingUnitCountByClusterAndInstanceType method.
 		FindStreamingUnitCountByClusterAndInstanceType []struct {
 		}
+		// FindStreamingUnitsCreatedSince holds details about calls to the FindStreamingUnitsCreatedSince method.
+		FindStreamingUnitsCreatedSince []struct {
+			// Since is the since argument value.
+			Since time.Time
+		}
 		// GetClientID holds details about calls to the GetClientID method.
 		GetClientID []struct {
 			// ClusterID is the clusterID argument value.
@@ -276,6 +336,11 @@ type ClusterServiceMock struct {
 			// ClusterID is the clusterID argument value.
 			ClusterID string
 		}
+		// GetClusterVersion holds details about calls to the GetClusterVersion method.
+		GetClusterVersion []struct {
+			// Cluster is the cluster argument value.
+			Cluster *api.Cluster
+		}
 		// GetExternalID holds details about calls to the GetExternalID method.
 		GetExternalID []struct {
 			// ClusterID is the clusterID argument value.
@@ -304,11 +369,23 @@ type ClusterServiceMock struct {
 			// IbpVersion is the ibpVersion argument value.
 			IbpVersion string
 		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// ListArgs is the listArgs argument value.
+			ListArgs *services.ListArguments
+		}
 		// ListByStatus holds details about calls to the ListByStatus method.
 		ListByStatus []struct {
 			// State is the state argument value.
 			State api.ClusterStatus
 		}
+		// ListEnterpriseClustersByOrganization holds details about calls to the ListEnterpriseClustersByOrganization method.
+		ListEnterpriseClustersByOrganization []struct {
+			// OrganizationID is the organizationID argument value.
+			OrganizationID string
+			// ListArgs is the listArgs argument value.
+			ListArgs *services.ListArguments
+		}
 		// ListGroupByProviderAndRegion holds details about calls to the ListGroupByProviderAndRegion method.
 		ListGroupByProviderAndRegion []struct {
 			// Providers is the providers argument value.
@@ -321,6 +398,13 @@ type ClusterServiceMock struct {
 		// ListNonEnterpriseClusterIDs holds details about calls to the ListNonEnterpriseClusterIDs method.
 		ListNonEnterpriseClusterIDs []struct {
 		}
+		// RecordStatusReport holds details about calls to the RecordStatusReport method.
+		RecordStatusReport []struct {
+			// Cluster is the cluster argument value.
+			Cluster *api.Cluster
+			// FleetshardOperatorReady is the fleetshardOperatorReady argument value.
+			FleetshardOperatorReady bool
+		}
 		// RegisterClusterJob holds details about calls to the RegisterClusterJob method.
 		RegisterClusterJob []struct {
 			// ClusterRequest is the clusterRequest argument value.
@@ -338,6 +422,15 @@ type ClusterServiceMock struct {
 			// Status is the status argument value.
 			Status api.ClusterStatus
 		}
+		// UpdateQuarantine holds details about calls to the UpdateQuarantine method.
+		UpdateQuarantine []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// Quarantined is the quarantined argument value.
+			Quarantined bool
+			// Reason is the reason argument value.
+			Reason string
+		}
 		// UpdateStatus holds details about calls to the UpdateStatus method.
 		UpdateStatus []struct {
 			// Cluster is the cluster argument value.
@@ -345,6 +438,13 @@ type ClusterServiceMock struct {
 			// Status is the status argument value.
 			Status api.ClusterStatus
 		}
+		// UpdateUnschedulable holds details about calls to the UpdateUnschedulable method.
+		UpdateUnschedulable []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// Unschedulable is the unschedulable argument value.
+			Unschedulable bool
+		}
 	}
 	lockApplyResources                                 sync.RWMutex
 	lockCheckClusterStatus                             sync.RWMutex
@@ -358,21 +458,29 @@ type ClusterServiceMock struct {
 	lockFindCluster                                    sync.RWMutex
 	lockFindClusterByID                                sync.RWMutex
 	lockFindKafkaInstanceCount                         sync.RWMutex
+	lockFindKafkaInstanceCountByOrganisation           sync.RWMutex
 	lockFindNonEmptyClusterByID                        sync.RWMutex
 	lockFindStreamingUnitCountByClusterAndInstanceType sync.RWMutex
+	lockFindStreamingUnitsCreatedSince                 sync.RWMutex
 	lockGetClientID                                    sync.RWMutex
 	lockGetClusterDNS                                  sync.RWMutex
+	lockGetClusterVersion                              sync.RWMutex
 	lockGetExternalID                                  sync.RWMutex
 	lockInstallClusterLogging                          sync.RWMutex
 	lockInstallStrimzi                                 sync.RWMutex
 	lockIsStrimziKafkaVersionAvailableInCluster        sync.RWMutex
+	lockList                                           sync.RWMutex
 	lockListByStatus                                   sync.RWMutex
+	lockListEnterpriseClustersByOrganization           sync.RWMutex
 	lockListGroupByProviderAndRegion                   sync.RWMutex
 	lockListNonEnterpriseClusterIDs                    sync.RWMutex
+	lockRecordStatusReport                             sync.RWMutex
 	lockRegisterClusterJob                             sync.RWMutex
 	lockUpdate                                         sync.RWMutex
 	lockUpdateMultiClusterStatus                       sync.RWMutex
+	lockUpdateQuarantine                               sync.RWMutex
 	lockUpdateStatus                                   sync.RWMutex
+	lockUpdateUnschedulable                            sync.RWMutex
 }
 
 // ApplyResources calls ApplyResourcesFunc.
@@ -771,6 +879,38 @@ func (mock *ClusterServiceMock) FindKafkaInstanceCountCalls() []struct {
 	return calls
 }
 
+// FindKafkaInstanceCountByOrganisation calls FindKafkaInstanceCountByOrganisationFunc.
+func (mock *ClusterServiceMock) FindKafkaInstanceCountByOrganisation(organisationID string) ([]ResKafkaInstanceCount, error) {
+	if mock.FindKafkaInstanceCountByOrganisationFunc == nil {
+		panic("ClusterServiceMock.FindKafkaInstanceCountByOrganisationFunc: method is nil but ClusterService.FindKafkaInstanceCountByOrganisation was just called")
+	}
+	callInfo := struct {
+		OrganisationID string
+	}{
+		OrganisationID: organisationID,
+	}
+	mock.lockFindKafkaInstanceCountByOrganisation.Lock()
+	mock.calls.FindKafkaInstanceCountByOrganisation = append(mock.calls.FindKafkaInstanceCountByOrganisation, callInfo)
+	mock.lockFindKafkaInstanceCountByOrganisation.Unlock()
+	return mock.FindKafkaInstanceCountByOrganisationFunc(organisationID)
+}
+
+// FindKafkaInstanceCountByOrganisationCalls gets all the calls that were made to FindKafkaInstanceCountByOrganisation.
+// Check the length with:
+//
+//	len(mockedClusterService.FindKafkaInstanceCountByOrganisationCalls())
+func (mock *ClusterServiceMock) FindKafkaInstanceCountByOrganisationCalls() []struct {
+	OrganisationID string
+} {
+	var calls []struct {
+		OrganisationID string
+	}
+	mock.lockFindKafkaInstanceCountByOrganisation.RLock()
+	calls = mock.calls.FindKafkaInstanceCountByOrganisation
+	mock.lockFindKafkaInstanceCountByOrganisation.RUnlock()
+	return calls
+}
+
 // FindNonEmptyClusterByID calls FindNonEmptyClusterByIDFunc.
 func (mock *ClusterServiceMock) FindNonEmptyClusterByID(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
 	if mock.FindNonEmptyClusterByIDFunc == nil {
@@ -830,6 +970,38 @@ func (mock *ClusterServiceMock) FindStreamingUnitCountByClusterAndInstanceTypeCa
 	return calls
 }
 
+// FindStreamingUnitsCreatedSince calls FindStreamingUnitsCreatedSinceFunc.
+func (mock *ClusterServiceMock) FindStreamingUnitsCreatedSince(since time.Time) (KafkaStreamingUnitCreationList, error) {
+	if mock.FindStreamingUnitsCreatedSinceFunc == nil {
+		panic("ClusterServiceMock.FindStreamingUnitsCreatedSinceFunc: method is nil but ClusterService.FindStreamingUnitsCreatedSince was just called")
+	}
+	callInfo := struct {
+		Since time.Time
+	}{
+		Since: since,
+	}
+	mock.lockFindStreamingUnitsCreatedSince.Lock()
+	mock.calls.FindStreamingUnitsCreatedSince = append(mock.calls.FindStreamingUnitsCreatedSince, callInfo)
+	mock.lockFindStreamingUnitsCreatedSince.Unlock()
+	return mock.FindStreamingUnitsCreatedSinceFunc(since)
+}
+
+// FindStreamingUnitsCreatedSinceCalls gets all the calls that were made to FindStreamingUnitsCreatedSince.
+// Check the length with:
+//
+//	len(mockedClusterService.FindStreamingUnitsCreatedSinceCalls())
+func (mock *ClusterServiceMock) FindStreamingUnitsCreatedSinceCalls() []struct {
+	Since time.Time
+} {
+	var calls []struct {
+		Since time.Time
+	}
+	mock.lockFindStreamingUnitsCreatedSince.RLock()
+	calls = mock.calls.FindStreamingUnitsCreatedSince
+	mock.lockFindStreamingUnitsCreatedSince.RUnlock()
+	return calls
+}
+
 // GetClientID calls GetClientIDFunc.
 func (mock *ClusterServiceMock) GetClientID(clusterID string) (string, error) {
 	if mock.GetClientIDFunc == nil {
@@ -894,6 +1066,38 @@ func (mock *ClusterServiceMock) GetClusterDNSCalls() []struct {
 	return calls
 }
 
+// GetClusterVersion calls GetClusterVersionFunc.
+func (mock *ClusterServiceMock) GetClusterVersion(cluster *api.Cluster) (string, *apiErrors.ServiceError) {
+	if mock.GetClusterVersionFunc == nil {
+		panic("ClusterServiceMock.GetClusterVersionFunc: method is nil but ClusterService.GetClusterVersion was just called")
+	}
+	callInfo := struct {
+		Cluster *api.Cluster
+	}{
+		Cluster: cluster,
+	}
+	mock.lockGetClusterVersion.Lock()
+	mock.calls.GetClusterVersion = append(mock.calls.GetClusterVersion, callInfo)
+	mock.lockGetClusterVersion.Unlock()
+	return mock.GetClusterVersionFunc(cluster)
+}
+
+// GetClusterVersionCalls gets all the calls that were made to GetClusterVersion.
+// Check the length with:
+//
+//	len(mockedClusterService.GetClusterVersionCalls())
+func (mock *ClusterServiceMock) GetClusterVersionCalls() []struct {
+	Cluster *api.Cluster
+} {
+	var calls []struct {
+		Cluster *api.Cluster
+	}
+	mock.lockGetClusterVersion.RLock()
+	calls = mock.calls.GetClusterVersion
+	mock.lockGetClusterVersion.RUnlock()
+	return calls
+}
+
 // GetExternalID calls GetExternalIDFunc.
 func (mock *ClusterServiceMock) GetExternalID(clusterID string) (string, *apiErrors.ServiceError) {
 	if mock.GetExternalIDFunc == nil {
@@ -1038,6 +1242,38 @@ func (mock *ClusterServiceMock) IsStrimziKafkaVersionAvailableInClusterCalls() [
 	return calls
 }
 
+// List calls ListFunc.
+func (mock *ClusterServiceMock) List(listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *apiErrors.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("ClusterServiceMock.ListFunc: method is nil but ClusterService.List was just called")
+	}
+	callInfo := struct {
+		ListArgs *services.ListArguments
+	}{
+		ListArgs: listArgs,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(listArgs)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedClusterService.ListCalls())
+func (mock *ClusterServiceMock) ListCalls() []struct {
+	ListArgs *services.ListArguments
+} {
+	var calls []struct {
+		ListArgs *services.ListArguments
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
 // ListByStatus calls ListByStatusFunc.
 func (mock *ClusterServiceMock) ListByStatus(state api.ClusterStatus) ([]api.Cluster, *apiErrors.ServiceError) {
 	if mock.ListByStatusFunc == nil {
@@ -1070,6 +1306,42 @@ func (mock *ClusterServiceMock) ListByStatusCalls() []struct {
 	return calls
 }
 
+// ListEnterpriseClustersByOrganization calls ListEnterpriseClustersByOrganizationFunc.
+func (mock *ClusterServiceMock) ListEnterpriseClustersByOrganization(organizationID string, listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *apiErrors.ServiceError) {
+	if mock.ListEnterpriseClustersByOrganizationFunc == nil {
+		panic("ClusterServiceMock.ListEnterpriseClustersByOrganizationFunc: method is nil but ClusterService.ListEnterpriseClustersByOrganization was just called")
+	}
+	callInfo := struct {
+		OrganizationID string
+		ListArgs       *services.ListArguments
+	}{
+		OrganizationID: organizationID,
+		ListArgs:       listArgs,
+	}
+	mock.lockListEnterpriseClustersByOrganization.Lock()
+	mock.calls.ListEnterpriseClustersByOrganization = append(mock.calls.ListEnterpriseClustersByOrganization, callInfo)
+	mock.lockListEnterpriseClustersByOrganization.Unlock()
+	return mock.ListEnterpriseClustersByOrganizationFunc(organizationID, listArgs)
+}
+
+// ListEnterpriseClustersByOrganizationCalls gets all the calls that were made to ListEnterpriseClustersByOrganization.
+// Check the length with:
+//
+//	len(mockedClusterService.ListEnterpriseClustersByOrganizationCalls())
+func (mock *ClusterServiceMock) ListEnterpriseClustersByOrganizationCalls() []struct {
+	OrganizationID string
+	ListArgs       *services.ListArguments
+} {
+	var calls []struct {
+		OrganizationID string
+		ListArgs       *services.ListArguments
+	}
+	mock.lockListEnterpriseClustersByOrganization.RLock()
+	calls = mock.calls.ListEnterpriseClustersByOrganization
+	mock.lockListEnterpriseClustersByOrganization.RUnlock()
+	return calls
+}
+
 // ListGroupByProviderAndRegion calls ListGroupByProviderAndRegionFunc.
 func (mock *ClusterServiceMock) ListGroupByProviderAndRegion(providers []string, regions []string, status []string) ([]*ResGroupCPRegion, *apiErrors.ServiceError) {
 	if mock.ListGroupByProviderAndRegionFunc == nil {
@@ -1137,6 +1409,42 @@ func (mock *ClusterServiceMock) ListNonEnterpriseClusterIDsCalls() []struct {
 	return calls
 }
 
+// RecordStatusReport calls RecordStatusReportFunc.
+func (mock *ClusterServiceMock) RecordStatusReport(cluster *api.Cluster, fleetshardOperatorReady bool) *apiErrors.ServiceError {
+	if mock.RecordStatusReportFunc == nil {
+		panic("ClusterServiceMock.RecordStatusReportFunc: method is nil but ClusterService.RecordStatusReport was just called")
+	}
+	callInfo := struct {
+		Cluster                 *api.Cluster
+		FleetshardOperatorReady bool
+	}{
+		Cluster:                 cluster,
+		FleetshardOperatorReady: fleetshardOperatorReady,
+	}
+	mock.lockRecordStatusReport.Lock()
+	mock.calls.RecordStatusReport = append(mock.calls.RecordStatusReport, callInfo)
+	mock.lockRecordStatusReport.Unlock()
+	return mock.RecordStatusReportFunc(cluster, fleetshardOperatorReady)
+}
+
+// RecordStatusReportCalls gets all the calls that were made to RecordStatusReport.
+// Check the length with:
+//
+//	len(mockedClusterService.RecordStatusReportCalls())
+func (mock *ClusterServiceMock) RecordStatusReportCalls() []struct {
+	Cluster                 *api.Cluster
+	FleetshardOperatorReady bool
+} {
+	var calls []struct {
+		Cluster                 *api.Cluster
+		FleetshardOperatorReady bool
+	}
+	mock.lockRecordStatusReport.RLock()
+	calls = mock.calls.RecordStatusReport
+	mock.lockRecordStatusReport.RUnlock()
+	return calls
+}
+
 // RegisterClusterJob calls RegisterClusterJobFunc.
 func (mock *ClusterServiceMock) RegisterClusterJob(clusterRequest *api.Cluster) *apiErrors.ServiceError {
 	if mock.RegisterClusterJobFunc == nil {
@@ -1237,6 +1545,46 @@ func (mock *ClusterServiceMock) UpdateMultiClusterStatusCalls() []struct {
 	return calls
 }
 
+// UpdateQuarantine calls UpdateQuarantineFunc.
+func (mock *ClusterServiceMock) UpdateQuarantine(clusterID string, quarantined bool, reason string) *apiErrors.ServiceError {
+	if mock.UpdateQuarantineFunc == nil {
+		panic("ClusterServiceMock.UpdateQuarantineFunc: method is nil but ClusterService.UpdateQuarantine was just called")
+	}
+	callInfo := struct {
+		ClusterID   string
+		Quarantined bool
+		Reason      string
+	}{
+		ClusterID:   clusterID,
+		Quarantined: quarantined,
+		Reason:      reason,
+	}
+	mock.lockUpdateQuarantine.Lock()
+	mock.calls.UpdateQuarantine = append(mock.calls.UpdateQuarantine, callInfo)
+	mock.lockUpdateQuarantine.Unlock()
+	return mock.UpdateQuarantineFunc(clusterID, quarantined, reason)
+}
+
+// UpdateQuarantineCalls gets all the calls that were made to UpdateQuarantine.
+// Check the length with:
+//
+//	len(mockedClusterService.UpdateQuarantineCalls())
+func (mock *ClusterServiceMock) UpdateQuarantineCalls() []struct {
+	ClusterID   string
+	Quarantined bool
+	Reason      string
+} {
+	var calls []struct {
+		ClusterID   string
+		Quarantined bool
+		Reason      string
+	}
+	mock.lockUpdateQuarantine.RLock()
+	calls = mock.calls.UpdateQuarantine
+	mock.lockUpdateQuarantine.RUnlock()
+	return calls
+}
+
 // UpdateStatus calls UpdateStatusFunc.
 func (mock *ClusterServiceMock) UpdateStatus(cluster api.Cluster, status api.ClusterStatus) error {
 	if mock.UpdateStatusFunc == nil {
@@ -1272,3 +1620,39 @@ func (mock *ClusterServiceMock) UpdateStatusCalls() []struct {
 	mock.lockUpdateStatus.RUnlock()
 	return calls
 }
+
+// UpdateUnschedulable calls UpdateUnschedulableFunc.
+func (mock *ClusterServiceMock) UpdateUnschedulable(clusterID string, unschedulable bool) *apiErrors.ServiceError {
+	if mock.UpdateUnschedulableFunc == nil {
+		panic("ClusterServiceMock.UpdateUnschedulableFunc: method is nil but ClusterService.UpdateUnschedulable was just called")
+	}
+	callInfo := struct {
+		ClusterID     string
+		Unschedulable bool
+	}{
+		ClusterID:     clusterID,
+		Unschedulable: unschedulable,
+	}
+	mock.lockUpdateUnschedulable.Lock()
+	mock.calls.UpdateUnschedulable = append(mock.calls.UpdateUnschedulable, callInfo)
+	mock.lockUpdateUnschedulable.Unlock()
+	return mock.UpdateUnschedulableFunc(clusterID, unschedulable)
+}
+
+// UpdateUnschedulableCalls gets all the calls that were made to UpdateUnschedulable.
+// Check the length with:
+//
+//	len(mockedClusterService.UpdateUnschedulableCalls())
+func (mock *ClusterServiceMock) UpdateUnschedulableCalls() []struct {
+	ClusterID     string
+	Unschedulable bool
+} {
+	var calls []struct {
+		ClusterID     string
+		Unschedulable bool
+	}
+	mock.lockUpdateUnschedulable.RLock()
+	calls = mock.calls.UpdateUnschedulable
+	mock.lockUpdateUnschedulable.RUnlock()
+	return calls
+}
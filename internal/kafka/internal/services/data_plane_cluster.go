@@ -29,6 +29,10 @@ var _ DataPlaneClusterService = &dataPlaneClusterService{}
 
 const dataPlaneClusterStatusCondReadyName = "Ready"
 
+// dataPlaneClusterOperatorNotReadyReason is the reason of the quarantine of the clusters reporting a kas fleetshard
+// operator that is not ready
+const dataPlaneClusterOperatorNotReadyReason = "kas fleetshard operator not ready"
+
 type dataPlaneClusterService struct {
 	di.Inject
 	ClusterService         ClusterService
@@ -86,7 +90,23 @@ func (d *dataPlaneClusterService) UpdateDataPlaneClusterStatus(ctx context.Conte
 	if err != nil {
 		return errors.ToServiceError(err)
 	}
+
+	if svcErr := d.ClusterService.RecordStatusReport(cluster, fleetShardOperatorReady); svcErr != nil {
+		return svcErr
+	}
+
 	if !fleetShardOperatorReady {
+		if d.clusterCanBeQuarantined(cluster) {
+			// kafkas must not be placed on the cluster as long as its operator is not ready, so the cluster is
+			// quarantined on the first failed status report. The cluster health manager releases it once it has recovered
+			glog.Infof("KAS Fleet Shard Operator not ready for Cluster ID '%s'. Failed status reports: %d", clusterID, cluster.FailedStatusReports)
+			if cluster.Status != api.ClusterQuarantined {
+				if svcErr := d.ClusterService.UpdateQuarantine(clusterID, true, dataPlaneClusterOperatorNotReadyReason); svcErr != nil {
+					return svcErr
+				}
+			}
+			return nil
+		}
 		if cluster.Status != api.ClusterWaitingForKasFleetShardOperator {
 			err := d.ClusterService.UpdateStatus(*cluster, api.ClusterWaitingForKasFleetShardOperator)
 			if err != nil {
@@ -153,7 +173,10 @@ func (d *dataPlaneClusterService) setClusterStatus(cluster *api.Cluster, status
 		metrics.UpdateClusterStatusSinceCreatedMetric(*cluster, api.ClusterReady)
 	}
 
-	cluster.Status = api.ClusterReady
+	// quarantined clusters are only released by the cluster health manager
+	if cluster.Status != api.ClusterQuarantined {
+		cluster.Status = api.ClusterReady
+	}
 
 	svcErr := d.ClusterService.Update(*cluster)
 
@@ -180,5 +203,13 @@ func (d *dataPlaneClusterService) isFleetShardOperatorReady(status *dbapi.DataPl
 func (d *dataPlaneClusterService) clusterCanProcessStatusReports(cluster *api.Cluster) bool {
 	return cluster.Status == api.ClusterReady ||
 		cluster.Status == api.ClusterFull ||
-		cluster.Status == api.ClusterWaitingForKasFleetShardOperator
+		cluster.Status == api.ClusterWaitingForKasFleetShardOperator ||
+		cluster.Status == api.ClusterQuarantined
+}
+
+// clusterCanBeQuarantined returns true when a failing status report of the given cluster should be left to the cluster
+// health manager instead of moving the cluster back to the waiting for kas fleetshard operator status
+func (d *dataPlaneClusterService) clusterCanBeQuarantined(cluster *api.Cluster) bool {
+	return d.DataplaneClusterConfig.ClusterHealthConfig.QuarantineEnabled &&
+		(cluster.Status == api.ClusterReady || cluster.Status == api.ClusterFull || cluster.Status == api.ClusterQuarantined)
 }
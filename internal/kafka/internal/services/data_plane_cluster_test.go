@@ -60,6 +60,9 @@ func Test_DataPlaneCluster_UpdateDataPlaneClusterStatus(t *testing.T) {
 							Status:    api.ClusterReady,
 						}, nil
 					},
+					RecordStatusReportFunc: func(cluster *api.Cluster, fleetshardOperatorReady bool) *errors.ServiceError {
+						return nil
+					},
 					UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
 						return nil
 					},
@@ -127,6 +130,9 @@ func Test_DataPlaneCluster_UpdateDataPlaneClusterStatus(t *testing.T) {
 							Status:    api.ClusterWaitingForKasFleetShardOperator,
 						}, nil
 					},
+					RecordStatusReportFunc: func(cluster *api.Cluster, fleetshardOperatorReady bool) *errors.ServiceError {
+						return nil
+					},
 					UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
 						return nil
 					},
@@ -137,6 +143,153 @@ func Test_DataPlaneCluster_UpdateDataPlaneClusterStatus(t *testing.T) {
 				return NewDataPlaneClusterService(sampleValidApplicationConfigForDataPlaneClusterTest(clusterService))
 			},
 		},
+		{
+			name:      "The cluster is quarantined on the first failed status report when quarantine is enabled and the fleet shard operator is not ready",
+			clusterID: testClusterID,
+			clusterStatus: &dbapi.DataPlaneClusterStatus{
+				Conditions: []dbapi.DataPlaneClusterStatusCondition{
+					{
+						Type:   "Ready",
+						Status: "False",
+					},
+				},
+			},
+			wantErr: false,
+			dataPlaneClusterServiceFactory: func() *dataPlaneClusterService {
+				clusterService := &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+						return &api.Cluster{
+							Meta: api.Meta{
+								ID: "id",
+							},
+							ClusterID: clusterID,
+							Status:    api.ClusterReady,
+						}, nil
+					},
+					RecordStatusReportFunc: func(cluster *api.Cluster, fleetshardOperatorReady bool) *errors.ServiceError {
+						return nil
+					},
+					UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
+						return errors.GeneralError("the status of the cluster should not be updated")
+					},
+					UpdateQuarantineFunc: func(clusterID string, quarantined bool, reason string) *errors.ServiceError {
+						if !quarantined || reason != dataPlaneClusterOperatorNotReadyReason {
+							return errors.GeneralError("the cluster should be quarantined")
+						}
+						return nil
+					},
+				}
+				c := sampleValidApplicationConfigForDataPlaneClusterTest(clusterService)
+				c.DataplaneClusterConfig.ClusterHealthConfig.QuarantineEnabled = true
+				return NewDataPlaneClusterService(c)
+			},
+		},
+		{
+			name:      "A quarantined cluster is left to the cluster health manager when the fleet shard operator is not ready",
+			clusterID: testClusterID,
+			clusterStatus: &dbapi.DataPlaneClusterStatus{
+				Conditions: []dbapi.DataPlaneClusterStatusCondition{
+					{
+						Type:   "Ready",
+						Status: "False",
+					},
+				},
+			},
+			wantErr: false,
+			dataPlaneClusterServiceFactory: func() *dataPlaneClusterService {
+				clusterService := &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+						return &api.Cluster{
+							Meta: api.Meta{
+								ID: "id",
+							},
+							ClusterID: clusterID,
+							Status:    api.ClusterQuarantined,
+						}, nil
+					},
+					RecordStatusReportFunc: func(cluster *api.Cluster, fleetshardOperatorReady bool) *errors.ServiceError {
+						return nil
+					},
+					UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
+						return errors.GeneralError("the status of the cluster should not be updated")
+					},
+					UpdateQuarantineFunc: func(clusterID string, quarantined bool, reason string) *errors.ServiceError {
+						return errors.GeneralError("the quarantine of the cluster should not be updated")
+					},
+				}
+				c := sampleValidApplicationConfigForDataPlaneClusterTest(clusterService)
+				c.DataplaneClusterConfig.ClusterHealthConfig.QuarantineEnabled = true
+				return NewDataPlaneClusterService(c)
+			},
+		},
+		{
+			name:      "An error is returned when the cluster cannot be quarantined",
+			clusterID: testClusterID,
+			clusterStatus: &dbapi.DataPlaneClusterStatus{
+				Conditions: []dbapi.DataPlaneClusterStatusCondition{
+					{
+						Type:   "Ready",
+						Status: "False",
+					},
+				},
+			},
+			wantErr: true,
+			dataPlaneClusterServiceFactory: func() *dataPlaneClusterService {
+				clusterService := &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+						return &api.Cluster{
+							Meta: api.Meta{
+								ID: "id",
+							},
+							ClusterID: clusterID,
+							Status:    api.ClusterFull,
+						}, nil
+					},
+					RecordStatusReportFunc: func(cluster *api.Cluster, fleetshardOperatorReady bool) *errors.ServiceError {
+						return nil
+					},
+					UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
+						return errors.GeneralError("the status of the cluster should not be updated")
+					},
+					UpdateQuarantineFunc: func(clusterID string, quarantined bool, reason string) *errors.ServiceError {
+						return errors.GeneralError("failed to update quarantine of cluster")
+					},
+				}
+				c := sampleValidApplicationConfigForDataPlaneClusterTest(clusterService)
+				c.DataplaneClusterConfig.ClusterHealthConfig.QuarantineEnabled = true
+				return NewDataPlaneClusterService(c)
+			},
+		},
+		{
+			name:      "An error is returned when the status report cannot be recorded",
+			clusterID: testClusterID,
+			clusterStatus: &dbapi.DataPlaneClusterStatus{
+				Conditions: []dbapi.DataPlaneClusterStatusCondition{
+					{
+						Type:   "Ready",
+						Status: "True",
+					},
+				},
+			},
+			wantErr: true,
+			dataPlaneClusterServiceFactory: func() *dataPlaneClusterService {
+				clusterService := &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+						return &api.Cluster{
+							Meta: api.Meta{
+								ID: "id",
+							},
+							ClusterID: clusterID,
+							Status:    api.ClusterReady,
+						}, nil
+					},
+					RecordStatusReportFunc: func(cluster *api.Cluster, fleetshardOperatorReady bool) *errors.ServiceError {
+						return errors.GeneralError("failed to record status report")
+					},
+				}
+				return NewDataPlaneClusterService(sampleValidApplicationConfigForDataPlaneClusterTest(clusterService))
+			},
+		},
 	}
 
 	for _, testcase := range tests {
@@ -533,6 +686,38 @@ func Test_DataPlaneCluster_setClusterStatus(t *testing.T) {
 			wantAvailableStrimziVersions: api.JSON([]byte(`[{"version":"1.0.0","ready":true,"kafkaVersions":[{"version":"3.0.1"}],"kafkaIBPVersions":[{"version":"3.0.1"}]}]`)),
 			wantErr:                      false,
 		},
+		{
+			name: "keep the quarantined status of the cluster",
+			inputFactory: func() *input {
+				apiCluster := &api.Cluster{
+					ClusterID:           testClusterID,
+					MultiAZ:             true,
+					Status:              api.ClusterQuarantined,
+					DynamicCapacityInfo: api.JSON([]byte(`{"key":{"max_nodes": 90}}`)),
+				}
+
+				clusterService := &ClusterServiceMock{
+					UpdateFunc: func(cluster api.Cluster) *errors.ServiceError {
+						return nil
+					},
+				}
+
+				testStatus := sampleValidBaseDataPlaneClusterStatusRequest()
+				c := sampleValidApplicationConfigForDataPlaneClusterTest(clusterService)
+				c.DataplaneClusterConfig.DataPlaneClusterScalingType = config.ManualScaling
+				dataPlaneClusterService := NewDataPlaneClusterService(c)
+				return &input{
+					status:                  testStatus,
+					cluster:                 apiCluster,
+					dataPlaneClusterService: dataPlaneClusterService,
+					clusterService:          clusterService,
+				}
+			},
+			wantStatus:                   api.ClusterQuarantined,
+			wantDynamicCapacityInfo:      api.JSON([]byte(`{"key":{"max_nodes":90,"max_units":10,"remaining_units":2}}`)),
+			wantAvailableStrimziVersions: api.JSON([]byte(`[{"version":"1.0.0","ready":true,"kafkaVersions":[{"version":"3.0.1"}],"kafkaIBPVersions":[{"version":"3.0.1"}]}]`)),
+			wantErr:                      false,
+		},
 		{
 			name: "return an error when updates in the database fails",
 			inputFactory: func() *input {
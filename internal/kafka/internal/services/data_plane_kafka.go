@@ -57,13 +57,15 @@ type DataPlaneKafkaService interface {
 type dataPlaneKafkaService struct {
 	kafkaService   KafkaService
 	clusterService ClusterService
+	webhookService WebhookService
 	kafkaConfig    *config.KafkaConfig
 }
 
-func NewDataPlaneKafkaService(kafkaSrv KafkaService, clusterSrv ClusterService, kafkaConfig *config.KafkaConfig) *dataPlaneKafkaService {
+func NewDataPlaneKafkaService(kafkaSrv KafkaService, clusterSrv ClusterService, webhookSrv WebhookService, kafkaConfig *config.KafkaConfig) *dataPlaneKafkaService {
 	return &dataPlaneKafkaService{
 		kafkaService:   kafkaSrv,
 		clusterService: clusterSrv,
+		webhookService: webhookSrv,
 		kafkaConfig:    kafkaConfig,
 	}
 }
@@ -202,7 +204,12 @@ func (d *dataPlaneKafkaService) processRealKafkaDeployment(ks *dbapi.DataPlaneKa
 	case statusSuspended:
 		if kafka.Status == constants.KafkaRequestStatusSuspending.String() {
 			logger.Logger.Infof("updating status of kafka %q from %q to %q", kafka.ID, kafka.Status, constants.KafkaRequestStatusSuspended)
-			_, e = d.kafkaService.UpdateStatus(kafka.ID, constants.KafkaRequestStatusSuspended)
+			var updated bool
+			updated, e = d.kafkaService.UpdateStatus(kafka.ID, constants.KafkaRequestStatusSuspended)
+			if updated && e == nil {
+				kafka.Status = constants.KafkaRequestStatusSuspended.String()
+				d.notifyWebhooks(kafka, dbapi.WebhookEventTypeKafkaSuspended)
+			}
 		}
 	case statusUnknown:
 		log.Infof("kafka %q status is unknown", ks.KafkaClusterId)
@@ -233,11 +240,20 @@ func (d *dataPlaneKafkaService) setKafkaClusterReady(kafka *dbapi.KafkaRequest)
 		return err
 	}
 
+	// the data plane keeps reporting ready kafkas as ready. Webhooks are only notified when the kafka becomes ready
+	wasReady := kafka.Status == constants.KafkaRequestStatusReady.String()
+
 	err = d.kafkaService.Updates(kafka, map[string]interface{}{"admin_api_server_url": kafka.AdminApiServerURL, "failed_reason": "", "status": constants.KafkaRequestStatusReady.String()})
 	if err != nil {
 		return serviceError.NewWithCause(err.Code, err, "failed to update kafka %q", kafka.ID)
 	}
 
+	if !wasReady {
+		kafka.Status = constants.KafkaRequestStatusReady.String()
+		kafka.FailedReason = ""
+		d.notifyWebhooks(kafka, dbapi.WebhookEventTypeKafkaReady)
+	}
+
 	if shouldSendMetric {
 		metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants.KafkaRequestStatusReady, kafka.ID, kafka.ClusterID, time.Since(kafka.CreatedAt))
 		metrics.UpdateKafkaCreationDurationMetric(metrics.JobTypeKafkaCreate, time.Since(kafka.CreatedAt))
@@ -329,6 +345,11 @@ func (d *dataPlaneKafkaService) setKafkaRequestVersionFields(kafka *dbapi.KafkaR
 		if err := d.kafkaService.Updates(kafka, versionFields); err != nil {
 			return serviceError.NewWithCause(err.Code, err, "failed to update actual version fields for kafka %q", kafka.ID)
 		}
+
+		// the version reported when the kafka is first provisioned is not an upgrade
+		if prevActualKafkaVersion != "" && prevActualKafkaVersion != kafka.ActualKafkaVersion {
+			d.notifyWebhooks(kafka, dbapi.WebhookEventTypeKafkaUpgraded)
+		}
 	}
 
 	return nil
@@ -354,6 +375,7 @@ func (d *dataPlaneKafkaService) setKafkaClusterFailed(kafka *dbapi.KafkaRequest,
 	if err != nil {
 		return serviceError.NewWithCause(err.Code, err, "failed to update kafka cluster to %q status for kafka %q", constants.KafkaRequestStatusFailed, kafka.ID)
 	}
+	d.notifyWebhooks(kafka, dbapi.WebhookEventTypeKafkaFailed)
 	if shouldSendMetric {
 		metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants.KafkaRequestStatusFailed, kafka.ID, kafka.ClusterID, time.Since(kafka.CreatedAt))
 		metrics.IncreaseKafkaTotalOperationsCountMetric(constants.KafkaOperationCreate)
@@ -369,11 +391,21 @@ func (d *dataPlaneKafkaService) setKafkaClusterDeleting(kafka *dbapi.KafkaReques
 			return serviceError.NewWithCause(updateErr.Code, updateErr, "failed to update status %q for kafka %q", constants.KafkaRequestStatusDeleting, kafka.ID)
 		} else {
 			metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants.KafkaRequestStatusDeleting, kafka.ID, kafka.ClusterID, time.Since(kafka.CreatedAt))
+			kafka.Status = constants.KafkaRequestStatusDeleting.String()
+			d.notifyWebhooks(kafka, dbapi.WebhookEventTypeKafkaDeleted)
 		}
 	}
 	return nil
 }
 
+// notifyWebhooks queues the delivery of the given lifecycle event of the kafka to the webhook subscriptions of its
+// organisation. Failing to do so does not fail the processing of the status reported by the data plane.
+func (d *dataPlaneKafkaService) notifyWebhooks(kafka *dbapi.KafkaRequest, eventType dbapi.WebhookEventType) {
+	if err := d.webhookService.Notify(kafka, eventType); err != nil {
+		logger.Logger.Error(errors.Wrapf(err, "failed to notify webhooks of %q event of kafka %q", eventType, kafka.ID))
+	}
+}
+
 // reassigns a Kafka instance to another data plane cluster. It only reassigns Kafka instances in a 'provisioning' state.
 func (d *dataPlaneKafkaService) reassignKafkaCluster(kafka *dbapi.KafkaRequest) *serviceError.ServiceError {
 	if kafka.Status == constants.KafkaRequestStatusProvisioning.String() {
@@ -723,7 +723,7 @@ func Test_dataPlaneKafkaService_UpdateDataPlaneKafkaService(t *testing.T) {
 				"rejected":  0,
 				"suspended": 0,
 			}
-			s := NewDataPlaneKafkaService(tt.fields.kafkaService(counter), tt.fields.clusterService, &config.KafkaConfig{})
+			s := NewDataPlaneKafkaService(tt.fields.kafkaService(counter), tt.fields.clusterService, webhookServiceMock(), &config.KafkaConfig{})
 			err := s.UpdateDataPlaneKafkaService(context.TODO(), tt.args.clusterId, tt.args.status)
 			g.Expect(err).To(gomega.Equal(tt.want))
 			g.Expect(counter).To(gomega.Equal(tt.expectCounters))
@@ -1002,7 +1002,7 @@ func TestDataPlaneKafkaService_UpdateVersions(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
 			v := versions{}
-			s := NewDataPlaneKafkaService(tt.kafkaService(&v), tt.clusterService, &config.KafkaConfig{})
+			s := NewDataPlaneKafkaService(tt.kafkaService(&v), tt.clusterService, webhookServiceMock(), &config.KafkaConfig{})
 			err := s.UpdateDataPlaneKafkaService(context.TODO(), tt.clusterId, tt.status)
 			if err != nil && !tt.wantErr {
 				t.Errorf("unexpected error %v", err)
@@ -1725,3 +1725,113 @@ func Test_dataPlaneKafkaService_processReservedKafkaDeployment(t *testing.T) {
 		})
 	}
 }
+
+// webhookServiceMock returns a webhook service mock ignoring the notifications
+func webhookServiceMock() *WebhookServiceMock {
+	return &WebhookServiceMock{
+		NotifyFunc: func(kafkaRequest *dbapi.KafkaRequest, eventType dbapi.WebhookEventType) *errors.ServiceError {
+			return nil
+		},
+	}
+}
+
+func Test_dataPlaneKafkaService_processRealKafkaDeployment_NotifiesWebhooks(t *testing.T) {
+	readyCondition := func(reason, status string) *dbapi.DataPlaneKafkaStatus {
+		return &dbapi.DataPlaneKafkaStatus{
+			KafkaClusterId: "kafka-id",
+			Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+				{Type: "Ready", Reason: reason, Status: status},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		kafka          *dbapi.KafkaRequest
+		status         *dbapi.DataPlaneKafkaStatus
+		wantEventTypes []dbapi.WebhookEventType
+	}{
+		{
+			name:           "should notify webhooks when the kafka becomes ready",
+			kafka:          &dbapi.KafkaRequest{Status: constants.KafkaRequestStatusProvisioning.String(), RoutesCreated: true},
+			status:         readyCondition("", "True"),
+			wantEventTypes: []dbapi.WebhookEventType{dbapi.WebhookEventTypeKafkaReady},
+		},
+		{
+			name:           "should not notify webhooks when the kafka is still ready",
+			kafka:          &dbapi.KafkaRequest{Status: constants.KafkaRequestStatusReady.String(), RoutesCreated: true},
+			status:         readyCondition("", "True"),
+			wantEventTypes: nil,
+		},
+		{
+			name:           "should notify webhooks when the kafka fails",
+			kafka:          &dbapi.KafkaRequest{Status: constants.KafkaRequestStatusProvisioning.String()},
+			status:         readyCondition("Error", "False"),
+			wantEventTypes: []dbapi.WebhookEventType{dbapi.WebhookEventTypeKafkaFailed},
+		},
+		{
+			name:           "should notify webhooks when the kafka is deleted",
+			kafka:          &dbapi.KafkaRequest{Status: constants.KafkaRequestStatusDeprovision.String()},
+			status:         readyCondition("Deleted", "False"),
+			wantEventTypes: []dbapi.WebhookEventType{dbapi.WebhookEventTypeKafkaDeleted},
+		},
+		{
+			name:           "should notify webhooks when the kafka is suspended",
+			kafka:          &dbapi.KafkaRequest{Status: constants.KafkaRequestStatusSuspending.String()},
+			status:         readyCondition("Suspended", "False"),
+			wantEventTypes: []dbapi.WebhookEventType{dbapi.WebhookEventTypeKafkaSuspended},
+		},
+		{
+			name:  "should notify webhooks when the kafka is upgraded",
+			kafka: &dbapi.KafkaRequest{Status: constants.KafkaRequestStatusReady.String(), ActualKafkaVersion: "3.0.0"},
+			status: &dbapi.DataPlaneKafkaStatus{
+				KafkaClusterId: "kafka-id",
+				KafkaVersion:   "3.1.0",
+			},
+			wantEventTypes: []dbapi.WebhookEventType{dbapi.WebhookEventTypeKafkaUpgraded},
+		},
+		{
+			name:  "should not notify webhooks when the first version of the kafka is reported",
+			kafka: &dbapi.KafkaRequest{Status: constants.KafkaRequestStatusProvisioning.String()},
+			status: &dbapi.DataPlaneKafkaStatus{
+				KafkaClusterId: "kafka-id",
+				KafkaVersion:   "3.1.0",
+			},
+			wantEventTypes: nil,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.kafka.ID = "kafka-id"
+			tt.kafka.ClusterID = "cluster-id"
+			kafkaService := &KafkaServiceMock{
+				GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					kafka := *tt.kafka
+					return &kafka, nil
+				},
+				UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+					return nil
+				},
+				UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+					return nil
+				},
+				UpdateStatusFunc: func(id string, status constants.KafkaStatus) (bool, *errors.ServiceError) {
+					return true, nil
+				},
+			}
+			var eventTypes []dbapi.WebhookEventType
+			webhookService := &WebhookServiceMock{
+				NotifyFunc: func(kafkaRequest *dbapi.KafkaRequest, eventType dbapi.WebhookEventType) *errors.ServiceError {
+					eventTypes = append(eventTypes, eventType)
+					return nil
+				},
+			}
+			d := NewDataPlaneKafkaService(kafkaService, &ClusterServiceMock{}, webhookService, &config.KafkaConfig{})
+			d.processRealKafkaDeployment(tt.status, &api.Cluster{ClusterID: "cluster-id"}, logger.NewUHCLogger(context.Background()))
+			g.Expect(eventTypes).To(gomega.Equal(tt.wantEventTypes))
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
@@ -41,6 +42,15 @@ import (
 )
 
 var kafkaDeletionStatuses = []string{constants.KafkaRequestStatusDeleting.String(), constants.KafkaRequestStatusDeprovision.String()}
+
+// kafkaDeletionGracePeriodStatuses are the statuses of the kafkas that are suspended rather than deprovisioned right away
+// when they are deleted while a deletion grace period is configured
+var kafkaDeletionGracePeriodStatuses = []string{
+	constants.KafkaRequestStatusReady.String(),
+	constants.KafkaRequestStatusSuspending.String(),
+	constants.KafkaRequestStatusSuspended.String(),
+}
+
 var kafkaManagedCRStatuses = []string{
 	constants.KafkaRequestStatusProvisioning.String(),
 	constants.KafkaRequestStatusDeprovision.String(),
@@ -51,6 +61,14 @@ var kafkaManagedCRStatuses = []string{
 	constants.KafkaRequestStatusResuming.String(),
 }
 
+// kafkaSearchKeyValueColumns are the key/value columns that can be used when searching kafka requests, e.g. `labels.team = payments`
+var kafkaSearchKeyValueColumns = []coreServices.KeyValueColumn{
+	{
+		Name:      "labels",
+		Condition: "id IN (SELECT kafka_id FROM kafka_labels WHERE deleted_at IS NULL AND key = ? AND value %s ?)",
+	},
+}
+
 type KafkaRoutesAction string
 
 const KafkaRoutesActionCreate KafkaRoutesAction = "CREATE"
@@ -107,10 +125,51 @@ type KafkaService interface {
 	ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError)
 	GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error)
 	AssignInstanceType(owner string, organisationID string) (types.KafkaInstanceType, *errors.ServiceError)
-	RegisterKafkaDeprovisionJob(ctx context.Context, id string) *errors.ServiceError
+	// RegisterKafkaDeprovisionJob marks the Kafka instance for deletion. A Kafka instance with deletion protection enabled
+	// cannot be deleted unless ignoreDeletionProtection is set by an admin. When a deletion grace period is configured,
+	// a 'ready', 'suspending' or 'suspended' Kafka instance is suspended instead and only deprovisioned once the grace
+	// period expires. Deleting a Kafka instance that is already pending deletion is a no-op. Kafka instances deleted by
+	// admins are deprovisioned right away, even when they are already pending deletion.
+	RegisterKafkaDeprovisionJob(ctx context.Context, id string, ignoreDeletionProtection bool) *errors.ServiceError
+	// RestoreKafka cancels the deletion of a Kafka instance pending deletion and moves it to the 'resuming' state. A Kafka
+	// instance can only be restored until its deletion grace period expires. A conflict error is returned when the Kafka
+	// instance is not pending deletion or not suspended. Like when resuming a Kafka instance, the quota of the Kafka owner
+	// is checked again.
+	RestoreKafka(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError
+	// DeprovisionKafkasWithExpiredDeletionGracePeriod marks for deprovisioning all the Kafka instances pending deletion
+	// whose deletion grace period has expired
+	DeprovisionKafkasWithExpiredDeletionGracePeriod() *errors.ServiceError
+	// SuspendKafka moves a Kafka instance in 'ready' state to the 'suspending' state. The kas-fleetshard operator will
+	// then scale down the Kafka instance and report it back as 'suspended'. Suspending a Kafka instance that is already
+	// 'suspending' or 'suspended' is a no-op. A conflict error is returned for Kafka instances in any other state.
+	SuspendKafka(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError
+	// ResumeKafka moves a Kafka instance in 'suspending' or 'suspended' state to the 'resuming' state. Before resuming,
+	// the quota of the Kafka owner is checked again as it may have been removed while the Kafka instance was suspended.
+	// Resuming a Kafka instance that is already 'resuming' is a no-op. A conflict error is returned for Kafka instances in
+	// any other state or pending deletion.
+	ResumeKafka(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError
+	// ResizeKafka changes the size of a Kafka instance in 'ready' state to the size with the given id. The size must be
+	// one of the sizes supported by the instance type of the Kafka instance. Before resizing, the region limits and the
+	// quota of the Kafka owner are checked again for the new size. Kafka instances are never moved to another data plane
+	// cluster as their data would be lost: a KafkaClusterCapacityReached error is returned if the data plane cluster the
+	// Kafka instance is assigned to has no capacity left for the new size. Resizing a Kafka instance to its current size
+	// is a no-op.
+	ResizeKafka(kafkaRequest *dbapi.KafkaRequest, sizeId string) *errors.ServiceError
+	// UpdateLabels replaces all the labels of the Kafka instance with the given labels
+	UpdateLabels(kafkaRequest *dbapi.KafkaRequest, labels dbapi.KafkaLabels) *errors.ServiceError
 	// DeprovisionKafkaForUsers registers all kafkas for deprovisioning given the list of owners
 	DeprovisionKafkaForUsers(users []string) *errors.ServiceError
 	DeprovisionExpiredKafkas() *errors.ServiceError
+	// GetExpirationTime returns the time at which the Kafka instance expires. nil is returned if the Kafka instance
+	// does not expire, either because its size has no lifespan or because it is protected against deletion.
+	GetExpirationTime(kafkaRequest *dbapi.KafkaRequest) (*time.Time, *errors.ServiceError)
+	// ExtendExpiration postpones the expiration of the Kafka instance to expiresAt, which must be after its current
+	// expiration time. The expiration notifications already sent for the Kafka instance are sent again when the new
+	// expiration time approaches.
+	ExtendExpiration(kafkaRequest *dbapi.KafkaRequest, expiresAt time.Time) *errors.ServiceError
+	// ListKafkasExpiringBefore returns the Kafka instances, not already being deleted, that expire before the given time.
+	// The ExpiresAt field of the returned Kafka instances is set to their expiration time.
+	ListKafkasExpiringBefore(expiresBefore time.Time) ([]*dbapi.KafkaRequest, *errors.ServiceError)
 	CountByStatus(status []constants.KafkaStatus) ([]KafkaStatusCount, error)
 	ListKafkasWithRoutesNotCreated() ([]*dbapi.KafkaRequest, *errors.ServiceError)
 	VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError
@@ -125,33 +184,35 @@ type KafkaService interface {
 var _ KafkaService = &kafkaService{}
 
 type kafkaService struct {
-	connectionFactory        *db.ConnectionFactory
-	clusterService           ClusterService
-	keycloakService          sso.KeycloakService
-	kafkaConfig              *config.KafkaConfig
-	awsConfig                *config.AWSConfig
-	quotaServiceFactory      QuotaServiceFactory
-	mu                       sync.Mutex
-	awsClientFactory         aws.ClientFactory
-	authService              authorization.Authorization
-	dataplaneClusterConfig   *config.DataplaneClusterConfig
-	providerConfig           *config.ProviderConfig
-	clusterPlacementStrategy ClusterPlacementStrategy
+	connectionFactory          *db.ConnectionFactory
+	clusterService             ClusterService
+	keycloakService            sso.KeycloakService
+	kafkaConfig                *config.KafkaConfig
+	awsConfig                  *config.AWSConfig
+	quotaServiceFactory        QuotaServiceFactory
+	mu                         sync.Mutex
+	awsClientFactory           aws.ClientFactory
+	authService                authorization.Authorization
+	dataplaneClusterConfig     *config.DataplaneClusterConfig
+	providerConfig             *config.ProviderConfig
+	clusterPlacementStrategy   ClusterPlacementStrategy
+	capacityReservationService CapacityReservationService
 }
 
-func NewKafkaService(connectionFactory *db.ConnectionFactory, clusterService ClusterService, keycloakService sso.KafkaKeycloakService, kafkaConfig *config.KafkaConfig, dataplaneClusterConfig *config.DataplaneClusterConfig, awsConfig *config.AWSConfig, quotaServiceFactory QuotaServiceFactory, awsClientFactory aws.ClientFactory, authorizationService authorization.Authorization, providerConfig *config.ProviderConfig, clusterPlacementStrategy ClusterPlacementStrategy) *kafkaService {
+func NewKafkaService(connectionFactory *db.ConnectionFactory, clusterService ClusterService, keycloakService sso.KafkaKeycloakService, kafkaConfig *config.KafkaConfig, dataplaneClusterConfig *config.DataplaneClusterConfig, awsConfig *config.AWSConfig, quotaServiceFactory QuotaServiceFactory, awsClientFactory aws.ClientFactory, authorizationService authorization.Authorization, providerConfig *config.ProviderConfig, clusterPlacementStrategy ClusterPlacementStrategy, capacityReservationService CapacityReservationService) *kafkaService {
 	return &kafkaService{
-		connectionFactory:        connectionFactory,
-		clusterService:           clusterService,
-		keycloakService:          keycloakService,
-		kafkaConfig:              kafkaConfig,
-		awsConfig:                awsConfig,
-		quotaServiceFactory:      quotaServiceFactory,
-		awsClientFactory:         awsClientFactory,
-		authService:              authorizationService,
-		dataplaneClusterConfig:   dataplaneClusterConfig,
-		providerConfig:           providerConfig,
-		clusterPlacementStrategy: clusterPlacementStrategy,
+		connectionFactory:          connectionFactory,
+		clusterService:             clusterService,
+		keycloakService:            keycloakService,
+		kafkaConfig:                kafkaConfig,
+		awsConfig:                  awsConfig,
+		quotaServiceFactory:        quotaServiceFactory,
+		awsClientFactory:           awsClientFactory,
+		authService:                authorizationService,
+		dataplaneClusterConfig:     dataplaneClusterConfig,
+		providerConfig:             providerConfig,
+		clusterPlacementStrategy:   clusterPlacementStrategy,
+		capacityReservationService: capacityReservationService,
 	}
 }
 
@@ -201,6 +262,10 @@ func (k *kafkaService) capacityAvailableForRegionAndInstanceType(instTypeRegCapa
 	}
 
 	for _, kafka := range kafkas {
+		// a kafka request being resized is already in the database. It is only counted with its new size below
+		if kafkaRequest.ID != "" && kafka.ID == kafkaRequest.ID {
+			continue
+		}
 		kafkaInstanceSize, e := k.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
 		if e != nil {
 			return false, errors.NewWithCause(errors.ErrorInstancePlanNotSupported, e, errMessage)
@@ -215,7 +280,19 @@ func (k *kafkaService) capacityAvailableForRegionAndInstanceType(instTypeRegCapa
 
 	count += int64(kafkaInstanceSize.CapacityConsumed)
 
-	return instTypeRegCapacity == nil || count <= int64(*instTypeRegCapacity), nil
+	if instTypeRegCapacity == nil {
+		return true, nil
+	}
+
+	// the streaming units reserved by organisations and not consumed yet are not available to the other organisations.
+	// The new kafka consumes the reservations of its own organisation first
+	usages, serviceErr := k.capacityReservationService.ListActiveUsages()
+	if serviceErr != nil {
+		return false, errors.NewWithCause(errors.ErrorGeneral, serviceErr, errMessage)
+	}
+	count += int64(usages.UnusedStreamingUnits(kafkaRequest.CloudProvider, kafkaRequest.Region, kafkaRequest.InstanceType, kafkaRequest.OrganisationId, kafkaInstanceSize.CapacityConsumed))
+
+	return count <= int64(*instTypeRegCapacity), nil
 }
 
 func (k *kafkaService) GetAvailableSizesInRegion(criteria *FindClusterCriteria) ([]string, *errors.ServiceError) {
@@ -430,6 +507,11 @@ func (k *kafkaService) RegisterKafkaJob(kafkaRequest *dbapi.KafkaRequest) *error
 		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create kafka request") //hide the db error to http caller
 	}
 
+	k.recordKafkaEvents(&dbapi.KafkaRequest{Meta: api.Meta{ID: kafkaRequest.ID}}, map[string]interface{}{
+		"status":     kafkaRequest.Status,
+		"cluster_id": kafkaRequest.ClusterID,
+	})
+
 	metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants.KafkaRequestStatusAccepted, kafkaRequest.ID, kafkaRequest.ClusterID, time.Since(kafkaRequest.CreatedAt))
 
 	return nil
@@ -529,7 +611,7 @@ func (k *kafkaService) Get(ctx context.Context, id string) (*dbapi.KafkaRequest,
 	}
 
 	var kafkaRequest dbapi.KafkaRequest
-	if err := dbConn.First(&kafkaRequest).Error; err != nil {
+	if err := dbConn.Preload("Labels").First(&kafkaRequest).Error; err != nil {
 		resourceTypeStr := "KafkaResource"
 		if user != "" {
 			resourceTypeStr = fmt.Sprintf("%s for user %s", resourceTypeStr, user)
@@ -553,7 +635,7 @@ func (k *kafkaService) GetByID(id string) (*dbapi.KafkaRequest, *errors.ServiceE
 }
 
 // RegisterKafkaDeprovisionJob registers a kafka deprovision job in the kafka table
-func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id string) *errors.ServiceError {
+func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id string, ignoreDeletionProtection bool) *errors.ServiceError {
 	if id == "" {
 		return errors.Validation("id is undefined")
 	}
@@ -580,6 +662,23 @@ func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id strin
 	if err := dbConn.First(&kafkaRequest).Error; err != nil {
 		return services.HandleGetError("KafkaResource", "id", id, err)
 	}
+
+	// only admins are allowed to bypass the deletion protection
+	if kafkaRequest.DeletionProtection && !(ignoreDeletionProtection && auth.GetIsAdminFromContext(ctx)) {
+		return errors.KafkaDeletionProtected("kafka %q is protected against deletion, disable its deletion protection before deleting it", id)
+	}
+
+	// kafkas deleted by admins are deprovisioned right away, including the kafkas already pending deletion
+	if !auth.GetIsAdminFromContext(ctx) {
+		if kafkaRequest.DeletionRequestedAt != nil {
+			return nil
+		}
+
+		if k.kafkaConfig.DeletionGracePeriod > 0 && arrays.Contains(kafkaDeletionGracePeriodStatuses, kafkaRequest.Status) {
+			return k.requestKafkaDeletion(&kafkaRequest)
+		}
+	}
+
 	metrics.IncreaseKafkaTotalOperationsCountMetric(constants.KafkaOperationDeprovision)
 
 	deprovisionStatus := constants.KafkaRequestStatusDeprovision
@@ -595,11 +694,404 @@ func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id strin
 	return nil
 }
 
+// requestKafkaDeletion starts the deletion grace period of the given kafka request. A 'ready' kafka is suspended at the
+// same time, while the kafkas already 'suspending' or 'suspended' keep their status.
+func (k *kafkaService) requestKafkaDeletion(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+	updates := map[string]interface{}{"deletion_requested_at": time.Now()}
+	suspend := kafkaRequest.Status == constants.KafkaRequestStatusReady.String()
+	if suspend {
+		metrics.IncreaseKafkaTotalOperationsCountMetric(constants.KafkaOperationSuspend)
+		updates["status"] = constants.KafkaRequestStatusSuspending
+	}
+
+	dbConn := k.connectionFactory.New().
+		Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: kafkaRequest.ID}}).
+		Where("status = ?", kafkaRequest.Status).
+		Where("deletion_requested_at IS NULL").
+		Updates(updates)
+
+	if err := dbConn.Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to request the deletion of kafka %q", kafkaRequest.ID)
+	}
+
+	if dbConn.RowsAffected == 0 {
+		return errors.New(errors.ErrorConflict, "unable to request the deletion of kafka %q: its status has been changed in the meantime", kafkaRequest.ID)
+	}
+
+	if suspend {
+		k.recordKafkaEvents(kafkaRequest, map[string]interface{}{"status": constants.KafkaRequestStatusSuspending})
+		kafkaRequest.Status = constants.KafkaRequestStatusSuspending.String()
+		metrics.IncreaseKafkaSuccessOperationsCountMetric(constants.KafkaOperationSuspend)
+		metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants.KafkaRequestStatusSuspending, kafkaRequest.ID, kafkaRequest.ClusterID, time.Since(kafkaRequest.CreatedAt))
+	}
+
+	return nil
+}
+
+func (k *kafkaService) RestoreKafka(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+	if kafkaRequest.DeletionRequestedAt == nil {
+		return errors.Conflict("kafka instance %q cannot be restored as it is not pending deletion", kafkaRequest.ID)
+	}
+
+	if time.Now().After(kafkaRequest.DeletionRequestedAt.Add(k.kafkaConfig.DeletionGracePeriod)) || arrays.Contains(kafkaDeletionStatuses, kafkaRequest.Status) {
+		return errors.New(errors.ErrorGone, "kafka instance %q cannot be restored anymore as its deletion grace period has expired", kafkaRequest.ID)
+	}
+
+	if !arrays.Contains(constants.GetSuspendedStatuses(), kafkaRequest.Status) {
+		return errors.Conflict("kafka instance with a status of %q cannot be restored. Kafka instances pending deletion can only be restored in the following states: %q", kafkaRequest.Status, constants.GetSuspendedStatuses())
+	}
+
+	if err := k.checkQuotaToResume(kafkaRequest); err != nil {
+		return err
+	}
+
+	metrics.IncreaseKafkaTotalOperationsCountMetric(constants.KafkaOperationResume)
+
+	dbConn := k.connectionFactory.New().
+		Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: kafkaRequest.ID}}).
+		Where("status IN (?)", constants.GetSuspendedStatuses()).
+		Where("deletion_requested_at IS NOT NULL").
+		Updates(map[string]interface{}{"status": constants.KafkaRequestStatusResuming, "deletion_requested_at": nil})
+
+	if err := dbConn.Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to restore kafka %q", kafkaRequest.ID)
+	}
+
+	if dbConn.RowsAffected == 0 {
+		return errors.New(errors.ErrorConflict, "unable to restore kafka %q: its status has been changed in the meantime", kafkaRequest.ID)
+	}
+
+	k.recordKafkaEvents(kafkaRequest, map[string]interface{}{"status": constants.KafkaRequestStatusResuming})
+	kafkaRequest.Status = constants.KafkaRequestStatusResuming.String()
+	kafkaRequest.DeletionRequestedAt = nil
+
+	metrics.IncreaseKafkaSuccessOperationsCountMetric(constants.KafkaOperationResume)
+	metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants.KafkaRequestStatusResuming, kafkaRequest.ID, kafkaRequest.ClusterID, time.Since(kafkaRequest.CreatedAt))
+
+	return nil
+}
+
+func (k *kafkaService) SuspendKafka(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+	if arrays.Contains(constants.GetSuspendedStatuses(), kafkaRequest.Status) {
+		return nil
+	}
+
+	if kafkaRequest.Status != constants.KafkaRequestStatusReady.String() {
+		return errors.Conflict("kafka instance with a status of %q cannot be suspended. Kafka instances can only be suspended in the following states: [%q]", kafkaRequest.Status, constants.KafkaRequestStatusReady)
+	}
+
+	metrics.IncreaseKafkaTotalOperationsCountMetric(constants.KafkaOperationSuspend)
+
+	if err := k.updateStatusFrom(kafkaRequest, constants.KafkaRequestStatusSuspending, constants.KafkaRequestStatusReady); err != nil {
+		return err
+	}
+
+	metrics.IncreaseKafkaSuccessOperationsCountMetric(constants.KafkaOperationSuspend)
+	metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants.KafkaRequestStatusSuspending, kafkaRequest.ID, kafkaRequest.ClusterID, time.Since(kafkaRequest.CreatedAt))
+
+	return nil
+}
+
+func (k *kafkaService) ResumeKafka(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+	if kafkaRequest.Status == constants.KafkaRequestStatusResuming.String() {
+		return nil
+	}
+
+	if !arrays.Contains(constants.GetSuspendedStatuses(), kafkaRequest.Status) {
+		return errors.Conflict("kafka instance with a status of %q cannot be resumed. Kafka instances can only be resumed in the following states: %q", kafkaRequest.Status, constants.GetSuspendedStatuses())
+	}
+
+	if kafkaRequest.DeletionRequestedAt != nil {
+		return errors.Conflict("kafka instance %q is pending deletion and can only be restored", kafkaRequest.ID)
+	}
+
+	if err := k.checkQuotaToResume(kafkaRequest); err != nil {
+		return err
+	}
+
+	metrics.IncreaseKafkaTotalOperationsCountMetric(constants.KafkaOperationResume)
+
+	// a kafka whose deletion has been requested in the meantime must be left suspended: it can only be restored
+	dbConn := k.connectionFactory.New().
+		Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: kafkaRequest.ID}}).
+		Where("status IN (?)", constants.GetSuspendedStatuses()).
+		Where("deletion_requested_at IS NULL").
+		Update("status", constants.KafkaRequestStatusResuming)
+
+	if err := dbConn.Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to resume kafka %q", kafkaRequest.ID)
+	}
+
+	if dbConn.RowsAffected == 0 {
+		return errors.New(errors.ErrorConflict, "unable to resume kafka %q: its status has been changed or its deletion has been requested in the meantime", kafkaRequest.ID)
+	}
+
+	k.recordKafkaEvents(kafkaRequest, map[string]interface{}{"status": constants.KafkaRequestStatusResuming})
+	kafkaRequest.Status = constants.KafkaRequestStatusResuming.String()
+
+	metrics.IncreaseKafkaSuccessOperationsCountMetric(constants.KafkaOperationResume)
+	metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants.KafkaRequestStatusResuming, kafkaRequest.ID, kafkaRequest.ClusterID, time.Since(kafkaRequest.CreatedAt))
+
+	return nil
+}
+
+// checkQuotaToResume checks that the owner of the given suspended kafka request still has quota for its instance type
+func (k *kafkaService) checkQuotaToResume(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+	quotaService, factoryErr := k.quotaServiceFactory.GetQuotaService(api.QuotaType(kafkaRequest.QuotaType))
+	if factoryErr != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, factoryErr, "unable to check quota")
+	}
+
+	instanceType, e := k.kafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(kafkaRequest.InstanceType)
+	if e != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, e, "unable to check quota")
+	}
+
+	// kafkas created before billing models were introduced have no billing model set. The quota for these is checked
+	// against any of the billing models supported by their instance type
+	billingModels := instanceType.SupportedBillingModels
+	if kafkaRequest.ActualKafkaBillingModel != "" {
+		billingModel, e := instanceType.GetKafkaSupportedBillingModelByID(kafkaRequest.ActualKafkaBillingModel)
+		if e != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, e, "unable to check quota")
+		}
+		billingModels = []config.KafkaBillingModel{*billingModel}
+	}
+
+	hasQuota := false
+	for _, bm := range billingModels {
+		var err *errors.ServiceError
+		hasQuota, err = quotaService.CheckIfQuotaIsDefinedForInstanceType(kafkaRequest.Owner, kafkaRequest.OrganisationId, types.KafkaInstanceType(kafkaRequest.InstanceType), bm)
+		if err != nil {
+			return errors.NewWithCause(errors.ErrorFailedToCheckQuota, err, "unable to check quota")
+		}
+		if hasQuota {
+			break
+		}
+	}
+	if !hasQuota {
+		return errors.InsufficientQuotaError("unable to resume kafka instance %q: no quota is available for instance type %q", kafkaRequest.ID, kafkaRequest.InstanceType)
+	}
+
+	return nil
+}
+
+// updateStatusFrom sets the status of the given kafka request to newStatus only if its current status in the database
+// is one of the given fromStatuses. This guards against concurrent status changes done by the reconcilers.
+func (k *kafkaService) updateStatusFrom(kafkaRequest *dbapi.KafkaRequest, newStatus constants.KafkaStatus, fromStatuses ...constants.KafkaStatus) *errors.ServiceError {
+	dbConn := k.connectionFactory.New().
+		Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: kafkaRequest.ID}}).
+		Where("status IN (?)", fromStatuses).
+		Update("status", newStatus)
+
+	if err := dbConn.Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update kafka status")
+	}
+
+	if dbConn.RowsAffected == 0 {
+		return errors.New(errors.ErrorConflict, "unable to update the status of kafka %q to %q: its status has been changed in the meantime", kafkaRequest.ID, newStatus)
+	}
+
+	k.recordKafkaEvents(kafkaRequest, map[string]interface{}{"status": newStatus})
+	kafkaRequest.Status = newStatus.String()
+	return nil
+}
+
+func (k *kafkaService) ResizeKafka(kafkaRequest *dbapi.KafkaRequest, sizeId string) *errors.ServiceError {
+	if kafkaRequest.SizeId == sizeId {
+		return nil
+	}
+
+	if kafkaRequest.Status != constants.KafkaRequestStatusReady.String() {
+		return errors.New(errors.ErrorValidation, "kafka instance with a status of %q cannot be resized. Kafka instances can only be resized in the following states: [%q]", kafkaRequest.Status, constants.KafkaRequestStatusReady)
+	}
+
+	instanceType, e := k.kafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(kafkaRequest.InstanceType)
+	if e != nil {
+		return errors.InstanceTypeNotSupported(e.Error())
+	}
+
+	currentSize, e := instanceType.GetKafkaInstanceSizeByID(kafkaRequest.SizeId)
+	if e != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, e, "unable to resize kafka %q", kafkaRequest.ID)
+	}
+
+	newSize, e := instanceType.GetKafkaInstanceSizeByID(sizeId)
+	if e != nil {
+		return errors.InstancePlanNotSupported(e.Error())
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	resizedKafkaRequest := *kafkaRequest
+	resizedKafkaRequest.SizeId = newSize.Id
+	// the billing model of a kafka does not change when it is resized
+	if kafkaRequest.ActualKafkaBillingModel != "" {
+		resizedKafkaRequest.DesiredKafkaBillingModel = kafkaRequest.ActualKafkaBillingModel
+	}
+
+	metrics.IncreaseKafkaTotalOperationsCountMetric(constants.KafkaOperationResize)
+
+	hasCapacity, err := k.HasAvailableCapacityInRegion(&resizedKafkaRequest)
+	if err != nil {
+		return errors.NewWithCause(err.Code, err, "unable to resize kafka %q", kafkaRequest.ID)
+	}
+	if !hasCapacity {
+		return errors.TooManyKafkaInstancesReached("region %s cannot accept instance type: %s with size: %s at this moment", kafkaRequest.Region, kafkaRequest.InstanceType, newSize.Id)
+	}
+
+	hasCapacity, err = k.hasCapacityOnAssignedClusterForResize(kafkaRequest, currentSize, newSize)
+	if err != nil {
+		return errors.NewWithCause(err.Code, err, "unable to resize kafka %q", kafkaRequest.ID)
+	}
+
+	// moving a kafka to another data plane cluster would provision a new, empty kafka there. Its data would be lost,
+	// so kafkas can only be resized when their current data plane cluster can accommodate the new size
+	if !hasCapacity {
+		return errors.KafkaClusterCapacityReached("kafka %q cannot be resized to %q: its data plane cluster cannot accept instance type: %s with size: %s at this moment", kafkaRequest.ID, newSize.Id, kafkaRequest.InstanceType, newSize.Id)
+	}
+
+	fields := map[string]interface{}{
+		"size_id": newSize.Id,
+	}
+
+	// the storage size is only changed when it is the default storage size of the current size. A storage size set
+	// explicitly, e.g. through the admin API, is kept
+	storageSize := kafkaRequest.KafkaStorageSize
+	if kafkaRequest.KafkaStorageSize == currentSize.MaxDataRetentionSize.String() {
+		storageSize = newSize.MaxDataRetentionSize.String()
+		fields["kafka_storage_size"] = storageSize
+	}
+
+	quotaService, factoryErr := k.quotaServiceFactory.GetQuotaService(api.QuotaType(kafkaRequest.QuotaType))
+	if factoryErr != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, factoryErr, "unable to check quota")
+	}
+
+	// the quota for the new size is reserved before releasing the quota of the current size so that the kafka
+	// keeps its current quota if the reservation fails
+	subscriptionId, err := quotaService.ReserveQuota(&resizedKafkaRequest)
+	if err != nil {
+		return err
+	}
+	fields["subscription_id"] = subscriptionId
+	fields["actual_kafka_billing_model"] = resizedKafkaRequest.ActualKafkaBillingModel
+
+	dbConn := k.connectionFactory.New().
+		Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: kafkaRequest.ID}}).
+		Where("status = ?", constants.KafkaRequestStatusReady).
+		Updates(fields)
+
+	var updateErr *errors.ServiceError
+	if err := dbConn.Error; err != nil {
+		updateErr = errors.NewWithCause(errors.ErrorGeneral, err, "failed to resize kafka")
+	} else if dbConn.RowsAffected == 0 {
+		updateErr = errors.New(errors.ErrorConflict, "unable to resize kafka %q: its status has been changed in the meantime", kafkaRequest.ID)
+	}
+
+	// some quota services, e.g. AMS, reserve the quota of the new size on the subscription the kafka already has.
+	// The subscription must only be deleted when the reservation created a new one
+	newSubscription := subscriptionId != kafkaRequest.SubscriptionId
+
+	if updateErr != nil {
+		if newSubscription {
+			if err := quotaService.DeleteQuota(subscriptionId); err != nil {
+				logger.Logger.Errorf("failed to delete the quota reserved to resize kafka %q: %v", kafkaRequest.ID, err)
+			}
+		}
+		return updateErr
+	}
+
+	if newSubscription {
+		if err := quotaService.DeleteQuota(kafkaRequest.SubscriptionId); err != nil {
+			// the kafka has already been resized at this point. Failing to release the quota of the previous size must not fail the request
+			logger.Logger.Errorf("failed to delete the quota of kafka %q before it was resized: %v", kafkaRequest.ID, err)
+		}
+	}
+
+	k.recordKafkaEvents(kafkaRequest, fields)
+
+	kafkaRequest.SizeId = newSize.Id
+	kafkaRequest.KafkaStorageSize = storageSize
+	kafkaRequest.SubscriptionId = subscriptionId
+	kafkaRequest.ActualKafkaBillingModel = resizedKafkaRequest.ActualKafkaBillingModel
+
+	metrics.IncreaseKafkaSuccessOperationsCountMetric(constants.KafkaOperationResize)
+
+	return nil
+}
+
+// hasCapacityOnAssignedClusterForResize checks whether the data plane cluster the kafka request is assigned to can accommodate
+// the kafka request with its new size. Only the capacity added on top of the capacity already consumed by the kafka request is
+// taken into account.
+func (k *kafkaService) hasCapacityOnAssignedClusterForResize(kafkaRequest *dbapi.KafkaRequest, currentSize, newSize *config.KafkaInstanceSize) (bool, *errors.ServiceError) {
+	additionalCapacity := newSize.CapacityConsumed - currentSize.CapacityConsumed
+	if additionalCapacity <= 0 {
+		return true, nil
+	}
+
+	switch {
+	case k.dataplaneClusterConfig.IsDataPlaneManualScalingEnabled():
+		clusterConfig := k.dataplaneClusterConfig.ClusterConfig
+		if !clusterConfig.IsClusterSchedulable(kafkaRequest.ClusterID) {
+			return false, nil
+		}
+
+		instanceCounts, err := k.clusterService.FindKafkaInstanceCount([]string{kafkaRequest.ClusterID})
+		if err != nil {
+			return false, errors.NewWithCause(errors.ErrorGeneral, err, "failed to find kafka instance count for cluster %q", kafkaRequest.ClusterID)
+		}
+
+		count := 0
+		for _, instanceCount := range instanceCounts {
+			if instanceCount.Clusterid == kafkaRequest.ClusterID {
+				count = instanceCount.Count
+			}
+		}
+
+		return clusterConfig.IsNumberOfKafkaWithinClusterLimit(kafkaRequest.ClusterID, count+additionalCapacity), nil
+	case k.dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled():
+		cluster, err := k.clusterService.FindClusterByID(kafkaRequest.ClusterID)
+		if err != nil {
+			return false, err
+		}
+		if cluster == nil {
+			return false, nil
+		}
+
+		streamingUnitCounts, e := k.clusterService.FindStreamingUnitCountByClusterAndInstanceType()
+		if e != nil {
+			return false, errors.NewWithCause(errors.ErrorGeneral, e, "failed to get count of streaming units for cluster %q", kafkaRequest.ClusterID)
+		}
+
+		streamingUnitsUsed := streamingUnitCounts.GetStreamingUnitCountForClusterAndInstanceType(cluster.ClusterID, kafkaRequest.InstanceType)
+		maxStreamingUnits := cluster.RetrieveDynamicCapacityInfo()[kafkaRequest.InstanceType].MaxUnits
+
+		return streamingUnitsUsed+additionalCapacity <= int(maxStreamingUnits), nil
+	default:
+		return true, nil
+	}
+}
+
 func (k *kafkaService) DeprovisionKafkaForUsers(users []string) *errors.ServiceError {
+	// the kafkas are read beforehand to record the change of their status
+	// kafkas protected against deletion are left untouched
+	var kafkasToDeprovision []*dbapi.KafkaRequest
+	if err := k.connectionFactory.New().
+		Select(kafkaEventColumnNames).
+		Where("owner IN (?)", users).
+		Where("status NOT IN (?)", kafkaDeletionStatuses).
+		Where("deletion_protection = ?", false).
+		Find(&kafkasToDeprovision).Error; err != nil {
+		logger.Logger.Errorf("failed to find the kafkas to deprovision for users %v: %v", users, err)
+	}
+
 	dbConn := k.connectionFactory.New().
 		Model(&dbapi.KafkaRequest{}).
 		Where("owner IN (?)", users).
 		Where("status NOT IN (?)", kafkaDeletionStatuses).
+		Where("deletion_protection = ?", false).
 		Update("status", constants.KafkaRequestStatusDeprovision)
 
 	err := dbConn.Error
@@ -609,6 +1101,9 @@ func (k *kafkaService) DeprovisionKafkaForUsers(users []string) *errors.ServiceE
 
 	if dbConn.RowsAffected >= 1 {
 		glog.Infof("%v kafkas are now deprovisioning for users %v", dbConn.RowsAffected, users)
+		for _, kafka := range kafkasToDeprovision {
+			k.recordKafkaEvents(kafka, map[string]interface{}{"status": constants.KafkaRequestStatusDeprovision})
+		}
 		var counter int64 = 0
 		for ; counter < dbConn.RowsAffected; counter++ {
 			metrics.IncreaseKafkaTotalOperationsCountMetric(constants.KafkaOperationDeprovision)
@@ -634,15 +1129,18 @@ func (k *kafkaService) DeprovisionExpiredKafkas() *errors.ServiceError {
 	}
 	glog.V(10).Infof("Kafka instance types with lifespan set: %+v", typesWithLifespan)
 
+	// kafkas protected against deletion do not expire
 	var existingKafkaRequests []dbapi.KafkaRequest
 	db := dbConn.Where("instance_type IN (?)", typesWithLifespan).
 		Where("status NOT IN (?)", kafkaDeletionStatuses).
+		Where("deletion_protection = ?", false).
 		Scan(&existingKafkaRequests)
 	err := db.Error
 	if err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to deprovision expired kafkas")
 	}
 
+	var kafkasToDeprovision []dbapi.KafkaRequest
 	var kafkasToDeprovisionIDs []string
 	timeNow := time.Now()
 	for _, existingKafkaRequest := range existingKafkaRequests {
@@ -657,6 +1155,7 @@ func (k *kafkaService) DeprovisionExpiredKafkas() *errors.ServiceError {
 			glog.V(10).Infof("Expiration time of kafka ID '%s' is '%s'", existingKafkaRequest.ID, expTime)
 			if timeNow.After(*expTime) {
 				glog.V(10).Infof("Kafka ID '%s' has expired", existingKafkaRequest.ID)
+				kafkasToDeprovision = append(kafkasToDeprovision, existingKafkaRequest)
 				kafkasToDeprovisionIDs = append(kafkasToDeprovisionIDs, existingKafkaRequest.ID)
 			} else {
 				glog.V(10).Infof("Kafka ID '%s' still has not expired", existingKafkaRequest.ID)
@@ -674,6 +1173,9 @@ func (k *kafkaService) DeprovisionExpiredKafkas() *errors.ServiceError {
 		}
 		if db.RowsAffected >= 1 {
 			glog.Infof("%v kafka_request's lifespans are over their lifespan and have had their status updated to deprovisioning", db.RowsAffected)
+			for i := range kafkasToDeprovision {
+				k.recordKafkaEvents(&kafkasToDeprovision[i], map[string]interface{}{"status": constants.KafkaRequestStatusDeprovision})
+			}
 			var counter int64 = 0
 			for ; counter < db.RowsAffected; counter++ {
 				metrics.IncreaseKafkaTotalOperationsCountMetric(constants.KafkaOperationDeprovision)
@@ -685,6 +1187,122 @@ func (k *kafkaService) DeprovisionExpiredKafkas() *errors.ServiceError {
 	return nil
 }
 
+func (k *kafkaService) GetExpirationTime(kafkaRequest *dbapi.KafkaRequest) (*time.Time, *errors.ServiceError) {
+	if kafkaRequest.DeletionProtection {
+		return nil, nil
+	}
+
+	instanceSize, err := k.kafkaConfig.GetKafkaInstanceSize(kafkaRequest.InstanceType, kafkaRequest.SizeId)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get the expiration time of kafka %q", kafkaRequest.ID)
+	}
+
+	if instanceSize.LifespanSeconds == nil {
+		return nil, nil
+	}
+
+	return kafkaRequest.GetExpirationTime(*instanceSize.LifespanSeconds), nil
+}
+
+func (k *kafkaService) ExtendExpiration(kafkaRequest *dbapi.KafkaRequest, expiresAt time.Time) *errors.ServiceError {
+	if arrays.Contains(kafkaDeletionStatuses, kafkaRequest.Status) {
+		return errors.New(errors.ErrorValidation, "the expiration of kafka %q cannot be extended as it is being deleted", kafkaRequest.ID)
+	}
+
+	currentExpiresAt, err := k.GetExpirationTime(kafkaRequest)
+	if err != nil {
+		return err
+	}
+
+	if currentExpiresAt == nil {
+		return errors.New(errors.ErrorValidation, "kafka %q does not expire", kafkaRequest.ID)
+	}
+
+	if !expiresAt.After(*currentExpiresAt) {
+		return errors.New(errors.ErrorValidation, "the new expiration time of kafka %q must be after its current expiration time %s", kafkaRequest.ID, currentExpiresAt.Format(time.RFC3339))
+	}
+
+	if err := k.connectionFactory.New().
+		Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: kafkaRequest.ID}}).
+		Updates(map[string]interface{}{
+			"expires_at": expiresAt,
+			"expiration_notification_threshold_seconds": 0,
+		}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to extend the expiration of kafka %q", kafkaRequest.ID)
+	}
+
+	kafkaRequest.ExpiresAt = expiresAt
+	kafkaRequest.ExpirationNotificationThresholdSeconds = 0
+	return nil
+}
+
+func (k *kafkaService) ListKafkasExpiringBefore(expiresBefore time.Time) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+	var typesWithLifespan []string
+	for _, kafkaInstanceType := range k.kafkaConfig.SupportedInstanceTypes.Configuration.SupportedKafkaInstanceTypes {
+		if kafkaInstanceType.HasAnInstanceSizeWithLifespan() {
+			typesWithLifespan = append(typesWithLifespan, kafkaInstanceType.Id)
+		}
+	}
+
+	if len(typesWithLifespan) == 0 {
+		return nil, nil
+	}
+
+	// kafkas pending deletion are left out as they are deleted regardless of their expiration
+	var kafkas []*dbapi.KafkaRequest
+	if err := k.connectionFactory.New().
+		Where("instance_type IN (?)", typesWithLifespan).
+		Where("status NOT IN (?)", kafkaDeletionStatuses).
+		Where("deletion_protection = ?", false).
+		Where("deletion_requested_at IS NULL").
+		Find(&kafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list expiring kafkas")
+	}
+
+	var expiringKafkas []*dbapi.KafkaRequest
+	for _, kafka := range kafkas {
+		expiresAt, err := k.GetExpirationTime(kafka)
+		if err != nil {
+			return nil, err
+		}
+		if expiresAt != nil && expiresAt.Before(expiresBefore) {
+			kafka.ExpiresAt = *expiresAt
+			expiringKafkas = append(expiringKafkas, kafka)
+		}
+	}
+
+	return expiringKafkas, nil
+}
+
+func (k *kafkaService) DeprovisionKafkasWithExpiredDeletionGracePeriod() *errors.ServiceError {
+	var kafkasToDeprovision []dbapi.KafkaRequest
+	if err := k.connectionFactory.New().
+		Where("deletion_requested_at < ?", time.Now().Add(-k.kafkaConfig.DeletionGracePeriod)).
+		Where("status NOT IN (?)", kafkaDeletionStatuses).
+		Find(&kafkasToDeprovision).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to list kafkas with an expired deletion grace period")
+	}
+
+	// a kafka failing to be deprovisioned does not prevent the other kafkas from being deprovisioned
+	var errList errors.ErrorList
+	for i := range kafkasToDeprovision {
+		kafkaRequest := &kafkasToDeprovision[i]
+		metrics.IncreaseKafkaTotalOperationsCountMetric(constants.KafkaOperationDeprovision)
+		if err := k.updateStatusFrom(kafkaRequest, constants.KafkaRequestStatusDeprovision, constants.KafkaStatus(kafkaRequest.Status)); err != nil {
+			errList.AddErrors(fmt.Errorf("unable to deprovision kafka %q with an expired deletion grace period: %w", kafkaRequest.ID, err))
+			continue
+		}
+		metrics.IncreaseKafkaSuccessOperationsCountMetric(constants.KafkaOperationDeprovision)
+		metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants.KafkaRequestStatusDeprovision, kafkaRequest.ID, kafkaRequest.ClusterID, time.Since(kafkaRequest.CreatedAt))
+	}
+
+	if !errList.IsEmpty() {
+		return errors.NewWithCause(errors.ErrorGeneral, errList, "unable to deprovision %d of the %d kafkas with an expired deletion grace period", len(errList), len(kafkasToDeprovision))
+	}
+
+	return nil
+}
+
 func (k *kafkaService) Delete(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 	dbConn := k.connectionFactory.New()
 
@@ -776,7 +1394,7 @@ func (k *kafkaService) List(ctx context.Context, listArgs *services.ListArgument
 
 	// Apply search query
 	if len(listArgs.Search) > 0 {
-		searchDbQuery, err := coreServices.NewQueryParser().Parse(listArgs.Search)
+		searchDbQuery, err := coreServices.NewQueryParserWithKeyValueColumns(kafkaSearchKeyValueColumns).Parse(listArgs.Search)
 		if err != nil {
 			return kafkaRequestList, pagingMeta, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "unable to list kafka requests: %s", err.Error())
 		}
@@ -803,7 +1421,7 @@ func (k *kafkaService) List(ctx context.Context, listArgs *services.ListArgument
 	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
 
 	// execute query
-	if err := dbConn.Find(&kafkaRequestList).Error; err != nil {
+	if err := dbConn.Preload("Labels").Find(&kafkaRequestList).Error; err != nil {
 		return kafkaRequestList, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list kafka requests")
 	}
 
@@ -880,11 +1498,13 @@ func (k *kafkaService) GenerateReservedManagedKafkasByClusterID(clusterID string
 }
 
 func (k *kafkaService) Update(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
-	dbConn := k.connectionFactory.New().
-		Model(kafkaRequest).
-		Where("status not IN (?)", kafkaDeletionStatuses) // ignore updates of kafka under deletion
-
-	if err := dbConn.Updates(kafkaRequest).Error; err != nil {
+	err := k.updateWithKafkaEvents(kafkaRequest.ID, kafkaEventChanges(kafkaRequest), func(dbConn *gorm.DB) *gorm.DB {
+		return dbConn.
+			Model(kafkaRequest).
+			Where("status not IN (?)", kafkaDeletionStatuses). // ignore updates of kafka under deletion
+			Updates(kafkaRequest)
+	})
+	if err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update kafka")
 	}
 
@@ -892,17 +1512,42 @@ func (k *kafkaService) Update(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceE
 }
 
 func (k *kafkaService) Updates(kafkaRequest *dbapi.KafkaRequest, fields map[string]interface{}) *errors.ServiceError {
-	dbConn := k.connectionFactory.New().
-		Model(kafkaRequest).
-		Where("status not IN (?)", kafkaDeletionStatuses) // ignore updates of kafka under deletion
-
-	if err := dbConn.Updates(fields).Error; err != nil {
+	err := k.updateWithKafkaEvents(kafkaRequest.ID, fields, func(dbConn *gorm.DB) *gorm.DB {
+		return dbConn.
+			Model(kafkaRequest).
+			Where("status not IN (?)", kafkaDeletionStatuses). // ignore updates of kafka under deletion
+			Updates(fields)
+	})
+	if err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update kafka")
 	}
 
 	return nil
 }
 
+func (k *kafkaService) UpdateLabels(kafkaRequest *dbapi.KafkaRequest, labels dbapi.KafkaLabels) *errors.ServiceError {
+	for i := range labels {
+		labels[i].KafkaID = kafkaRequest.ID
+	}
+
+	err := k.connectionFactory.New().Transaction(func(dbConn *gorm.DB) error {
+		// labels are hard deleted so that a label can be set again with the same key
+		if err := dbConn.Unscoped().Where("kafka_id = ?", kafkaRequest.ID).Delete(&dbapi.KafkaLabel{}).Error; err != nil {
+			return err
+		}
+		if len(labels) == 0 {
+			return nil
+		}
+		return dbConn.Create(&labels).Error
+	})
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update labels of kafka %q", kafkaRequest.ID)
+	}
+
+	kafkaRequest.Labels = labels
+	return nil
+}
+
 func (k *kafkaService) VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 	if !auth.GetIsAdminFromContext(ctx) {
 		return errors.New(errors.ErrorUnauthenticated, "user not authenticated")
@@ -914,13 +1559,16 @@ func (k *kafkaService) VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaReque
 		"desired_strimzi_version":   kafkaRequest.DesiredStrimziVersion,
 		"desired_kafka_version":     kafkaRequest.DesiredKafkaVersion,
 		"desired_kafka_ibp_version": kafkaRequest.DesiredKafkaIBPVersion,
+		"pending_strimzi_version":   kafkaRequest.PendingStrimziVersion,
+		"pending_kafka_version":     kafkaRequest.PendingKafkaVersion,
+		"pending_kafka_ibp_version": kafkaRequest.PendingKafkaIBPVersion,
 		"status":                    kafkaRequest.Status,
 	}
 
-	dbConn := k.connectionFactory.New().
-		Model(kafkaRequest)
-
-	if err := dbConn.Updates(updatableFields).Error; err != nil {
+	err := k.updateWithKafkaEvents(kafkaRequest.ID, updatableFields, func(dbConn *gorm.DB) *gorm.DB {
+		return dbConn.Model(kafkaRequest).Updates(updatableFields)
+	})
+	if err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update kafka")
 	}
 
@@ -930,27 +1578,93 @@ func (k *kafkaService) VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaReque
 func (k *kafkaService) UpdateStatus(id string, status constants.KafkaStatus) (bool, *errors.ServiceError) {
 	dbConn := k.connectionFactory.New()
 
-	if kafka, err := k.GetByID(id); err != nil {
+	kafka, err := k.GetByID(id)
+	if err != nil {
 		return true, errors.NewWithCause(errors.ErrorGeneral, err, "failed to update status")
-	} else {
-		// only allow to change the status to "deleting" if the cluster is already in "deprovision" status
-		if kafka.Status == constants.KafkaRequestStatusDeprovision.String() && status != constants.KafkaRequestStatusDeleting {
-			return false, errors.GeneralError("failed to update status: cluster is deprovisioning")
-		}
+	}
 
-		if kafka.Status == status.String() {
-			// no update needed
-			return false, errors.GeneralError("failed to update status: the cluster %s is already in %s state", id, status.String())
-		}
+	// only allow to change the status to "deleting" if the cluster is already in "deprovision" status
+	if kafka.Status == constants.KafkaRequestStatusDeprovision.String() && status != constants.KafkaRequestStatusDeleting {
+		return false, errors.GeneralError("failed to update status: cluster is deprovisioning")
+	}
+
+	if kafka.Status == status.String() {
+		// no update needed
+		return false, errors.GeneralError("failed to update status: the cluster %s is already in %s state", id, status.String())
 	}
 
 	if err := dbConn.Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: id}}).Update("status", status).Error; err != nil {
 		return true, errors.NewWithCause(errors.ErrorGeneral, err, "failed to update kafka status")
 	}
 
+	k.recordKafkaEvents(kafka, map[string]interface{}{"status": status})
+
 	return true, nil
 }
 
+// updateWithKafkaEvents applies the given update of the kafka request with the given id and records the kafka events
+// caused by the given changes in the same transaction. The kafka request is locked while its previous values are read,
+// so that concurrent updates cannot change them before the update is applied.
+func (k *kafkaService) updateWithKafkaEvents(id string, changes map[string]interface{}, update func(dbConn *gorm.DB) *gorm.DB) error {
+	return k.connectionFactory.New().Transaction(func(dbConn *gorm.DB) error {
+		previous := findKafkaEventColumns(dbConn, id, changes)
+
+		result := update(dbConn)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if previous != nil && result.RowsAffected > 0 {
+			createKafkaEvents(dbConn, previous, changes)
+		}
+		return nil
+	})
+}
+
+// findKafkaEventColumns locks the kafka request with the given id and returns it with only the columns tracked by kafka
+// events set, or nil if none of the given changes is on a tracked column. Kafka events are recorded on a best effort
+// basis: nil is also returned if the kafka request cannot be read, in a savepoint so that the transaction can go on.
+func findKafkaEventColumns(dbConn *gorm.DB, id string, changes map[string]interface{}) *dbapi.KafkaRequest {
+	if id == "" || !hasKafkaEventColumn(changes) {
+		return nil
+	}
+
+	var kafkaRequest dbapi.KafkaRequest
+	if err := dbConn.Transaction(func(tx *gorm.DB) error {
+		return tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select(kafkaEventColumnNames).
+			Where("id = ?", id).
+			First(&kafkaRequest).Error
+	}); err != nil {
+		logger.Logger.Errorf("failed to read kafka %q to record its events: %v", id, err)
+		return nil
+	}
+
+	return &kafkaRequest
+}
+
+// recordKafkaEvents records the events caused by applying the given changes to the kafka request whose values before the
+// changes are given in previous. Failing to record the events does not fail the change of the kafka request.
+func (k *kafkaService) recordKafkaEvents(previous *dbapi.KafkaRequest, changes map[string]interface{}) {
+	createKafkaEvents(k.connectionFactory.New(), previous, changes)
+}
+
+// createKafkaEvents inserts the events caused by applying the given changes to the kafka request whose values before the
+// changes are given in previous. The events are inserted in a savepoint, so that failing to record them does not fail
+// the transaction of the change of the kafka request.
+func createKafkaEvents(dbConn *gorm.DB, previous *dbapi.KafkaRequest, changes map[string]interface{}) {
+	events := buildKafkaEvents(previous, changes)
+	if len(events) == 0 {
+		return
+	}
+
+	if err := dbConn.Transaction(func(tx *gorm.DB) error {
+		return tx.Create(&events).Error
+	}); err != nil {
+		logger.Logger.Errorf("failed to record events of kafka %q: %v", previous.ID, err)
+	}
+}
+
 func (k *kafkaService) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
 	routes, err := kafkaRequest.GetRoutes()
 	if routes == nil || err != nil {
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+)
+
+// kafkaEventColumn is a column of a kafka request whose changes are recorded as kafka events
+type kafkaEventColumn struct {
+	name      string
+	label     string
+	eventType dbapi.KafkaEventType
+	value     func(kafkaRequest *dbapi.KafkaRequest) string
+}
+
+var kafkaEventColumns = []kafkaEventColumn{
+	{name: "status", label: "status", eventType: dbapi.KafkaEventTypeStatusChange, value: func(k *dbapi.KafkaRequest) string { return k.Status }},
+	{name: "desired_kafka_version", label: "kafka version", eventType: dbapi.KafkaEventTypeUpgradeRequested, value: func(k *dbapi.KafkaRequest) string { return k.DesiredKafkaVersion }},
+	{name: "desired_strimzi_version", label: "strimzi version", eventType: dbapi.KafkaEventTypeUpgradeRequested, value: func(k *dbapi.KafkaRequest) string { return k.DesiredStrimziVersion }},
+	{name: "desired_kafka_ibp_version", label: "kafka ibp version", eventType: dbapi.KafkaEventTypeUpgradeRequested, value: func(k *dbapi.KafkaRequest) string { return k.DesiredKafkaIBPVersion }},
+	{name: "actual_kafka_version", label: "kafka version", eventType: dbapi.KafkaEventTypeUpgradeCompleted, value: func(k *dbapi.KafkaRequest) string { return k.ActualKafkaVersion }},
+	{name: "actual_strimzi_version", label: "strimzi version", eventType: dbapi.KafkaEventTypeUpgradeCompleted, value: func(k *dbapi.KafkaRequest) string { return k.ActualStrimziVersion }},
+	{name: "actual_kafka_ibp_version", label: "kafka ibp version", eventType: dbapi.KafkaEventTypeUpgradeCompleted, value: func(k *dbapi.KafkaRequest) string { return k.ActualKafkaIBPVersion }},
+	{name: "cluster_id", label: "cluster", eventType: dbapi.KafkaEventTypePlacementChange, value: func(k *dbapi.KafkaRequest) string { return k.ClusterID }},
+	{name: "placement_id", label: "placement id", eventType: dbapi.KafkaEventTypePlacementChange, value: func(k *dbapi.KafkaRequest) string { return k.PlacementId }},
+	{name: "size_id", label: "size", eventType: dbapi.KafkaEventTypeResize, value: func(k *dbapi.KafkaRequest) string { return k.SizeId }},
+}
+
+// kafkaEventColumnNames are the columns that have to be read to compute the kafka events caused by an update
+var kafkaEventColumnNames = func() []string {
+	names := []string{"id", "failed_reason"}
+	for _, column := range kafkaEventColumns {
+		names = append(names, column.name)
+	}
+	return names
+}()
+
+// PublicKafkaEventFields are the fields of the kafka events that are visible to the owners of the kafkas. The placement
+// of the kafkas and the versions of their components other than kafka are internal to the service.
+var PublicKafkaEventFields = []string{"status", "desired_kafka_version", "actual_kafka_version", "size_id"}
+
+//go:generate moq -out kafka_event_service_moq.go . KafkaEventService
+type KafkaEventService interface {
+	// List returns the events of the kafka with the given id, oldest first. Only the events on one of the given fields
+	// are returned if any field is given.
+	List(kafkaID string, fields []string, listArgs *coreServices.ListArguments) (dbapi.KafkaEventList, *api.PagingMeta, *apiErrors.ServiceError)
+}
+
+var _ KafkaEventService = &kafkaEventService{}
+
+type kafkaEventService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewKafkaEventService(connectionFactory *db.ConnectionFactory) KafkaEventService {
+	return &kafkaEventService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *kafkaEventService) List(kafkaID string, fields []string, listArgs *coreServices.ListArguments) (dbapi.KafkaEventList, *api.PagingMeta, *apiErrors.ServiceError) {
+	var events dbapi.KafkaEventList
+	dbConn := s.connectionFactory.New().Where("kafka_id = ?", kafkaID)
+	if len(fields) > 0 {
+		dbConn = dbConn.Where("field IN (?)", fields)
+	}
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	total := int64(pagingMeta.Total)
+	dbConn.Model(&events).Count(&total)
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	dbConn = dbConn.Order("created_at asc").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size)
+
+	if err := dbConn.Find(&events).Error; err != nil {
+		return events, pagingMeta, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "unable to list events of kafka %q", kafkaID)
+	}
+
+	return events, pagingMeta, nil
+}
+
+// buildKafkaEvents returns an event for each tracked column of the kafka whose value in changes differs from its value
+// in previous. previous holds the values of the kafka before the changes are applied.
+func buildKafkaEvents(previous *dbapi.KafkaRequest, changes map[string]interface{}) []*dbapi.KafkaEvent {
+	clusterID := previous.ClusterID
+	if value, ok := changes["cluster_id"]; ok {
+		clusterID = fmt.Sprint(value)
+	}
+
+	var events []*dbapi.KafkaEvent
+	for _, column := range kafkaEventColumns {
+		newValue, ok := changes[column.name]
+		if !ok {
+			continue
+		}
+
+		previousValue := column.value(previous)
+		value := fmt.Sprint(newValue)
+		if value == previousValue {
+			continue
+		}
+
+		var message string
+		switch column.eventType {
+		case dbapi.KafkaEventTypeStatusChange:
+			message = fmt.Sprintf("status changed from %q to %q", previousValue, value)
+			if previousValue == "" {
+				message = fmt.Sprintf("kafka created with status %q", value)
+			}
+			if failedReason, ok := changes["failed_reason"]; ok && value == constants.KafkaRequestStatusFailed.String() {
+				message = fmt.Sprintf("%s: %v", message, failedReason)
+			}
+		case dbapi.KafkaEventTypeUpgradeRequested, dbapi.KafkaEventTypeUpgradeCompleted:
+			// versions are set when the kafka is first provisioned and reset when it is unassigned from its data plane
+			// cluster. Neither of them is an upgrade
+			if previousValue == "" || value == "" {
+				continue
+			}
+			if column.eventType == dbapi.KafkaEventTypeUpgradeRequested {
+				message = fmt.Sprintf("upgrade of %s from %q to %q requested", column.label, previousValue, value)
+			} else {
+				message = fmt.Sprintf("%s upgraded from %q to %q", column.label, previousValue, value)
+			}
+		case dbapi.KafkaEventTypePlacementChange:
+			switch {
+			case column.name != "cluster_id":
+				message = fmt.Sprintf("%s changed from %q to %q", column.label, previousValue, value)
+			case previousValue == "":
+				message = fmt.Sprintf("kafka assigned to cluster %q", value)
+			case value == "":
+				message = fmt.Sprintf("kafka unassigned from cluster %q", previousValue)
+			default:
+				message = fmt.Sprintf("kafka moved from cluster %q to cluster %q", previousValue, value)
+			}
+		default:
+			message = fmt.Sprintf("%s changed from %q to %q", column.label, previousValue, value)
+		}
+
+		events = append(events, &dbapi.KafkaEvent{
+			KafkaID:       previous.ID,
+			EventType:     column.eventType,
+			Field:         column.name,
+			PreviousValue: previousValue,
+			Value:         value,
+			ClusterID:     clusterID,
+			Message:       message,
+		})
+	}
+
+	return events
+}
+
+// kafkaEventChanges returns the values of the tracked columns set in the given kafka request. Empty values are left out
+// as they are not written when the kafka request is updated.
+func kafkaEventChanges(kafkaRequest *dbapi.KafkaRequest) map[string]interface{} {
+	changes := map[string]interface{}{}
+	for _, column := range kafkaEventColumns {
+		if value := column.value(kafkaRequest); value != "" {
+			changes[column.name] = value
+		}
+	}
+	if kafkaRequest.FailedReason != "" {
+		changes["failed_reason"] = kafkaRequest.FailedReason
+	}
+	return changes
+}
+
+// hasKafkaEventColumn returns true if any of the given changes is on a column tracked by kafka events
+func hasKafkaEventColumn(changes map[string]interface{}) bool {
+	for _, column := range kafkaEventColumns {
+		if _, ok := changes[column.name]; ok {
+			return true
+		}
+	}
+	return false
+}
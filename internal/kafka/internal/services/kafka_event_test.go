@@ -0,0 +1,174 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_buildKafkaEvents(t *testing.T) {
+	previous := &dbapi.KafkaRequest{
+		Meta:                api.Meta{ID: "kafka-id"},
+		Status:              constants.KafkaRequestStatusProvisioning.String(),
+		ClusterID:           "cluster-1",
+		PlacementId:         "placement-1",
+		DesiredKafkaVersion: "3.0.0",
+		ActualKafkaVersion:  "3.0.0",
+	}
+
+	tests := []struct {
+		name     string
+		previous *dbapi.KafkaRequest
+		changes  map[string]interface{}
+		want     []*dbapi.KafkaEvent
+	}{
+		{
+			name:     "should not record any event if no tracked column changed",
+			previous: previous,
+			changes:  map[string]interface{}{"status": constants.KafkaRequestStatusProvisioning, "routes_created": true},
+			want:     nil,
+		},
+		{
+			name:     "should record the change of status along with the reason of the failure",
+			previous: previous,
+			changes:  map[string]interface{}{"status": constants.KafkaRequestStatusFailed.String(), "failed_reason": "no capacity"},
+			want: []*dbapi.KafkaEvent{
+				{
+					KafkaID:       "kafka-id",
+					EventType:     dbapi.KafkaEventTypeStatusChange,
+					Field:         "status",
+					PreviousValue: constants.KafkaRequestStatusProvisioning.String(),
+					Value:         constants.KafkaRequestStatusFailed.String(),
+					ClusterID:     "cluster-1",
+					Message:       `status changed from "provisioning" to "failed": no capacity`,
+				},
+			},
+		},
+		{
+			name:     "should record the creation of the kafka",
+			previous: &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-id"}},
+			changes:  map[string]interface{}{"status": constants.KafkaRequestStatusAccepted.String(), "cluster_id": ""},
+			want: []*dbapi.KafkaEvent{
+				{
+					KafkaID:   "kafka-id",
+					EventType: dbapi.KafkaEventTypeStatusChange,
+					Field:     "status",
+					Value:     constants.KafkaRequestStatusAccepted.String(),
+					Message:   `kafka created with status "accepted"`,
+				},
+			},
+		},
+		{
+			name:     "should record upgrades but not the versions set when the kafka is unassigned from its cluster",
+			previous: previous,
+			changes:  map[string]interface{}{"actual_kafka_version": "3.1.0", "desired_kafka_version": ""},
+			want: []*dbapi.KafkaEvent{
+				{
+					KafkaID:       "kafka-id",
+					EventType:     dbapi.KafkaEventTypeUpgradeCompleted,
+					Field:         "actual_kafka_version",
+					PreviousValue: "3.0.0",
+					Value:         "3.1.0",
+					ClusterID:     "cluster-1",
+					Message:       `kafka version upgraded from "3.0.0" to "3.1.0"`,
+				},
+			},
+		},
+		{
+			name:     "should record the move of the kafka to another cluster",
+			previous: previous,
+			changes:  map[string]interface{}{"cluster_id": "cluster-2", "placement_id": "placement-2"},
+			want: []*dbapi.KafkaEvent{
+				{
+					KafkaID:       "kafka-id",
+					EventType:     dbapi.KafkaEventTypePlacementChange,
+					Field:         "cluster_id",
+					PreviousValue: "cluster-1",
+					Value:         "cluster-2",
+					ClusterID:     "cluster-2",
+					Message:       `kafka moved from cluster "cluster-1" to cluster "cluster-2"`,
+				},
+				{
+					KafkaID:       "kafka-id",
+					EventType:     dbapi.KafkaEventTypePlacementChange,
+					Field:         "placement_id",
+					PreviousValue: "placement-1",
+					Value:         "placement-2",
+					ClusterID:     "cluster-2",
+					Message:       `placement id changed from "placement-1" to "placement-2"`,
+				},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(buildKafkaEvents(tt.previous, tt.changes)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_kafkaService_Updates_RecordsKafkaEvents(t *testing.T) {
+	tests := []struct {
+		name              string
+		insertEventsError bool
+	}{
+		{
+			name: "should record the events of the update",
+		},
+		{
+			name:              "should not fail the update if the events cannot be recorded",
+			insertEventsError: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			// the previous values are read with a lock on the kafka, so that they cannot change before the update
+			selectPrevious := mocket.Catcher.Reset().NewMock().WithQuery(`FOR UPDATE`).
+				WithReply([]map[string]interface{}{{"id": "kafka-id", "status": constants.KafkaRequestStatusProvisioning.String()}})
+			update := mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`).WithRowsNum(1)
+			insertEvent := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_events"`)
+			if tt.insertEventsError {
+				insertEvent.WithQueryException().WithExecException()
+			}
+
+			k := kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			kafkaRequest := &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-id"}, Status: constants.KafkaRequestStatusProvisioning.String()}
+			err := k.Updates(kafkaRequest, map[string]interface{}{"status": constants.KafkaRequestStatusReady.String()})
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(selectPrevious.Triggered).To(gomega.BeTrue())
+			g.Expect(update.Triggered).To(gomega.BeTrue())
+			g.Expect(insertEvent.Triggered).To(gomega.BeTrue())
+		})
+	}
+}
+
+func Test_kafkaEventService_List(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_events"`).
+		WithReply([]map[string]interface{}{
+			{"id": "event-1", "kafka_id": "kafka-id", "event_type": "status_change"},
+			{"id": "event-2", "kafka_id": "kafka-id", "event_type": "resize"},
+		})
+	s := NewKafkaEventService(db.NewMockConnectionFactory(nil))
+	got, _, err := s.List("kafka-id", PublicKafkaEventFields, coreServices.NewListArguments(nil))
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(got).To(gomega.HaveLen(2))
+
+	mocket.Catcher.Reset().NewMock().WithQueryException()
+	_, _, err = s.List("kafka-id", nil, coreServices.NewListArguments(nil))
+	g.Expect(err).ToNot(gomega.BeNil())
+}
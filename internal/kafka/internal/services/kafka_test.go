@@ -28,6 +28,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/onsi/gomega"
 	goerrors "github.com/pkg/errors"
 	mocket "github.com/selvatico/go-mocket"
@@ -323,13 +324,21 @@ func Test_kafkaService_Get(t *testing.T) {
 				ctx: authenticatedCtx,
 				id:  testID,
 			},
-			want: buildKafkaRequest(nil),
+			want: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.Labels = dbapi.KafkaLabels{
+					{Meta: api.Meta{ID: "label-id"}, KafkaID: testID, Key: "team", Value: "payments"},
+				}
+			}),
 			setupFn: func() {
 				mocket.Catcher.Reset().
 					NewMock().
 					WithQuery(`SELECT * FROM "kafka_requests" WHERE id = $1 AND owner = $2`).
 					WithArgs(testID, testUser).
 					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(nil)))
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT * FROM "kafka_labels" WHERE "kafka_labels"."kafka_id" = $1`).
+					WithArgs(testID).
+					WithReply([]map[string]interface{}{{"id": "label-id", "kafka_id": testID, "key": "team", "value": "payments"}})
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 		},
@@ -671,19 +680,57 @@ func Test_kafkaService_PrepareKafkaRequest(t *testing.T) {
 
 func Test_kafkaService_RegisterKafkaDeprovisionJob(t *testing.T) {
 	type fields struct {
-		connectionFactory *db.ConnectionFactory
-		quotaService      QuotaService
+		connectionFactory   *db.ConnectionFactory
+		quotaService        QuotaService
+		deletionGracePeriod time.Duration
 	}
 	type args struct {
-		kafkaRequest *dbapi.KafkaRequest
+		ctx                      context.Context
+		kafkaRequest             *dbapi.KafkaRequest
+		ignoreDeletionProtection bool
+	}
+
+	authHelper, err := auth.NewAuthHelper(JwtKeyFile, JwtCAFile, "")
+	if err != nil {
+		t.Fatalf("failed to create auth helper: %s", err.Error())
+	}
+	account, err := authHelper.NewAccount(testUser, "", "", "")
+	if err != nil {
+		t.Fatal("failed to build a new account")
 	}
+	jwt, err := authHelper.CreateJWTWithClaims(account, nil)
+	if err != nil {
+		t.Fatalf("failed to create jwt: %s", err.Error())
+	}
+	authenticatedCtx := auth.SetTokenInContext(context.TODO(), jwt)
+	authenticatedAdminCtx := auth.SetTokenInContext(auth.SetIsAdminContext(context.TODO(), true), jwt)
+
+	protectedKafkaRequest := buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+		kafkaRequest.ID = testID
+		kafkaRequest.DeletionProtection = true
+	})
+	readyKafkaRequest := buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+		kafkaRequest.ID = testID
+		kafkaRequest.Status = constants.KafkaRequestStatusReady.String()
+	})
+	deletionRequestedAt := time.Now()
+	kafkaRequestPendingDeletion := buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+		kafkaRequest.ID = testID
+		kafkaRequest.Status = constants.KafkaRequestStatusSuspended.String()
+		kafkaRequest.DeletionRequestedAt = &deletionRequestedAt
+	})
+
+	// set by the test cases expecting the status of the kafka to be updated
+	var statusUpdate *mocket.FakeResponse
+
 	tests := []struct {
-		name       string
-		fields     fields
-		args       args
-		wantErr    bool
-		wantErrMsg string
-		setupFn    func()
+		name             string
+		fields           fields
+		args             args
+		wantErr          bool
+		wantErrMsg       string
+		wantStatusUpdate bool
+		setupFn          func()
 	}{
 		{
 			name: "error when id is undefined",
@@ -735,20 +782,145 @@ func Test_kafkaService_RegisterKafkaDeprovisionJob(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "error when the kafka is protected against deletion",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			args: args{
+				ctx:                      authenticatedCtx,
+				kafkaRequest:             protectedKafkaRequest,
+				ignoreDeletionProtection: true,
+			},
+			wantErr:    true,
+			wantErrMsg: "KAFKAS-MGMT-48",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).WithReply(converters.ConvertKafkaRequest(protectedKafkaRequest))
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`).WithExecException()
+			},
+		},
+		{
+			name: "error when an admin deletes a kafka protected against deletion without ignoring the deletion protection",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			args: args{
+				ctx:          authenticatedAdminCtx,
+				kafkaRequest: protectedKafkaRequest,
+			},
+			wantErr:    true,
+			wantErrMsg: "KAFKAS-MGMT-48",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).WithReply(converters.ConvertKafkaRequest(protectedKafkaRequest))
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`).WithExecException()
+			},
+		},
+		{
+			name: "admin can delete a kafka protected against deletion when ignoring the deletion protection",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			args: args{
+				ctx:                      authenticatedAdminCtx,
+				kafkaRequest:             protectedKafkaRequest,
+				ignoreDeletionProtection: true,
+			},
+			wantErr: false,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).WithReply(converters.ConvertKafkaRequest(protectedKafkaRequest))
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`).WithReply(nil)
+			},
+		},
+		{
+			name: "suspends a ready kafka instead of deprovisioning it when a deletion grace period is set",
+			fields: fields{
+				connectionFactory:   db.NewMockConnectionFactory(nil),
+				deletionGracePeriod: time.Hour,
+			},
+			args: args{
+				ctx:          authenticatedCtx,
+				kafkaRequest: readyKafkaRequest,
+			},
+			wantErr: false,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).WithReply(converters.ConvertKafkaRequest(readyKafkaRequest))
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "deletion_requested_at"=$1,"status"=$2`).WithRowsNum(1)
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`).WithExecException()
+			},
+		},
+		{
+			name: "does nothing when the kafka is already pending deletion",
+			fields: fields{
+				connectionFactory:   db.NewMockConnectionFactory(nil),
+				deletionGracePeriod: time.Hour,
+			},
+			args: args{
+				ctx:          authenticatedCtx,
+				kafkaRequest: kafkaRequestPendingDeletion,
+			},
+			wantErr: false,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).WithReply([]map[string]interface{}{{"id": testID, "status": constants.KafkaRequestStatusSuspended.String(), "deletion_requested_at": deletionRequestedAt}})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`).WithExecException()
+			},
+		},
+		{
+			name: "admin deprovisions a ready kafka right away when a deletion grace period is set",
+			fields: fields{
+				connectionFactory:   db.NewMockConnectionFactory(nil),
+				deletionGracePeriod: time.Hour,
+			},
+			args: args{
+				ctx:          authenticatedAdminCtx,
+				kafkaRequest: readyKafkaRequest,
+			},
+			wantErr:          false,
+			wantStatusUpdate: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).WithReply(converters.ConvertKafkaRequest(readyKafkaRequest))
+				statusUpdate = mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1`).WithRowsNum(1)
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`).WithExecException()
+			},
+		},
+		{
+			name: "admin deprovisions a kafka already pending deletion right away",
+			fields: fields{
+				connectionFactory:   db.NewMockConnectionFactory(nil),
+				deletionGracePeriod: time.Hour,
+			},
+			args: args{
+				ctx:          authenticatedAdminCtx,
+				kafkaRequest: kafkaRequestPendingDeletion,
+			},
+			wantErr:          false,
+			wantStatusUpdate: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).WithReply([]map[string]interface{}{{"id": testID, "status": constants.KafkaRequestStatusSuspended.String(), "deletion_requested_at": deletionRequestedAt}})
+				statusUpdate = mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1`).WithRowsNum(1)
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`).WithExecException()
+			},
+		},
 	}
 	for _, testcase := range tests {
 		tt := testcase
 
 		t.Run(tt.name, func(t *testing.T) {
+			statusUpdate = nil
 			if tt.setupFn != nil {
 				tt.setupFn()
 			}
+			kafkaConfig := config.NewKafkaConfig()
+			kafkaConfig.DeletionGracePeriod = tt.fields.deletionGracePeriod
 			k := &kafkaService{
 				connectionFactory: tt.fields.connectionFactory,
-				kafkaConfig:       config.NewKafkaConfig(),
+				kafkaConfig:       kafkaConfig,
 				awsConfig:         config.NewAWSConfig(),
 			}
-			err := k.RegisterKafkaDeprovisionJob(context.TODO(), tt.args.kafkaRequest.ID)
+			ctx := tt.args.ctx
+			if ctx == nil {
+				ctx = context.TODO()
+			}
+			err := k.RegisterKafkaDeprovisionJob(ctx, tt.args.kafkaRequest.ID, tt.args.ignoreDeletionProtection)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -758,6 +930,9 @@ func Test_kafkaService_RegisterKafkaDeprovisionJob(t *testing.T) {
 					t.Errorf("bad error message received: '%s'. Expecting to contain %s", err.Error(), tt.wantErrMsg)
 				}
 			}
+			if tt.wantStatusUpdate && (statusUpdate == nil || !statusUpdate.Triggered) {
+				t.Errorf("expected the status of the kafka to be updated")
+			}
 		})
 	}
 }
@@ -943,6 +1118,7 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 		dataplaneClusterConfig *config.DataplaneClusterConfig
 		providerConfig         *config.ProviderConfig
 		clusterPlmtStrategy    ClusterPlacementStrategy
+		capacityReservations   CapacityReservationUsageList
 	}
 
 	type errorCheck struct {
@@ -1047,6 +1223,62 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 				wantErr: false,
 			},
 		},
+		{
+			name: "unsuccessful registering kafka job when the capacity left in the region is reserved by another organisation",
+			fields: fields{
+				connectionFactory:      db.NewMockConnectionFactory(nil),
+				clusterService:         nil,
+				kafkaConfig:            defaultKafkaConf,
+				dataplaneClusterConfig: buildDataplaneClusterConfig(defaultDataplaneClusterConfig),
+				clusterPlmtStrategy: &ClusterPlacementStrategyMock{
+					FindClusterFunc: func(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+						return mockCluster, nil
+					},
+				},
+				quotaService: &QuotaServiceMock{
+					CheckIfQuotaIsDefinedForInstanceTypeFunc: func(owner string, organisationID string, instanceType types.KafkaInstanceType, billingModel config.KafkaBillingModel) (bool, *errors.ServiceError) {
+						return true, nil
+					},
+					ReserveQuotaFunc: func(kafka *dbapi.KafkaRequest) (string, *errors.ServiceError) {
+						return "fake-subscription-id", nil
+					},
+				},
+				providerConfig: buildProviderConfiguration(testKafkaRequestRegion, MaxClusterCapacity, MaxClusterCapacity, false),
+				capacityReservations: CapacityReservationUsageList{
+					{
+						Reservation: &dbapi.CapacityReservation{
+							OrganisationId: "other-org",
+							CloudProvider:  "aws",
+							Region:         testKafkaRequestRegion,
+							InstanceType:   types.STANDARD.String(),
+							StreamingUnits: MaxClusterCapacity - 1,
+						},
+					},
+				},
+			},
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					// we need to empty to ID otherwise an UPDATE will be performed instead of an insert
+					kafkaRequest.ID = ""
+					kafkaRequest.InstanceType = types.STANDARD.String()
+				}),
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "kafka_requests" WHERE region = $1 AND cloud_provider = $2 AND instance_type = $3 AND "kafka_requests"."deleted_at" IS NULL`).
+					WithArgs("us-east-1", "aws", "standard").
+					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+						kafkaRequest.InstanceType = types.STANDARD.String()
+					})))
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			},
+			error: errorCheck{
+				wantErr:  true,
+				code:     errors.ErrorTooManyKafkaInstancesReached,
+				httpCode: http.StatusForbidden,
+			},
+		},
 		{
 			name: "registering kafka job succeeds with developer",
 			fields: fields{
@@ -1582,6 +1814,11 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 				providerConfig:           tt.fields.providerConfig,
 				clusterPlacementStrategy: tt.fields.clusterPlmtStrategy,
 				dataplaneClusterConfig:   tt.fields.dataplaneClusterConfig,
+				capacityReservationService: &CapacityReservationServiceMock{
+					ListActiveUsagesFunc: func() (CapacityReservationUsageList, *errors.ServiceError) {
+						return tt.fields.capacityReservations, nil
+					},
+				},
 				quotaServiceFactory: &QuotaServiceFactoryMock{
 					GetQuotaServiceFunc: func(quotaType api.QuotaType) (QuotaService, *errors.ServiceError) {
 						return tt.fields.quotaService, nil
@@ -2442,94 +2679,1022 @@ func Test_kafkaService_Updates(t *testing.T) {
 	}
 }
 
-func Test_kafkaService_DeprovisionKafkaForUsers(t *testing.T) {
-	type fields struct {
-		connectionFactory *db.ConnectionFactory
-	}
+func Test_kafkaService_SuspendKafka(t *testing.T) {
 	type args struct {
-		users []string
+		kafkaRequest *dbapi.KafkaRequest
 	}
-
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr bool
-		setupFn func()
+		name       string
+		args       args
+		wantErr    bool
+		wantCode   errors.ServiceErrorCode
+		wantStatus string
+		setupFn    func()
 	}{
 		{
-			name: "should receive error when update fails",
-			fields: fields{
-				connectionFactory: db.NewMockConnectionFactory(nil),
+			name: "should not update kafka when it is already suspended",
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusSuspended.String()
+				}),
 			},
-			wantErr: true,
+			wantStatus: constants.KafkaRequestStatusSuspended.String(),
 			setupFn: func() {
-				mocket.Catcher.Reset().NewMock().WithQuery("UPDATE").WithError(fmt.Errorf("some update error"))
-				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
 			},
-			args: args{users: []string{"user"}},
 		},
 		{
-			name: "should not receive error when update succeed",
-			fields: fields{
-				connectionFactory: db.NewMockConnectionFactory(nil),
+			name: "should return a conflict error when kafka is not ready",
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusProvisioning.String()
+				}),
 			},
-			wantErr: false,
-			args:    args{users: []string{"user"}},
+			wantErr:    true,
+			wantCode:   errors.ErrorConflict,
+			wantStatus: constants.KafkaRequestStatusProvisioning.String(),
 			setupFn: func() {
-				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"`)
-				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should return a conflict error when kafka status has been changed in the meantime",
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusReady.String()
+				}),
+			},
+			wantErr:    true,
+			wantCode:   errors.ErrorConflict,
+			wantStatus: constants.KafkaRequestStatusReady.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1`).WithRowsNum(0)
+			},
+		},
+		{
+			name: "should return an error when the database update fails",
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusReady.String()
+				}),
+			},
+			wantErr:    true,
+			wantCode:   errors.ErrorGeneral,
+			wantStatus: constants.KafkaRequestStatusReady.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1`).WithExecException()
+			},
+		},
+		{
+			name: "should move a ready kafka to suspending",
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusReady.String()
+				}),
+			},
+			wantStatus: constants.KafkaRequestStatusSuspending.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1`).WithRowsNum(1)
 			},
 		},
 	}
-
 	for _, testcase := range tests {
 		tt := testcase
 
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
 			tt.setupFn()
-			k := kafkaService{
-				connectionFactory: tt.fields.connectionFactory,
+			k := &kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				kafkaConfig:       &defaultKafkaConf,
 			}
-			err := k.DeprovisionKafkaForUsers(tt.args.users)
+			err := k.SuspendKafka(tt.args.kafkaRequest)
 			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(err.Code).To(gomega.Equal(tt.wantCode))
+			}
+			g.Expect(tt.args.kafkaRequest.Status).To(gomega.Equal(tt.wantStatus))
 		})
 	}
 }
 
-func Test_kafkaService_DeprovisionExpiredKafkas(t *testing.T) {
+func Test_kafkaService_ResumeKafka(t *testing.T) {
 	type fields struct {
-		connectionFactory *db.ConnectionFactory
+		quotaService QuotaService
+	}
+	type args struct {
+		kafkaRequest *dbapi.KafkaRequest
 	}
 
-	const instanceType = "type1"
-	const instanceSize = "size4"
+	quotaServiceWithQuota := func(hasQuota bool) QuotaService {
+		return &QuotaServiceMock{
+			CheckIfQuotaIsDefinedForInstanceTypeFunc: func(username string, externalID string, instanceTypeID types.KafkaInstanceType, kafkaBillingModel config.KafkaBillingModel) (bool, *errors.ServiceError) {
+				return hasQuota, nil
+			},
+		}
+	}
 
 	tests := []struct {
-		name    string
-		fields  fields
-		wantErr bool
-		setupFn func()
+		name       string
+		fields     fields
+		args       args
+		wantErr    bool
+		wantCode   errors.ServiceErrorCode
+		wantStatus string
+		setupFn    func()
 	}{
 		{
-			name: "fail when database update throws an error",
-			fields: fields{
-				connectionFactory: db.NewMockConnectionFactory(nil),
+			name: "should not update kafka when it is already resuming",
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusResuming.String()
+				}),
 			},
-			wantErr: true,
+			wantStatus: constants.KafkaRequestStatusResuming.String(),
 			setupFn: func() {
-				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type IN ($1) AND status NOT IN ($2,$3)`).WithReply([]map[string]interface{}{{"id": "kafkainstance1", "instance_type": instanceType, "size_id": instanceSize}})
-				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE id IN ($3)`).WithError(fmt.Errorf("an update error"))
-				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
 			},
 		},
 		{
-			name: "success when database does not throw an error",
-			fields: fields{
-				connectionFactory: db.NewMockConnectionFactory(nil),
+			name: "should return a conflict error when kafka is not suspended",
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusReady.String()
+				}),
 			},
-			wantErr: false,
+			wantErr:    true,
+			wantCode:   errors.ErrorConflict,
+			wantStatus: constants.KafkaRequestStatusReady.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should return an insufficient quota error when quota is no longer available",
+			fields: fields{
+				quotaService: quotaServiceWithQuota(false),
+			},
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusSuspended.String()
+					kafkaRequest.InstanceType = types.STANDARD.String()
+					kafkaRequest.ActualKafkaBillingModel = "standard"
+				}),
+			},
+			wantErr:    true,
+			wantCode:   errors.ErrorInsufficientQuota,
+			wantStatus: constants.KafkaRequestStatusSuspended.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should return an error when the billing model of the kafka is not supported",
+			fields: fields{
+				quotaService: quotaServiceWithQuota(true),
+			},
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusSuspended.String()
+					kafkaRequest.InstanceType = types.STANDARD.String()
+					kafkaRequest.ActualKafkaBillingModel = "unsupported"
+				}),
+			},
+			wantErr:    true,
+			wantCode:   errors.ErrorGeneral,
+			wantStatus: constants.KafkaRequestStatusSuspended.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should move a suspended kafka to resuming",
+			fields: fields{
+				quotaService: quotaServiceWithQuota(true),
+			},
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusSuspended.String()
+					kafkaRequest.InstanceType = types.STANDARD.String()
+				}),
+			},
+			wantStatus: constants.KafkaRequestStatusResuming.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE status IN ($3,$4) AND deletion_requested_at IS NULL`).WithRowsNum(1)
+			},
+		},
+		{
+			name: "should return a conflict error when the deletion of the kafka has been requested in the meantime",
+			fields: fields{
+				quotaService: quotaServiceWithQuota(true),
+			},
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusSuspended.String()
+					kafkaRequest.InstanceType = types.STANDARD.String()
+				}),
+			},
+			wantErr:    true,
+			wantCode:   errors.ErrorConflict,
+			wantStatus: constants.KafkaRequestStatusSuspended.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE status IN ($3,$4) AND deletion_requested_at IS NULL`).WithRowsNum(0)
+			},
+		},
+		{
+			name: "should return a conflict error when kafka is pending deletion",
+			fields: fields{
+				quotaService: quotaServiceWithQuota(true),
+			},
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					deletionRequestedAt := time.Now()
+					kafkaRequest.Status = constants.KafkaRequestStatusSuspended.String()
+					kafkaRequest.DeletionRequestedAt = &deletionRequestedAt
+				}),
+			},
+			wantErr:    true,
+			wantCode:   errors.ErrorConflict,
+			wantStatus: constants.KafkaRequestStatusSuspended.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
+			},
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			k := &kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				kafkaConfig:       &defaultKafkaConf,
+				quotaServiceFactory: &QuotaServiceFactoryMock{
+					GetQuotaServiceFunc: func(quotaType api.QuotaType) (QuotaService, *errors.ServiceError) {
+						return tt.fields.quotaService, nil
+					},
+				},
+			}
+			err := k.ResumeKafka(tt.args.kafkaRequest)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(err.Code).To(gomega.Equal(tt.wantCode))
+			}
+			g.Expect(tt.args.kafkaRequest.Status).To(gomega.Equal(tt.wantStatus))
+		})
+	}
+}
+
+func Test_kafkaService_RestoreKafka(t *testing.T) {
+	type fields struct {
+		quotaService QuotaService
+	}
+	type args struct {
+		kafkaRequest *dbapi.KafkaRequest
+	}
+
+	quotaServiceWithQuota := func(hasQuota bool) QuotaService {
+		return &QuotaServiceMock{
+			CheckIfQuotaIsDefinedForInstanceTypeFunc: func(username string, externalID string, instanceTypeID types.KafkaInstanceType, kafkaBillingModel config.KafkaBillingModel) (bool, *errors.ServiceError) {
+				return hasQuota, nil
+			},
+		}
+	}
+
+	kafkaPendingDeletionSince := func(deletionRequestedAt time.Time) *dbapi.KafkaRequest {
+		return buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+			kafkaRequest.Status = constants.KafkaRequestStatusSuspended.String()
+			kafkaRequest.InstanceType = types.STANDARD.String()
+			kafkaRequest.DeletionRequestedAt = &deletionRequestedAt
+		})
+	}
+
+	kafkaConf := defaultKafkaConf
+	kafkaConf.DeletionGracePeriod = time.Hour
+
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantErr    bool
+		wantCode   errors.ServiceErrorCode
+		wantStatus string
+		setupFn    func()
+	}{
+		{
+			name: "should return a conflict error when kafka is not pending deletion",
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusSuspended.String()
+				}),
+			},
+			wantErr:    true,
+			wantCode:   errors.ErrorConflict,
+			wantStatus: constants.KafkaRequestStatusSuspended.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should return a gone error when the deletion grace period has expired",
+			args: args{
+				kafkaRequest: kafkaPendingDeletionSince(time.Now().Add(-2 * time.Hour)),
+			},
+			wantErr:    true,
+			wantCode:   errors.ErrorGone,
+			wantStatus: constants.KafkaRequestStatusSuspended.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should return a gone error when the kafka is already being deprovisioned",
+			args: args{
+				kafkaRequest: func() *dbapi.KafkaRequest {
+					kafkaRequest := kafkaPendingDeletionSince(time.Now())
+					kafkaRequest.Status = constants.KafkaRequestStatusDeprovision.String()
+					return kafkaRequest
+				}(),
+			},
+			wantErr:    true,
+			wantCode:   errors.ErrorGone,
+			wantStatus: constants.KafkaRequestStatusDeprovision.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should return a conflict error when the kafka pending deletion is not suspended",
+			args: args{
+				kafkaRequest: func() *dbapi.KafkaRequest {
+					kafkaRequest := kafkaPendingDeletionSince(time.Now())
+					kafkaRequest.Status = constants.KafkaRequestStatusFailed.String()
+					return kafkaRequest
+				}(),
+			},
+			wantErr:    true,
+			wantCode:   errors.ErrorConflict,
+			wantStatus: constants.KafkaRequestStatusFailed.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should return an insufficient quota error when quota is no longer available",
+			fields: fields{
+				quotaService: quotaServiceWithQuota(false),
+			},
+			args: args{
+				kafkaRequest: kafkaPendingDeletionSince(time.Now()),
+			},
+			wantErr:    true,
+			wantCode:   errors.ErrorInsufficientQuota,
+			wantStatus: constants.KafkaRequestStatusSuspended.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should return a conflict error when the status of the kafka has been changed in the meantime",
+			fields: fields{
+				quotaService: quotaServiceWithQuota(true),
+			},
+			args: args{
+				kafkaRequest: kafkaPendingDeletionSince(time.Now()),
+			},
+			wantErr:    true,
+			wantCode:   errors.ErrorConflict,
+			wantStatus: constants.KafkaRequestStatusSuspended.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "deletion_requested_at"=$1,"status"=$2`).WithRowsNum(0)
+			},
+		},
+		{
+			name: "should move a kafka pending deletion to resuming",
+			fields: fields{
+				quotaService: quotaServiceWithQuota(true),
+			},
+			args: args{
+				kafkaRequest: kafkaPendingDeletionSince(time.Now()),
+			},
+			wantStatus: constants.KafkaRequestStatusResuming.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "deletion_requested_at"=$1,"status"=$2`).WithRowsNum(1)
+			},
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			k := &kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				kafkaConfig:       &kafkaConf,
+				quotaServiceFactory: &QuotaServiceFactoryMock{
+					GetQuotaServiceFunc: func(quotaType api.QuotaType) (QuotaService, *errors.ServiceError) {
+						return tt.fields.quotaService, nil
+					},
+				},
+			}
+			err := k.RestoreKafka(tt.args.kafkaRequest)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(err.Code).To(gomega.Equal(tt.wantCode))
+			} else {
+				g.Expect(tt.args.kafkaRequest.DeletionRequestedAt).To(gomega.BeNil())
+			}
+			g.Expect(tt.args.kafkaRequest.Status).To(gomega.Equal(tt.wantStatus))
+		})
+	}
+}
+
+func Test_kafkaService_DeprovisionKafkasWithExpiredDeletionGracePeriod(t *testing.T) {
+	kafkaConf := defaultKafkaConf
+	kafkaConf.DeletionGracePeriod = time.Hour
+
+	tests := []struct {
+		name    string
+		wantErr bool
+		setupFn func() *mocket.FakeResponse
+	}{
+		{
+			name:    "should return an error when listing the kafkas fails",
+			wantErr: true,
+			setupFn: func() *mocket.FakeResponse {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests" WHERE deletion_requested_at < $1`).WithQueryException()
+				return nil
+			},
+		},
+		{
+			name:    "should deprovision the kafkas with an expired deletion grace period",
+			wantErr: false,
+			setupFn: func() *mocket.FakeResponse {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests" WHERE deletion_requested_at < $1`).
+					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+						kafkaRequest.Status = constants.KafkaRequestStatusSuspended.String()
+					})))
+				return mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1`).WithRowsNum(1)
+			},
+		},
+		{
+			name:    "should deprovision the other kafkas when a kafka fails to be deprovisioned",
+			wantErr: true,
+			setupFn: func() *mocket.FakeResponse {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests" WHERE deletion_requested_at < $1`).
+					WithReply(converters.ConvertKafkaRequestList([]*dbapi.KafkaRequest{
+						buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+							kafkaRequest.ID = "kafka-1"
+							kafkaRequest.Status = constants.KafkaRequestStatusSuspended.String()
+						}),
+						buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+							kafkaRequest.ID = "kafka-2"
+							kafkaRequest.Status = constants.KafkaRequestStatusSuspended.String()
+						}),
+					}))
+				// the first kafka fails to be deprovisioned, the second one is deprovisioned
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1`).WithExecException().OneTime()
+				return mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1`).WithRowsNum(1)
+			},
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			updateMock := tt.setupFn()
+			k := &kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				kafkaConfig:       &kafkaConf,
+			}
+			err := k.DeprovisionKafkasWithExpiredDeletionGracePeriod()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if updateMock != nil {
+				g.Expect(updateMock.Triggered).To(gomega.BeTrue())
+			}
+		})
+	}
+}
+
+func Test_kafkaService_ResizeKafka(t *testing.T) {
+	type fields struct {
+		clusterService ClusterService
+		quotaService   QuotaService
+	}
+	type args struct {
+		kafkaRequest *dbapi.KafkaRequest
+		sizeId       string
+	}
+
+	resizeKafkaConf := config.KafkaConfig{
+		Quota: config.NewKafkaQuotaConfig(),
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+					{
+						Id:                     types.STANDARD.String(),
+						DisplayName:            "Standard",
+						SupportedBillingModels: testSupportedKafkaBillingModelsStandard,
+						Sizes: []config.KafkaInstanceSize{
+							{
+								Id:                   "x1",
+								MaxDataRetentionSize: "100Gi",
+								QuotaConsumed:        1,
+								CapacityConsumed:     1,
+							},
+							{
+								Id:                   "x2",
+								MaxDataRetentionSize: "200Gi",
+								QuotaConsumed:        2,
+								CapacityConsumed:     2,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	manualCluster := buildManualCluster(2, types.STANDARD.String(), testKafkaRequestRegion)
+
+	clusterServiceWithInstanceCount := func(count int) ClusterService {
+		return &ClusterServiceMock{
+			FindKafkaInstanceCountFunc: func(clusterIDs []string) ([]ResKafkaInstanceCount, error) {
+				return []ResKafkaInstanceCount{{Clusterid: manualCluster.ClusterId, Count: count}}, nil
+			},
+		}
+	}
+
+	deletedSubscriptions := []string{}
+	quotaServiceWithSubscription := func(subscriptionId string, reserveErr *errors.ServiceError) QuotaService {
+		return &QuotaServiceMock{
+			ReserveQuotaFunc: func(kafka *dbapi.KafkaRequest) (string, *errors.ServiceError) {
+				if reserveErr != nil {
+					return "", reserveErr
+				}
+				kafka.ActualKafkaBillingModel = kafka.DesiredKafkaBillingModel
+				return subscriptionId, nil
+			},
+			DeleteQuotaFunc: func(subscriptionId string) *errors.ServiceError {
+				deletedSubscriptions = append(deletedSubscriptions, subscriptionId)
+				return nil
+			},
+		}
+	}
+
+	// the quota management list reserves the quota of the new size on a new subscription
+	quotaService := func(reserveErr *errors.ServiceError) QuotaService {
+		return quotaServiceWithSubscription("new-subscription-id", reserveErr)
+	}
+
+	buildReadyKafkaRequest := func() *dbapi.KafkaRequest {
+		return buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+			kafkaRequest.Status = constants.KafkaRequestStatusReady.String()
+			kafkaRequest.InstanceType = types.STANDARD.String()
+			kafkaRequest.ClusterID = manualCluster.ClusterId
+			kafkaRequest.KafkaStorageSize = "100Gi"
+			kafkaRequest.SubscriptionId = "old-subscription-id"
+			kafkaRequest.ActualKafkaBillingModel = "standard"
+			kafkaRequest.RoutesCreated = true
+		})
+	}
+
+	tests := []struct {
+		name                     string
+		fields                   fields
+		args                     args
+		wantErr                  bool
+		wantCode                 errors.ServiceErrorCode
+		wantSizeId               string
+		wantStorageSize          string
+		wantSubscriptionId       string
+		wantClusterID            string
+		wantStatus               string
+		wantDeletedSubscriptions []string
+		setupFn                  func()
+	}{
+		{
+			name: "should not update kafka when it already has the requested size",
+			args: args{
+				kafkaRequest: buildReadyKafkaRequest(),
+				sizeId:       "x1",
+			},
+			wantSizeId:    "x1",
+			wantClusterID: manualCluster.ClusterId,
+			wantStatus:    constants.KafkaRequestStatusReady.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should return a validation error when kafka is not ready",
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusProvisioning.String()
+					kafkaRequest.InstanceType = types.STANDARD.String()
+					kafkaRequest.ClusterID = manualCluster.ClusterId
+				}),
+				sizeId: "x2",
+			},
+			wantErr:       true,
+			wantCode:      errors.ErrorValidation,
+			wantSizeId:    "x1",
+			wantClusterID: manualCluster.ClusterId,
+			wantStatus:    constants.KafkaRequestStatusProvisioning.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should return an error when the size is not supported by the instance type",
+			args: args{
+				kafkaRequest: buildReadyKafkaRequest(),
+				sizeId:       "x3",
+			},
+			wantErr:       true,
+			wantCode:      errors.ErrorInstancePlanNotSupported,
+			wantSizeId:    "x1",
+			wantClusterID: manualCluster.ClusterId,
+			wantStatus:    constants.KafkaRequestStatusReady.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should return an error when the region has no capacity left for the new size",
+			args: args{
+				kafkaRequest: buildReadyKafkaRequest(),
+				sizeId:       "x2",
+			},
+			wantErr:       true,
+			wantCode:      errors.ErrorTooManyKafkaInstancesReached,
+			wantSizeId:    "x1",
+			wantClusterID: manualCluster.ClusterId,
+			wantStatus:    constants.KafkaRequestStatusReady.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "kafka_requests" WHERE region = $1 AND cloud_provider = $2 AND instance_type = $3`).
+					WithReply(converters.ConvertKafkaRequestList([]*dbapi.KafkaRequest{
+						buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+							kafkaRequest.ID = "another-kafka-id"
+							kafkaRequest.InstanceType = types.STANDARD.String()
+						}),
+					}))
+			},
+		},
+		{
+			name: "should return an error and not move the kafka when it does not fit its current cluster",
+			fields: fields{
+				clusterService: clusterServiceWithInstanceCount(2),
+				quotaService:   quotaService(nil),
+			},
+			args: args{
+				kafkaRequest: buildReadyKafkaRequest(),
+				sizeId:       "x2",
+			},
+			wantErr:       true,
+			wantCode:      errors.ErrorKafkaClusterCapacityReached,
+			wantSizeId:    "x1",
+			wantClusterID: manualCluster.ClusterId,
+			wantStatus:    constants.KafkaRequestStatusReady.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+		},
+		{
+			name: "should return an error when the quota for the new size cannot be reserved",
+			fields: fields{
+				clusterService: clusterServiceWithInstanceCount(1),
+				quotaService:   quotaService(errors.InsufficientQuotaError("insufficient quota")),
+			},
+			args: args{
+				kafkaRequest: buildReadyKafkaRequest(),
+				sizeId:       "x2",
+			},
+			wantErr:       true,
+			wantCode:      errors.ErrorInsufficientQuota,
+			wantSizeId:    "x1",
+			wantClusterID: manualCluster.ClusterId,
+			wantStatus:    constants.KafkaRequestStatusReady.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+		},
+		{
+			name: "should release the reserved quota when the kafka status has changed in the meantime",
+			fields: fields{
+				clusterService: clusterServiceWithInstanceCount(1),
+				quotaService:   quotaService(nil),
+			},
+			args: args{
+				kafkaRequest: buildReadyKafkaRequest(),
+				sizeId:       "x2",
+			},
+			wantErr:                  true,
+			wantCode:                 errors.ErrorConflict,
+			wantSizeId:               "x1",
+			wantClusterID:            manualCluster.ClusterId,
+			wantStatus:               constants.KafkaRequestStatusReady.String(),
+			wantDeletedSubscriptions: []string{"new-subscription-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET`).WithRowsNum(0)
+			},
+		},
+		{
+			name: "should resize kafka on its current cluster",
+			fields: fields{
+				clusterService: clusterServiceWithInstanceCount(1),
+				quotaService:   quotaService(nil),
+			},
+			args: args{
+				kafkaRequest: buildReadyKafkaRequest(),
+				sizeId:       "x2",
+			},
+			wantSizeId:               "x2",
+			wantClusterID:            manualCluster.ClusterId,
+			wantStatus:               constants.KafkaRequestStatusReady.String(),
+			wantDeletedSubscriptions: []string{"old-subscription-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET`).WithRowsNum(1)
+			},
+		},
+		{
+			name: "should not delete the subscription of the kafka when the quota is reserved on the same subscription as with AMS",
+			fields: fields{
+				clusterService: clusterServiceWithInstanceCount(1),
+				quotaService:   quotaServiceWithSubscription("old-subscription-id", nil),
+			},
+			args: args{
+				kafkaRequest: buildReadyKafkaRequest(),
+				sizeId:       "x2",
+			},
+			wantSizeId:               "x2",
+			wantSubscriptionId:       "old-subscription-id",
+			wantClusterID:            manualCluster.ClusterId,
+			wantStatus:               constants.KafkaRequestStatusReady.String(),
+			wantDeletedSubscriptions: []string{},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET`).WithRowsNum(1)
+			},
+		},
+		{
+			name: "should not delete the subscription of the kafka when the resize fails and the quota is reserved on the same subscription as with AMS",
+			fields: fields{
+				clusterService: clusterServiceWithInstanceCount(1),
+				quotaService:   quotaServiceWithSubscription("old-subscription-id", nil),
+			},
+			args: args{
+				kafkaRequest: buildReadyKafkaRequest(),
+				sizeId:       "x2",
+			},
+			wantErr:                  true,
+			wantCode:                 errors.ErrorConflict,
+			wantSizeId:               "x1",
+			wantClusterID:            manualCluster.ClusterId,
+			wantStatus:               constants.KafkaRequestStatusReady.String(),
+			wantDeletedSubscriptions: []string{},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET`).WithRowsNum(0)
+			},
+		},
+		{
+			name: "should keep a storage size that differs from the default storage size of the current size",
+			fields: fields{
+				clusterService: clusterServiceWithInstanceCount(1),
+				quotaService:   quotaService(nil),
+			},
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusReady.String()
+					kafkaRequest.InstanceType = types.STANDARD.String()
+					kafkaRequest.ClusterID = manualCluster.ClusterId
+					kafkaRequest.KafkaStorageSize = "500Gi"
+					kafkaRequest.SubscriptionId = "old-subscription-id"
+					kafkaRequest.ActualKafkaBillingModel = "standard"
+				}),
+				sizeId: "x2",
+			},
+			wantSizeId:               "x2",
+			wantStorageSize:          "500Gi",
+			wantClusterID:            manualCluster.ClusterId,
+			wantStatus:               constants.KafkaRequestStatusReady.String(),
+			wantDeletedSubscriptions: []string{"old-subscription-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET`).WithRowsNum(1)
+			},
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			deletedSubscriptions = []string{}
+			k := &kafkaService{
+				connectionFactory:      db.NewMockConnectionFactory(nil),
+				kafkaConfig:            &resizeKafkaConf,
+				providerConfig:         buildProviderConfiguration(testKafkaRequestRegion, 2, 1, false),
+				dataplaneClusterConfig: buildDataplaneClusterConfig([]config.ManualCluster{manualCluster}),
+				clusterService:         tt.fields.clusterService,
+				capacityReservationService: &CapacityReservationServiceMock{
+					ListActiveUsagesFunc: func() (CapacityReservationUsageList, *errors.ServiceError) {
+						return nil, nil
+					},
+				},
+				quotaServiceFactory: &QuotaServiceFactoryMock{
+					GetQuotaServiceFunc: func(quotaType api.QuotaType) (QuotaService, *errors.ServiceError) {
+						return tt.fields.quotaService, nil
+					},
+				},
+			}
+			previousPlacementId := tt.args.kafkaRequest.PlacementId
+			previousStorageSize := tt.args.kafkaRequest.KafkaStorageSize
+			err := k.ResizeKafka(tt.args.kafkaRequest, tt.args.sizeId)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(err.Code).To(gomega.Equal(tt.wantCode))
+			}
+			g.Expect(tt.args.kafkaRequest.SizeId).To(gomega.Equal(tt.wantSizeId))
+			g.Expect(tt.args.kafkaRequest.ClusterID).To(gomega.Equal(tt.wantClusterID))
+			g.Expect(tt.args.kafkaRequest.Status).To(gomega.Equal(tt.wantStatus))
+			g.Expect(deletedSubscriptions).To(gomega.ConsistOf(tt.wantDeletedSubscriptions))
+			g.Expect(tt.args.kafkaRequest.PlacementId).To(gomega.Equal(previousPlacementId))
+			if !tt.wantErr && tt.wantSizeId != "x1" {
+				wantStorageSize := tt.wantStorageSize
+				if wantStorageSize == "" {
+					wantStorageSize = "200Gi"
+				}
+				wantSubscriptionId := tt.wantSubscriptionId
+				if wantSubscriptionId == "" {
+					wantSubscriptionId = "new-subscription-id"
+				}
+				g.Expect(tt.args.kafkaRequest.KafkaStorageSize).To(gomega.Equal(wantStorageSize))
+				g.Expect(tt.args.kafkaRequest.SubscriptionId).To(gomega.Equal(wantSubscriptionId))
+			} else {
+				g.Expect(tt.args.kafkaRequest.KafkaStorageSize).To(gomega.Equal(previousStorageSize))
+			}
+		})
+	}
+}
+
+func Test_kafkaService_UpdateLabels(t *testing.T) {
+	tests := []struct {
+		name            string
+		labels          dbapi.KafkaLabels
+		wantInsert      bool
+		wantLabelsCount int
+	}{
+		{
+			name:            "should replace the labels of the kafka",
+			labels:          dbapi.NewKafkaLabels(map[string]string{"team": "payments", "env": "prod"}),
+			wantInsert:      true,
+			wantLabelsCount: 2,
+		},
+		{
+			name:            "should remove all the labels of the kafka when no labels are given",
+			labels:          dbapi.KafkaLabels{},
+			wantInsert:      false,
+			wantLabelsCount: 0,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			deleteLabels := mocket.Catcher.NewMock().WithQuery(`DELETE FROM "kafka_labels" WHERE kafka_id = $1`).WithArgs(testID)
+			insertLabels := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_labels"`)
+
+			k := &kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			kafkaRequest := buildKafkaRequest(nil)
+			g.Expect(k.UpdateLabels(kafkaRequest, tt.labels)).To(gomega.BeNil())
+			g.Expect(deleteLabels.Triggered).To(gomega.BeTrue())
+			g.Expect(insertLabels.Triggered).To(gomega.Equal(tt.wantInsert))
+			g.Expect(kafkaRequest.Labels).To(gomega.HaveLen(tt.wantLabelsCount))
+			for _, label := range kafkaRequest.Labels {
+				g.Expect(label.KafkaID).To(gomega.Equal(testID))
+			}
+		})
+	}
+}
+
+func Test_kafkaService_List_SearchByLabel(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := auth.SetTokenInContext(auth.SetIsAdminContext(context.TODO(), true), &jwt.Token{Claims: jwt.MapClaims{}})
+
+	mocket.Catcher.Reset()
+	listKafkas := mocket.Catcher.NewMock().
+		WithQuery(`SELECT * FROM "kafka_requests" WHERE (id IN (SELECT kafka_id FROM kafka_labels WHERE deleted_at IS NULL AND key = $1 AND value = $2) and name = $3)`).
+		WithArgs("team", "payments", "test-kafka").
+		WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(nil)))
+
+	k := &kafkaService{
+		connectionFactory: db.NewMockConnectionFactory(nil),
+	}
+	_, _, err := k.List(ctx, &services.ListArguments{Page: 1, Size: 100, Search: "labels.team = payments and name = test-kafka"})
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(listKafkas.Triggered).To(gomega.BeTrue())
+
+	_, _, err = k.List(ctx, &services.ListArguments{Page: 1, Size: 100, Search: "tags.team = payments"})
+	g.Expect(err).ToNot(gomega.BeNil())
+	g.Expect(err.Code).To(gomega.Equal(errors.ErrorFailedToParseSearch))
+}
+
+func Test_kafkaService_DeprovisionKafkaForUsers(t *testing.T) {
+	type fields struct {
+		connectionFactory *db.ConnectionFactory
+	}
+	type args struct {
+		users []string
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		setupFn func()
+	}{
+		{
+			name: "should receive error when update fails",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("UPDATE").WithError(fmt.Errorf("some update error"))
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+			args: args{users: []string{"user"}},
+		},
+		{
+			name: "should not receive error when update succeed",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			wantErr: false,
+			args:    args{users: []string{"user"}},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"`)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			k := kafkaService{
+				connectionFactory: tt.fields.connectionFactory,
+			}
+			err := k.DeprovisionKafkaForUsers(tt.args.users)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func Test_kafkaService_DeprovisionExpiredKafkas(t *testing.T) {
+	type fields struct {
+		connectionFactory *db.ConnectionFactory
+	}
+
+	const instanceType = "type1"
+	const instanceSize = "size4"
+
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+		setupFn func()
+	}{
+		{
+			name: "fail when database update throws an error",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type IN ($1) AND status NOT IN ($2,$3)`).WithReply([]map[string]interface{}{{"id": "kafkainstance1", "instance_type": instanceType, "size_id": instanceSize}})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE id IN ($3)`).WithError(fmt.Errorf("an update error"))
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "success when database does not throw an error",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			wantErr: false,
 			setupFn: func() {
 				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type IN ($1) AND status NOT IN ($2,$3)`).WithReply([]map[string]interface{}{{"id": "kafkainstance1", "instance_type": instanceType, "size_id": instanceSize}})
 				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE id IN ($3)`)
@@ -2567,6 +3732,201 @@ func Test_kafkaService_DeprovisionExpiredKafkas(t *testing.T) {
 	}
 }
 
+func Test_kafkaService_GetExpirationTime(t *testing.T) {
+	const instanceType = "type1"
+	createdAt := time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+	extendedExpiresAt := createdAt.Add(10 * 24 * time.Hour)
+
+	tests := []struct {
+		name         string
+		kafkaRequest *dbapi.KafkaRequest
+		want         *time.Time
+		wantErr      bool
+	}{
+		{
+			name:         "should return the end of the lifespan of the size of the kafka",
+			kafkaRequest: &dbapi.KafkaRequest{Meta: api.Meta{CreatedAt: createdAt}, InstanceType: instanceType, SizeId: "size2"},
+			want:         &[]time.Time{createdAt.Add(48 * time.Hour)}[0],
+		},
+		{
+			name:         "should return the expiration time set by an admin",
+			kafkaRequest: &dbapi.KafkaRequest{Meta: api.Meta{CreatedAt: createdAt}, InstanceType: instanceType, SizeId: "size2", ExpiresAt: extendedExpiresAt},
+			want:         &extendedExpiresAt,
+		},
+		{
+			name:         "should return nil when the size of the kafka has no lifespan",
+			kafkaRequest: &dbapi.KafkaRequest{Meta: api.Meta{CreatedAt: createdAt}, InstanceType: instanceType, SizeId: "size1"},
+		},
+		{
+			name:         "should return nil when the kafka is protected against deletion",
+			kafkaRequest: &dbapi.KafkaRequest{Meta: api.Meta{CreatedAt: createdAt}, InstanceType: instanceType, SizeId: "size2", DeletionProtection: true},
+		},
+		{
+			name:         "should return an error when the size of the kafka is not supported",
+			kafkaRequest: &dbapi.KafkaRequest{Meta: api.Meta{CreatedAt: createdAt}, InstanceType: instanceType, SizeId: "unknown"},
+			wantErr:      true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			k := &kafkaService{
+				kafkaConfig: newKafkaConfigWithLifespan(instanceType),
+			}
+			got, err := k.GetExpirationTime(tt.kafkaRequest)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_kafkaService_ExtendExpiration(t *testing.T) {
+	const instanceType = "type1"
+	createdAt := time.Now()
+
+	tests := []struct {
+		name         string
+		kafkaRequest *dbapi.KafkaRequest
+		expiresAt    time.Time
+		wantErr      bool
+		setupFn      func()
+	}{
+		{
+			name:         "should extend the expiration of the kafka and reset its notifications",
+			kafkaRequest: &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-id", CreatedAt: createdAt}, InstanceType: instanceType, SizeId: "size2", Status: constants.KafkaRequestStatusReady.String(), ExpirationNotificationThresholdSeconds: 3600},
+			expiresAt:    createdAt.Add(72 * time.Hour),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "expiration_notification_threshold_seconds"=$1,"expires_at"=$2`)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name:         "should fail when the new expiration time is not after the current one",
+			kafkaRequest: &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-id", CreatedAt: createdAt}, InstanceType: instanceType, SizeId: "size2", Status: constants.KafkaRequestStatusReady.String()},
+			expiresAt:    createdAt.Add(24 * time.Hour),
+			wantErr:      true,
+		},
+		{
+			name:         "should fail when the kafka does not expire",
+			kafkaRequest: &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-id", CreatedAt: createdAt}, InstanceType: instanceType, SizeId: "size1", Status: constants.KafkaRequestStatusReady.String()},
+			expiresAt:    createdAt.Add(72 * time.Hour),
+			wantErr:      true,
+		},
+		{
+			name:         "should fail when the kafka is being deleted",
+			kafkaRequest: &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-id", CreatedAt: createdAt}, InstanceType: instanceType, SizeId: "size2", Status: constants.KafkaRequestStatusDeprovision.String()},
+			expiresAt:    createdAt.Add(72 * time.Hour),
+			wantErr:      true,
+		},
+		{
+			name:         "should fail when the database update fails",
+			kafkaRequest: &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-id", CreatedAt: createdAt}, InstanceType: instanceType, SizeId: "size2", Status: constants.KafkaRequestStatusReady.String()},
+			expiresAt:    createdAt.Add(72 * time.Hour),
+			wantErr:      true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests"`).WithExecException()
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+			k := &kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				kafkaConfig:       newKafkaConfigWithLifespan(instanceType),
+			}
+			err := k.ExtendExpiration(tt.kafkaRequest, tt.expiresAt)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(tt.kafkaRequest.ExpiresAt).To(gomega.Equal(tt.expiresAt))
+				g.Expect(tt.kafkaRequest.ExpirationNotificationThresholdSeconds).To(gomega.BeZero())
+			}
+		})
+	}
+}
+
+func Test_kafkaService_ListKafkasExpiringBefore(t *testing.T) {
+	const instanceType = "type1"
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		want    []string
+		wantErr bool
+		setupFn func()
+	}{
+		{
+			name: "should only return the kafkas expiring before the given time",
+			want: []string{"expiring"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type IN ($1) AND status NOT IN ($2,$3) AND deletion_protection = $4 AND deletion_requested_at IS NULL`).
+					WithReply([]map[string]interface{}{
+						{"id": "expiring", "instance_type": instanceType, "size_id": "size2", "created_at": now.Add(-47 * time.Hour)},
+						{"id": "not-expiring-yet", "instance_type": instanceType, "size_id": "size2", "created_at": now},
+						{"id": "no-lifespan", "instance_type": instanceType, "size_id": "size1", "created_at": now.Add(-47 * time.Hour)},
+					})
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name:    "should return an error when the kafkas cannot be listed",
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).WithQueryException()
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			k := &kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				kafkaConfig:       newKafkaConfigWithLifespan(instanceType),
+			}
+			kafkas, err := k.ListKafkasExpiringBefore(now.Add(24 * time.Hour))
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			var ids []string
+			for _, kafka := range kafkas {
+				g.Expect(kafka.ExpiresAt.IsZero()).To(gomega.BeFalse())
+				ids = append(ids, kafka.ID)
+			}
+			g.Expect(ids).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+// newKafkaConfigWithLifespan returns a kafka config supporting the given instance type with a size without lifespan,
+// size1, and a size with a lifespan of 48 hours, size2
+func newKafkaConfigWithLifespan(instanceType string) *config.KafkaConfig {
+	kafkaConfig := config.NewKafkaConfig()
+	kafkaConfig.SupportedInstanceTypes.Configuration = config.SupportedKafkaInstanceTypesConfig{
+		SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+			{
+				Id: instanceType,
+				Sizes: []config.KafkaInstanceSize{
+					{Id: "size1"},
+					{Id: "size2", LifespanSeconds: &[]int{48 * 60 * 60}[0]},
+				},
+			},
+		},
+	}
+	return kafkaConfig
+}
+
 func Test_KafkaService_CountByStatus(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -3178,6 +4538,11 @@ func Test_kafkaService_GetAvailableSizesInRegion(t *testing.T) {
 				dataplaneClusterConfig:   tt.fields.dataplaneClusterConfig,
 				providerConfig:           tt.fields.providerConfig,
 				clusterPlacementStrategy: tt.fields.clusterPlacementStrategy,
+				capacityReservationService: &CapacityReservationServiceMock{
+					ListActiveUsagesFunc: func() (CapacityReservationUsageList, *errors.ServiceError) {
+						return nil, nil
+					},
+				},
 			}
 
 			got, err := k.GetAvailableSizesInRegion(tt.args.criteria)
@@ -3959,17 +5324,18 @@ func Test_kafkaService_GetCNAMERecordStatus(t *testing.T) {
 
 func Test_NewKafkaService(t *testing.T) {
 	type args struct {
-		connectionFactory        *db.ConnectionFactory
-		clusterService           ClusterService
-		keycloakService          sso.KafkaKeycloakService
-		kafkaConfig              *config.KafkaConfig
-		dataplaneClusterConfig   *config.DataplaneClusterConfig
-		awsConfig                *config.AWSConfig
-		quotaServiceFactory      QuotaServiceFactory
-		awsClientFactory         aws.ClientFactory
-		authorizationService     authorization.Authorization
-		providerConfig           *config.ProviderConfig
-		clusterPlacementStrategy ClusterPlacementStrategy
+		connectionFactory          *db.ConnectionFactory
+		clusterService             ClusterService
+		keycloakService            sso.KafkaKeycloakService
+		kafkaConfig                *config.KafkaConfig
+		dataplaneClusterConfig     *config.DataplaneClusterConfig
+		awsConfig                  *config.AWSConfig
+		quotaServiceFactory        QuotaServiceFactory
+		awsClientFactory           aws.ClientFactory
+		authorizationService       authorization.Authorization
+		providerConfig             *config.ProviderConfig
+		clusterPlacementStrategy   ClusterPlacementStrategy
+		capacityReservationService CapacityReservationService
 	}
 	tests := []struct {
 		name string
@@ -3979,28 +5345,30 @@ func Test_NewKafkaService(t *testing.T) {
 		{
 			name: "should return the kafka service",
 			args: args{
-				connectionFactory:        &db.ConnectionFactory{},
-				clusterService:           &ClusterServiceMock{},
-				keycloakService:          &sso.KeycloakServiceMock{},
-				kafkaConfig:              &config.KafkaConfig{},
-				dataplaneClusterConfig:   &config.DataplaneClusterConfig{},
-				awsConfig:                &config.AWSConfig{},
-				quotaServiceFactory:      &QuotaServiceFactoryMock{},
-				awsClientFactory:         &aws.MockClientFactory{},
-				providerConfig:           &config.ProviderConfig{},
-				clusterPlacementStrategy: &ClusterPlacementStrategyMock{},
+				connectionFactory:          &db.ConnectionFactory{},
+				clusterService:             &ClusterServiceMock{},
+				keycloakService:            &sso.KeycloakServiceMock{},
+				kafkaConfig:                &config.KafkaConfig{},
+				dataplaneClusterConfig:     &config.DataplaneClusterConfig{},
+				awsConfig:                  &config.AWSConfig{},
+				quotaServiceFactory:        &QuotaServiceFactoryMock{},
+				awsClientFactory:           &aws.MockClientFactory{},
+				providerConfig:             &config.ProviderConfig{},
+				clusterPlacementStrategy:   &ClusterPlacementStrategyMock{},
+				capacityReservationService: &CapacityReservationServiceMock{},
 			},
 			want: &kafkaService{
-				connectionFactory:        &db.ConnectionFactory{},
-				clusterService:           &ClusterServiceMock{},
-				keycloakService:          &sso.KeycloakServiceMock{},
-				kafkaConfig:              &config.KafkaConfig{},
-				dataplaneClusterConfig:   &config.DataplaneClusterConfig{},
-				awsConfig:                &config.AWSConfig{},
-				quotaServiceFactory:      &QuotaServiceFactoryMock{},
-				awsClientFactory:         &aws.MockClientFactory{},
-				providerConfig:           &config.ProviderConfig{},
-				clusterPlacementStrategy: &ClusterPlacementStrategyMock{},
+				connectionFactory:          &db.ConnectionFactory{},
+				clusterService:             &ClusterServiceMock{},
+				keycloakService:            &sso.KeycloakServiceMock{},
+				kafkaConfig:                &config.KafkaConfig{},
+				dataplaneClusterConfig:     &config.DataplaneClusterConfig{},
+				awsConfig:                  &config.AWSConfig{},
+				quotaServiceFactory:        &QuotaServiceFactoryMock{},
+				awsClientFactory:           &aws.MockClientFactory{},
+				providerConfig:             &config.ProviderConfig{},
+				clusterPlacementStrategy:   &ClusterPlacementStrategyMock{},
+				capacityReservationService: &CapacityReservationServiceMock{},
 			},
 		},
 	}
@@ -4008,7 +5376,7 @@ func Test_NewKafkaService(t *testing.T) {
 	for _, testcase := range tests {
 		g := gomega.NewWithT(t)
 		tt := testcase
-		g.Expect(NewKafkaService(tt.args.connectionFactory, tt.args.clusterService, tt.args.keycloakService, tt.args.kafkaConfig, tt.args.dataplaneClusterConfig, tt.args.awsConfig, tt.args.quotaServiceFactory, tt.args.awsClientFactory, tt.args.authorizationService, tt.args.providerConfig, tt.args.clusterPlacementStrategy)).To(gomega.Equal(tt.want))
+		g.Expect(NewKafkaService(tt.args.connectionFactory, tt.args.clusterService, tt.args.keycloakService, tt.args.kafkaConfig, tt.args.dataplaneClusterConfig, tt.args.awsConfig, tt.args.quotaServiceFactory, tt.args.awsClientFactory, tt.args.authorizationService, tt.args.providerConfig, tt.args.clusterPlacementStrategy, tt.args.capacityReservationService)).To(gomega.Equal(tt.want))
 	}
 }
 
@@ -14,6 +14,7 @@ import (
 	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"sync"
+	"time"
 )
 
 // Ensure, that KafkaServiceMock does implement KafkaService.
@@ -47,6 +48,12 @@ var _ KafkaService = &KafkaServiceMock{}
 //			DeprovisionKafkaForUsersFunc: func(users []string) *apiErrors.ServiceError {
 //				panic("mock out the DeprovisionKafkaForUsers method")
 //			},
+//			DeprovisionKafkasWithExpiredDeletionGracePeriodFunc: func() *apiErrors.ServiceError {
+//				panic("mock out the DeprovisionKafkasWithExpiredDeletionGracePeriod method")
+//			},
+//			ExtendExpirationFunc: func(kafkaRequest *dbapi.KafkaRequest, expiresAt time.Time) *apiErrors.ServiceError {
+//				panic("mock out the ExtendExpiration method")
+//			},
 //			GenerateReservedManagedKafkasByClusterIDFunc: func(clusterID string) ([]v1.ManagedKafka, *apiErrors.ServiceError) {
 //				panic("mock out the GenerateReservedManagedKafkasByClusterID method")
 //			},
@@ -62,6 +69,9 @@ var _ KafkaService = &KafkaServiceMock{}
 //			GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
 //				panic("mock out the GetCNAMERecordStatus method")
 //			},
+//			GetExpirationTimeFunc: func(kafkaRequest *dbapi.KafkaRequest) (*time.Time, *apiErrors.ServiceError) {
+//				panic("mock out the GetExpirationTime method")
+//			},
 //			GetManagedKafkaByClusterIDFunc: func(clusterID string) ([]v1.ManagedKafka, *apiErrors.ServiceError) {
 //				panic("mock out the GetManagedKafkaByClusterID method")
 //			},
@@ -80,21 +90,39 @@ var _ KafkaService = &KafkaServiceMock{}
 //			ListComponentVersionsFunc: func() ([]KafkaComponentVersions, error) {
 //				panic("mock out the ListComponentVersions method")
 //			},
+//			ListKafkasExpiringBeforeFunc: func(expiresBefore time.Time) ([]*dbapi.KafkaRequest, *apiErrors.ServiceError) {
+//				panic("mock out the ListKafkasExpiringBefore method")
+//			},
 //			ListKafkasWithRoutesNotCreatedFunc: func() ([]*dbapi.KafkaRequest, *apiErrors.ServiceError) {
 //				panic("mock out the ListKafkasWithRoutesNotCreated method")
 //			},
 //			PrepareKafkaRequestFunc: func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError {
 //				panic("mock out the PrepareKafkaRequest method")
 //			},
-//			RegisterKafkaDeprovisionJobFunc: func(ctx context.Context, id string) *apiErrors.ServiceError {
+//			RegisterKafkaDeprovisionJobFunc: func(ctx context.Context, id string, ignoreDeletionProtection bool) *apiErrors.ServiceError {
 //				panic("mock out the RegisterKafkaDeprovisionJob method")
 //			},
 //			RegisterKafkaJobFunc: func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError {
 //				panic("mock out the RegisterKafkaJob method")
 //			},
+//			ResizeKafkaFunc: func(kafkaRequest *dbapi.KafkaRequest, sizeId string) *apiErrors.ServiceError {
+//				panic("mock out the ResizeKafka method")
+//			},
+//			RestoreKafkaFunc: func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError {
+//				panic("mock out the RestoreKafka method")
+//			},
+//			ResumeKafkaFunc: func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError {
+//				panic("mock out the ResumeKafka method")
+//			},
+//			SuspendKafkaFunc: func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError {
+//				panic("mock out the SuspendKafka method")
+//			},
 //			UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError {
 //				panic("mock out the Update method")
 //			},
+//			UpdateLabelsFunc: func(kafkaRequest *dbapi.KafkaRequest, labels dbapi.KafkaLabels) *apiErrors.ServiceError {
+//				panic("mock out the UpdateLabels method")
+//			},
 //			UpdateStatusFunc: func(id string, status constants.KafkaStatus) (bool, *apiErrors.ServiceError) {
 //				panic("mock out the UpdateStatus method")
 //			},
@@ -135,6 +163,12 @@ type KafkaServiceMock struct {
 	// DeprovisionKafkaForUsersFunc mocks the DeprovisionKafkaForUsers method.
 	DeprovisionKafkaForUsersFunc func(users []string) *apiErrors.ServiceError
 
+	// DeprovisionKafkasWithExpiredDeletionGracePeriodFunc mocks the DeprovisionKafkasWithExpiredDeletionGracePeriod method.
+	DeprovisionKafkasWithExpiredDeletionGracePeriodFunc func() *apiErrors.ServiceError
+
+	// ExtendExpirationFunc mocks the ExtendExpiration method.
+	ExtendExpirationFunc func(kafkaRequest *dbapi.KafkaRequest, expiresAt time.Time) *apiErrors.ServiceError
+
 	// GenerateReservedManagedKafkasByClusterIDFunc mocks the GenerateReservedManagedKafkasByClusterID method.
 	GenerateReservedManagedKafkasByClusterIDFunc func(clusterID string) ([]v1.ManagedKafka, *apiErrors.ServiceError)
 
@@ -150,6 +184,9 @@ type KafkaServiceMock struct {
 	// GetCNAMERecordStatusFunc mocks the GetCNAMERecordStatus method.
 	GetCNAMERecordStatusFunc func(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error)
 
+	// GetExpirationTimeFunc mocks the GetExpirationTime method.
+	GetExpirationTimeFunc func(kafkaRequest *dbapi.KafkaRequest) (*time.Time, *apiErrors.ServiceError)
+
 	// GetManagedKafkaByClusterIDFunc mocks the GetManagedKafkaByClusterID method.
 	GetManagedKafkaByClusterIDFunc func(clusterID string) ([]v1.ManagedKafka, *apiErrors.ServiceError)
 
@@ -168,6 +205,9 @@ type KafkaServiceMock struct {
 	// ListComponentVersionsFunc mocks the ListComponentVersions method.
 	ListComponentVersionsFunc func() ([]KafkaComponentVersions, error)
 
+	// ListKafkasExpiringBeforeFunc mocks the ListKafkasExpiringBefore method.
+	ListKafkasExpiringBeforeFunc func(expiresBefore time.Time) ([]*dbapi.KafkaRequest, *apiErrors.ServiceError)
+
 	// ListKafkasWithRoutesNotCreatedFunc mocks the ListKafkasWithRoutesNotCreated method.
 	ListKafkasWithRoutesNotCreatedFunc func() ([]*dbapi.KafkaRequest, *apiErrors.ServiceError)
 
@@ -175,14 +215,29 @@ type KafkaServiceMock struct {
 	PrepareKafkaRequestFunc func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError
 
 	// RegisterKafkaDeprovisionJobFunc mocks the RegisterKafkaDeprovisionJob method.
-	RegisterKafkaDeprovisionJobFunc func(ctx context.Context, id string) *apiErrors.ServiceError
+	RegisterKafkaDeprovisionJobFunc func(ctx context.Context, id string, ignoreDeletionProtection bool) *apiErrors.ServiceError
 
 	// RegisterKafkaJobFunc mocks the RegisterKafkaJob method.
 	RegisterKafkaJobFunc func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError
 
+	// ResizeKafkaFunc mocks the ResizeKafka method.
+	ResizeKafkaFunc func(kafkaRequest *dbapi.KafkaRequest, sizeId string) *apiErrors.ServiceError
+
+	// RestoreKafkaFunc mocks the RestoreKafka method.
+	RestoreKafkaFunc func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError
+
+	// ResumeKafkaFunc mocks the ResumeKafka method.
+	ResumeKafkaFunc func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError
+
+	// SuspendKafkaFunc mocks the SuspendKafka method.
+	SuspendKafkaFunc func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError
+
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError
 
+	// UpdateLabelsFunc mocks the UpdateLabels method.
+	UpdateLabelsFunc func(kafkaRequest *dbapi.KafkaRequest, labels dbapi.KafkaLabels) *apiErrors.ServiceError
+
 	// UpdateStatusFunc mocks the UpdateStatus method.
 	UpdateStatusFunc func(id string, status constants.KafkaStatus) (bool, *apiErrors.ServiceError)
 
@@ -234,6 +289,16 @@ type KafkaServiceMock struct {
 			// Users is the users argument value.
 			Users []string
 		}
+		// DeprovisionKafkasWithExpiredDeletionGracePeriod holds details about calls to the DeprovisionKafkasWithExpiredDeletionGracePeriod method.
+		DeprovisionKafkasWithExpiredDeletionGracePeriod []struct {
+		}
+		// ExtendExpiration holds details about calls to the ExtendExpiration method.
+		ExtendExpiration []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// ExpiresAt is the expiresAt argument value.
+			ExpiresAt time.Time
+		}
 		// GenerateReservedManagedKafkasByClusterID holds details about calls to the GenerateReservedManagedKafkasByClusterID method.
 		GenerateReservedManagedKafkasByClusterID []struct {
 			// ClusterID is the clusterID argument value.
@@ -261,6 +326,11 @@ type KafkaServiceMock struct {
 			// KafkaRequest is the kafkaRequest argument value.
 			KafkaRequest *dbapi.KafkaRequest
 		}
+		// GetExpirationTime holds details about calls to the GetExpirationTime method.
+		GetExpirationTime []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+		}
 		// GetManagedKafkaByClusterID holds details about calls to the GetManagedKafkaByClusterID method.
 		GetManagedKafkaByClusterID []struct {
 			// ClusterID is the clusterID argument value.
@@ -289,6 +359,11 @@ type KafkaServiceMock struct {
 		// ListComponentVersions holds details about calls to the ListComponentVersions method.
 		ListComponentVersions []struct {
 		}
+		// ListKafkasExpiringBefore holds details about calls to the ListKafkasExpiringBefore method.
+		ListKafkasExpiringBefore []struct {
+			// ExpiresBefore is the expiresBefore argument value.
+			ExpiresBefore time.Time
+		}
 		// ListKafkasWithRoutesNotCreated holds details about calls to the ListKafkasWithRoutesNotCreated method.
 		ListKafkasWithRoutesNotCreated []struct {
 		}
@@ -303,17 +378,48 @@ type KafkaServiceMock struct {
 			Ctx context.Context
 			// ID is the id argument value.
 			ID string
+			// IgnoreDeletionProtection is the ignoreDeletionProtection argument value.
+			IgnoreDeletionProtection bool
 		}
 		// RegisterKafkaJob holds details about calls to the RegisterKafkaJob method.
 		RegisterKafkaJob []struct {
 			// KafkaRequest is the kafkaRequest argument value.
 			KafkaRequest *dbapi.KafkaRequest
 		}
+		// ResizeKafka holds details about calls to the ResizeKafka method.
+		ResizeKafka []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// SizeId is the sizeId argument value.
+			SizeId string
+		}
+		// RestoreKafka holds details about calls to the RestoreKafka method.
+		RestoreKafka []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+		}
+		// ResumeKafka holds details about calls to the ResumeKafka method.
+		ResumeKafka []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+		}
+		// SuspendKafka holds details about calls to the SuspendKafka method.
+		SuspendKafka []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
 			// KafkaRequest is the kafkaRequest argument value.
 			KafkaRequest *dbapi.KafkaRequest
 		}
+		// UpdateLabels holds details about calls to the UpdateLabels method.
+		UpdateLabels []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// Labels is the labels argument value.
+			Labels dbapi.KafkaLabels
+		}
 		// UpdateStatus holds details about calls to the UpdateStatus method.
 		UpdateStatus []struct {
 			// ID is the id argument value.
@@ -347,33 +453,42 @@ type KafkaServiceMock struct {
 			KafkaRequest *dbapi.KafkaRequest
 		}
 	}
-	lockAssignBootstrapServerHost                sync.RWMutex
-	lockAssignInstanceType                       sync.RWMutex
-	lockChangeKafkaCNAMErecords                  sync.RWMutex
-	lockCountByStatus                            sync.RWMutex
-	lockDelete                                   sync.RWMutex
-	lockDeprovisionExpiredKafkas                 sync.RWMutex
-	lockDeprovisionKafkaForUsers                 sync.RWMutex
-	lockGenerateReservedManagedKafkasByClusterID sync.RWMutex
-	lockGet                                      sync.RWMutex
-	lockGetAvailableSizesInRegion                sync.RWMutex
-	lockGetByID                                  sync.RWMutex
-	lockGetCNAMERecordStatus                     sync.RWMutex
-	lockGetManagedKafkaByClusterID               sync.RWMutex
-	lockHasAvailableCapacityInRegion             sync.RWMutex
-	lockList                                     sync.RWMutex
-	lockListAll                                  sync.RWMutex
-	lockListByStatus                             sync.RWMutex
-	lockListComponentVersions                    sync.RWMutex
-	lockListKafkasWithRoutesNotCreated           sync.RWMutex
-	lockPrepareKafkaRequest                      sync.RWMutex
-	lockRegisterKafkaDeprovisionJob              sync.RWMutex
-	lockRegisterKafkaJob                         sync.RWMutex
-	lockUpdate                                   sync.RWMutex
-	lockUpdateStatus                             sync.RWMutex
-	lockUpdates                                  sync.RWMutex
-	lockValidateBillingAccount                   sync.RWMutex
-	lockVerifyAndUpdateKafkaAdmin                sync.RWMutex
+	lockAssignBootstrapServerHost                       sync.RWMutex
+	lockAssignInstanceType                              sync.RWMutex
+	lockChangeKafkaCNAMErecords                         sync.RWMutex
+	lockCountByStatus                                   sync.RWMutex
+	lockDelete                                          sync.RWMutex
+	lockDeprovisionExpiredKafkas                        sync.RWMutex
+	lockDeprovisionKafkaForUsers                        sync.RWMutex
+	lockDeprovisionKafkasWithExpiredDeletionGracePeriod sync.RWMutex
+	lockExtendExpiration                                sync.RWMutex
+	lockGenerateReservedManagedKafkasByClusterID        sync.RWMutex
+	lockGet                                             sync.RWMutex
+	lockGetAvailableSizesInRegion                       sync.RWMutex
+	lockGetByID                                         sync.RWMutex
+	lockGetCNAMERecordStatus                            sync.RWMutex
+	lockGetExpirationTime                               sync.RWMutex
+	lockGetManagedKafkaByClusterID                      sync.RWMutex
+	lockHasAvailableCapacityInRegion                    sync.RWMutex
+	lockList                                            sync.RWMutex
+	lockListAll                                         sync.RWMutex
+	lockListByStatus                                    sync.RWMutex
+	lockListComponentVersions                           sync.RWMutex
+	lockListKafkasExpiringBefore                        sync.RWMutex
+	lockListKafkasWithRoutesNotCreated                  sync.RWMutex
+	lockPrepareKafkaRequest                             sync.RWMutex
+	lockRegisterKafkaDeprovisionJob                     sync.RWMutex
+	lockRegisterKafkaJob                                sync.RWMutex
+	lockResizeKafka                                     sync.RWMutex
+	lockRestoreKafka                                    sync.RWMutex
+	lockResumeKafka                                     sync.RWMutex
+	lockSuspendKafka                                    sync.RWMutex
+	lockUpdate                                          sync.RWMutex
+	lockUpdateLabels                                    sync.RWMutex
+	lockUpdateStatus                                    sync.RWMutex
+	lockUpdates                                         sync.RWMutex
+	lockValidateBillingAccount                          sync.RWMutex
+	lockVerifyAndUpdateKafkaAdmin                       sync.RWMutex
 }
 
 // AssignBootstrapServerHost calls AssignBootstrapServerHostFunc.
@@ -603,6 +718,69 @@ func (mock *KafkaServiceMock) DeprovisionKafkaForUsersCalls() []struct {
 	return calls
 }
 
+// DeprovisionKafkasWithExpiredDeletionGracePeriod calls DeprovisionKafkasWithExpiredDeletionGracePeriodFunc.
+func (mock *KafkaServiceMock) DeprovisionKafkasWithExpiredDeletionGracePeriod() *apiErrors.ServiceError {
+	if mock.DeprovisionKafkasWithExpiredDeletionGracePeriodFunc == nil {
+		panic("KafkaServiceMock.DeprovisionKafkasWithExpiredDeletionGracePeriodFunc: method is nil but KafkaService.DeprovisionKafkasWithExpiredDeletionGracePeriod was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockDeprovisionKafkasWithExpiredDeletionGracePeriod.Lock()
+	mock.calls.DeprovisionKafkasWithExpiredDeletionGracePeriod = append(mock.calls.DeprovisionKafkasWithExpiredDeletionGracePeriod, callInfo)
+	mock.lockDeprovisionKafkasWithExpiredDeletionGracePeriod.Unlock()
+	return mock.DeprovisionKafkasWithExpiredDeletionGracePeriodFunc()
+}
+
+// DeprovisionKafkasWithExpiredDeletionGracePeriodCalls gets all the calls that were made to DeprovisionKafkasWithExpiredDeletionGracePeriod.
+// Check the length with:
+//
+//	len(mockedKafkaService.DeprovisionKafkasWithExpiredDeletionGracePeriodCalls())
+func (mock *KafkaServiceMock) DeprovisionKafkasWithExpiredDeletionGracePeriodCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockDeprovisionKafkasWithExpiredDeletionGracePeriod.RLock()
+	calls = mock.calls.DeprovisionKafkasWithExpiredDeletionGracePeriod
+	mock.lockDeprovisionKafkasWithExpiredDeletionGracePeriod.RUnlock()
+	return calls
+}
+
+// ExtendExpiration calls ExtendExpirationFunc.
+func (mock *KafkaServiceMock) ExtendExpiration(kafkaRequest *dbapi.KafkaRequest, expiresAt time.Time) *apiErrors.ServiceError {
+	if mock.ExtendExpirationFunc == nil {
+		panic("KafkaServiceMock.ExtendExpirationFunc: method is nil but KafkaService.ExtendExpiration was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+		ExpiresAt    time.Time
+	}{
+		KafkaRequest: kafkaRequest,
+		ExpiresAt:    expiresAt,
+	}
+	mock.lockExtendExpiration.Lock()
+	mock.calls.ExtendExpiration = append(mock.calls.ExtendExpiration, callInfo)
+	mock.lockExtendExpiration.Unlock()
+	return mock.ExtendExpirationFunc(kafkaRequest, expiresAt)
+}
+
+// ExtendExpirationCalls gets all the calls that were made to ExtendExpiration.
+// Check the length with:
+//
+//	len(mockedKafkaService.ExtendExpirationCalls())
+func (mock *KafkaServiceMock) ExtendExpirationCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+	ExpiresAt    time.Time
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+		ExpiresAt    time.Time
+	}
+	mock.lockExtendExpiration.RLock()
+	calls = mock.calls.ExtendExpiration
+	mock.lockExtendExpiration.RUnlock()
+	return calls
+}
+
 // GenerateReservedManagedKafkasByClusterID calls GenerateReservedManagedKafkasByClusterIDFunc.
 func (mock *KafkaServiceMock) GenerateReservedManagedKafkasByClusterID(clusterID string) ([]v1.ManagedKafka, *apiErrors.ServiceError) {
 	if mock.GenerateReservedManagedKafkasByClusterIDFunc == nil {
@@ -767,6 +945,38 @@ func (mock *KafkaServiceMock) GetCNAMERecordStatusCalls() []struct {
 	return calls
 }
 
+// GetExpirationTime calls GetExpirationTimeFunc.
+func (mock *KafkaServiceMock) GetExpirationTime(kafkaRequest *dbapi.KafkaRequest) (*time.Time, *apiErrors.ServiceError) {
+	if mock.GetExpirationTimeFunc == nil {
+		panic("KafkaServiceMock.GetExpirationTimeFunc: method is nil but KafkaService.GetExpirationTime was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+	}{
+		KafkaRequest: kafkaRequest,
+	}
+	mock.lockGetExpirationTime.Lock()
+	mock.calls.GetExpirationTime = append(mock.calls.GetExpirationTime, callInfo)
+	mock.lockGetExpirationTime.Unlock()
+	return mock.GetExpirationTimeFunc(kafkaRequest)
+}
+
+// GetExpirationTimeCalls gets all the calls that were made to GetExpirationTime.
+// Check the length with:
+//
+//	len(mockedKafkaService.GetExpirationTimeCalls())
+func (mock *KafkaServiceMock) GetExpirationTimeCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+	}
+	mock.lockGetExpirationTime.RLock()
+	calls = mock.calls.GetExpirationTime
+	mock.lockGetExpirationTime.RUnlock()
+	return calls
+}
+
 // GetManagedKafkaByClusterID calls GetManagedKafkaByClusterIDFunc.
 func (mock *KafkaServiceMock) GetManagedKafkaByClusterID(clusterID string) ([]v1.ManagedKafka, *apiErrors.ServiceError) {
 	if mock.GetManagedKafkaByClusterIDFunc == nil {
@@ -953,6 +1163,38 @@ func (mock *KafkaServiceMock) ListComponentVersionsCalls() []struct {
 	return calls
 }
 
+// ListKafkasExpiringBefore calls ListKafkasExpiringBeforeFunc.
+func (mock *KafkaServiceMock) ListKafkasExpiringBefore(expiresBefore time.Time) ([]*dbapi.KafkaRequest, *apiErrors.ServiceError) {
+	if mock.ListKafkasExpiringBeforeFunc == nil {
+		panic("KafkaServiceMock.ListKafkasExpiringBeforeFunc: method is nil but KafkaService.ListKafkasExpiringBefore was just called")
+	}
+	callInfo := struct {
+		ExpiresBefore time.Time
+	}{
+		ExpiresBefore: expiresBefore,
+	}
+	mock.lockListKafkasExpiringBefore.Lock()
+	mock.calls.ListKafkasExpiringBefore = append(mock.calls.ListKafkasExpiringBefore, callInfo)
+	mock.lockListKafkasExpiringBefore.Unlock()
+	return mock.ListKafkasExpiringBeforeFunc(expiresBefore)
+}
+
+// ListKafkasExpiringBeforeCalls gets all the calls that were made to ListKafkasExpiringBefore.
+// Check the length with:
+//
+//	len(mockedKafkaService.ListKafkasExpiringBeforeCalls())
+func (mock *KafkaServiceMock) ListKafkasExpiringBeforeCalls() []struct {
+	ExpiresBefore time.Time
+} {
+	var calls []struct {
+		ExpiresBefore time.Time
+	}
+	mock.lockListKafkasExpiringBefore.RLock()
+	calls = mock.calls.ListKafkasExpiringBefore
+	mock.lockListKafkasExpiringBefore.RUnlock()
+	return calls
+}
+
 // ListKafkasWithRoutesNotCreated calls ListKafkasWithRoutesNotCreatedFunc.
 func (mock *KafkaServiceMock) ListKafkasWithRoutesNotCreated() ([]*dbapi.KafkaRequest, *apiErrors.ServiceError) {
 	if mock.ListKafkasWithRoutesNotCreatedFunc == nil {
@@ -1013,21 +1255,23 @@ func (mock *KafkaServiceMock) PrepareKafkaRequestCalls() []struct {
 }
 
 // RegisterKafkaDeprovisionJob calls RegisterKafkaDeprovisionJobFunc.
-func (mock *KafkaServiceMock) RegisterKafkaDeprovisionJob(ctx context.Context, id string) *apiErrors.ServiceError {
+func (mock *KafkaServiceMock) RegisterKafkaDeprovisionJob(ctx context.Context, id string, ignoreDeletionProtection bool) *apiErrors.ServiceError {
 	if mock.RegisterKafkaDeprovisionJobFunc == nil {
 		panic("KafkaServiceMock.RegisterKafkaDeprovisionJobFunc: method is nil but KafkaService.RegisterKafkaDeprovisionJob was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
-		ID  string
+		Ctx                      context.Context
+		ID                       string
+		IgnoreDeletionProtection bool
 	}{
-		Ctx: ctx,
-		ID:  id,
+		Ctx:                      ctx,
+		ID:                       id,
+		IgnoreDeletionProtection: ignoreDeletionProtection,
 	}
 	mock.lockRegisterKafkaDeprovisionJob.Lock()
 	mock.calls.RegisterKafkaDeprovisionJob = append(mock.calls.RegisterKafkaDeprovisionJob, callInfo)
 	mock.lockRegisterKafkaDeprovisionJob.Unlock()
-	return mock.RegisterKafkaDeprovisionJobFunc(ctx, id)
+	return mock.RegisterKafkaDeprovisionJobFunc(ctx, id, ignoreDeletionProtection)
 }
 
 // RegisterKafkaDeprovisionJobCalls gets all the calls that were made to RegisterKafkaDeprovisionJob.
@@ -1035,12 +1279,14 @@ func (mock *KafkaServiceMock) RegisterKafkaDeprovisionJob(ctx context.Context, i
 //
 //	len(mockedKafkaService.RegisterKafkaDeprovisionJobCalls())
 func (mock *KafkaServiceMock) RegisterKafkaDeprovisionJobCalls() []struct {
-	Ctx context.Context
-	ID  string
+	Ctx                      context.Context
+	ID                       string
+	IgnoreDeletionProtection bool
 } {
 	var calls []struct {
-		Ctx context.Context
-		ID  string
+		Ctx                      context.Context
+		ID                       string
+		IgnoreDeletionProtection bool
 	}
 	mock.lockRegisterKafkaDeprovisionJob.RLock()
 	calls = mock.calls.RegisterKafkaDeprovisionJob
@@ -1080,6 +1326,138 @@ func (mock *KafkaServiceMock) RegisterKafkaJobCalls() []struct {
 	return calls
 }
 
+// ResizeKafka calls ResizeKafkaFunc.
+func (mock *KafkaServiceMock) ResizeKafka(kafkaRequest *dbapi.KafkaRequest, sizeId string) *apiErrors.ServiceError {
+	if mock.ResizeKafkaFunc == nil {
+		panic("KafkaServiceMock.ResizeKafkaFunc: method is nil but KafkaService.ResizeKafka was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+		SizeId       string
+	}{
+		KafkaRequest: kafkaRequest,
+		SizeId:       sizeId,
+	}
+	mock.lockResizeKafka.Lock()
+	mock.calls.ResizeKafka = append(mock.calls.ResizeKafka, callInfo)
+	mock.lockResizeKafka.Unlock()
+	return mock.ResizeKafkaFunc(kafkaRequest, sizeId)
+}
+
+// ResizeKafkaCalls gets all the calls that were made to ResizeKafka.
+// Check the length with:
+//
+//	len(mockedKafkaService.ResizeKafkaCalls())
+func (mock *KafkaServiceMock) ResizeKafkaCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+	SizeId       string
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+		SizeId       string
+	}
+	mock.lockResizeKafka.RLock()
+	calls = mock.calls.ResizeKafka
+	mock.lockResizeKafka.RUnlock()
+	return calls
+}
+
+// RestoreKafka calls RestoreKafkaFunc.
+func (mock *KafkaServiceMock) RestoreKafka(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError {
+	if mock.RestoreKafkaFunc == nil {
+		panic("KafkaServiceMock.RestoreKafkaFunc: method is nil but KafkaService.RestoreKafka was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+	}{
+		KafkaRequest: kafkaRequest,
+	}
+	mock.lockRestoreKafka.Lock()
+	mock.calls.RestoreKafka = append(mock.calls.RestoreKafka, callInfo)
+	mock.lockRestoreKafka.Unlock()
+	return mock.RestoreKafkaFunc(kafkaRequest)
+}
+
+// RestoreKafkaCalls gets all the calls that were made to RestoreKafka.
+// Check the length with:
+//
+//	len(mockedKafkaService.RestoreKafkaCalls())
+func (mock *KafkaServiceMock) RestoreKafkaCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+	}
+	mock.lockRestoreKafka.RLock()
+	calls = mock.calls.RestoreKafka
+	mock.lockRestoreKafka.RUnlock()
+	return calls
+}
+
+// ResumeKafka calls ResumeKafkaFunc.
+func (mock *KafkaServiceMock) ResumeKafka(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError {
+	if mock.ResumeKafkaFunc == nil {
+		panic("KafkaServiceMock.ResumeKafkaFunc: method is nil but KafkaService.ResumeKafka was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+	}{
+		KafkaRequest: kafkaRequest,
+	}
+	mock.lockResumeKafka.Lock()
+	mock.calls.ResumeKafka = append(mock.calls.ResumeKafka, callInfo)
+	mock.lockResumeKafka.Unlock()
+	return mock.ResumeKafkaFunc(kafkaRequest)
+}
+
+// ResumeKafkaCalls gets all the calls that were made to ResumeKafka.
+// Check the length with:
+//
+//	len(mockedKafkaService.ResumeKafkaCalls())
+func (mock *KafkaServiceMock) ResumeKafkaCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+	}
+	mock.lockResumeKafka.RLock()
+	calls = mock.calls.ResumeKafka
+	mock.lockResumeKafka.RUnlock()
+	return calls
+}
+
+// SuspendKafka calls SuspendKafkaFunc.
+func (mock *KafkaServiceMock) SuspendKafka(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError {
+	if mock.SuspendKafkaFunc == nil {
+		panic("KafkaServiceMock.SuspendKafkaFunc: method is nil but KafkaService.SuspendKafka was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+	}{
+		KafkaRequest: kafkaRequest,
+	}
+	mock.lockSuspendKafka.Lock()
+	mock.calls.SuspendKafka = append(mock.calls.SuspendKafka, callInfo)
+	mock.lockSuspendKafka.Unlock()
+	return mock.SuspendKafkaFunc(kafkaRequest)
+}
+
+// SuspendKafkaCalls gets all the calls that were made to SuspendKafka.
+// Check the length with:
+//
+//	len(mockedKafkaService.SuspendKafkaCalls())
+func (mock *KafkaServiceMock) SuspendKafkaCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+	}
+	mock.lockSuspendKafka.RLock()
+	calls = mock.calls.SuspendKafka
+	mock.lockSuspendKafka.RUnlock()
+	return calls
+}
+
 // Update calls UpdateFunc.
 func (mock *KafkaServiceMock) Update(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError {
 	if mock.UpdateFunc == nil {
@@ -1112,6 +1490,42 @@ func (mock *KafkaServiceMock) UpdateCalls() []struct {
 	return calls
 }
 
+// UpdateLabels calls UpdateLabelsFunc.
+func (mock *KafkaServiceMock) UpdateLabels(kafkaRequest *dbapi.KafkaRequest, labels dbapi.KafkaLabels) *apiErrors.ServiceError {
+	if mock.UpdateLabelsFunc == nil {
+		panic("KafkaServiceMock.UpdateLabelsFunc: method is nil but KafkaService.UpdateLabels was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+		Labels       dbapi.KafkaLabels
+	}{
+		KafkaRequest: kafkaRequest,
+		Labels:       labels,
+	}
+	mock.lockUpdateLabels.Lock()
+	mock.calls.UpdateLabels = append(mock.calls.UpdateLabels, callInfo)
+	mock.lockUpdateLabels.Unlock()
+	return mock.UpdateLabelsFunc(kafkaRequest, labels)
+}
+
+// UpdateLabelsCalls gets all the calls that were made to UpdateLabels.
+// Check the length with:
+//
+//	len(mockedKafkaService.UpdateLabelsCalls())
+func (mock *KafkaServiceMock) UpdateLabelsCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+	Labels       dbapi.KafkaLabels
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+		Labels       dbapi.KafkaLabels
+	}
+	mock.lockUpdateLabels.RLock()
+	calls = mock.calls.UpdateLabels
+	mock.lockUpdateLabels.RUnlock()
+	return calls
+}
+
 // UpdateStatus calls UpdateStatusFunc.
 func (mock *KafkaServiceMock) UpdateStatus(id string, status constants.KafkaStatus) (bool, *apiErrors.ServiceError) {
 	if mock.UpdateStatusFunc == nil {
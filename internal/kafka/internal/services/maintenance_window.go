@@ -0,0 +1,179 @@
+package services
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+//go:generate moq -out maintenance_window_service_moq.go . MaintenanceWindowService
+type MaintenanceWindowService interface {
+	// GetOrganisationMaintenanceWindow returns the default maintenance window of the Kafka instances of the given organisation.
+	// nil is returned if the organisation does not have a default maintenance window.
+	GetOrganisationMaintenanceWindow(organisationId string) (*dbapi.MaintenanceWindow, *apiErrors.ServiceError)
+	// SetOrganisationMaintenanceWindow creates or replaces the default maintenance window of the Kafka instances of the given organisation
+	SetOrganisationMaintenanceWindow(organisationId string, window dbapi.MaintenanceWindow) *apiErrors.ServiceError
+	// DeleteOrganisationMaintenanceWindow removes the default maintenance window of the Kafka instances of the given organisation
+	DeleteOrganisationMaintenanceWindow(organisationId string) *apiErrors.ServiceError
+	// SetKafkaMaintenanceWindow sets the maintenance window of the given Kafka instance. Passing an empty maintenance window
+	// removes the maintenance window of the Kafka instance, which then falls back to the default one of its organisation.
+	SetKafkaMaintenanceWindow(kafkaRequest *dbapi.KafkaRequest, window dbapi.MaintenanceWindow) *apiErrors.ServiceError
+	// GetEffectiveMaintenanceWindow returns the maintenance window that applies to the given Kafka instance: its own
+	// maintenance window if set, the default one of its organisation otherwise. nil is returned if none applies.
+	GetEffectiveMaintenanceWindow(kafkaRequest *dbapi.KafkaRequest) (*dbapi.MaintenanceWindow, *apiErrors.ServiceError)
+	// CanUpgradeNow returns true if the given Kafka instance can be upgraded right away, that is when no maintenance
+	// window applies to it or when the current time is within its maintenance window
+	CanUpgradeNow(kafkaRequest *dbapi.KafkaRequest) (bool, *apiErrors.ServiceError)
+	// ListKafkasWithPendingUpgrades returns all the Kafka instances with versions waiting for a maintenance window to be rolled out
+	ListKafkasWithPendingUpgrades() (dbapi.KafkaList, *apiErrors.ServiceError)
+}
+
+// kafkaStatusesWithoutPendingUpgrades are the statuses of the kafkas whose pending upgrades will never be rolled out
+var kafkaStatusesWithoutPendingUpgrades = []string{
+	constants.KafkaRequestStatusDeprovision.String(),
+	constants.KafkaRequestStatusDeleting.String(),
+	constants.KafkaRequestStatusFailed.String(),
+}
+
+var _ MaintenanceWindowService = &maintenanceWindowService{}
+
+type maintenanceWindowService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewMaintenanceWindowService(connectionFactory *db.ConnectionFactory) MaintenanceWindowService {
+	return &maintenanceWindowService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (m *maintenanceWindowService) GetOrganisationMaintenanceWindow(organisationId string) (*dbapi.MaintenanceWindow, *apiErrors.ServiceError) {
+	if organisationId == "" {
+		return nil, nil
+	}
+
+	var organisationMaintenanceWindow dbapi.OrganisationMaintenanceWindow
+	dbConn := m.connectionFactory.New()
+	if err := dbConn.Where("organisation_id = ?", organisationId).First(&organisationMaintenanceWindow).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get maintenance window of organisation %q", organisationId)
+	}
+
+	return &organisationMaintenanceWindow.MaintenanceWindow, nil
+}
+
+func (m *maintenanceWindowService) SetOrganisationMaintenanceWindow(organisationId string, window dbapi.MaintenanceWindow) *apiErrors.ServiceError {
+	if err := window.Validate(); err != nil {
+		return apiErrors.Validation(err.Error())
+	}
+
+	dbConn := m.connectionFactory.New()
+	result := dbConn.Model(&dbapi.OrganisationMaintenanceWindow{}).
+		Where("organisation_id = ?", organisationId).
+		Updates(map[string]interface{}{
+			"maintenance_window_day_of_week":    window.DayOfWeek,
+			"maintenance_window_start_hour":     window.StartHour,
+			"maintenance_window_duration_hours": window.DurationHours,
+		})
+	if err := result.Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update maintenance window of organisation %q", organisationId)
+	}
+
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	organisationMaintenanceWindow := &dbapi.OrganisationMaintenanceWindow{
+		OrganisationId:    organisationId,
+		MaintenanceWindow: window,
+	}
+	if err := dbConn.Create(organisationMaintenanceWindow).Error; err != nil {
+		// the maintenance window has been concurrently created by another request
+		if strings.Contains(err.Error(), "violates unique constraint") {
+			return apiErrors.Conflict("maintenance window of organisation %q has been concurrently modified", organisationId)
+		}
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to create maintenance window of organisation %q", organisationId)
+	}
+
+	return nil
+}
+
+func (m *maintenanceWindowService) DeleteOrganisationMaintenanceWindow(organisationId string) *apiErrors.ServiceError {
+	dbConn := m.connectionFactory.New()
+	result := dbConn.Unscoped().
+		Where("organisation_id = ?", organisationId).
+		Delete(&dbapi.OrganisationMaintenanceWindow{})
+	if err := result.Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to delete maintenance window of organisation %q", organisationId)
+	}
+
+	if result.RowsAffected == 0 {
+		return apiErrors.NotFound("organisation %q does not have a maintenance window", organisationId)
+	}
+
+	return nil
+}
+
+func (m *maintenanceWindowService) SetKafkaMaintenanceWindow(kafkaRequest *dbapi.KafkaRequest, window dbapi.MaintenanceWindow) *apiErrors.ServiceError {
+	if window.IsSet() {
+		if err := window.Validate(); err != nil {
+			return apiErrors.Validation(err.Error())
+		}
+	} else {
+		window = dbapi.MaintenanceWindow{}
+	}
+
+	dbConn := m.connectionFactory.New().
+		Model(kafkaRequest).
+		Where("status not IN (?)", kafkaDeletionStatuses)
+
+	if err := dbConn.Updates(map[string]interface{}{
+		"maintenance_window_day_of_week":    window.DayOfWeek,
+		"maintenance_window_start_hour":     window.StartHour,
+		"maintenance_window_duration_hours": window.DurationHours,
+	}).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update maintenance window of kafka %q", kafkaRequest.ID)
+	}
+
+	kafkaRequest.MaintenanceWindow = window
+	return nil
+}
+
+func (m *maintenanceWindowService) GetEffectiveMaintenanceWindow(kafkaRequest *dbapi.KafkaRequest) (*dbapi.MaintenanceWindow, *apiErrors.ServiceError) {
+	if kafkaRequest.MaintenanceWindow.IsSet() {
+		window := kafkaRequest.MaintenanceWindow
+		return &window, nil
+	}
+
+	return m.GetOrganisationMaintenanceWindow(kafkaRequest.OrganisationId)
+}
+
+func (m *maintenanceWindowService) CanUpgradeNow(kafkaRequest *dbapi.KafkaRequest) (bool, *apiErrors.ServiceError) {
+	window, err := m.GetEffectiveMaintenanceWindow(kafkaRequest)
+	if err != nil {
+		return false, err
+	}
+
+	return window == nil || window.IsActive(time.Now()), nil
+}
+
+func (m *maintenanceWindowService) ListKafkasWithPendingUpgrades() (dbapi.KafkaList, *apiErrors.ServiceError) {
+	var kafkas dbapi.KafkaList
+	dbConn := m.connectionFactory.New().
+		Where("status not IN (?)", kafkaStatusesWithoutPendingUpgrades).
+		Where("pending_kafka_version != '' OR pending_strimzi_version != '' OR pending_kafka_ibp_version != ''")
+
+	if err := dbConn.Find(&kafkas).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list kafkas with pending upgrades")
+	}
+
+	return kafkas, nil
+}
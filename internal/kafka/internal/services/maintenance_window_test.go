@@ -0,0 +1,425 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+// buildMaintenanceWindowStartingAt returns a one hour long maintenance window starting at the hour of the given time
+func buildMaintenanceWindowStartingAt(t time.Time) dbapi.MaintenanceWindow {
+	t = t.UTC()
+	return dbapi.MaintenanceWindow{
+		DayOfWeek:     strings.ToLower(t.Weekday().String()),
+		StartHour:     t.Hour(),
+		DurationHours: 1,
+	}
+}
+
+func Test_maintenanceWindowService_GetOrganisationMaintenanceWindow(t *testing.T) {
+	tests := []struct {
+		name           string
+		organisationId string
+		setupFn        func()
+		want           *dbapi.MaintenanceWindow
+		wantErr        bool
+	}{
+		{
+			name:           "should return nil if the organisation id is empty",
+			organisationId: "",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:           "should return the maintenance window of the organisation",
+			organisationId: "org-id",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "organisation_maintenance_windows"`).
+					WithArgs("org-id").
+					WithReply([]map[string]interface{}{
+						{
+							"id":                                "id",
+							"organisation_id":                   "org-id",
+							"maintenance_window_day_of_week":    "monday",
+							"maintenance_window_start_hour":     2,
+							"maintenance_window_duration_hours": 4,
+						},
+					})
+			},
+			want: &dbapi.MaintenanceWindow{
+				DayOfWeek:     "monday",
+				StartHour:     2,
+				DurationHours: 4,
+			},
+			wantErr: false,
+		},
+		{
+			name:           "should return nil if the organisation does not have a maintenance window",
+			organisationId: "org-id",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:           "should return an error if the query fails",
+			organisationId: "org-id",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQueryException()
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			m := NewMaintenanceWindowService(db.NewMockConnectionFactory(nil))
+			got, err := m.GetOrganisationMaintenanceWindow(tt.organisationId)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_maintenanceWindowService_SetOrganisationMaintenanceWindow(t *testing.T) {
+	validWindow := dbapi.MaintenanceWindow{DayOfWeek: "monday", StartHour: 2, DurationHours: 4}
+
+	tests := []struct {
+		name    string
+		window  dbapi.MaintenanceWindow
+		setupFn func()
+		wantErr *errors.ServiceError
+	}{
+		{
+			name:   "should return a validation error if the maintenance window is not valid",
+			window: dbapi.MaintenanceWindow{DayOfWeek: "someday", StartHour: 2, DurationHours: 4},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: errors.Validation(dbapi.MaintenanceWindow{DayOfWeek: "someday", StartHour: 2, DurationHours: 4}.Validate().Error()),
+		},
+		{
+			name:   "should update the existing maintenance window of the organisation",
+			window: validWindow,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "organisation_maintenance_windows"`).WithRowsNum(1)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "organisation_maintenance_windows"`).WithQueryException().WithExecException()
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "should create the maintenance window of the organisation if it does not exist",
+			window: validWindow,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "organisation_maintenance_windows"`).WithRowsNum(0)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "organisation_maintenance_windows"`)
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			m := NewMaintenanceWindowService(db.NewMockConnectionFactory(nil))
+			g.Expect(m.SetOrganisationMaintenanceWindow("org-id", tt.window)).To(gomega.Equal(tt.wantErr))
+		})
+	}
+
+	t.Run("should return an error if creating the maintenance window fails", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "organisation_maintenance_windows"`).WithRowsNum(0)
+		mocket.Catcher.NewMock().WithQuery(`INSERT INTO "organisation_maintenance_windows"`).WithQueryException().WithExecException()
+		m := NewMaintenanceWindowService(db.NewMockConnectionFactory(nil))
+		g.Expect(m.SetOrganisationMaintenanceWindow("org-id", validWindow)).ToNot(gomega.BeNil())
+	})
+
+	t.Run("should return a conflict error if the maintenance window has been concurrently created", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "organisation_maintenance_windows"`).WithRowsNum(0)
+		mocket.Catcher.NewMock().WithQuery(`INSERT INTO "organisation_maintenance_windows"`).
+			WithError(fmt.Errorf(`duplicate key value violates unique constraint "uix_organisation_maintenance_windows_organisation_id"`))
+		m := NewMaintenanceWindowService(db.NewMockConnectionFactory(nil))
+		err := m.SetOrganisationMaintenanceWindow("org-id", validWindow)
+		g.Expect(err).ToNot(gomega.BeNil())
+		g.Expect(err.Code).To(gomega.Equal(errors.ErrorConflict))
+	})
+}
+
+func Test_maintenanceWindowService_DeleteOrganisationMaintenanceWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		setupFn  func()
+		wantCode errors.ServiceErrorCode
+		wantErr  bool
+	}{
+		{
+			name: "should delete the maintenance window of the organisation",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`DELETE FROM "organisation_maintenance_windows"`).WithRowsNum(1)
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return a not found error if the organisation does not have a maintenance window",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`DELETE FROM "organisation_maintenance_windows"`).WithRowsNum(0)
+			},
+			wantErr:  true,
+			wantCode: errors.ErrorNotFound,
+		},
+		{
+			name: "should return an error if the deletion fails",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException()
+			},
+			wantErr:  true,
+			wantCode: errors.ErrorGeneral,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			m := NewMaintenanceWindowService(db.NewMockConnectionFactory(nil))
+			err := m.DeleteOrganisationMaintenanceWindow("org-id")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(err.Code).To(gomega.Equal(tt.wantCode))
+			}
+		})
+	}
+}
+
+func Test_maintenanceWindowService_SetKafkaMaintenanceWindow(t *testing.T) {
+	tests := []struct {
+		name       string
+		kafka      *dbapi.KafkaRequest
+		window     dbapi.MaintenanceWindow
+		setupFn    func()
+		wantErr    bool
+		wantWindow dbapi.MaintenanceWindow
+	}{
+		{
+			name:  "should return an error if the maintenance window is not valid",
+			kafka: &dbapi.KafkaRequest{},
+			window: dbapi.MaintenanceWindow{
+				DayOfWeek:     "monday",
+				StartHour:     2,
+				DurationHours: 48,
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: true,
+		},
+		{
+			name:  "should set the maintenance window of the kafka",
+			kafka: &dbapi.KafkaRequest{},
+			window: dbapi.MaintenanceWindow{
+				DayOfWeek:     "monday",
+				StartHour:     2,
+				DurationHours: 4,
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "maintenance_window_day_of_week"`)
+			},
+			wantErr: false,
+			wantWindow: dbapi.MaintenanceWindow{
+				DayOfWeek:     "monday",
+				StartHour:     2,
+				DurationHours: 4,
+			},
+		},
+		{
+			name: "should remove the maintenance window of the kafka if the given maintenance window is empty",
+			kafka: &dbapi.KafkaRequest{
+				MaintenanceWindow: dbapi.MaintenanceWindow{
+					DayOfWeek:     "monday",
+					StartHour:     2,
+					DurationHours: 4,
+				},
+			},
+			window: dbapi.MaintenanceWindow{StartHour: 2},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr:    false,
+			wantWindow: dbapi.MaintenanceWindow{},
+		},
+		{
+			name:  "should return an error if the update fails",
+			kafka: &dbapi.KafkaRequest{},
+			window: dbapi.MaintenanceWindow{
+				DayOfWeek:     "monday",
+				StartHour:     2,
+				DurationHours: 4,
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			m := NewMaintenanceWindowService(db.NewMockConnectionFactory(nil))
+			err := m.SetKafkaMaintenanceWindow(tt.kafka, tt.window)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(tt.kafka.MaintenanceWindow).To(gomega.Equal(tt.wantWindow))
+			}
+		})
+	}
+}
+
+func Test_maintenanceWindowService_CanUpgradeNow(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		kafka   *dbapi.KafkaRequest
+		setupFn func()
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:  "should return true if no maintenance window applies to the kafka",
+			kafka: &dbapi.KafkaRequest{OrganisationId: "org-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "should return true if the kafka is within its maintenance window",
+			kafka: &dbapi.KafkaRequest{
+				OrganisationId:    "org-id",
+				MaintenanceWindow: buildMaintenanceWindowStartingAt(now),
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "should return false if the kafka is outside of its maintenance window",
+			kafka: &dbapi.KafkaRequest{
+				OrganisationId:    "org-id",
+				MaintenanceWindow: buildMaintenanceWindowStartingAt(now.Add(48 * time.Hour)),
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name:  "should fall back to the maintenance window of the organisation",
+			kafka: &dbapi.KafkaRequest{OrganisationId: "org-id"},
+			setupFn: func() {
+				window := buildMaintenanceWindowStartingAt(now.Add(48 * time.Hour))
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "organisation_maintenance_windows"`).
+					WithReply([]map[string]interface{}{
+						{
+							"id":                                "id",
+							"organisation_id":                   "org-id",
+							"maintenance_window_day_of_week":    window.DayOfWeek,
+							"maintenance_window_start_hour":     window.StartHour,
+							"maintenance_window_duration_hours": window.DurationHours,
+						},
+					})
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name:  "should return an error if the maintenance window of the organisation cannot be retrieved",
+			kafka: &dbapi.KafkaRequest{OrganisationId: "org-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQueryException()
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			m := NewMaintenanceWindowService(db.NewMockConnectionFactory(nil))
+			got, err := m.CanUpgradeNow(tt.kafka)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_maintenanceWindowService_ListKafkasWithPendingUpgrades(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantLen int
+		wantErr bool
+	}{
+		{
+			name: "should return the kafkas with pending upgrades",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests" WHERE status not IN`).
+					WithReply([]map[string]interface{}{
+						{"id": "kafka-1", "pending_kafka_version": "3.0.0"},
+						{"id": "kafka-2", "pending_strimzi_version": "strimzi-cluster-operator.v0.24.0"},
+					})
+			},
+			wantLen: 2,
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the query fails",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQueryException()
+			},
+			wantLen: 0,
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			m := NewMaintenanceWindowService(db.NewMockConnectionFactory(nil))
+			got, err := m.ListKafkasWithPendingUpgrades()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.HaveLen(tt.wantLen))
+		})
+	}
+}
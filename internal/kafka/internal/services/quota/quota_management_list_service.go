@@ -118,8 +118,12 @@ func (q QuotaManagementListService) ReserveQuota(kafka *dbapi.KafkaRequest) (str
 		return "", errors.GeneralError(errMessage)
 	}
 
-	for _, kafka := range kafkas {
-		kafkaInstanceSize, e := q.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
+	for _, existingKafka := range kafkas {
+		// a kafka request being resized is already in the database. It is only counted with its new size below
+		if kafka.ID != "" && existingKafka.ID == kafka.ID {
+			continue
+		}
+		kafkaInstanceSize, e := q.kafkaConfig.GetKafkaInstanceSize(existingKafka.InstanceType, existingKafka.SizeId)
 		if e != nil {
 			return "", errors.NewWithCause(errors.ErrorGeneral, e, errMessage)
 		}
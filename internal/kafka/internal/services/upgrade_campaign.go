@@ -0,0 +1,275 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultUpgradeCampaignBatchSize             = 10
+	defaultUpgradeCampaignMaxConcurrentUpgrades = 10
+	defaultUpgradeCampaignStallTimeoutMinutes   = 60
+)
+
+// upgradeCampaignStatusTransitions lists the statuses an upgrade campaign can be moved to from each of its statuses
+var upgradeCampaignStatusTransitions = map[dbapi.UpgradeCampaignStatus][]dbapi.UpgradeCampaignStatus{
+	dbapi.UpgradeCampaignStatusInProgress: {dbapi.UpgradeCampaignStatusPaused, dbapi.UpgradeCampaignStatusCancelled, dbapi.UpgradeCampaignStatusCompleted},
+	dbapi.UpgradeCampaignStatusPaused:     {dbapi.UpgradeCampaignStatusInProgress, dbapi.UpgradeCampaignStatusCancelled},
+}
+
+// activeUpgradeCampaignKafkaStatuses are the statuses of the kafkas of a campaign that have not been processed yet
+var activeUpgradeCampaignKafkaStatuses = []string{
+	dbapi.UpgradeCampaignKafkaStatusPending.String(),
+	dbapi.UpgradeCampaignKafkaStatusUpgrading.String(),
+}
+
+//go:generate moq -out upgrade_campaign_service_moq.go . UpgradeCampaignService
+type UpgradeCampaignService interface {
+	// Create creates the given upgrade campaign targeting all the kafkas matching its filter which are not yet running
+	// the target kafka version and which are not already targeted by another active campaign
+	Create(campaign *dbapi.UpgradeCampaign) *apiErrors.ServiceError
+	// Get returns the upgrade campaign with the given id along with its kafkas
+	Get(id string) (*dbapi.UpgradeCampaign, *apiErrors.ServiceError)
+	// List returns the upgrade campaigns along with the number of their kafkas in each status, most recent first.
+	// The kafkas of the campaigns are not loaded.
+	List(listArgs *coreServices.ListArguments) (dbapi.UpgradeCampaignList, *api.PagingMeta, *apiErrors.ServiceError)
+	// ListInProgress returns all the upgrade campaigns in progress along with their kafkas
+	ListInProgress() (dbapi.UpgradeCampaignList, *apiErrors.ServiceError)
+	// UpdateStatus moves the upgrade campaign to the given status. An error is returned if the campaign cannot be moved
+	// from its current status to the given one.
+	UpdateStatus(campaign *dbapi.UpgradeCampaign, status dbapi.UpgradeCampaignStatus, reason string) *apiErrors.ServiceError
+	// UpdateCampaignKafka persists the progress of the upgrade of a kafka targeted by an upgrade campaign
+	UpdateCampaignKafka(campaignKafka *dbapi.UpgradeCampaignKafka) *apiErrors.ServiceError
+}
+
+var _ UpgradeCampaignService = &upgradeCampaignService{}
+
+type upgradeCampaignService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewUpgradeCampaignService(connectionFactory *db.ConnectionFactory) UpgradeCampaignService {
+	return &upgradeCampaignService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (u *upgradeCampaignService) Create(campaign *dbapi.UpgradeCampaign) *apiErrors.ServiceError {
+	if campaign.KafkaVersion == "" {
+		return apiErrors.Validation("kafka_version is required")
+	}
+	if campaign.BatchSize < 0 || campaign.MaxConcurrentUpgrades < 0 || campaign.StallTimeoutMinutes < 0 {
+		return apiErrors.Validation("batch_size, max_concurrent_upgrades and stall_timeout_minutes must be positive")
+	}
+	if campaign.BatchSize == 0 {
+		campaign.BatchSize = defaultUpgradeCampaignBatchSize
+	}
+	if campaign.MaxConcurrentUpgrades == 0 {
+		campaign.MaxConcurrentUpgrades = defaultUpgradeCampaignMaxConcurrentUpgrades
+	}
+	if campaign.StallTimeoutMinutes == 0 {
+		campaign.StallTimeoutMinutes = defaultUpgradeCampaignStallTimeoutMinutes
+	}
+
+	dbConn := u.connectionFactory.New()
+
+	var kafkaIDs []string
+	query := dbConn.Model(&dbapi.KafkaRequest{}).
+		Where("status not IN (?)", kafkaStatusesWithoutPendingUpgrades).
+		Where("actual_kafka_version != ?", campaign.KafkaVersion).
+		Where("id not IN (?)", dbConn.Table("upgrade_campaign_kafkas").
+			Select("upgrade_campaign_kafkas.kafka_id").
+			Joins("JOIN upgrade_campaigns ON upgrade_campaigns.id = upgrade_campaign_kafkas.upgrade_campaign_id").
+			Where("upgrade_campaigns.deleted_at IS NULL AND upgrade_campaign_kafkas.deleted_at IS NULL").
+			Where("upgrade_campaigns.status IN (?)", []string{dbapi.UpgradeCampaignStatusInProgress.String(), dbapi.UpgradeCampaignStatusPaused.String()}).
+			Where("upgrade_campaign_kafkas.status IN (?)", activeUpgradeCampaignKafkaStatuses))
+
+	if campaign.Filter.InstanceType != "" {
+		query = query.Where("instance_type = ?", campaign.Filter.InstanceType)
+	}
+	if campaign.Filter.Region != "" {
+		query = query.Where("region = ?", campaign.Filter.Region)
+	}
+	if campaign.Filter.ClusterID != "" {
+		query = query.Where("cluster_id = ?", campaign.Filter.ClusterID)
+	}
+	if campaign.Filter.ActualKafkaVersion != "" {
+		query = query.Where("actual_kafka_version = ?", campaign.Filter.ActualKafkaVersion)
+	}
+
+	if err := query.Order("created_at").Pluck("id", &kafkaIDs).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to find kafkas targeted by upgrade campaign")
+	}
+
+	if len(kafkaIDs) == 0 {
+		return apiErrors.BadRequest("no kafka matches the filter of the upgrade campaign")
+	}
+
+	campaign.Status = dbapi.UpgradeCampaignStatusInProgress
+	campaign.Kafkas = make([]dbapi.UpgradeCampaignKafka, 0, len(kafkaIDs))
+	for _, kafkaID := range kafkaIDs {
+		campaign.Kafkas = append(campaign.Kafkas, dbapi.UpgradeCampaignKafka{
+			KafkaID: kafkaID,
+			Status:  dbapi.UpgradeCampaignKafkaStatusPending,
+		})
+	}
+
+	// the kafkas of the campaign are created along with the campaign within the same transaction
+	if err := dbConn.Create(campaign).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to create upgrade campaign")
+	}
+
+	return nil
+}
+
+func (u *upgradeCampaignService) Get(id string) (*dbapi.UpgradeCampaign, *apiErrors.ServiceError) {
+	if id == "" {
+		return nil, apiErrors.Validation("id is undefined")
+	}
+
+	var campaign dbapi.UpgradeCampaign
+	dbConn := u.connectionFactory.New()
+	if err := dbConn.Preload("Kafkas").Where("id = ?", id).First(&campaign).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apiErrors.NotFound("upgrade campaign with id='%v' not found", id)
+		}
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get upgrade campaign %q", id)
+	}
+
+	return &campaign, nil
+}
+
+func (u *upgradeCampaignService) List(listArgs *coreServices.ListArguments) (dbapi.UpgradeCampaignList, *api.PagingMeta, *apiErrors.ServiceError) {
+	var campaigns dbapi.UpgradeCampaignList
+	dbConn := u.connectionFactory.New()
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Model(&campaigns).Count(&total).Error; err != nil {
+		return campaigns, pagingMeta, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "unable to count upgrade campaigns")
+	}
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	query := dbConn.Order("created_at desc").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size)
+
+	if err := query.Find(&campaigns).Error; err != nil {
+		return campaigns, pagingMeta, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "unable to list upgrade campaigns")
+	}
+
+	if err := u.setKafkaCounts(dbConn, campaigns); err != nil {
+		return campaigns, pagingMeta, err
+	}
+
+	return campaigns, pagingMeta, nil
+}
+
+// setKafkaCounts sets the number of kafkas in each status of the given campaigns with a single aggregated query
+func (u *upgradeCampaignService) setKafkaCounts(dbConn *gorm.DB, campaigns dbapi.UpgradeCampaignList) *apiErrors.ServiceError {
+	if len(campaigns) == 0 {
+		return nil
+	}
+
+	campaignsByID := make(map[string]*dbapi.UpgradeCampaign, len(campaigns))
+	campaignIDs := make([]string, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		campaign.KafkaCounts = map[dbapi.UpgradeCampaignKafkaStatus]int{}
+		campaignsByID[campaign.ID] = campaign
+		campaignIDs = append(campaignIDs, campaign.ID)
+	}
+
+	var counts []struct {
+		UpgradeCampaignID string
+		Status            dbapi.UpgradeCampaignKafkaStatus
+		Count             int
+	}
+	if err := dbConn.Model(&dbapi.UpgradeCampaignKafka{}).
+		Select("upgrade_campaign_id, status, count(*) as count").
+		Where("upgrade_campaign_id IN (?)", campaignIDs).
+		Group("upgrade_campaign_id, status").
+		Scan(&counts).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "unable to count kafkas of upgrade campaigns")
+	}
+
+	for _, count := range counts {
+		if campaign, ok := campaignsByID[count.UpgradeCampaignID]; ok {
+			campaign.KafkaCounts[count.Status] = count.Count
+		}
+	}
+
+	return nil
+}
+
+func (u *upgradeCampaignService) ListInProgress() (dbapi.UpgradeCampaignList, *apiErrors.ServiceError) {
+	var campaigns dbapi.UpgradeCampaignList
+	dbConn := u.connectionFactory.New().
+		Preload("Kafkas").
+		Where("status = ?", dbapi.UpgradeCampaignStatusInProgress.String())
+
+	if err := dbConn.Find(&campaigns).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list upgrade campaigns in progress")
+	}
+
+	return campaigns, nil
+}
+
+func (u *upgradeCampaignService) UpdateStatus(campaign *dbapi.UpgradeCampaign, status dbapi.UpgradeCampaignStatus, reason string) *apiErrors.ServiceError {
+	if !isUpgradeCampaignStatusTransitionAllowed(campaign.Status, status) {
+		return apiErrors.BadRequest("upgrade campaign %q cannot be moved from status %q to status %q", campaign.ID, campaign.Status, status)
+	}
+
+	dbConn := u.connectionFactory.New().
+		Model(campaign).
+		Where("status = ?", campaign.Status.String())
+
+	result := dbConn.Updates(map[string]interface{}{
+		"status":        status.String(),
+		"status_reason": reason,
+	})
+	if err := result.Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update status of upgrade campaign %q", campaign.ID)
+	}
+	if result.RowsAffected == 0 {
+		return apiErrors.Conflict("upgrade campaign %q has been updated concurrently", campaign.ID)
+	}
+
+	campaign.Status = status
+	campaign.StatusReason = reason
+	return nil
+}
+
+func (u *upgradeCampaignService) UpdateCampaignKafka(campaignKafka *dbapi.UpgradeCampaignKafka) *apiErrors.ServiceError {
+	dbConn := u.connectionFactory.New().Model(campaignKafka)
+
+	if err := dbConn.Updates(map[string]interface{}{
+		"status":        campaignKafka.Status.String(),
+		"failed_reason": campaignKafka.FailedReason,
+		"started_at":    campaignKafka.StartedAt,
+		"finished_at":   campaignKafka.FinishedAt,
+	}).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update kafka %q of upgrade campaign %q", campaignKafka.KafkaID, campaignKafka.UpgradeCampaignID)
+	}
+
+	return nil
+}
+
+func isUpgradeCampaignStatusTransitionAllowed(from, to dbapi.UpgradeCampaignStatus) bool {
+	for _, status := range upgradeCampaignStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
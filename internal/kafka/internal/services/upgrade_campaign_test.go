@@ -0,0 +1,285 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_upgradeCampaignService_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		campaign   *dbapi.UpgradeCampaign
+		setupFn    func()
+		wantErr    *errors.ServiceError
+		wantKafkas []string
+	}{
+		{
+			name:     "should return a validation error if the target kafka version is not provided",
+			campaign: &dbapi.UpgradeCampaign{},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: errors.Validation("kafka_version is required"),
+		},
+		{
+			name:     "should return a validation error if the batch size is negative",
+			campaign: &dbapi.UpgradeCampaign{KafkaVersion: "3.3.1", BatchSize: -1},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: errors.Validation("batch_size, max_concurrent_upgrades and stall_timeout_minutes must be positive"),
+		},
+		{
+			name:     "should return an error if no kafka matches the filter of the campaign",
+			campaign: &dbapi.UpgradeCampaign{KafkaVersion: "3.3.1"},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: errors.BadRequest("no kafka matches the filter of the upgrade campaign"),
+		},
+		{
+			name: "should create the campaign with the kafkas matching its filter",
+			campaign: &dbapi.UpgradeCampaign{
+				KafkaVersion: "3.3.1",
+				Filter:       dbapi.UpgradeCampaignFilter{Region: "us-east-1"},
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "id" FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{{"id": "kafka-1"}, {"id": "kafka-2"}})
+			},
+			wantErr:    nil,
+			wantKafkas: []string{"kafka-1", "kafka-2"},
+		},
+		{
+			name:     "should return an error if creating the campaign fails",
+			campaign: &dbapi.UpgradeCampaign{KafkaVersion: "3.3.1"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "id" FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{{"id": "kafka-1"}})
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "upgrade_campaigns"`).WithQueryException().WithExecException()
+			},
+			wantErr: errors.GeneralError("failed to create upgrade campaign"),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			u := NewUpgradeCampaignService(db.NewMockConnectionFactory(nil))
+			err := u.Create(tt.campaign)
+			if tt.wantErr != nil {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErr.Code))
+				g.Expect(err.Reason).To(gomega.Equal(tt.wantErr.Reason))
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(tt.campaign.Status).To(gomega.Equal(dbapi.UpgradeCampaignStatusInProgress))
+			g.Expect(tt.campaign.BatchSize).To(gomega.Equal(defaultUpgradeCampaignBatchSize))
+			g.Expect(tt.campaign.MaxConcurrentUpgrades).To(gomega.Equal(defaultUpgradeCampaignMaxConcurrentUpgrades))
+			g.Expect(tt.campaign.StallTimeoutMinutes).To(gomega.Equal(defaultUpgradeCampaignStallTimeoutMinutes))
+			var kafkaIDs []string
+			for _, kafka := range tt.campaign.Kafkas {
+				g.Expect(kafka.Status).To(gomega.Equal(dbapi.UpgradeCampaignKafkaStatusPending))
+				kafkaIDs = append(kafkaIDs, kafka.KafkaID)
+			}
+			g.Expect(kafkaIDs).To(gomega.Equal(tt.wantKafkas))
+		})
+	}
+}
+
+func Test_upgradeCampaignService_Get(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		setupFn func()
+		wantErr bool
+	}{
+		{
+			name: "should return an error if the id is empty",
+			id:   "",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return the campaign",
+			id:   "campaign-id",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "upgrade_campaigns"`).
+					WithReply([]map[string]interface{}{{"id": "campaign-id", "status": "in_progress"}})
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return a not found error if the campaign does not exist",
+			id:   "campaign-id",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			u := NewUpgradeCampaignService(db.NewMockConnectionFactory(nil))
+			got, err := u.Get(tt.id)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(got.ID).To(gomega.Equal(tt.id))
+			}
+		})
+	}
+}
+
+func Test_upgradeCampaignService_UpdateStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		campaign   *dbapi.UpgradeCampaign
+		status     dbapi.UpgradeCampaignStatus
+		setupFn    func()
+		wantErr    bool
+		wantStatus dbapi.UpgradeCampaignStatus
+	}{
+		{
+			name:       "should pause a campaign in progress",
+			campaign:   &dbapi.UpgradeCampaign{Meta: api.Meta{ID: "campaign-id"}, Status: dbapi.UpgradeCampaignStatusInProgress},
+			status:     dbapi.UpgradeCampaignStatusPaused,
+			setupFn:    func() { mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "upgrade_campaigns"`).WithRowsNum(1) },
+			wantErr:    false,
+			wantStatus: dbapi.UpgradeCampaignStatusPaused,
+		},
+		{
+			name:       "should resume a paused campaign",
+			campaign:   &dbapi.UpgradeCampaign{Meta: api.Meta{ID: "campaign-id"}, Status: dbapi.UpgradeCampaignStatusPaused},
+			status:     dbapi.UpgradeCampaignStatusInProgress,
+			setupFn:    func() { mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "upgrade_campaigns"`).WithRowsNum(1) },
+			wantErr:    false,
+			wantStatus: dbapi.UpgradeCampaignStatusInProgress,
+		},
+		{
+			name:       "should not resume a cancelled campaign",
+			campaign:   &dbapi.UpgradeCampaign{Meta: api.Meta{ID: "campaign-id"}, Status: dbapi.UpgradeCampaignStatusCancelled},
+			status:     dbapi.UpgradeCampaignStatusInProgress,
+			setupFn:    func() { mocket.Catcher.Reset() },
+			wantErr:    true,
+			wantStatus: dbapi.UpgradeCampaignStatusCancelled,
+		},
+		{
+			name:       "should return an error if the campaign has been updated concurrently",
+			campaign:   &dbapi.UpgradeCampaign{Meta: api.Meta{ID: "campaign-id"}, Status: dbapi.UpgradeCampaignStatusInProgress},
+			status:     dbapi.UpgradeCampaignStatusCancelled,
+			setupFn:    func() { mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "upgrade_campaigns"`).WithRowsNum(0) },
+			wantErr:    true,
+			wantStatus: dbapi.UpgradeCampaignStatusInProgress,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			u := NewUpgradeCampaignService(db.NewMockConnectionFactory(nil))
+			err := u.UpdateStatus(tt.campaign, tt.status, "reason")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(tt.campaign.Status).To(gomega.Equal(tt.wantStatus))
+			}
+		})
+	}
+}
+
+func Test_upgradeCampaignService_List(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupFn        func()
+		wantErr        bool
+		wantCampaigns  int
+		wantKafkaCount map[dbapi.UpgradeCampaignKafkaStatus]int
+	}{
+		{
+			name: "should return the campaigns along with the number of their kafkas in each status",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1) FROM "upgrade_campaigns"`).
+					WithReply([]map[string]interface{}{{"count": 1}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "upgrade_campaigns"`).
+					WithReply([]map[string]interface{}{{"id": "campaign-id", "status": "in_progress"}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT upgrade_campaign_id, status, count(*) as count FROM "upgrade_campaign_kafkas"`).
+					WithReply([]map[string]interface{}{
+						{"upgrade_campaign_id": "campaign-id", "status": "pending", "count": 3},
+						{"upgrade_campaign_id": "campaign-id", "status": "completed", "count": 2},
+					})
+			},
+			wantErr:       false,
+			wantCampaigns: 1,
+			wantKafkaCount: map[dbapi.UpgradeCampaignKafkaStatus]int{
+				dbapi.UpgradeCampaignKafkaStatusPending:   3,
+				dbapi.UpgradeCampaignKafkaStatusCompleted: 2,
+			},
+		},
+		{
+			name: "should return an error if counting the campaigns fails",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1) FROM "upgrade_campaigns"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error if counting the kafkas of the campaigns fails",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1) FROM "upgrade_campaigns"`).
+					WithReply([]map[string]interface{}{{"count": 1}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "upgrade_campaigns"`).
+					WithReply([]map[string]interface{}{{"id": "campaign-id", "status": "in_progress"}})
+				mocket.Catcher.NewMock().WithQuery(`FROM "upgrade_campaign_kafkas"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			u := NewUpgradeCampaignService(db.NewMockConnectionFactory(nil))
+			got, _, err := u.List(&coreServices.ListArguments{Page: 1, Size: 10})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(got).To(gomega.HaveLen(tt.wantCampaigns))
+				g.Expect(got[0].Kafkas).To(gomega.BeEmpty())
+				g.Expect(got[0].CountKafkasByStatus()).To(gomega.Equal(tt.wantKafkaCount))
+			}
+		})
+	}
+}
+
+func Test_upgradeCampaignService_ListInProgress(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "upgrade_campaigns"`).
+		WithArgs(dbapi.UpgradeCampaignStatusInProgress.String()).
+		WithReply([]map[string]interface{}{{"id": "campaign-id", "status": "in_progress"}})
+	u := NewUpgradeCampaignService(db.NewMockConnectionFactory(nil))
+	got, err := u.ListInProgress()
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(got).To(gomega.HaveLen(1))
+
+	mocket.Catcher.Reset().NewMock().WithQueryException()
+	_, err = u.ListInProgress()
+	g.Expect(err).ToNot(gomega.BeNil())
+}
@@ -0,0 +1,545 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+)
+
+const (
+	// WebhookSignatureHeader holds the hex encoded HMAC-SHA256 of the payload computed with the secret of the subscription
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	// WebhookEventHeader holds the event type of the payload
+	WebhookEventHeader = "X-Webhook-Event"
+	// WebhookDeliveryHeader holds the id of the delivery. It is the same for all the attempts of a delivery
+	WebhookDeliveryHeader = "X-Webhook-Delivery"
+
+	webhookDeliveryTimeout        = 10 * time.Second
+	webhookDeliveryMaxAttempts    = 6
+	webhookDeliveryInitialBackoff = 30 * time.Second
+	webhookDeliveryMaxBackoff     = time.Hour
+	webhookDeliveryBatchSize      = 100
+	webhookSecretLength           = 32
+)
+
+// webhookPayload is the body of the requests sent to the webhook subscriptions
+type webhookPayload struct {
+	Id         string                 `json:"id"`
+	EventType  dbapi.WebhookEventType `json:"event_type"`
+	OccurredAt time.Time              `json:"occurred_at"`
+	Kafka      webhookKafkaPayload    `json:"kafka"`
+}
+
+type webhookKafkaPayload struct {
+	Id            string     `json:"id"`
+	Name          string     `json:"name"`
+	Status        string     `json:"status"`
+	CloudProvider string     `json:"cloud_provider"`
+	Region        string     `json:"region"`
+	Version       string     `json:"version"`
+	FailedReason  string     `json:"failed_reason,omitempty"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+}
+
+//go:generate moq -out webhook_service_moq.go . WebhookService
+type WebhookService interface {
+	// Create creates the given webhook subscription for the organisation of the user in the context. A secret is
+	// generated for the subscription if none is given.
+	Create(ctx context.Context, subscription *dbapi.WebhookSubscription) *apiErrors.ServiceError
+	// Get returns the webhook subscription with the given id of the organisation of the user in the context
+	Get(ctx context.Context, id string) (*dbapi.WebhookSubscription, *apiErrors.ServiceError)
+	// List returns the webhook subscriptions of the organisation of the user in the context, most recent first
+	List(ctx context.Context, listArgs *coreServices.ListArguments) (dbapi.WebhookSubscriptionList, *api.PagingMeta, *apiErrors.ServiceError)
+	// Update persists the url, the event types and the enabled flag of the given webhook subscription. Callers must make
+	// sure that the user is the owner of the subscription or an admin of its organisation.
+	Update(subscription *dbapi.WebhookSubscription) *apiErrors.ServiceError
+	// Delete deletes the webhook subscription with the given id of the organisation of the user in the context. Callers
+	// must make sure that the user is the owner of the subscription or an admin of its organisation.
+	Delete(ctx context.Context, id string) *apiErrors.ServiceError
+	// ListDeliveries returns the deliveries of the webhook subscription with the given id, most recent first
+	ListDeliveries(subscriptionID string, listArgs *coreServices.ListArguments) (dbapi.WebhookDeliveryList, *api.PagingMeta, *apiErrors.ServiceError)
+	// Notify queues a delivery of the given event of the kafka to each enabled webhook subscription of the organisation
+	// of the kafka subscribing to the event type
+	Notify(kafkaRequest *dbapi.KafkaRequest, eventType dbapi.WebhookEventType) *apiErrors.ServiceError
+	// ListDueDeliveries returns the pending deliveries whose next attempt is due, along with their subscription
+	ListDueDeliveries() (dbapi.WebhookDeliveryList, *apiErrors.ServiceError)
+	// Deliver sends the payload of the delivery to the url of its subscription and persists the outcome of the attempt.
+	// Deliveries not acknowledged with a 2xx response are retried with an exponential backoff until they run out of
+	// attempts. An error is only returned if the outcome of the attempt cannot be persisted.
+	Deliver(delivery *dbapi.WebhookDelivery) *apiErrors.ServiceError
+}
+
+var _ WebhookService = &webhookService{}
+
+type webhookService struct {
+	connectionFactory *db.ConnectionFactory
+	httpClient        *http.Client
+}
+
+func NewWebhookService(connectionFactory *db.ConnectionFactory) WebhookService {
+	return &webhookService{
+		connectionFactory: connectionFactory,
+		httpClient:        newWebhookHTTPClient(),
+	}
+}
+
+// newWebhookHTTPClient returns the client used to send the deliveries. Subscription urls are provided by users, so the
+// client refuses to connect to internal addresses and does not follow redirects. The addresses are checked once the
+// host has been resolved, at connection time, so that a host resolving to a different address after the subscription
+// was validated cannot be used to reach internal services either.
+func newWebhookHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookDeliveryTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isInternalIP(ip) {
+				return fmt.Errorf("webhook endpoint address %q is not allowed", host)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   webhookDeliveryTimeout,
+		Transport: transport,
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// webhookDeniedNetworks are the special purpose address ranges, as registered by IANA, that webhook endpoints must not
+// be reached on. Besides the loopback, private and link local (including the cloud metadata endpoints) ranges, they
+// cover the shared address space used by carrier grade NATs and some cloud providers, the benchmarking, documentation,
+// multicast and reserved ranges, and the IPv6 ranges embedding IPv4 addresses that could be translated to internal ones.
+var webhookDeniedNetworks = mustParseCIDRs(
+	"0.0.0.0/8",       // "this" network
+	"10.0.0.0/8",      // private
+	"100.64.0.0/10",   // shared address space
+	"127.0.0.0/8",     // loopback
+	"169.254.0.0/16",  // link local
+	"172.16.0.0/12",   // private
+	"192.0.0.0/24",    // IETF protocol assignments
+	"192.0.2.0/24",    // documentation (TEST-NET-1)
+	"192.88.99.0/24",  // 6to4 relay anycast
+	"192.168.0.0/16",  // private
+	"198.18.0.0/15",   // benchmarking
+	"198.51.100.0/24", // documentation (TEST-NET-2)
+	"203.0.113.0/24",  // documentation (TEST-NET-3)
+	"224.0.0.0/4",     // multicast
+	"240.0.0.0/4",     // reserved, including the limited broadcast address
+	"::/128",          // unspecified
+	"::1/128",         // loopback
+	"64:ff9b::/96",    // IPv4/IPv6 translation
+	"64:ff9b:1::/48",  // local use IPv4/IPv6 translation
+	"100::/64",        // discard only
+	"2001::/23",       // IETF protocol assignments, including Teredo
+	"2001:db8::/32",   // documentation
+	"2002::/16",       // 6to4
+	"fc00::/7",        // unique local
+	"fe80::/10",       // link local
+	"fec0::/10",       // deprecated site local
+	"ff00::/8",        // multicast
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// isInternalIP returns true if the address is in one of the webhookDeniedNetworks. IPv4-mapped IPv6 addresses are
+// checked as the IPv4 address they map.
+func isInternalIP(ip net.IP) bool {
+	for _, network := range webhookDeniedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *webhookService) Create(ctx context.Context, subscription *dbapi.WebhookSubscription) *apiErrors.ServiceError {
+	orgId, owner, err := getWebhookOrganisationFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if err := validateWebhookSubscription(subscription); err != nil {
+		return err
+	}
+
+	if subscription.Secret == "" {
+		secret, genErr := generateWebhookSecret()
+		if genErr != nil {
+			return apiErrors.NewWithCause(apiErrors.ErrorGeneral, genErr, "failed to generate webhook subscription secret")
+		}
+		subscription.Secret = secret
+	}
+	subscription.OrganisationId = orgId
+	subscription.Owner = owner
+
+	if err := w.connectionFactory.New().Create(subscription).Error; err != nil {
+		return coreServices.HandleCreateError("WebhookSubscription", err)
+	}
+
+	return nil
+}
+
+func (w *webhookService) Get(ctx context.Context, id string) (*dbapi.WebhookSubscription, *apiErrors.ServiceError) {
+	if id == "" {
+		return nil, apiErrors.Validation("id is undefined")
+	}
+	orgId, _, err := getWebhookOrganisationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var subscription dbapi.WebhookSubscription
+	dbConn := w.connectionFactory.New().Where("id = ? AND organisation_id = ?", id, orgId)
+	if err := dbConn.First(&subscription).Error; err != nil {
+		return nil, coreServices.HandleGetError("WebhookSubscription", "id", id, err)
+	}
+
+	return &subscription, nil
+}
+
+func (w *webhookService) List(ctx context.Context, listArgs *coreServices.ListArguments) (dbapi.WebhookSubscriptionList, *api.PagingMeta, *apiErrors.ServiceError) {
+	var subscriptions dbapi.WebhookSubscriptionList
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+	orgId, _, err := getWebhookOrganisationFromContext(ctx)
+	if err != nil {
+		return subscriptions, pagingMeta, err
+	}
+
+	dbConn := w.connectionFactory.New().Where("organisation_id = ?", orgId)
+
+	total := int64(pagingMeta.Total)
+	dbConn.Model(&subscriptions).Count(&total)
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	dbConn = dbConn.Order("created_at desc").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size)
+
+	if err := dbConn.Find(&subscriptions).Error; err != nil {
+		return subscriptions, pagingMeta, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "unable to list webhook subscriptions")
+	}
+
+	return subscriptions, pagingMeta, nil
+}
+
+func (w *webhookService) Update(subscription *dbapi.WebhookSubscription) *apiErrors.ServiceError {
+	if err := validateWebhookSubscription(subscription); err != nil {
+		return err
+	}
+
+	dbConn := w.connectionFactory.New().Model(subscription)
+	if err := dbConn.Updates(map[string]interface{}{
+		"url":         subscription.Url,
+		"event_types": subscription.EventTypes,
+		"enabled":     subscription.Enabled,
+	}).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update webhook subscription %q", subscription.ID)
+	}
+
+	return nil
+}
+
+func (w *webhookService) Delete(ctx context.Context, id string) *apiErrors.ServiceError {
+	subscription, err := w.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// pending deliveries of the subscription are failed by the next delivery attempt
+	if err := w.connectionFactory.New().Delete(subscription).Error; err != nil {
+		return coreServices.HandleDeleteError("WebhookSubscription", "id", id, err)
+	}
+
+	return nil
+}
+
+func (w *webhookService) ListDeliveries(subscriptionID string, listArgs *coreServices.ListArguments) (dbapi.WebhookDeliveryList, *api.PagingMeta, *apiErrors.ServiceError) {
+	var deliveries dbapi.WebhookDeliveryList
+	dbConn := w.connectionFactory.New().Where("subscription_id = ?", subscriptionID)
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	total := int64(pagingMeta.Total)
+	dbConn.Model(&deliveries).Count(&total)
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	dbConn = dbConn.Order("created_at desc").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size)
+
+	if err := dbConn.Find(&deliveries).Error; err != nil {
+		return deliveries, pagingMeta, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "unable to list deliveries of webhook subscription %q", subscriptionID)
+	}
+
+	return deliveries, pagingMeta, nil
+}
+
+func (w *webhookService) Notify(kafkaRequest *dbapi.KafkaRequest, eventType dbapi.WebhookEventType) *apiErrors.ServiceError {
+	if kafkaRequest.OrganisationId == "" {
+		return nil
+	}
+
+	var subscriptions dbapi.WebhookSubscriptionList
+	dbConn := w.connectionFactory.New().
+		Where("organisation_id = ? AND enabled = ?", kafkaRequest.OrganisationId, true)
+	if err := dbConn.Find(&subscriptions).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list webhook subscriptions of organisation %q", kafkaRequest.OrganisationId)
+	}
+
+	now := time.Now()
+	var deliveries dbapi.WebhookDeliveryList
+	for _, subscription := range subscriptions {
+		if !subscription.Subscribes(eventType) {
+			continue
+		}
+
+		deliveryID := api.NewID()
+		var expiresAt *time.Time
+		if !kafkaRequest.ExpiresAt.IsZero() {
+			expiresAt = &kafkaRequest.ExpiresAt
+		}
+		payload, err := json.Marshal(webhookPayload{
+			Id:         deliveryID,
+			EventType:  eventType,
+			OccurredAt: now,
+			Kafka: webhookKafkaPayload{
+				Id:            kafkaRequest.ID,
+				Name:          kafkaRequest.Name,
+				Status:        kafkaRequest.Status,
+				CloudProvider: kafkaRequest.CloudProvider,
+				Region:        kafkaRequest.Region,
+				Version:       kafkaRequest.ActualKafkaVersion,
+				FailedReason:  kafkaRequest.FailedReason,
+				ExpiresAt:     expiresAt,
+			},
+		})
+		if err != nil {
+			return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to marshal %q webhook payload for kafka %q", eventType, kafkaRequest.ID)
+		}
+
+		deliveries = append(deliveries, &dbapi.WebhookDelivery{
+			Meta:           api.Meta{ID: deliveryID},
+			SubscriptionID: subscription.ID,
+			KafkaID:        kafkaRequest.ID,
+			EventType:      eventType,
+			Payload:        string(payload),
+			Status:         dbapi.WebhookDeliveryStatusPending,
+			NextAttemptAt:  &now,
+		})
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	if err := w.connectionFactory.New().Create(&deliveries).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to queue %q webhook deliveries for kafka %q", eventType, kafkaRequest.ID)
+	}
+
+	return nil
+}
+
+func (w *webhookService) ListDueDeliveries() (dbapi.WebhookDeliveryList, *apiErrors.ServiceError) {
+	var deliveries dbapi.WebhookDeliveryList
+	dbConn := w.connectionFactory.New().
+		Preload("Subscription").
+		Where("status = ? AND next_attempt_at <= ?", dbapi.WebhookDeliveryStatusPending.String(), time.Now()).
+		Order("next_attempt_at").
+		Limit(webhookDeliveryBatchSize)
+
+	if err := dbConn.Find(&deliveries).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list due webhook deliveries")
+	}
+
+	return deliveries, nil
+}
+
+func (w *webhookService) Deliver(delivery *dbapi.WebhookDelivery) *apiErrors.ServiceError {
+	now := time.Now()
+
+	if delivery.Subscription == nil || !delivery.Subscription.Enabled {
+		delivery.Status = dbapi.WebhookDeliveryStatusFailed
+		delivery.NextAttemptAt = nil
+		delivery.LastError = "webhook subscription has been deleted or disabled"
+		return w.updateDelivery(delivery)
+	}
+
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	statusCode, err := w.send(delivery)
+	delivery.ResponseStatusCode = statusCode
+
+	switch {
+	case err == nil:
+		delivery.Status = dbapi.WebhookDeliveryStatusSucceeded
+		delivery.NextAttemptAt = nil
+		delivery.LastError = ""
+	case delivery.Attempts >= webhookDeliveryMaxAttempts:
+		logger.Logger.Infof("webhook delivery %q of subscription %q failed after %d attempts: %v", delivery.ID, delivery.SubscriptionID, delivery.Attempts, err)
+		delivery.Status = dbapi.WebhookDeliveryStatusFailed
+		delivery.NextAttemptAt = nil
+		delivery.LastError = err.Error()
+	default:
+		nextAttemptAt := now.Add(webhookDeliveryBackoff(delivery.Attempts))
+		delivery.NextAttemptAt = &nextAttemptAt
+		delivery.LastError = err.Error()
+	}
+
+	return w.updateDelivery(delivery)
+}
+
+// send posts the payload of the delivery to the url of its subscription. The status code of the response is returned
+// along with an error if the delivery was not acknowledged.
+func (w *webhookService) send(delivery *dbapi.WebhookDelivery) (int, error) {
+	payload := []byte(delivery.Payload)
+	request, err := http.NewRequest(http.MethodPost, delivery.Subscription.Url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(WebhookSignatureHeader, SignWebhookPayload(delivery.Subscription.Secret, payload))
+	request.Header.Set(WebhookEventHeader, delivery.EventType.String())
+	request.Header.Set(WebhookDeliveryHeader, delivery.ID)
+
+	response, err := w.httpClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return response.StatusCode, fmt.Errorf("webhook endpoint responded with status code %d", response.StatusCode)
+	}
+
+	return response.StatusCode, nil
+}
+
+func (w *webhookService) updateDelivery(delivery *dbapi.WebhookDelivery) *apiErrors.ServiceError {
+	// the subscription of the delivery is only loaded to send the delivery and is left untouched
+	dbConn := w.connectionFactory.New().Model(delivery).Omit("Subscription")
+
+	if err := dbConn.Updates(map[string]interface{}{
+		"status":               delivery.Status.String(),
+		"attempts":             delivery.Attempts,
+		"next_attempt_at":      delivery.NextAttemptAt,
+		"last_attempt_at":      delivery.LastAttemptAt,
+		"response_status_code": delivery.ResponseStatusCode,
+		"last_error":           delivery.LastError,
+	}).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update webhook delivery %q", delivery.ID)
+	}
+
+	return nil
+}
+
+// SignWebhookPayload returns the value of the signature header of the given payload. Subscribers verify the payloads
+// they receive by computing the same signature with the secret of their subscription.
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookDeliveryBackoff returns the delay before the next attempt of a delivery that failed the given number of times
+func webhookDeliveryBackoff(attempts int) time.Duration {
+	backoff := webhookDeliveryInitialBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= webhookDeliveryMaxBackoff {
+			return webhookDeliveryMaxBackoff
+		}
+	}
+	return backoff
+}
+
+func validateWebhookSubscription(subscription *dbapi.WebhookSubscription) *apiErrors.ServiceError {
+	parsedUrl, err := url.Parse(subscription.Url)
+	if err != nil || parsedUrl.Scheme != "https" || parsedUrl.Hostname() == "" {
+		return apiErrors.Validation("url %q must be an absolute https url", subscription.Url)
+	}
+	if ip := net.ParseIP(parsedUrl.Hostname()); ip != nil && isInternalIP(ip) {
+		return apiErrors.Validation("url %q must not target an internal address", subscription.Url)
+	}
+
+	eventTypes := subscription.GetEventTypes()
+	if len(eventTypes) == 0 {
+		return apiErrors.Validation("at least one event type is required")
+	}
+	for _, eventType := range eventTypes {
+		if !eventType.IsValid() {
+			return apiErrors.Validation("event type %q is not valid. Valid event types are: %v", eventType, dbapi.WebhookEventTypes)
+		}
+	}
+
+	return nil
+}
+
+func getWebhookOrganisationFromContext(ctx context.Context) (string, string, *apiErrors.ServiceError) {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return "", "", apiErrors.NewWithCause(apiErrors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+
+	orgId, _ := claims.GetOrgId()
+	if orgId == "" {
+		return "", "", apiErrors.Unauthenticated("user is not part of an organisation")
+	}
+	owner, _ := claims.GetUsername()
+
+	return orgId, owner, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, webhookSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+var webhookCtx = auth.SetTokenInContext(context.TODO(), &jwt.Token{
+	Claims: jwt.MapClaims{
+		"username": "test-user",
+		"org_id":   "org-id",
+	},
+})
+
+func Test_webhookService_Create(t *testing.T) {
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		subscription *dbapi.WebhookSubscription
+		wantErr      *errors.ServiceError
+	}{
+		{
+			name:         "should create the subscription for the organisation of the user with a generated secret",
+			ctx:          webhookCtx,
+			subscription: &dbapi.WebhookSubscription{Url: "https://example.com/hook", EventTypes: "kafka.ready,kafka.failed", Enabled: true},
+		},
+		{
+			name:         "should return a validation error if the url is not absolute",
+			ctx:          webhookCtx,
+			subscription: &dbapi.WebhookSubscription{Url: "/hook", EventTypes: "kafka.ready"},
+			wantErr:      errors.Validation("url \"/hook\" must be an absolute https url"),
+		},
+		{
+			name:         "should return a validation error if the url is not https",
+			ctx:          webhookCtx,
+			subscription: &dbapi.WebhookSubscription{Url: "http://example.com/hook", EventTypes: "kafka.ready"},
+			wantErr:      errors.Validation("url \"http://example.com/hook\" must be an absolute https url"),
+		},
+		{
+			name:         "should return a validation error if the url targets the metadata endpoint",
+			ctx:          webhookCtx,
+			subscription: &dbapi.WebhookSubscription{Url: "https://169.254.169.254/latest", EventTypes: "kafka.ready"},
+			wantErr:      errors.Validation("url \"https://169.254.169.254/latest\" must not target an internal address"),
+		},
+		{
+			name:         "should return a validation error if an event type is not valid",
+			ctx:          webhookCtx,
+			subscription: &dbapi.WebhookSubscription{Url: "https://example.com/hook", EventTypes: "kafka.created"},
+			wantErr:      errors.Validation("event type \"kafka.created\" is not valid. Valid event types are: %v", dbapi.WebhookEventTypes),
+		},
+		{
+			name:         "should return an error if the user is not part of an organisation",
+			ctx:          context.TODO(),
+			subscription: &dbapi.WebhookSubscription{Url: "https://example.com/hook", EventTypes: "kafka.ready"},
+			wantErr:      errors.Unauthenticated("user is not part of an organisation"),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			s := NewWebhookService(db.NewMockConnectionFactory(nil))
+			err := s.Create(tt.ctx, tt.subscription)
+			if tt.wantErr != nil {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErr.Code))
+				g.Expect(err.Reason).To(gomega.Equal(tt.wantErr.Reason))
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(tt.subscription.OrganisationId).To(gomega.Equal("org-id"))
+			g.Expect(tt.subscription.Owner).To(gomega.Equal("test-user"))
+			g.Expect(tt.subscription.Secret).To(gomega.HaveLen(2 * webhookSecretLength))
+		})
+	}
+}
+
+func Test_webhookService_Notify(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "webhook_subscriptions"`).
+		WithArgs("org-id", true).
+		WithReply([]map[string]interface{}{
+			{"id": "subscription-1", "organisation_id": "org-id", "event_types": "kafka.ready,kafka.failed", "enabled": true},
+			{"id": "subscription-2", "organisation_id": "org-id", "event_types": "kafka.deleted", "enabled": true},
+		})
+	insertDelivery := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "webhook_deliveries"`)
+
+	s := NewWebhookService(db.NewMockConnectionFactory(nil))
+	kafkaRequest := &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-id"}, OrganisationId: "org-id"}
+	g.Expect(s.Notify(kafkaRequest, dbapi.WebhookEventTypeKafkaReady)).To(gomega.BeNil())
+	g.Expect(insertDelivery.Triggered).To(gomega.BeTrue())
+
+	insertDelivery.Triggered = false
+	g.Expect(s.Notify(kafkaRequest, dbapi.WebhookEventTypeKafkaUpgraded)).To(gomega.BeNil())
+	g.Expect(insertDelivery.Triggered).To(gomega.BeFalse())
+}
+
+func Test_webhookService_Deliver(t *testing.T) {
+	tests := []struct {
+		name             string
+		responseCode     int
+		subscription     *dbapi.WebhookSubscription
+		attempts         int
+		wantStatus       dbapi.WebhookDeliveryStatus
+		wantNextAttempt  bool
+		wantRequestCount int
+	}{
+		{
+			name:             "should succeed if the endpoint acknowledges the delivery",
+			responseCode:     http.StatusNoContent,
+			wantStatus:       dbapi.WebhookDeliveryStatusSucceeded,
+			wantRequestCount: 1,
+		},
+		{
+			name:             "should retry the delivery later if the endpoint does not acknowledge it",
+			responseCode:     http.StatusInternalServerError,
+			wantStatus:       dbapi.WebhookDeliveryStatusPending,
+			wantNextAttempt:  true,
+			wantRequestCount: 1,
+		},
+		{
+			name:             "should fail the delivery once it runs out of attempts",
+			responseCode:     http.StatusInternalServerError,
+			attempts:         webhookDeliveryMaxAttempts - 1,
+			wantStatus:       dbapi.WebhookDeliveryStatusFailed,
+			wantRequestCount: 1,
+		},
+		{
+			name:             "should fail the delivery without sending it if the subscription is disabled",
+			subscription:     &dbapi.WebhookSubscription{Enabled: false},
+			wantStatus:       dbapi.WebhookDeliveryStatusFailed,
+			wantRequestCount: 0,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			payload := `{"event_type":"kafka.ready"}`
+			requestCount := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestCount++
+				body, err := io.ReadAll(r.Body)
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+				g.Expect(string(body)).To(gomega.Equal(payload))
+				g.Expect(r.Header.Get(WebhookSignatureHeader)).To(gomega.Equal(SignWebhookPayload("secret", body)))
+				g.Expect(r.Header.Get(WebhookEventHeader)).To(gomega.Equal("kafka.ready"))
+				g.Expect(r.Header.Get(WebhookDeliveryHeader)).To(gomega.Equal("delivery-id"))
+				w.WriteHeader(tt.responseCode)
+			}))
+			defer server.Close()
+
+			subscription := tt.subscription
+			if subscription == nil {
+				subscription = &dbapi.WebhookSubscription{Url: server.URL, Secret: "secret", Enabled: true}
+			}
+			delivery := &dbapi.WebhookDelivery{
+				Meta:         api.Meta{ID: "delivery-id"},
+				Subscription: subscription,
+				EventType:    dbapi.WebhookEventTypeKafkaReady,
+				Payload:      payload,
+				Status:       dbapi.WebhookDeliveryStatusPending,
+				Attempts:     tt.attempts,
+			}
+
+			mocket.Catcher.Reset()
+			updateDelivery := mocket.Catcher.NewMock().WithQuery(`UPDATE "webhook_deliveries"`)
+			s := &webhookService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				httpClient:        server.Client(),
+			}
+			g.Expect(s.Deliver(delivery)).To(gomega.BeNil())
+			g.Expect(updateDelivery.Triggered).To(gomega.BeTrue())
+			g.Expect(requestCount).To(gomega.Equal(tt.wantRequestCount))
+			g.Expect(delivery.Status).To(gomega.Equal(tt.wantStatus))
+			g.Expect(delivery.NextAttemptAt != nil).To(gomega.Equal(tt.wantNextAttempt))
+		})
+	}
+}
+
+func Test_newWebhookHTTPClient(t *testing.T) {
+	g := gomega.NewWithT(t)
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+	}))
+	defer server.Close()
+
+	// the test server listens on a loopback address
+	_, err := newWebhookHTTPClient().Post(server.URL, "application/json", nil)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("is not allowed")))
+	g.Expect(requestCount).To(gomega.Equal(0))
+}
+
+func Test_newWebhookHTTPClient_DoesNotFollowRedirects(t *testing.T) {
+	g := gomega.NewWithT(t)
+	client := newWebhookHTTPClient()
+	g.Expect(client.CheckRedirect(nil, nil)).To(gomega.Equal(http.ErrUseLastResponse))
+}
+
+func Test_isInternalIP(t *testing.T) {
+	g := gomega.NewWithT(t)
+	for _, ip := range []string{
+		"127.0.0.1", "::1", "10.0.0.1", "172.16.0.1", "192.168.1.1", "169.254.169.254", "fe80::1", "fd00::1", "0.0.0.0", "::",
+		// shared address space, IETF protocol assignments and benchmarking
+		"100.64.0.1", "100.127.255.254", "192.0.0.8", "198.18.0.1", "198.19.255.254",
+		// documentation
+		"192.0.2.1", "198.51.100.1", "203.0.113.1", "2001:db8::1",
+		// multicast, reserved and broadcast
+		"224.0.0.1", "239.255.255.250", "240.0.0.1", "255.255.255.255", "ff02::1", "fec0::1",
+		// IPv6 addresses embedding internal IPv4 addresses
+		"::ffff:10.0.0.1", "::ffff:169.254.169.254", "64:ff9b::a00:1", "2002:a00:1::1", "2001::1",
+	} {
+		g.Expect(isInternalIP(net.ParseIP(ip))).To(gomega.BeTrue(), ip)
+	}
+	for _, ip := range []string{"8.8.8.8", "1.1.1.1", "100.128.0.1", "198.20.0.1", "223.255.255.254", "2001:4860:4860::8888"} {
+		g.Expect(isInternalIP(net.ParseIP(ip))).To(gomega.BeFalse(), ip)
+	}
+}
+
+func Test_webhookDeliveryBackoff(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(webhookDeliveryBackoff(1)).To(gomega.Equal(webhookDeliveryInitialBackoff))
+	g.Expect(webhookDeliveryBackoff(3)).To(gomega.Equal(4 * webhookDeliveryInitialBackoff))
+	g.Expect(webhookDeliveryBackoff(20)).To(gomega.Equal(time.Hour))
+}
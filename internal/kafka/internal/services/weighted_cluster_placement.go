@@ -0,0 +1,296 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// placement attributes of a kafka the labels and taints of the data plane clusters are matched against
+const (
+	PlacementAttributeOrganisationID = "organisation_id"
+	PlacementAttributeInstanceType   = "instance_type"
+)
+
+// maxClusterCapacityScore is the score of a data plane cluster that best fits the placement mode
+const maxClusterCapacityScore = 100
+
+//go:generate moq -out cluster_placement_explainer_moq.go . ClusterPlacementExplainer
+type ClusterPlacementExplainer interface {
+	// ExplainPlacement scores the data plane clusters matching the kafka and returns the one it would be placed on along
+	// with the reasons of the decision. The kafka is not placed.
+	ExplainPlacement(kafka *dbapi.KafkaRequest) (*ClusterPlacementDecision, error)
+}
+
+// ClusterPlacementCandidate is a data plane cluster considered for the placement of a kafka
+type ClusterPlacementCandidate struct {
+	Cluster *api.Cluster
+	// Eligible is false when the kafka cannot be placed on the cluster
+	Eligible bool
+	Score    int
+	// RemainingStreamingUnits are the streaming units left on the cluster once the kafka is placed on it. It is nil
+	// when the capacity of the cluster is unknown or unlimited
+	RemainingStreamingUnits *int
+	Reasons                 []string
+}
+
+// ClusterPlacementDecision is the outcome of the placement of a kafka
+type ClusterPlacementDecision struct {
+	// Cluster is the data plane cluster the kafka is placed on. It is nil when no cluster is eligible
+	Cluster *api.Cluster
+	// Candidates are the data plane clusters considered, highest score first
+	Candidates []ClusterPlacementCandidate
+}
+
+func (d *ClusterPlacementDecision) String() string {
+	candidates := make([]string, 0, len(d.Candidates))
+	for _, candidate := range d.Candidates {
+		eligibility := "eligible"
+		if !candidate.Eligible {
+			eligibility = "not eligible"
+		}
+		candidates = append(candidates, fmt.Sprintf("%s (%s, score %d: %s)", candidate.Cluster.ClusterID, eligibility, candidate.Score, strings.Join(candidate.Reasons, ", ")))
+	}
+
+	selected := "none"
+	if d.Cluster != nil {
+		selected = d.Cluster.ClusterID
+	}
+	return fmt.Sprintf("selected cluster: %s, candidates: [%s]", selected, strings.Join(candidates, "; "))
+}
+
+// WeightedClusterPlacement scores the ready data plane clusters and returns the one with the highest score. Depending on
+// the placement mode, clusters get a higher score the fewer (bin-packing) or the more (spreading) streaming units they
+// have left once the kafka is placed. The score is then lowered for each kafka of the same organisation already placed
+// on the cluster and raised for each cluster label matching the kafka. Clusters with a taint the kafka does not
+// tolerate are not eligible. Clusters with the same score are picked in their creation order.
+type WeightedClusterPlacement struct {
+	ClusterService         ClusterService
+	DataplaneClusterConfig *config.DataplaneClusterConfig
+	KafkaConfig            *config.KafkaConfig
+}
+
+var _ ClusterPlacementStrategy = &WeightedClusterPlacement{}
+var _ ClusterPlacementExplainer = &WeightedClusterPlacement{}
+
+func (w *WeightedClusterPlacement) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+	decision, err := w.ExplainPlacement(kafka)
+	if err != nil {
+		return nil, err
+	}
+
+	glog.Infof("placement of kafka %q of organisation %q in region %q: %s", kafka.ID, kafka.OrganisationId, kafka.Region, decision)
+	return decision.Cluster, nil
+}
+
+func (w *WeightedClusterPlacement) ExplainPlacement(kafka *dbapi.KafkaRequest) (*ClusterPlacementDecision, error) {
+	criteria := FindClusterCriteria{
+		Provider:              kafka.CloudProvider,
+		Region:                kafka.Region,
+		MultiAZ:               kafka.MultiAZ,
+		Status:                api.ClusterReady,
+		SupportedInstanceType: kafka.InstanceType,
+	}
+
+	instanceSize, err := w.KafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get kafka instance size for cluster with criteria '%v'", criteria)
+	}
+
+	clusters, err := w.ClusterService.FindAllClusters(criteria)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find all clusters with criteria '%v'", criteria)
+	}
+
+	decision := &ClusterPlacementDecision{Candidates: []ClusterPlacementCandidate{}}
+	if len(clusters) == 0 {
+		return decision, nil
+	}
+
+	capacities, err := findClusterCapacities(w.ClusterService, w.DataplaneClusterConfig, clusters, kafka.InstanceType)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find capacity of clusters with criteria '%v'", criteria)
+	}
+
+	organisationKafkaCounts := map[string]int{}
+	if kafka.OrganisationId != "" && w.DataplaneClusterConfig.ClusterPlacementConfig.OrganisationAntiAffinityWeight > 0 {
+		counts, err := w.ClusterService.FindKafkaInstanceCountByOrganisation(kafka.OrganisationId)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to find kafka instance count of organisation %q", kafka.OrganisationId)
+		}
+		for _, count := range counts {
+			organisationKafkaCounts[count.Clusterid] = count.Count
+		}
+	}
+
+	attributes := kafkaPlacementAttributes(kafka)
+	for _, cluster := range clusters {
+		candidate := w.scoreCluster(cluster, capacities[cluster.ClusterID], instanceSize.CapacityConsumed, attributes, organisationKafkaCounts[cluster.ClusterID])
+		decision.Candidates = append(decision.Candidates, candidate)
+	}
+
+	// the sort is stable so that clusters with the same score keep their creation order
+	sort.SliceStable(decision.Candidates, func(i, j int) bool {
+		if decision.Candidates[i].Eligible != decision.Candidates[j].Eligible {
+			return decision.Candidates[i].Eligible
+		}
+		return decision.Candidates[i].Score > decision.Candidates[j].Score
+	})
+
+	if decision.Candidates[0].Eligible {
+		decision.Cluster = decision.Candidates[0].Cluster
+	}
+
+	return decision, nil
+}
+
+// clusterCapacity holds the streaming units used on a data plane cluster and its maximum. The maximum is -1 when
+// it is unknown or unlimited
+type clusterCapacity struct {
+	used int
+	max  int
+}
+
+func findClusterCapacities(clusterService ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig, clusters []*api.Cluster, instanceType string) (map[string]clusterCapacity, error) {
+	capacities := make(map[string]clusterCapacity, len(clusters))
+
+	switch {
+	case dataplaneClusterConfig.IsDataPlaneManualScalingEnabled():
+		clusterIDs := make([]string, 0, len(clusters))
+		for _, cluster := range clusters {
+			clusterIDs = append(clusterIDs, cluster.ClusterID)
+		}
+		counts, err := clusterService.FindKafkaInstanceCount(clusterIDs)
+		if err != nil {
+			return nil, err
+		}
+		used := make(map[string]int, len(counts))
+		for _, count := range counts {
+			used[count.Clusterid] = count.Count
+		}
+		for _, cluster := range clusters {
+			capacities[cluster.ClusterID] = clusterCapacity{
+				used: used[cluster.ClusterID],
+				max:  dataplaneClusterConfig.ClusterConfig.GetKafkaInstanceLimit(cluster.ClusterID),
+			}
+		}
+	case dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled():
+		streamingUnitCounts, err := clusterService.FindStreamingUnitCountByClusterAndInstanceType()
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range clusters {
+			capacities[cluster.ClusterID] = clusterCapacity{
+				used: streamingUnitCounts.GetStreamingUnitCountForClusterAndInstanceType(cluster.ClusterID, instanceType),
+				max:  int(cluster.RetrieveDynamicCapacityInfo()[instanceType].MaxUnits),
+			}
+		}
+	default:
+		for _, cluster := range clusters {
+			capacities[cluster.ClusterID] = clusterCapacity{max: -1}
+		}
+	}
+
+	return capacities, nil
+}
+
+// HasClusterCapacityForKafka returns whether the kafka can be placed on the data plane cluster without exceeding its
+// capacity, computed as the placement strategies do: the kafka instance limit of the cluster with manual scaling, the
+// streaming units of the instance type of the kafka with auto scaling
+func HasClusterCapacityForKafka(clusterService ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig, kafkaConfig *config.KafkaConfig, cluster *api.Cluster, kafka *dbapi.KafkaRequest) (bool, error) {
+	capacities, err := findClusterCapacities(clusterService, dataplaneClusterConfig, []*api.Cluster{cluster}, kafka.InstanceType)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to find capacity of cluster %q", cluster.ClusterID)
+	}
+
+	capacity := capacities[cluster.ClusterID]
+	if capacity.max < 0 {
+		return true, nil
+	}
+
+	required := 1
+	if !dataplaneClusterConfig.IsDataPlaneManualScalingEnabled() {
+		instanceSize, err := kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get kafka instance size of kafka %q", kafka.ID)
+		}
+		required = instanceSize.CapacityConsumed
+	}
+
+	return capacity.used+required <= capacity.max, nil
+}
+
+func (w *WeightedClusterPlacement) scoreCluster(cluster *api.Cluster, capacity clusterCapacity, capacityConsumed int, attributes map[string]string, organisationKafkaCount int) ClusterPlacementCandidate {
+	placementConfig := w.DataplaneClusterConfig.ClusterPlacementConfig
+	candidate := ClusterPlacementCandidate{
+		Cluster:  cluster,
+		Eligible: true,
+		Reasons:  []string{},
+	}
+
+	if w.DataplaneClusterConfig.IsDataPlaneManualScalingEnabled() && !w.DataplaneClusterConfig.ClusterConfig.IsClusterSchedulable(cluster.ClusterID) {
+		candidate.Eligible = false
+		candidate.Reasons = append(candidate.Reasons, "cluster is not schedulable in the data plane cluster configuration")
+	}
+
+	for _, taint := range cluster.RetrieveTaints() {
+		if attributes[taint.Key] != taint.Value {
+			candidate.Eligible = false
+			candidate.Reasons = append(candidate.Reasons, fmt.Sprintf("kafka does not tolerate taint %s", taint))
+		}
+	}
+
+	if capacity.max < 0 {
+		candidate.Reasons = append(candidate.Reasons, "capacity is unlimited")
+	} else {
+		remaining := capacity.max - capacity.used - capacityConsumed
+		candidate.RemainingStreamingUnits = &remaining
+		if remaining < 0 || capacity.max == 0 {
+			candidate.Eligible = false
+			candidate.Reasons = append(candidate.Reasons, fmt.Sprintf("not enough capacity: %d of %d streaming units used, %d required", capacity.used, capacity.max, capacityConsumed))
+		} else {
+			capacityScore := maxClusterCapacityScore * (capacity.used + capacityConsumed) / capacity.max
+			if placementConfig.Mode == config.SpreadClusterPlacementMode {
+				capacityScore = maxClusterCapacityScore * remaining / capacity.max
+			}
+			candidate.Score += capacityScore
+			candidate.Reasons = append(candidate.Reasons, fmt.Sprintf("%d of %d streaming units left once placed (+%d)", remaining, capacity.max, capacityScore))
+		}
+	}
+
+	labels := cluster.RetrieveLabels()
+	// labels are iterated in order to keep the reasons stable
+	labelKeys := make([]string, 0, len(labels))
+	for key := range labels {
+		labelKeys = append(labelKeys, key)
+	}
+	sort.Strings(labelKeys)
+	for _, key := range labelKeys {
+		if value, ok := attributes[key]; ok && value == labels[key] {
+			candidate.Score += placementConfig.LabelAffinityWeight
+			candidate.Reasons = append(candidate.Reasons, fmt.Sprintf("matches label %s=%s (+%d)", key, labels[key], placementConfig.LabelAffinityWeight))
+		}
+	}
+
+	if organisationKafkaCount > 0 {
+		penalty := organisationKafkaCount * placementConfig.OrganisationAntiAffinityWeight
+		candidate.Score -= penalty
+		candidate.Reasons = append(candidate.Reasons, fmt.Sprintf("%d kafka(s) of the same organisation already placed (-%d)", organisationKafkaCount, penalty))
+	}
+
+	return candidate
+}
+
+// kafkaPlacementAttributes returns the attributes of the kafka matched against the labels and taints of the data plane clusters
+func kafkaPlacementAttributes(kafka *dbapi.KafkaRequest) map[string]string {
+	return map[string]string{
+		PlacementAttributeOrganisationID: kafka.OrganisationId,
+		PlacementAttributeInstanceType:   kafka.InstanceType,
+	}
+}
@@ -0,0 +1,227 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	mockkafkas "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+func buildWeightedPlacementKafkaConfig() *config.KafkaConfig {
+	return &config.KafkaConfig{
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+					{
+						Id: types.STANDARD.String(),
+						Sizes: []config.KafkaInstanceSize{
+							{
+								Id:               "x1",
+								CapacityConsumed: 1,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildWeightedPlacementDataplaneClusterConfig(mode string) *config.DataplaneClusterConfig {
+	dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+	dataplaneClusterConfig.DataPlaneClusterScalingType = config.AutoScaling
+	dataplaneClusterConfig.ClusterPlacementConfig.Strategy = config.WeightedClusterPlacementStrategy
+	dataplaneClusterConfig.ClusterPlacementConfig.Mode = mode
+	return dataplaneClusterConfig
+}
+
+func buildWeightedPlacementClusters() []*api.Cluster {
+	return []*api.Cluster{
+		{
+			ClusterID:           "cluster-1",
+			DynamicCapacityInfo: api.JSON([]byte(`{"standard":{"max_nodes":1,"max_units":10,"remaining_units":8}}`)),
+		},
+		{
+			ClusterID:           "cluster-2",
+			DynamicCapacityInfo: api.JSON([]byte(`{"standard":{"max_nodes":1,"max_units":10,"remaining_units":5}}`)),
+		},
+	}
+}
+
+func TestWeightedClusterPlacement_FindCluster(t *testing.T) {
+	type fields struct {
+		clusterService         ClusterService
+		dataplaneClusterConfig *config.DataplaneClusterConfig
+	}
+
+	streamingUnitCounts := func() (KafkaStreamingUnitCountPerClusterList, error) {
+		return KafkaStreamingUnitCountPerClusterList{
+			{ClusterId: "cluster-1", InstanceType: types.STANDARD.String(), Count: 2},
+			{ClusterId: "cluster-2", InstanceType: types.STANDARD.String(), Count: 5},
+		}, nil
+	}
+	noOrganisationKafka := func(organisationID string) ([]ResKafkaInstanceCount, error) {
+		return nil, nil
+	}
+
+	tests := []struct {
+		name             string
+		fields           fields
+		wantClusterID    string
+		wantErr          bool
+		wantNotEligibles []string
+	}{
+		{
+			name: "should bin-pack the kafka on the cluster with the least remaining streaming units",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+						return buildWeightedPlacementClusters(), nil
+					},
+					FindStreamingUnitCountByClusterAndInstanceTypeFunc: streamingUnitCounts,
+					FindKafkaInstanceCountByOrganisationFunc:           noOrganisationKafka,
+				},
+				dataplaneClusterConfig: buildWeightedPlacementDataplaneClusterConfig(config.BinPackClusterPlacementMode),
+			},
+			wantClusterID: "cluster-2",
+		},
+		{
+			name: "should spread the kafka on the cluster with the most remaining streaming units",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+						return buildWeightedPlacementClusters(), nil
+					},
+					FindStreamingUnitCountByClusterAndInstanceTypeFunc: streamingUnitCounts,
+					FindKafkaInstanceCountByOrganisationFunc:           noOrganisationKafka,
+				},
+				dataplaneClusterConfig: buildWeightedPlacementDataplaneClusterConfig(config.SpreadClusterPlacementMode),
+			},
+			wantClusterID: "cluster-1",
+		},
+		{
+			name: "should place the kafka away from the clusters hosting kafkas of the same organisation",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+						return buildWeightedPlacementClusters(), nil
+					},
+					FindStreamingUnitCountByClusterAndInstanceTypeFunc: streamingUnitCounts,
+					FindKafkaInstanceCountByOrganisationFunc: func(organisationID string) ([]ResKafkaInstanceCount, error) {
+						return []ResKafkaInstanceCount{{Clusterid: "cluster-2", Count: 3}}, nil
+					},
+				},
+				dataplaneClusterConfig: buildWeightedPlacementDataplaneClusterConfig(config.BinPackClusterPlacementMode),
+			},
+			wantClusterID: "cluster-1",
+		},
+		{
+			name: "should place the kafka on the cluster dedicated to its organisation",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+						clusters := buildWeightedPlacementClusters()
+						clusters[0].Labels = api.JSON([]byte(`{"organisation_id":"13640203"}`))
+						clusters[0].Taints = api.JSON([]byte(`[{"key":"organisation_id","value":"13640203"}]`))
+						return clusters, nil
+					},
+					FindStreamingUnitCountByClusterAndInstanceTypeFunc: streamingUnitCounts,
+					FindKafkaInstanceCountByOrganisationFunc:           noOrganisationKafka,
+				},
+				dataplaneClusterConfig: buildWeightedPlacementDataplaneClusterConfig(config.BinPackClusterPlacementMode),
+			},
+			wantClusterID: "cluster-1",
+		},
+		{
+			name: "should not place the kafka on a cluster with a taint it does not tolerate",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+						clusters := buildWeightedPlacementClusters()
+						clusters[1].Taints = api.JSON([]byte(`[{"key":"organisation_id","value":"another-organisation"}]`))
+						return clusters, nil
+					},
+					FindStreamingUnitCountByClusterAndInstanceTypeFunc: streamingUnitCounts,
+					FindKafkaInstanceCountByOrganisationFunc:           noOrganisationKafka,
+				},
+				dataplaneClusterConfig: buildWeightedPlacementDataplaneClusterConfig(config.BinPackClusterPlacementMode),
+			},
+			wantClusterID:    "cluster-1",
+			wantNotEligibles: []string{"cluster-2"},
+		},
+		{
+			name: "should not place the kafka when no cluster has enough remaining streaming units",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+						return buildWeightedPlacementClusters(), nil
+					},
+					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+						return KafkaStreamingUnitCountPerClusterList{
+							{ClusterId: "cluster-1", InstanceType: types.STANDARD.String(), Count: 10},
+							{ClusterId: "cluster-2", InstanceType: types.STANDARD.String(), Count: 10},
+						}, nil
+					},
+					FindKafkaInstanceCountByOrganisationFunc: noOrganisationKafka,
+				},
+				dataplaneClusterConfig: buildWeightedPlacementDataplaneClusterConfig(config.BinPackClusterPlacementMode),
+			},
+			wantNotEligibles: []string{"cluster-1", "cluster-2"},
+		},
+		{
+			name: "should return an error when clusters cannot be found",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+						return nil, errors.New("failed to find clusters")
+					},
+				},
+				dataplaneClusterConfig: buildWeightedPlacementDataplaneClusterConfig(config.BinPackClusterPlacementMode),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			w := &WeightedClusterPlacement{
+				ClusterService:         tt.fields.clusterService,
+				DataplaneClusterConfig: tt.fields.dataplaneClusterConfig,
+				KafkaConfig:            buildWeightedPlacementKafkaConfig(),
+			}
+			kafka := mockkafkas.BuildKafkaRequest(
+				mockkafkas.With(mockkafkas.INSTANCE_TYPE, types.STANDARD.String()),
+				mockkafkas.With(mockkafkas.SIZE_ID, "x1"),
+				mockkafkas.With(mockkafkas.ORGANISATION_ID, "13640203"),
+			)
+
+			decision, err := w.ExplainPlacement(kafka)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+			for _, candidate := range decision.Candidates {
+				g.Expect(candidate.Eligible).To(gomega.Equal(!arrays.Contains(tt.wantNotEligibles, candidate.Cluster.ClusterID)), candidate.Reasons)
+				g.Expect(candidate.Reasons).ToNot(gomega.BeEmpty())
+			}
+
+			cluster, err := w.FindCluster(kafka)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			if tt.wantClusterID == "" {
+				g.Expect(cluster).To(gomega.BeNil())
+			} else {
+				g.Expect(cluster).ToNot(gomega.BeNil())
+				g.Expect(cluster.ClusterID).To(gomega.Equal(tt.wantClusterID), decision.String())
+			}
+		})
+	}
+}
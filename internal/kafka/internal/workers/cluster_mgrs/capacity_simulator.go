@@ -0,0 +1,521 @@
+package cluster_mgrs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	clusterTypes "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/golang/glog"
+)
+
+// simulatedScaleUpClusterIDPrefix prefixes the id of the data plane clusters added to the snapshot when a scale up is
+// triggered during a simulation
+const simulatedScaleUpClusterIDPrefix = "simulated-scale-up-"
+
+// CapacitySimulator simulates the arrival of a burst of kafkas in a region. It runs the configured placement strategy
+// and the dynamic scale up evaluation of the DynamicScaleUpManager against an in-memory snapshot of the data plane
+// clusters, so that the outcome matches what would happen without anything being written.
+type CapacitySimulator struct {
+	DataplaneClusterConfig *config.DataplaneClusterConfig
+	ClusterProvidersConfig *config.ProviderConfig
+	KafkaConfig            *config.KafkaConfig
+
+	ClusterService             services.ClusterService
+	CapacityReservationService services.CapacityReservationService
+}
+
+var _ services.ClusterCapacitySimulator = &CapacitySimulator{}
+
+func NewCapacitySimulator(
+	dataplaneClusterConfig *config.DataplaneClusterConfig,
+	clusterProvidersConfig *config.ProviderConfig,
+	kafkaConfig *config.KafkaConfig,
+	clusterService services.ClusterService,
+	capacityReservationService services.CapacityReservationService,
+) *CapacitySimulator {
+	return &CapacitySimulator{
+		DataplaneClusterConfig: dataplaneClusterConfig,
+		ClusterProvidersConfig: clusterProvidersConfig,
+		KafkaConfig:            kafkaConfig,
+
+		ClusterService:             clusterService,
+		CapacityReservationService: capacityReservationService,
+	}
+}
+
+// SimulateClusterCapacity places the kafkas of the request one after the other. The dynamic scale up is evaluated, when
+// enabled, before the first kafka and after each kafka. A triggered scale up adds an accepted data plane cluster to
+// the snapshot: like in the real flow, it takes part in the evaluation as an ongoing scale up but does not accept
+// kafkas until the end of the simulation.
+func (s *CapacitySimulator) SimulateClusterCapacity(request services.ClusterCapacitySimulationRequest) (*services.ClusterCapacitySimulation, *errors.ServiceError) {
+	instanceTypeConfig, err := s.findInstanceTypeConfig(request)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceSize, e := s.KafkaConfig.GetKafkaInstanceSize(request.InstanceType, request.SizeId)
+	if e != nil {
+		return nil, errors.NewWithCause(errors.ErrorBadRequest, e, "size %q of instance type %q is not supported", request.SizeId, request.InstanceType)
+	}
+
+	snapshot, e := newClusterCapacitySnapshot(s.ClusterService, s.CapacityReservationService, request)
+	if e != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, e, "failed to take a snapshot of the data plane clusters")
+	}
+
+	locator := supportedInstanceTypeLocator{
+		provider:         request.CloudProvider,
+		region:           request.Region,
+		instanceTypeName: request.InstanceType,
+	}
+	placementStrategy := services.NewSimulatedClusterPlacementStrategy(snapshot, s.DataplaneClusterConfig, s.KafkaConfig, snapshot.capacityReservations)
+
+	simulation := &services.ClusterCapacitySimulation{
+		Placements: []services.ClusterCapacitySimulationPlacement{},
+		Rejections: []services.ClusterCapacitySimulationRejection{},
+		ScaleUps:   []services.ClusterCapacitySimulationScaleUp{},
+	}
+
+	simulation.ConsumptionBefore, err = s.calculateConsumption(locator, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.evaluateScaleUp(simulation, 0, locator, instanceTypeConfig, snapshot); err != nil {
+		return nil, err
+	}
+
+	for i := 1; i <= request.Count; i++ {
+		kafka := &dbapi.KafkaRequest{
+			CloudProvider:  request.CloudProvider,
+			Region:         request.Region,
+			MultiAZ:        request.InstanceType == types.STANDARD.String(),
+			InstanceType:   request.InstanceType,
+			SizeId:         request.SizeId,
+			OrganisationId: request.OrganisationId,
+		}
+
+		cluster, e := placementStrategy.FindCluster(kafka)
+		if e != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, e, "failed to place simulated kafka %d", i)
+		}
+
+		if cluster == nil {
+			simulation.Rejections = append(simulation.Rejections, services.ClusterCapacitySimulationRejection{
+				Kafka:  i,
+				Reason: "no data plane cluster has enough capacity left to place the kafka",
+			})
+		} else {
+			snapshot.place(cluster.ClusterID, request.InstanceType, instanceSize.CapacityConsumed)
+			simulation.Placements = append(simulation.Placements, services.ClusterCapacitySimulationPlacement{
+				Kafka:     i,
+				ClusterID: cluster.ClusterID,
+			})
+		}
+
+		if err := s.evaluateScaleUp(simulation, i, locator, instanceTypeConfig, snapshot); err != nil {
+			return nil, err
+		}
+	}
+
+	simulation.ConsumptionAfter, err = s.calculateConsumption(locator, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return simulation, nil
+}
+
+func (s *CapacitySimulator) findInstanceTypeConfig(request services.ClusterCapacitySimulationRequest) (*config.InstanceTypeConfig, *errors.ServiceError) {
+	provider, ok := s.ClusterProvidersConfig.ProvidersConfig.SupportedProviders.GetByName(request.CloudProvider)
+	if !ok {
+		return nil, errors.BadRequest("cloud provider %q is not supported", request.CloudProvider)
+	}
+	region, ok := provider.Regions.GetByName(request.Region)
+	if !ok {
+		return nil, errors.BadRequest("region %q is not supported by cloud provider %q", request.Region, request.CloudProvider)
+	}
+	instanceTypeConfig, ok := region.SupportedInstanceTypes[request.InstanceType]
+	if !ok {
+		return nil, errors.BadRequest("instance type %q is not supported in region %q", request.InstanceType, request.Region)
+	}
+	return &instanceTypeConfig, nil
+}
+
+func (s *CapacitySimulator) calculateConsumption(locator supportedInstanceTypeLocator, snapshot *clusterCapacitySnapshot) (services.ClusterCapacityConsumption, *errors.ServiceError) {
+	summaryCalculator := instanceTypeConsumptionSummaryCalculator{
+		locator:                               locator,
+		kafkaStreamingUnitCountPerClusterList: snapshot.streamingUnitCounts,
+		capacityReservationUsages:             snapshot.capacityReservations.usages,
+		supportedKafkaInstanceTypesConfig:     &s.KafkaConfig.SupportedInstanceTypes.Configuration,
+	}
+	summary, err := summaryCalculator.Calculate()
+	if err != nil {
+		return services.ClusterCapacityConsumption{}, errors.NewWithCause(errors.ErrorGeneral, err, "failed to calculate consumption summary for instance type %q", locator.instanceTypeName)
+	}
+
+	return services.ClusterCapacityConsumption{
+		MaxStreamingUnits:      summary.maxStreamingUnits,
+		ConsumedStreamingUnits: summary.consumedStreamingUnits,
+		FreeStreamingUnits:     summary.freeStreamingUnits,
+		OngoingScaleUp:         summary.ongoingScaleUpAction,
+	}, nil
+}
+
+func (s *CapacitySimulator) evaluateScaleUp(simulation *services.ClusterCapacitySimulation, afterKafka int, locator supportedInstanceTypeLocator,
+	instanceTypeConfig *config.InstanceTypeConfig, snapshot *clusterCapacitySnapshot) *errors.ServiceError {
+	if !s.DataplaneClusterConfig.IsDataPlaneAutoScalingEnabled() {
+		return nil
+	}
+
+	// ScaleUp is never called on the processor so that nothing is registered, hence the dry run
+	processor := &standardDynamicScaleUpProcessor{
+		locator:                               locator,
+		instanceTypeConfig:                    instanceTypeConfig,
+		kafkaStreamingUnitCountPerClusterList: snapshot.streamingUnitCounts,
+		capacityReservationUsages:             snapshot.capacityReservations.usages,
+		supportedKafkaInstanceTypesConfig:     &s.KafkaConfig.SupportedInstanceTypes.Configuration,
+		dryRun:                                true,
+	}
+	shouldScaleUp, err := processor.ShouldScaleUp()
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to evaluate dynamic scale up for instance type %q", locator.instanceTypeName)
+	}
+	if !shouldScaleUp {
+		return nil
+	}
+
+	glog.V(10).Infof("simulated scale up triggered for locator '%+v' after kafka %d", locator, afterKafka)
+	simulation.ScaleUps = append(simulation.ScaleUps, services.ClusterCapacitySimulationScaleUp{
+		AfterKafka:    afterKafka,
+		CloudProvider: locator.provider,
+		Region:        locator.region,
+		InstanceType:  locator.instanceTypeName,
+	})
+	snapshot.streamingUnitCounts = append(snapshot.streamingUnitCounts, services.KafkaStreamingUnitCountPerCluster{
+		CloudProvider: locator.provider,
+		Region:        locator.region,
+		InstanceType:  locator.instanceTypeName,
+		ClusterId:     fmt.Sprintf("%s%d", simulatedScaleUpClusterIDPrefix, len(simulation.ScaleUps)),
+		Status:        api.ClusterAccepted.String(),
+	})
+
+	return nil
+}
+
+// clusterCapacitySnapshot is an in-memory services.ClusterService holding the data plane clusters of a region and the
+// capacity they consume. It serves the queries of the placement strategies, and the kafkas placed during a simulation
+// are only added to the snapshot. The other methods are not used by the placement strategies and return an error.
+type clusterCapacitySnapshot struct {
+	clusters            []*api.Cluster
+	streamingUnitCounts services.KafkaStreamingUnitCountPerClusterList
+	// kafkaInstanceCounts is the capacity consumed on each cluster, as returned by FindKafkaInstanceCount
+	kafkaInstanceCounts     map[string]int
+	organisationID          string
+	organisationKafkaCounts map[string]int
+	capacityReservations    *capacityReservationSnapshot
+}
+
+func newClusterCapacitySnapshot(clusterService services.ClusterService, capacityReservationService services.CapacityReservationService, request services.ClusterCapacitySimulationRequest) (*clusterCapacitySnapshot, error) {
+	clusters, err := clusterService.FindAllClusters(services.FindClusterCriteria{
+		Provider: request.CloudProvider,
+		Region:   request.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	streamingUnitCounts, err := clusterService.FindStreamingUnitCountByClusterAndInstanceType()
+	if err != nil {
+		return nil, err
+	}
+
+	capacityReservationUsages, serviceErr := capacityReservationService.ListActiveUsages()
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	snapshot := &clusterCapacitySnapshot{
+		clusters: clusters,
+		// the counts are copied as the kafkas placed during the simulation are added to them
+		streamingUnitCounts:     append(services.KafkaStreamingUnitCountPerClusterList{}, streamingUnitCounts...),
+		kafkaInstanceCounts:     map[string]int{},
+		organisationID:          request.OrganisationId,
+		organisationKafkaCounts: map[string]int{},
+		// the usages are copied as the kafkas placed during the simulation consume the reservations of their organisation
+		capacityReservations: &capacityReservationSnapshot{
+			cloudProvider: request.CloudProvider,
+			region:        request.Region,
+			usages:        append(services.CapacityReservationUsageList{}, capacityReservationUsages...),
+		},
+	}
+
+	if len(clusters) > 0 {
+		clusterIDs := make([]string, 0, len(clusters))
+		for _, cluster := range clusters {
+			clusterIDs = append(clusterIDs, cluster.ClusterID)
+		}
+		counts, err := clusterService.FindKafkaInstanceCount(clusterIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, count := range counts {
+			snapshot.kafkaInstanceCounts[count.Clusterid] = count.Count
+		}
+	}
+
+	if request.OrganisationId != "" {
+		counts, err := clusterService.FindKafkaInstanceCountByOrganisation(request.OrganisationId)
+		if err != nil {
+			return nil, err
+		}
+		for _, count := range counts {
+			snapshot.organisationKafkaCounts[count.Clusterid] = count.Count
+		}
+	}
+
+	return snapshot, nil
+}
+
+// place adds a kafka consuming the given capacity to the cluster
+func (c *clusterCapacitySnapshot) place(clusterID string, instanceType string, capacityConsumed int) {
+	c.kafkaInstanceCounts[clusterID] += capacityConsumed
+	if c.organisationID != "" {
+		c.organisationKafkaCounts[clusterID]++
+		c.capacityReservations.consume(c.organisationID, instanceType, capacityConsumed)
+	}
+	for i := range c.streamingUnitCounts {
+		if c.streamingUnitCounts[i].ClusterId == clusterID && c.streamingUnitCounts[i].InstanceType == instanceType {
+			c.streamingUnitCounts[i].Count += int32(capacityConsumed)
+		}
+	}
+}
+
+// FindAllClusters applies the criteria the same way as the database query of the cluster service does: empty and false
+// criteria values are ignored
+func (c *clusterCapacitySnapshot) FindAllClusters(criteria services.FindClusterCriteria) ([]*api.Cluster, error) {
+	clusters := []*api.Cluster{}
+	for _, cluster := range c.clusters {
+		if criteria.Provider != "" && cluster.CloudProvider != criteria.Provider ||
+			criteria.Region != "" && cluster.Region != criteria.Region ||
+			criteria.MultiAZ && !cluster.MultiAZ ||
+			criteria.Status != "" && cluster.Status != criteria.Status ||
+			!strings.Contains(cluster.SupportedInstanceType, criteria.SupportedInstanceType) {
+			continue
+		}
+		clusters = append(clusters, cluster)
+	}
+	return clusters, nil
+}
+
+func (c *clusterCapacitySnapshot) FindCluster(criteria services.FindClusterCriteria) (*api.Cluster, error) {
+	clusters, err := c.FindAllClusters(criteria)
+	if err != nil || len(clusters) == 0 {
+		return nil, err
+	}
+	return clusters[0], nil
+}
+
+func (c *clusterCapacitySnapshot) FindKafkaInstanceCount(clusterIDs []string) ([]services.ResKafkaInstanceCount, error) {
+	res := make([]services.ResKafkaInstanceCount, 0, len(clusterIDs))
+	for _, clusterID := range clusterIDs {
+		res = append(res, services.ResKafkaInstanceCount{Clusterid: clusterID, Count: c.kafkaInstanceCounts[clusterID]})
+	}
+	return res, nil
+}
+
+func (c *clusterCapacitySnapshot) FindKafkaInstanceCountByOrganisation(organisationID string) ([]services.ResKafkaInstanceCount, error) {
+	if organisationID != c.organisationID {
+		return nil, nil
+	}
+	res := make([]services.ResKafkaInstanceCount, 0, len(c.organisationKafkaCounts))
+	for clusterID, count := range c.organisationKafkaCounts {
+		res = append(res, services.ResKafkaInstanceCount{Clusterid: clusterID, Count: count})
+	}
+	return res, nil
+}
+
+func (c *clusterCapacitySnapshot) FindStreamingUnitCountByClusterAndInstanceType() (services.KafkaStreamingUnitCountPerClusterList, error) {
+	return c.streamingUnitCounts, nil
+}
+
+func (c *clusterCapacitySnapshot) Create(cluster *api.Cluster) (*api.Cluster, *errors.ServiceError) {
+	return nil, notSupportedBySnapshot("cluster capacity snapshot", "Create")
+}
+
+func (c *clusterCapacitySnapshot) GetClusterDNS(clusterID string) (string, *errors.ServiceError) {
+	return "", notSupportedBySnapshot("cluster capacity snapshot", "GetClusterDNS")
+}
+
+func (c *clusterCapacitySnapshot) GetExternalID(clusterID string) (string, *errors.ServiceError) {
+	return "", notSupportedBySnapshot("cluster capacity snapshot", "GetExternalID")
+}
+
+func (c *clusterCapacitySnapshot) ListByStatus(state api.ClusterStatus) ([]api.Cluster, *errors.ServiceError) {
+	return nil, notSupportedBySnapshot("cluster capacity snapshot", "ListByStatus")
+}
+
+func (c *clusterCapacitySnapshot) UpdateStatus(cluster api.Cluster, status api.ClusterStatus) error {
+	return notSupportedBySnapshot("cluster capacity snapshot", "UpdateStatus")
+}
+
+func (c *clusterCapacitySnapshot) Update(cluster api.Cluster) *errors.ServiceError {
+	return notSupportedBySnapshot("cluster capacity snapshot", "Update")
+}
+
+func (c *clusterCapacitySnapshot) FindClusterByID(clusterID string) (*api.Cluster, *errors.ServiceError) {
+	return nil, notSupportedBySnapshot("cluster capacity snapshot", "FindClusterByID")
+}
+
+func (c *clusterCapacitySnapshot) GetClientID(clusterID string) (string, error) {
+	return "", notSupportedBySnapshot("cluster capacity snapshot", "GetClientID")
+}
+
+func (c *clusterCapacitySnapshot) ListGroupByProviderAndRegion(providers []string, regions []string, status []string) ([]*services.ResGroupCPRegion, *errors.ServiceError) {
+	return nil, notSupportedBySnapshot("cluster capacity snapshot", "ListGroupByProviderAndRegion")
+}
+
+func (c *clusterCapacitySnapshot) RegisterClusterJob(clusterRequest *api.Cluster) *errors.ServiceError {
+	return notSupportedBySnapshot("cluster capacity snapshot", "RegisterClusterJob")
+}
+
+func (c *clusterCapacitySnapshot) DeleteByClusterID(clusterID string) *errors.ServiceError {
+	return notSupportedBySnapshot("cluster capacity snapshot", "DeleteByClusterID")
+}
+
+func (c *clusterCapacitySnapshot) FindNonEmptyClusterByID(clusterID string) (*api.Cluster, *errors.ServiceError) {
+	return nil, notSupportedBySnapshot("cluster capacity snapshot", "FindNonEmptyClusterByID")
+}
+
+func (c *clusterCapacitySnapshot) ListNonEnterpriseClusterIDs() ([]api.Cluster, *errors.ServiceError) {
+	return nil, notSupportedBySnapshot("cluster capacity snapshot", "ListNonEnterpriseClusterIDs")
+}
+
+func (c *clusterCapacitySnapshot) ListEnterpriseClustersByOrganization(organizationID string, listArgs *coreServices.ListArguments) ([]*api.Cluster, *api.PagingMeta, *errors.ServiceError) {
+	return nil, nil, notSupportedBySnapshot("cluster capacity snapshot", "ListEnterpriseClustersByOrganization")
+}
+
+func (c *clusterCapacitySnapshot) List(listArgs *coreServices.ListArguments) ([]*api.Cluster, *api.PagingMeta, *errors.ServiceError) {
+	return nil, nil, notSupportedBySnapshot("cluster capacity snapshot", "List")
+}
+
+func (c *clusterCapacitySnapshot) UpdateUnschedulable(clusterID string, unschedulable bool) *errors.ServiceError {
+	return notSupportedBySnapshot("cluster capacity snapshot", "UpdateUnschedulable")
+}
+
+func (c *clusterCapacitySnapshot) RecordStatusReport(cluster *api.Cluster, fleetshardOperatorReady bool) *errors.ServiceError {
+	return notSupportedBySnapshot("cluster capacity snapshot", "RecordStatusReport")
+}
+
+func (c *clusterCapacitySnapshot) UpdateQuarantine(clusterID string, quarantined bool, reason string) *errors.ServiceError {
+	return notSupportedBySnapshot("cluster capacity snapshot", "UpdateQuarantine")
+}
+
+func (c *clusterCapacitySnapshot) UpdateMultiClusterStatus(clusterIDs []string, status api.ClusterStatus) *errors.ServiceError {
+	return notSupportedBySnapshot("cluster capacity snapshot", "UpdateMultiClusterStatus")
+}
+
+func (c *clusterCapacitySnapshot) CountByStatus(statuses []api.ClusterStatus) ([]services.ClusterStatusCount, *errors.ServiceError) {
+	return nil, notSupportedBySnapshot("cluster capacity snapshot", "CountByStatus")
+}
+
+func (c *clusterCapacitySnapshot) CheckClusterStatus(cluster *api.Cluster) (*api.Cluster, *errors.ServiceError) {
+	return nil, notSupportedBySnapshot("cluster capacity snapshot", "CheckClusterStatus")
+}
+
+func (c *clusterCapacitySnapshot) GetClusterVersion(cluster *api.Cluster) (string, *errors.ServiceError) {
+	return "", notSupportedBySnapshot("cluster capacity snapshot", "GetClusterVersion")
+}
+
+func (c *clusterCapacitySnapshot) Delete(cluster *api.Cluster) (bool, *errors.ServiceError) {
+	return false, notSupportedBySnapshot("cluster capacity snapshot", "Delete")
+}
+
+func (c *clusterCapacitySnapshot) ConfigureAndSaveIdentityProvider(cluster *api.Cluster, identityProviderInfo clusterTypes.IdentityProviderInfo) (*api.Cluster, *errors.ServiceError) {
+	return nil, notSupportedBySnapshot("cluster capacity snapshot", "ConfigureAndSaveIdentityProvider")
+}
+
+func (c *clusterCapacitySnapshot) ApplyResources(cluster *api.Cluster, resources clusterTypes.ResourceSet) *errors.ServiceError {
+	return notSupportedBySnapshot("cluster capacity snapshot", "ApplyResources")
+}
+
+func (c *clusterCapacitySnapshot) InstallStrimzi(cluster *api.Cluster) (bool, *errors.ServiceError) {
+	return false, notSupportedBySnapshot("cluster capacity snapshot", "InstallStrimzi")
+}
+
+func (c *clusterCapacitySnapshot) InstallClusterLogging(cluster *api.Cluster, params []clusterTypes.Parameter) (bool, *errors.ServiceError) {
+	return false, notSupportedBySnapshot("cluster capacity snapshot", "InstallClusterLogging")
+}
+
+func (c *clusterCapacitySnapshot) CheckStrimziVersionReady(cluster *api.Cluster, strimziVersion string) (bool, error) {
+	return false, notSupportedBySnapshot("cluster capacity snapshot", "CheckStrimziVersionReady")
+}
+
+func (c *clusterCapacitySnapshot) IsStrimziKafkaVersionAvailableInCluster(cluster *api.Cluster, strimziVersion string, kafkaVersion string, ibpVersion string) (bool, error) {
+	return false, notSupportedBySnapshot("cluster capacity snapshot", "IsStrimziKafkaVersionAvailableInCluster")
+}
+
+func (c *clusterCapacitySnapshot) FindStreamingUnitsCreatedSince(since time.Time) (services.KafkaStreamingUnitCreationList, error) {
+	return nil, notSupportedBySnapshot("cluster capacity snapshot", "FindStreamingUnitsCreatedSince")
+}
+
+// capacityReservationSnapshot is an in-memory services.CapacityReservationService holding the usages of the active
+// capacity reservations. The kafkas placed during a simulation only consume the reservations of the snapshot. The
+// methods not used by the placement strategies return an error.
+type capacityReservationSnapshot struct {
+	cloudProvider string
+	region        string
+	usages        services.CapacityReservationUsageList
+}
+
+// consume adds the given capacity to the capacity consumed by the organisation for the instance type in the region of
+// the simulation
+func (c *capacityReservationSnapshot) consume(organisationID string, instanceType string, capacityConsumed int) {
+	for i := range c.usages {
+		reservation := c.usages[i].Reservation
+		if reservation.OrganisationId == organisationID && reservation.CloudProvider == c.cloudProvider &&
+			reservation.Region == c.region && reservation.InstanceType == instanceType {
+			c.usages[i].ConsumedStreamingUnits += capacityConsumed
+		}
+	}
+}
+
+func (c *capacityReservationSnapshot) ListActiveUsages() (services.CapacityReservationUsageList, *errors.ServiceError) {
+	return c.usages, nil
+}
+
+func (c *capacityReservationSnapshot) Create(reservation *dbapi.CapacityReservation) *errors.ServiceError {
+	return notSupportedBySnapshot("capacity reservation snapshot", "Create")
+}
+
+func (c *capacityReservationSnapshot) Get(id string) (*dbapi.CapacityReservation, *errors.ServiceError) {
+	return nil, notSupportedBySnapshot("capacity reservation snapshot", "Get")
+}
+
+func (c *capacityReservationSnapshot) List(listArgs *coreServices.ListArguments) (dbapi.CapacityReservationList, *api.PagingMeta, *errors.ServiceError) {
+	return nil, nil, notSupportedBySnapshot("capacity reservation snapshot", "List")
+}
+
+func (c *capacityReservationSnapshot) Delete(id string) *errors.ServiceError {
+	return notSupportedBySnapshot("capacity reservation snapshot", "Delete")
+}
+
+func (c *capacityReservationSnapshot) GetUsages(reservations dbapi.CapacityReservationList) (services.CapacityReservationUsageList, *errors.ServiceError) {
+	return nil, notSupportedBySnapshot("capacity reservation snapshot", "GetUsages")
+}
+
+// notSupportedBySnapshot returns the error of the methods of the snapshots that are not used by the placement strategies
+func notSupportedBySnapshot(snapshot string, method string) *errors.ServiceError {
+	return errors.GeneralError("%s is not supported by the %s of the capacity simulation", method, snapshot)
+}
+
+var _ services.ClusterService = &clusterCapacitySnapshot{}
+var _ services.CapacityReservationService = &capacityReservationSnapshot{}
@@ -0,0 +1,270 @@
+package cluster_mgrs
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+func newTestHelperCapacitySimulatorProviderConfig(instanceTypeConfig config.InstanceTypeConfig) *config.ProviderConfig {
+	locator := newTestHelperBaseSupportedInstanceTypeLocator()
+	return &config.ProviderConfig{
+		ProvidersConfig: config.ProviderConfiguration{
+			SupportedProviders: config.ProviderList{
+				{
+					Name: locator.provider,
+					Regions: config.RegionList{
+						{
+							Name: locator.region,
+							SupportedInstanceTypes: config.InstanceTypeMap{
+								locator.instanceTypeName: instanceTypeConfig,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// newTestHelperCapacitySimulatorClusterService returns a cluster service with a single ready cluster able to host 5
+// streaming units, 3 of them being used. Only the read methods used by the simulation are mocked.
+func newTestHelperCapacitySimulatorClusterService() *services.ClusterServiceMock {
+	locator := newTestHelperBaseSupportedInstanceTypeLocator()
+	return &services.ClusterServiceMock{
+		FindAllClustersFunc: func(criteria services.FindClusterCriteria) ([]*api.Cluster, error) {
+			return []*api.Cluster{
+				{
+					ClusterID:             "cluster-1",
+					CloudProvider:         locator.provider,
+					Region:                locator.region,
+					Status:                api.ClusterReady,
+					SupportedInstanceType: locator.instanceTypeName,
+					DynamicCapacityInfo:   api.JSON([]byte(`{"t1":{"max_nodes":1,"max_units":5,"remaining_units":2}}`)),
+				},
+			}, nil
+		},
+		FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
+			return services.KafkaStreamingUnitCountPerClusterList{
+				{
+					CloudProvider: locator.provider,
+					Region:        locator.region,
+					InstanceType:  locator.instanceTypeName,
+					ClusterId:     "cluster-1",
+					Count:         3,
+					MaxUnits:      5,
+					Status:        api.ClusterReady.String(),
+				},
+			}, nil
+		},
+		FindKafkaInstanceCountFunc: func(clusterIDs []string) ([]services.ResKafkaInstanceCount, error) {
+			return []services.ResKafkaInstanceCount{{Clusterid: "cluster-1", Count: 3}}, nil
+		},
+	}
+}
+
+func TestCapacitySimulator_SimulateClusterCapacity(t *testing.T) {
+	type fields struct {
+		clusterService       services.ClusterService
+		scalingType          string
+		instanceTypeConfig   config.InstanceTypeConfig
+		capacityReservations services.CapacityReservationUsageList
+	}
+
+	locator := newTestHelperBaseSupportedInstanceTypeLocator()
+	request := services.ClusterCapacitySimulationRequest{
+		CloudProvider: locator.provider,
+		Region:        locator.region,
+		InstanceType:  locator.instanceTypeName,
+		SizeId:        "s1",
+		Count:         3,
+	}
+
+	tests := []struct {
+		name     string
+		fields   fields
+		request  services.ClusterCapacitySimulationRequest
+		want     *services.ClusterCapacitySimulation
+		wantCode apiErrors.ServiceErrorCode
+	}{
+		{
+			name: "should place kafkas until the cluster is full and trigger a single scale up when the biggest size no longer fits",
+			fields: fields{
+				clusterService: newTestHelperCapacitySimulatorClusterService(),
+				scalingType:    config.AutoScaling,
+			},
+			request: request,
+			want: &services.ClusterCapacitySimulation{
+				Placements: []services.ClusterCapacitySimulationPlacement{
+					{Kafka: 1, ClusterID: "cluster-1"},
+					{Kafka: 2, ClusterID: "cluster-1"},
+				},
+				Rejections: []services.ClusterCapacitySimulationRejection{
+					{Kafka: 3, Reason: "no data plane cluster has enough capacity left to place the kafka"},
+				},
+				ScaleUps: []services.ClusterCapacitySimulationScaleUp{
+					{AfterKafka: 1, CloudProvider: locator.provider, Region: locator.region, InstanceType: locator.instanceTypeName},
+				},
+				ConsumptionBefore: services.ClusterCapacityConsumption{MaxStreamingUnits: 5, ConsumedStreamingUnits: 3, FreeStreamingUnits: 2},
+				ConsumptionAfter:  services.ClusterCapacityConsumption{MaxStreamingUnits: 5, ConsumedStreamingUnits: 5, FreeStreamingUnits: 0, OngoingScaleUp: true},
+			},
+		},
+		{
+			name: "should not place kafkas on the streaming units reserved for other organisations",
+			fields: fields{
+				clusterService: newTestHelperCapacitySimulatorClusterService(),
+				scalingType:    config.AutoScaling,
+				capacityReservations: services.CapacityReservationUsageList{
+					{
+						Reservation: &dbapi.CapacityReservation{
+							OrganisationId: "other-org",
+							CloudProvider:  locator.provider,
+							Region:         locator.region,
+							InstanceType:   locator.instanceTypeName,
+							StreamingUnits: 1,
+						},
+					},
+				},
+			},
+			request: request,
+			want: &services.ClusterCapacitySimulation{
+				Placements: []services.ClusterCapacitySimulationPlacement{
+					{Kafka: 1, ClusterID: "cluster-1"},
+				},
+				Rejections: []services.ClusterCapacitySimulationRejection{
+					{Kafka: 2, Reason: "no data plane cluster has enough capacity left to place the kafka"},
+					{Kafka: 3, Reason: "no data plane cluster has enough capacity left to place the kafka"},
+				},
+				ScaleUps: []services.ClusterCapacitySimulationScaleUp{
+					{AfterKafka: 1, CloudProvider: locator.provider, Region: locator.region, InstanceType: locator.instanceTypeName},
+				},
+				ConsumptionBefore: services.ClusterCapacityConsumption{MaxStreamingUnits: 5, ConsumedStreamingUnits: 4, FreeStreamingUnits: 1},
+				ConsumptionAfter:  services.ClusterCapacityConsumption{MaxStreamingUnits: 5, ConsumedStreamingUnits: 5, FreeStreamingUnits: 0, OngoingScaleUp: true},
+			},
+		},
+		{
+			name: "should trigger a scale up before the first kafka when the capacity slack is not met",
+			fields: fields{
+				clusterService:     newTestHelperCapacitySimulatorClusterService(),
+				scalingType:        config.AutoScaling,
+				instanceTypeConfig: config.InstanceTypeConfig{MinAvailableCapacitySlackStreamingUnits: 3},
+			},
+			request: services.ClusterCapacitySimulationRequest{
+				CloudProvider: locator.provider,
+				Region:        locator.region,
+				InstanceType:  locator.instanceTypeName,
+				SizeId:        "s1",
+				Count:         1,
+			},
+			want: &services.ClusterCapacitySimulation{
+				Placements: []services.ClusterCapacitySimulationPlacement{
+					{Kafka: 1, ClusterID: "cluster-1"},
+				},
+				Rejections: []services.ClusterCapacitySimulationRejection{},
+				ScaleUps: []services.ClusterCapacitySimulationScaleUp{
+					{AfterKafka: 0, CloudProvider: locator.provider, Region: locator.region, InstanceType: locator.instanceTypeName},
+				},
+				ConsumptionBefore: services.ClusterCapacityConsumption{MaxStreamingUnits: 5, ConsumedStreamingUnits: 3, FreeStreamingUnits: 2},
+				ConsumptionAfter:  services.ClusterCapacityConsumption{MaxStreamingUnits: 5, ConsumedStreamingUnits: 4, FreeStreamingUnits: 1, OngoingScaleUp: true},
+			},
+		},
+		{
+			name: "should not evaluate scale ups when dynamic scaling is disabled",
+			fields: fields{
+				clusterService: newTestHelperCapacitySimulatorClusterService(),
+				scalingType:    config.NoScaling,
+			},
+			request: request,
+			want: &services.ClusterCapacitySimulation{
+				Placements: []services.ClusterCapacitySimulationPlacement{
+					{Kafka: 1, ClusterID: "cluster-1"},
+					{Kafka: 2, ClusterID: "cluster-1"},
+					{Kafka: 3, ClusterID: "cluster-1"},
+				},
+				Rejections:        []services.ClusterCapacitySimulationRejection{},
+				ScaleUps:          []services.ClusterCapacitySimulationScaleUp{},
+				ConsumptionBefore: services.ClusterCapacityConsumption{MaxStreamingUnits: 5, ConsumedStreamingUnits: 3, FreeStreamingUnits: 2},
+				ConsumptionAfter:  services.ClusterCapacityConsumption{MaxStreamingUnits: 5, ConsumedStreamingUnits: 6, FreeStreamingUnits: -1},
+			},
+		},
+		{
+			name: "should return a bad request error when the region is not supported",
+			fields: fields{
+				clusterService: newTestHelperCapacitySimulatorClusterService(),
+				scalingType:    config.AutoScaling,
+			},
+			request: services.ClusterCapacitySimulationRequest{
+				CloudProvider: locator.provider,
+				Region:        "unsupported",
+				InstanceType:  locator.instanceTypeName,
+				SizeId:        "s1",
+				Count:         1,
+			},
+			wantCode: apiErrors.ErrorBadRequest,
+		},
+		{
+			name: "should return an error when the snapshot of the clusters cannot be taken",
+			fields: fields{
+				clusterService: &services.ClusterServiceMock{
+					FindAllClustersFunc: func(criteria services.FindClusterCriteria) ([]*api.Cluster, error) {
+						return nil, errors.New("failed to find clusters")
+					},
+				},
+				scalingType: config.AutoScaling,
+			},
+			request:  request,
+			wantCode: apiErrors.ErrorGeneral,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+			dataplaneClusterConfig.DataPlaneClusterScalingType = tt.fields.scalingType
+			kafkaConfig := &config.KafkaConfig{
+				SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+					Configuration: *newTestHelperBaseSupportedKafkaInstanceTypesConfig(),
+				},
+			}
+			capacityReservationService := &services.CapacityReservationServiceMock{
+				ListActiveUsagesFunc: func() (services.CapacityReservationUsageList, *apiErrors.ServiceError) {
+					return tt.fields.capacityReservations, nil
+				},
+			}
+			s := NewCapacitySimulator(dataplaneClusterConfig, newTestHelperCapacitySimulatorProviderConfig(tt.fields.instanceTypeConfig), kafkaConfig, tt.fields.clusterService, capacityReservationService)
+
+			got, err := s.SimulateClusterCapacity(tt.request)
+			if tt.wantCode != 0 {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantCode))
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestCapacitySimulator_snapshotsUnsupportedMethods(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	// the methods not used by the placement strategies fail instead of panicking
+	clusters := &clusterCapacitySnapshot{}
+	_, err := clusters.FindClusterByID("cluster-id")
+	g.Expect(err).ToNot(gomega.BeNil())
+	g.Expect(clusters.UpdateStatus(api.Cluster{}, api.ClusterReady)).ToNot(gomega.BeNil())
+
+	reservations := &capacityReservationSnapshot{}
+	_, err = reservations.Get("reservation-id")
+	g.Expect(err).ToNot(gomega.BeNil())
+}
@@ -0,0 +1,321 @@
+package cluster_mgrs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	clusterDrainWorkerType = "cluster_drain"
+)
+
+// ClusterDrainManager represents a cluster manager that periodically migrates the kafkas of the data plane clusters
+// being drained to other data plane clusters, and hands the drained clusters over for deprovisioning once they are empty.
+type ClusterDrainManager struct {
+	workers.BaseWorker
+	clusterDrainService      services.ClusterDrainService
+	clusterService           services.ClusterService
+	kafkaService             services.KafkaService
+	clusterPlacementStrategy services.ClusterPlacementStrategy
+	dataplaneClusterConfig   *config.DataplaneClusterConfig
+	kafkaConfig              *config.KafkaConfig
+}
+
+// NewClusterDrainManager creates a new cluster manager to drain data plane clusters.
+func NewClusterDrainManager(reconciler workers.Reconciler, clusterDrainService services.ClusterDrainService, clusterService services.ClusterService,
+	kafkaService services.KafkaService, clusterPlacementStrategy services.ClusterPlacementStrategy, dataplaneClusterConfig *config.DataplaneClusterConfig,
+	kafkaConfig *config.KafkaConfig) *ClusterDrainManager {
+	return &ClusterDrainManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: clusterDrainWorkerType,
+			Reconciler: reconciler,
+		},
+		clusterDrainService:      clusterDrainService,
+		clusterService:           clusterService,
+		kafkaService:             kafkaService,
+		clusterPlacementStrategy: clusterPlacementStrategy,
+		dataplaneClusterConfig:   dataplaneClusterConfig,
+		kafkaConfig:              kafkaConfig,
+	}
+}
+
+// Start initializes the cluster manager to drain data plane clusters.
+func (m *ClusterDrainManager) Start() {
+	m.StartWorker(m)
+}
+
+// Stop causes the process for draining data plane clusters to stop.
+func (m *ClusterDrainManager) Stop() {
+	m.StopWorker(m)
+}
+
+func (m *ClusterDrainManager) Reconcile() []error {
+	glog.Infoln("reconciling cluster drains")
+	var encounteredErrors []error
+
+	drains, serviceErr := m.clusterDrainService.ListInProgress()
+	if serviceErr != nil {
+		return append(encounteredErrors, errors.Wrap(serviceErr, "failed to list cluster drains in progress"))
+	}
+	glog.Infof("cluster drains in progress count = %d", len(drains))
+
+	for _, drain := range drains {
+		if err := m.reconcileDrain(drain); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile drain of cluster %s", drain.ClusterID))
+		}
+	}
+
+	return encounteredErrors
+}
+
+// reconcileDrain updates the progress of the kafkas being migrated and starts the migration of the next batch of kafkas.
+// The drain is stopped as soon as the migration of one of its kafkas fails or stalls.
+func (m *ClusterDrainManager) reconcileDrain(drain *dbapi.ClusterDrain) error {
+	now := time.Now()
+	migrating := 0
+
+	for i := range drain.Kafkas {
+		drainKafka := &drain.Kafkas[i]
+		if drainKafka.Status != dbapi.ClusterDrainKafkaStatusMigrating {
+			continue
+		}
+
+		stillMigrating, err := m.reconcileMigratingKafka(drain, drainKafka, now)
+		if err != nil {
+			return err
+		}
+		if stillMigrating {
+			migrating++
+			continue
+		}
+
+		if drainKafka.Status == dbapi.ClusterDrainKafkaStatusFailed {
+			return m.failDrain(drain, drainKafka)
+		}
+	}
+
+	slots := drain.BatchSize - migrating
+	for i := range drain.Kafkas {
+		if slots <= 0 {
+			break
+		}
+		drainKafka := &drain.Kafkas[i]
+		if drainKafka.Status != dbapi.ClusterDrainKafkaStatusPending {
+			continue
+		}
+
+		if err := m.startKafkaMigration(drain, drainKafka, now); err != nil {
+			return err
+		}
+		switch drainKafka.Status {
+		case dbapi.ClusterDrainKafkaStatusMigrating:
+			slots--
+		case dbapi.ClusterDrainKafkaStatusFailed:
+			return m.failDrain(drain, drainKafka)
+		}
+	}
+
+	counts := drain.CountKafkasByStatus()
+	if counts[dbapi.ClusterDrainKafkaStatusPending] > 0 || counts[dbapi.ClusterDrainKafkaStatusMigrating] > 0 {
+		return nil
+	}
+
+	return m.completeDrain(drain)
+}
+
+// reconcileMigratingKafka checks whether the migration of the kafka completed, failed or stalled. true is returned if
+// the kafka is still being migrated.
+func (m *ClusterDrainManager) reconcileMigratingKafka(drain *dbapi.ClusterDrain, drainKafka *dbapi.ClusterDrainKafka, now time.Time) (bool, error) {
+	kafka, serviceErr := m.kafkaService.GetByID(drainKafka.KafkaID)
+	if serviceErr != nil && !serviceErr.Is404() {
+		return false, serviceErr
+	}
+
+	switch {
+	case kafka == nil || isKafkaLeavingCluster(kafka):
+		drainKafka.Status = dbapi.ClusterDrainKafkaStatusSkipped
+		drainKafka.FailedReason = "kafka has been deleted"
+	case kafka.ClusterID != drainKafka.TargetClusterID, kafka.Status == constants.KafkaRequestStatusReady.String():
+		// the kafka is ready on its target cluster or has been moved to yet another cluster e.g. by a resize
+		drainKafka.Status = dbapi.ClusterDrainKafkaStatusCompleted
+	case kafka.Status == constants.KafkaRequestStatusFailed.String():
+		drainKafka.Status = dbapi.ClusterDrainKafkaStatusFailed
+		drainKafka.FailedReason = fmt.Sprintf("kafka is in %s status", kafka.Status)
+	case drainKafka.StartedAt != nil && now.Sub(*drainKafka.StartedAt) > time.Duration(drain.StallTimeoutMinutes)*time.Minute:
+		drainKafka.Status = dbapi.ClusterDrainKafkaStatusFailed
+		drainKafka.FailedReason = fmt.Sprintf("migration did not complete within %d minutes", drain.StallTimeoutMinutes)
+	default:
+		return true, nil
+	}
+
+	drainKafka.FinishedAt = &now
+	return false, m.updateDrainKafka(drainKafka)
+}
+
+// startKafkaMigration assigns the kafka to the data plane cluster chosen by the placement strategy. The data of the kafka
+// is not migrated: the migration of a kafka that may hold data fails unless the drain allows data loss. Kafkas that are
+// being suspended or resumed, or for which no data plane cluster is available, are left pending until the next run.
+func (m *ClusterDrainManager) startKafkaMigration(drain *dbapi.ClusterDrain, drainKafka *dbapi.ClusterDrainKafka, now time.Time) error {
+	kafka, serviceErr := m.kafkaService.GetByID(drainKafka.KafkaID)
+	if serviceErr != nil && !serviceErr.Is404() {
+		return serviceErr
+	}
+
+	switch {
+	case kafka == nil || isKafkaLeavingCluster(kafka):
+		drainKafka.Status = dbapi.ClusterDrainKafkaStatusSkipped
+		drainKafka.FailedReason = "kafka has been deleted"
+		drainKafka.FinishedAt = &now
+		return m.updateDrainKafka(drainKafka)
+	case kafka.ClusterID != drain.ClusterID:
+		drainKafka.Status = dbapi.ClusterDrainKafkaStatusCompleted
+		drainKafka.FinishedAt = &now
+		return m.updateDrainKafka(drainKafka)
+	case kafka.Status == constants.KafkaRequestStatusFailed.String():
+		// failed kafkas are not migrated. The cluster is handed over for deprovisioning once they have been deleted
+		drainKafka.Status = dbapi.ClusterDrainKafkaStatusSkipped
+		drainKafka.FailedReason = "kafka is in failed status and has to be deleted"
+		drainKafka.FinishedAt = &now
+		return m.updateDrainKafka(drainKafka)
+	case arrays.Contains(services.KafkaStatusesHoldingData, kafka.Status) && !drain.AllowDataLoss:
+		drainKafka.Status = dbapi.ClusterDrainKafkaStatusFailed
+		drainKafka.FailedReason = fmt.Sprintf("kafka is in %s status and its data would be lost by its migration, the drain does not allow data loss", kafka.Status)
+		drainKafka.FinishedAt = &now
+		return m.updateDrainKafka(drainKafka)
+	case kafka.Status == constants.KafkaRequestStatusSuspending.String(), kafka.Status == constants.KafkaRequestStatusResuming.String():
+		glog.V(10).Infof("postponing migration of kafka %s with status %s from drained cluster %s", kafka.ID, kafka.Status, drain.ClusterID)
+		return nil
+	}
+
+	target, err := m.findTargetCluster(drain, kafka)
+	if err != nil {
+		return errors.Wrapf(err, "failed to find a cluster to migrate kafka %s to", kafka.ID)
+	}
+	if target == nil {
+		glog.Infof("no cluster available to migrate kafka %s from drained cluster %s", kafka.ID, drain.ClusterID)
+		return nil
+	}
+
+	available, err := m.clusterService.IsStrimziKafkaVersionAvailableInCluster(target, kafka.DesiredStrimziVersion, kafka.DesiredKafkaVersion, kafka.DesiredKafkaIBPVersion)
+	if err != nil {
+		return err
+	}
+	if !available {
+		drainKafka.Status = dbapi.ClusterDrainKafkaStatusFailed
+		drainKafka.FailedReason = fmt.Sprintf("kafka version %s with strimzi version %s and kafka ibp version %s is not available in cluster %s", kafka.DesiredKafkaVersion, kafka.DesiredStrimziVersion, kafka.DesiredKafkaIBPVersion, target.ClusterID)
+		drainKafka.FinishedAt = &now
+		return m.updateDrainKafka(drainKafka)
+	}
+
+	// the bootstrap server host of the kafkas that have already been prepared has to point to the target cluster
+	var bootstrapServerHost string
+	if kafka.BootstrapServerHost != "" {
+		migratedKafka := *kafka
+		migratedKafka.ClusterID = target.ClusterID
+		if err := m.kafkaService.AssignBootstrapServerHost(&migratedKafka); err != nil {
+			return errors.Wrapf(err, "failed to assign the bootstrap server host of kafka %s on cluster %s", kafka.ID, target.ClusterID)
+		}
+		bootstrapServerHost = migratedKafka.BootstrapServerHost
+	}
+
+	glog.Infof("migrating kafka %s with status %s from drained cluster %s to cluster %s", kafka.ID, kafka.Status, drain.ClusterID, target.ClusterID)
+	if serviceErr := m.clusterDrainService.MigrateKafka(drainKafka, kafka, target, bootstrapServerHost); serviceErr != nil {
+		if serviceErr.IsConflict() {
+			glog.Infof("postponing migration of kafka %s updated concurrently", kafka.ID)
+			return nil
+		}
+		return serviceErr
+	}
+
+	// suspended kafkas keep their status on the target cluster, there is nothing to wait for
+	if kafka.Status == constants.KafkaRequestStatusSuspended.String() {
+		drainKafka.Status = dbapi.ClusterDrainKafkaStatusCompleted
+		drainKafka.FinishedAt = &now
+		return m.updateDrainKafka(drainKafka)
+	}
+
+	return nil
+}
+
+// findTargetCluster returns the target cluster of the drain if it is ready, supports the instance type of the kafka and
+// has enough capacity left for it. The kafka is otherwise placed by the placement strategy.
+func (m *ClusterDrainManager) findTargetCluster(drain *dbapi.ClusterDrain, kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+	if drain.TargetClusterID != "" {
+		target, serviceErr := m.clusterService.FindClusterByID(drain.TargetClusterID)
+		if serviceErr != nil {
+			return nil, serviceErr
+		}
+		if target != nil && target.Status == api.ClusterReady && !target.Unschedulable && arrays.Contains(target.GetSupportedInstanceTypes(), kafka.InstanceType) {
+			hasCapacity, err := services.HasClusterCapacityForKafka(m.clusterService, m.dataplaneClusterConfig, m.kafkaConfig, target, kafka)
+			if err != nil {
+				return nil, err
+			}
+			if hasCapacity {
+				return target, nil
+			}
+		}
+		glog.Infof("target cluster %s of drained cluster %s cannot host kafka %s, falling back to the placement strategy", drain.TargetClusterID, drain.ClusterID, kafka.ID)
+	}
+
+	return m.clusterPlacementStrategy.FindCluster(kafka)
+}
+
+// completeDrain hands the drained cluster over for deprovisioning once it no longer holds any kafka
+func (m *ClusterDrainManager) completeDrain(drain *dbapi.ClusterDrain) error {
+	nonEmptyCluster, serviceErr := m.clusterService.FindNonEmptyClusterByID(drain.ClusterID)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	if nonEmptyCluster != nil {
+		glog.Infof("waiting for the remaining kafkas of drained cluster %s to be deleted", drain.ClusterID)
+		return nil
+	}
+
+	cluster, serviceErr := m.clusterService.FindClusterByID(drain.ClusterID)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	if cluster != nil && cluster.Status != api.ClusterDeprovisioning && cluster.Status != api.ClusterCleanup {
+		if err := m.clusterService.UpdateStatus(*cluster, api.ClusterDeprovisioning); err != nil {
+			return errors.Wrapf(err, "failed to update drained cluster %s status to 'deprovisioning'", drain.ClusterID)
+		}
+	}
+
+	glog.Infof("drain of cluster %s completed", drain.ClusterID)
+	if err := m.clusterDrainService.UpdateStatus(drain, dbapi.ClusterDrainStatusCompleted, ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterDrainManager) failDrain(drain *dbapi.ClusterDrain, drainKafka *dbapi.ClusterDrainKafka) error {
+	reason := fmt.Sprintf("migration of kafka %s failed: %s", drainKafka.KafkaID, drainKafka.FailedReason)
+	glog.Infof("stopping drain of cluster %s: %s", drain.ClusterID, reason)
+	if err := m.clusterDrainService.UpdateStatus(drain, dbapi.ClusterDrainStatusFailed, reason); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterDrainManager) updateDrainKafka(drainKafka *dbapi.ClusterDrainKafka) error {
+	if err := m.clusterDrainService.UpdateDrainKafka(drainKafka); err != nil {
+		return err
+	}
+	return nil
+}
+
+func isKafkaLeavingCluster(kafka *dbapi.KafkaRequest) bool {
+	return kafka.Status == constants.KafkaRequestStatusDeprovision.String() || kafka.Status == constants.KafkaRequestStatusDeleting.String()
+}
@@ -0,0 +1,457 @@
+package cluster_mgrs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+const (
+	drainedClusterID = "drained-cluster-id"
+	targetClusterID  = "target-cluster-id"
+)
+
+func buildClusterDrain(kafkas ...dbapi.ClusterDrainKafka) *dbapi.ClusterDrain {
+	return &dbapi.ClusterDrain{
+		Meta:                api.Meta{ID: "drain-id"},
+		ClusterID:           drainedClusterID,
+		Status:              dbapi.ClusterDrainStatusInProgress,
+		BatchSize:           2,
+		StallTimeoutMinutes: 60,
+		Kafkas:              kafkas,
+	}
+}
+
+func newTestDrainDataplaneClusterConfig(scalingType string) *config.DataplaneClusterConfig {
+	dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+	dataplaneClusterConfig.DataPlaneClusterScalingType = scalingType
+	return dataplaneClusterConfig
+}
+
+func newTestDrainKafkaConfig() *config.KafkaConfig {
+	return &config.KafkaConfig{
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: *newTestHelperBaseSupportedKafkaInstanceTypesConfig(),
+		},
+	}
+}
+
+func allowingDataLoss(drain *dbapi.ClusterDrain) *dbapi.ClusterDrain {
+	drain.AllowDataLoss = true
+	return drain
+}
+
+func buildClusterDrainKafka(kafkaID string, status dbapi.ClusterDrainKafkaStatus, startedAt *time.Time) dbapi.ClusterDrainKafka {
+	drainKafka := dbapi.ClusterDrainKafka{
+		ClusterDrainID: "drain-id",
+		KafkaID:        kafkaID,
+		Status:         status,
+		StartedAt:      startedAt,
+	}
+	if status == dbapi.ClusterDrainKafkaStatusMigrating {
+		drainKafka.TargetClusterID = targetClusterID
+	}
+	return drainKafka
+}
+
+func buildDrainedKafka(id string, clusterID string, status constants.KafkaStatus) *dbapi.KafkaRequest {
+	return &dbapi.KafkaRequest{
+		Meta:                   api.Meta{ID: id},
+		ClusterID:              clusterID,
+		Status:                 status.String(),
+		DesiredKafkaVersion:    "2.8.0",
+		DesiredStrimziVersion:  "strimzi-cluster-operator.v0.24.0",
+		DesiredKafkaIBPVersion: "2.8",
+	}
+}
+
+func TestClusterDrainManager_Reconcile(t *testing.T) {
+	startedAt := time.Now().Add(-10 * time.Minute)
+	stalledAt := time.Now().Add(-2 * time.Hour)
+
+	type fields struct {
+		kafkaService             *services.KafkaServiceMock
+		clusterService           *services.ClusterServiceMock
+		clusterPlacementStrategy *services.ClusterPlacementStrategyMock
+	}
+
+	kafkaServiceReturning := func(kafkas ...*dbapi.KafkaRequest) *services.KafkaServiceMock {
+		return &services.KafkaServiceMock{
+			GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+				for _, kafka := range kafkas {
+					if kafka.ID == id {
+						return kafka, nil
+					}
+				}
+				return nil, errors.NotFound("kafka %s not found", id)
+			},
+		}
+	}
+
+	clusterServiceWith := func(versionAvailable bool, empty bool) *services.ClusterServiceMock {
+		return &services.ClusterServiceMock{
+			IsStrimziKafkaVersionAvailableInClusterFunc: func(cluster *api.Cluster, strimziVersion string, kafkaVersion string, ibpVersion string) (bool, error) {
+				return versionAvailable, nil
+			},
+			FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+				if empty {
+					return nil, nil
+				}
+				return &api.Cluster{ClusterID: clusterID}, nil
+			},
+			FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+				return &api.Cluster{ClusterID: clusterID, Status: api.ClusterReady}, nil
+			},
+			UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
+				return nil
+			},
+		}
+	}
+
+	placementReturning := func(target *api.Cluster) *services.ClusterPlacementStrategyMock {
+		return &services.ClusterPlacementStrategyMock{
+			FindClusterFunc: func(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+				return target, nil
+			},
+		}
+	}
+
+	tests := []struct {
+		name                string
+		drain               *dbapi.ClusterDrain
+		fields              fields
+		wantErr             bool
+		wantDrainStatus     dbapi.ClusterDrainStatus
+		wantKafkaStatuses   []dbapi.ClusterDrainKafkaStatus
+		wantMigrations      int
+		wantClusterStatuses []api.ClusterStatus
+	}{
+		{
+			name:  "should start the migration of the first batch of pending kafkas",
+			drain: allowingDataLoss(buildClusterDrain(buildClusterDrainKafka("kafka-1", dbapi.ClusterDrainKafkaStatusPending, nil), buildClusterDrainKafka("kafka-2", dbapi.ClusterDrainKafkaStatusPending, nil), buildClusterDrainKafka("kafka-3", dbapi.ClusterDrainKafkaStatusPending, nil))),
+			fields: fields{
+				kafkaService: kafkaServiceReturning(
+					buildDrainedKafka("kafka-1", drainedClusterID, constants.KafkaRequestStatusReady),
+					buildDrainedKafka("kafka-2", drainedClusterID, constants.KafkaRequestStatusReady),
+					buildDrainedKafka("kafka-3", drainedClusterID, constants.KafkaRequestStatusReady),
+				),
+				clusterService:           clusterServiceWith(true, false),
+				clusterPlacementStrategy: placementReturning(&api.Cluster{ClusterID: targetClusterID}),
+			},
+			wantDrainStatus:   dbapi.ClusterDrainStatusInProgress,
+			wantKafkaStatuses: []dbapi.ClusterDrainKafkaStatus{dbapi.ClusterDrainKafkaStatusMigrating, dbapi.ClusterDrainKafkaStatusMigrating, dbapi.ClusterDrainKafkaStatusPending},
+			wantMigrations:    2,
+		},
+		{
+			name:  "should complete the migration of suspended kafkas straight away and postpone the kafkas being resumed",
+			drain: allowingDataLoss(buildClusterDrain(buildClusterDrainKafka("kafka-1", dbapi.ClusterDrainKafkaStatusPending, nil), buildClusterDrainKafka("kafka-2", dbapi.ClusterDrainKafkaStatusPending, nil))),
+			fields: fields{
+				kafkaService: kafkaServiceReturning(
+					buildDrainedKafka("kafka-1", drainedClusterID, constants.KafkaRequestStatusSuspended),
+					buildDrainedKafka("kafka-2", drainedClusterID, constants.KafkaRequestStatusResuming),
+				),
+				clusterService:           clusterServiceWith(true, false),
+				clusterPlacementStrategy: placementReturning(&api.Cluster{ClusterID: targetClusterID}),
+			},
+			wantDrainStatus:   dbapi.ClusterDrainStatusInProgress,
+			wantKafkaStatuses: []dbapi.ClusterDrainKafkaStatus{dbapi.ClusterDrainKafkaStatusCompleted, dbapi.ClusterDrainKafkaStatusPending},
+			wantMigrations:    1,
+		},
+		{
+			name:  "should migrate the kafkas that have not been ready yet when the drain does not allow data loss",
+			drain: buildClusterDrain(buildClusterDrainKafka("kafka-1", dbapi.ClusterDrainKafkaStatusPending, nil), buildClusterDrainKafka("kafka-2", dbapi.ClusterDrainKafkaStatusPending, nil)),
+			fields: fields{
+				kafkaService: kafkaServiceReturning(
+					buildDrainedKafka("kafka-1", drainedClusterID, constants.KafkaRequestStatusAccepted),
+					buildDrainedKafka("kafka-2", drainedClusterID, constants.KafkaRequestStatusProvisioning),
+				),
+				clusterService:           clusterServiceWith(true, false),
+				clusterPlacementStrategy: placementReturning(&api.Cluster{ClusterID: targetClusterID}),
+			},
+			wantDrainStatus:   dbapi.ClusterDrainStatusInProgress,
+			wantKafkaStatuses: []dbapi.ClusterDrainKafkaStatus{dbapi.ClusterDrainKafkaStatusMigrating, dbapi.ClusterDrainKafkaStatusMigrating},
+			wantMigrations:    2,
+		},
+		{
+			name:  "should fail the drain if a kafka holds data and the drain does not allow data loss",
+			drain: buildClusterDrain(buildClusterDrainKafka("kafka-1", dbapi.ClusterDrainKafkaStatusPending, nil), buildClusterDrainKafka("kafka-2", dbapi.ClusterDrainKafkaStatusPending, nil)),
+			fields: fields{
+				kafkaService: kafkaServiceReturning(
+					buildDrainedKafka("kafka-1", drainedClusterID, constants.KafkaRequestStatusReady),
+					buildDrainedKafka("kafka-2", drainedClusterID, constants.KafkaRequestStatusProvisioning),
+				),
+				clusterService:           clusterServiceWith(true, false),
+				clusterPlacementStrategy: placementReturning(&api.Cluster{ClusterID: targetClusterID}),
+			},
+			wantDrainStatus:   dbapi.ClusterDrainStatusFailed,
+			wantKafkaStatuses: []dbapi.ClusterDrainKafkaStatus{dbapi.ClusterDrainKafkaStatusFailed, dbapi.ClusterDrainKafkaStatusPending},
+			wantMigrations:    0,
+		},
+		{
+			name:  "should leave the kafkas pending if no cluster is available",
+			drain: allowingDataLoss(buildClusterDrain(buildClusterDrainKafka("kafka-1", dbapi.ClusterDrainKafkaStatusPending, nil))),
+			fields: fields{
+				kafkaService:             kafkaServiceReturning(buildDrainedKafka("kafka-1", drainedClusterID, constants.KafkaRequestStatusReady)),
+				clusterService:           clusterServiceWith(true, false),
+				clusterPlacementStrategy: placementReturning(nil),
+			},
+			wantDrainStatus:   dbapi.ClusterDrainStatusInProgress,
+			wantKafkaStatuses: []dbapi.ClusterDrainKafkaStatus{dbapi.ClusterDrainKafkaStatusPending},
+			wantMigrations:    0,
+		},
+		{
+			name:  "should fail the drain if the target cluster does not support the versions of the kafka",
+			drain: allowingDataLoss(buildClusterDrain(buildClusterDrainKafka("kafka-1", dbapi.ClusterDrainKafkaStatusPending, nil), buildClusterDrainKafka("kafka-2", dbapi.ClusterDrainKafkaStatusPending, nil))),
+			fields: fields{
+				kafkaService: kafkaServiceReturning(
+					buildDrainedKafka("kafka-1", drainedClusterID, constants.KafkaRequestStatusReady),
+					buildDrainedKafka("kafka-2", drainedClusterID, constants.KafkaRequestStatusReady),
+				),
+				clusterService:           clusterServiceWith(false, false),
+				clusterPlacementStrategy: placementReturning(&api.Cluster{ClusterID: targetClusterID}),
+			},
+			wantDrainStatus:   dbapi.ClusterDrainStatusFailed,
+			wantKafkaStatuses: []dbapi.ClusterDrainKafkaStatus{dbapi.ClusterDrainKafkaStatusFailed, dbapi.ClusterDrainKafkaStatusPending},
+			wantMigrations:    0,
+		},
+		{
+			name:  "should complete the migration of the kafkas ready on their target cluster",
+			drain: buildClusterDrain(buildClusterDrainKafka("kafka-1", dbapi.ClusterDrainKafkaStatusMigrating, &startedAt), buildClusterDrainKafka("kafka-2", dbapi.ClusterDrainKafkaStatusMigrating, &startedAt)),
+			fields: fields{
+				kafkaService: kafkaServiceReturning(
+					buildDrainedKafka("kafka-1", targetClusterID, constants.KafkaRequestStatusReady),
+					buildDrainedKafka("kafka-2", targetClusterID, constants.KafkaRequestStatusProvisioning),
+				),
+				clusterService: clusterServiceWith(true, false),
+			},
+			wantDrainStatus:   dbapi.ClusterDrainStatusInProgress,
+			wantKafkaStatuses: []dbapi.ClusterDrainKafkaStatus{dbapi.ClusterDrainKafkaStatusCompleted, dbapi.ClusterDrainKafkaStatusMigrating},
+			wantMigrations:    0,
+		},
+		{
+			name:  "should fail the drain if the migration of a kafka failed",
+			drain: buildClusterDrain(buildClusterDrainKafka("kafka-1", dbapi.ClusterDrainKafkaStatusMigrating, &startedAt), buildClusterDrainKafka("kafka-2", dbapi.ClusterDrainKafkaStatusPending, nil)),
+			fields: fields{
+				kafkaService: kafkaServiceReturning(
+					buildDrainedKafka("kafka-1", targetClusterID, constants.KafkaRequestStatusFailed),
+					buildDrainedKafka("kafka-2", drainedClusterID, constants.KafkaRequestStatusReady),
+				),
+			},
+			wantDrainStatus:   dbapi.ClusterDrainStatusFailed,
+			wantKafkaStatuses: []dbapi.ClusterDrainKafkaStatus{dbapi.ClusterDrainKafkaStatusFailed, dbapi.ClusterDrainKafkaStatusPending},
+			wantMigrations:    0,
+		},
+		{
+			name:  "should fail the drain if the migration of a kafka stalled",
+			drain: buildClusterDrain(buildClusterDrainKafka("kafka-1", dbapi.ClusterDrainKafkaStatusMigrating, &stalledAt)),
+			fields: fields{
+				kafkaService: kafkaServiceReturning(buildDrainedKafka("kafka-1", targetClusterID, constants.KafkaRequestStatusProvisioning)),
+			},
+			wantDrainStatus:   dbapi.ClusterDrainStatusFailed,
+			wantKafkaStatuses: []dbapi.ClusterDrainKafkaStatus{dbapi.ClusterDrainKafkaStatusFailed},
+			wantMigrations:    0,
+		},
+		{
+			name:  "should skip the kafkas that have been deleted and the failed kafkas",
+			drain: buildClusterDrain(buildClusterDrainKafka("kafka-1", dbapi.ClusterDrainKafkaStatusPending, nil), buildClusterDrainKafka("kafka-2", dbapi.ClusterDrainKafkaStatusPending, nil)),
+			fields: fields{
+				kafkaService:   kafkaServiceReturning(buildDrainedKafka("kafka-2", drainedClusterID, constants.KafkaRequestStatusFailed)),
+				clusterService: clusterServiceWith(true, false),
+			},
+			wantDrainStatus:   dbapi.ClusterDrainStatusInProgress,
+			wantKafkaStatuses: []dbapi.ClusterDrainKafkaStatus{dbapi.ClusterDrainKafkaStatusSkipped, dbapi.ClusterDrainKafkaStatusSkipped},
+			wantMigrations:    0,
+		},
+		{
+			name:  "should complete the drain and deprovision the cluster once it is empty",
+			drain: buildClusterDrain(buildClusterDrainKafka("kafka-1", dbapi.ClusterDrainKafkaStatusMigrating, &startedAt)),
+			fields: fields{
+				kafkaService:   kafkaServiceReturning(buildDrainedKafka("kafka-1", targetClusterID, constants.KafkaRequestStatusReady)),
+				clusterService: clusterServiceWith(true, true),
+			},
+			wantDrainStatus:     dbapi.ClusterDrainStatusCompleted,
+			wantKafkaStatuses:   []dbapi.ClusterDrainKafkaStatus{dbapi.ClusterDrainKafkaStatusCompleted},
+			wantMigrations:      0,
+			wantClusterStatuses: []api.ClusterStatus{api.ClusterDeprovisioning},
+		},
+		{
+			name:  "should return an error if getting a kafka fails",
+			drain: buildClusterDrain(buildClusterDrainKafka("kafka-1", dbapi.ClusterDrainKafkaStatusPending, nil)),
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return nil, errors.GeneralError("failed to get kafka")
+					},
+				},
+			},
+			wantErr:           true,
+			wantDrainStatus:   dbapi.ClusterDrainStatusInProgress,
+			wantKafkaStatuses: []dbapi.ClusterDrainKafkaStatus{dbapi.ClusterDrainKafkaStatusPending},
+			wantMigrations:    0,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			clusterDrainService := &services.ClusterDrainServiceMock{
+				ListInProgressFunc: func() ([]*dbapi.ClusterDrain, *errors.ServiceError) {
+					return []*dbapi.ClusterDrain{tt.drain}, nil
+				},
+				UpdateDrainKafkaFunc: func(drainKafka *dbapi.ClusterDrainKafka) *errors.ServiceError {
+					return nil
+				},
+				UpdateStatusFunc: func(drain *dbapi.ClusterDrain, status dbapi.ClusterDrainStatus, reason string) *errors.ServiceError {
+					drain.Status = status
+					drain.StatusReason = reason
+					return nil
+				},
+				MigrateKafkaFunc: func(drainKafka *dbapi.ClusterDrainKafka, kafka *dbapi.KafkaRequest, target *api.Cluster, bootstrapServerHost string) *errors.ServiceError {
+					drainKafka.Status = dbapi.ClusterDrainKafkaStatusMigrating
+					drainKafka.TargetClusterID = target.ClusterID
+					return nil
+				},
+			}
+			clusterService := tt.fields.clusterService
+			if clusterService == nil {
+				clusterService = &services.ClusterServiceMock{}
+			}
+			m := NewClusterDrainManager(w.Reconciler{}, clusterDrainService, clusterService, tt.fields.kafkaService, tt.fields.clusterPlacementStrategy, newTestDrainDataplaneClusterConfig(config.NoScaling), newTestDrainKafkaConfig())
+
+			g.Expect(len(m.Reconcile()) > 0).To(gomega.Equal(tt.wantErr))
+			g.Expect(tt.drain.Status).To(gomega.Equal(tt.wantDrainStatus))
+			for i, status := range tt.wantKafkaStatuses {
+				g.Expect(tt.drain.Kafkas[i].Status).To(gomega.Equal(status))
+			}
+			g.Expect(clusterDrainService.MigrateKafkaCalls()).To(gomega.HaveLen(tt.wantMigrations))
+			updateStatusCalls := clusterService.UpdateStatusCalls()
+			g.Expect(updateStatusCalls).To(gomega.HaveLen(len(tt.wantClusterStatuses)))
+			for i, status := range tt.wantClusterStatuses {
+				g.Expect(updateStatusCalls[i].Status).To(gomega.Equal(status))
+			}
+		})
+	}
+
+	t.Run("should wait for the remaining kafkas to be deleted before deprovisioning the cluster", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		drain := buildClusterDrain(buildClusterDrainKafka("kafka-1", dbapi.ClusterDrainKafkaStatusSkipped, nil))
+		clusterDrainService := &services.ClusterDrainServiceMock{
+			ListInProgressFunc: func() ([]*dbapi.ClusterDrain, *errors.ServiceError) {
+				return []*dbapi.ClusterDrain{drain}, nil
+			},
+		}
+		clusterService := clusterServiceWith(true, false)
+		m := NewClusterDrainManager(w.Reconciler{}, clusterDrainService, clusterService, &services.KafkaServiceMock{}, &services.ClusterPlacementStrategyMock{}, newTestDrainDataplaneClusterConfig(config.NoScaling), newTestDrainKafkaConfig())
+
+		g.Expect(m.Reconcile()).To(gomega.BeEmpty())
+		g.Expect(drain.Status).To(gomega.Equal(dbapi.ClusterDrainStatusInProgress))
+		g.Expect(clusterService.UpdateStatusCalls()).To(gomega.BeEmpty())
+	})
+
+	for _, testcase := range []struct {
+		name                 string
+		targetStatus         api.ClusterStatus
+		targetStreamingUnits int
+		wantTargetCluster    string
+	}{
+		{
+			name:                 "should migrate the kafkas to the target cluster of the drain when it is ready and has enough capacity",
+			targetStatus:         api.ClusterReady,
+			targetStreamingUnits: 3,
+			wantTargetCluster:    "replacement-cluster-id",
+		},
+		{
+			name:                 "should fall back to the placement strategy when the target cluster of the drain is not ready",
+			targetStatus:         api.ClusterProvisioning,
+			targetStreamingUnits: 0,
+			wantTargetCluster:    targetClusterID,
+		},
+		{
+			name:                 "should fall back to the placement strategy when the target cluster of the drain is full",
+			targetStatus:         api.ClusterReady,
+			targetStreamingUnits: 4,
+			wantTargetCluster:    targetClusterID,
+		},
+	} {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			drain := allowingDataLoss(buildClusterDrain(buildClusterDrainKafka("kafka-1", dbapi.ClusterDrainKafkaStatusPending, nil)))
+			drain.TargetClusterID = "replacement-cluster-id"
+			kafka := buildDrainedKafka("kafka-1", drainedClusterID, constants.KafkaRequestStatusReady)
+			kafka.InstanceType = "t1"
+			kafka.SizeId = "s2"
+			clusterDrainService := &services.ClusterDrainServiceMock{
+				ListInProgressFunc: func() ([]*dbapi.ClusterDrain, *errors.ServiceError) {
+					return []*dbapi.ClusterDrain{drain}, nil
+				},
+				MigrateKafkaFunc: func(drainKafka *dbapi.ClusterDrainKafka, kafka *dbapi.KafkaRequest, target *api.Cluster, bootstrapServerHost string) *errors.ServiceError {
+					drainKafka.Status = dbapi.ClusterDrainKafkaStatusMigrating
+					drainKafka.TargetClusterID = target.ClusterID
+					return nil
+				},
+			}
+			clusterService := clusterServiceWith(true, false)
+			clusterService.FindClusterByIDFunc = func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+				return &api.Cluster{ClusterID: clusterID, Status: tt.targetStatus, SupportedInstanceType: "t1,t2", DynamicCapacityInfo: api.JSON([]byte(`{"t1":{"max_nodes":1,"max_units":5,"remaining_units":2}}`))}, nil
+			}
+			clusterService.FindStreamingUnitCountByClusterAndInstanceTypeFunc = func() (services.KafkaStreamingUnitCountPerClusterList, error) {
+				return services.KafkaStreamingUnitCountPerClusterList{{ClusterId: "replacement-cluster-id", InstanceType: "t1", Count: int32(tt.targetStreamingUnits)}}, nil
+			}
+			m := NewClusterDrainManager(w.Reconciler{}, clusterDrainService, clusterService, kafkaServiceReturning(kafka), placementReturning(&api.Cluster{ClusterID: targetClusterID}), newTestDrainDataplaneClusterConfig(config.AutoScaling), newTestDrainKafkaConfig())
+
+			g.Expect(m.Reconcile()).To(gomega.BeEmpty())
+			g.Expect(clusterDrainService.MigrateKafkaCalls()).To(gomega.HaveLen(1))
+			g.Expect(drain.Kafkas[0].TargetClusterID).To(gomega.Equal(tt.wantTargetCluster))
+		})
+	}
+
+	t.Run("should migrate a kafka with the bootstrap server host of its target cluster", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		drain := allowingDataLoss(buildClusterDrain(buildClusterDrainKafka("kafka-1", dbapi.ClusterDrainKafkaStatusPending, nil)))
+		kafka := buildDrainedKafka("kafka-1", drainedClusterID, constants.KafkaRequestStatusReady)
+		kafka.BootstrapServerHost = "kafka-1." + drainedClusterID
+		clusterDrainService := &services.ClusterDrainServiceMock{
+			ListInProgressFunc: func() ([]*dbapi.ClusterDrain, *errors.ServiceError) {
+				return []*dbapi.ClusterDrain{drain}, nil
+			},
+			MigrateKafkaFunc: func(drainKafka *dbapi.ClusterDrainKafka, kafka *dbapi.KafkaRequest, target *api.Cluster, bootstrapServerHost string) *errors.ServiceError {
+				drainKafka.Status = dbapi.ClusterDrainKafkaStatusMigrating
+				return nil
+			},
+		}
+		kafkaService := kafkaServiceReturning(kafka)
+		kafkaService.AssignBootstrapServerHostFunc = func(kafkaRequest *dbapi.KafkaRequest) error {
+			kafkaRequest.BootstrapServerHost = kafkaRequest.ID + "." + kafkaRequest.ClusterID
+			return nil
+		}
+		m := NewClusterDrainManager(w.Reconciler{}, clusterDrainService, clusterServiceWith(true, false), kafkaService, placementReturning(&api.Cluster{ClusterID: targetClusterID}), newTestDrainDataplaneClusterConfig(config.NoScaling), newTestDrainKafkaConfig())
+
+		g.Expect(m.Reconcile()).To(gomega.BeEmpty())
+		migrateCalls := clusterDrainService.MigrateKafkaCalls()
+		g.Expect(migrateCalls).To(gomega.HaveLen(1))
+		g.Expect(migrateCalls[0].BootstrapServerHost).To(gomega.Equal("kafka-1." + targetClusterID))
+		g.Expect(kafka.ClusterID).To(gomega.Equal(drainedClusterID))
+	})
+
+	t.Run("should return an error if listing the drains in progress fails", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		clusterDrainService := &services.ClusterDrainServiceMock{
+			ListInProgressFunc: func() ([]*dbapi.ClusterDrain, *errors.ServiceError) {
+				return nil, errors.GeneralError("failed to list cluster drains")
+			},
+		}
+		m := NewClusterDrainManager(w.Reconciler{}, clusterDrainService, &services.ClusterServiceMock{}, &services.KafkaServiceMock{}, &services.ClusterPlacementStrategyMock{}, newTestDrainDataplaneClusterConfig(config.NoScaling), newTestDrainKafkaConfig())
+		g.Expect(m.Reconcile()).To(gomega.HaveLen(1))
+	})
+}
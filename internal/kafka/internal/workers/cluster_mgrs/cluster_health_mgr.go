@@ -0,0 +1,164 @@
+package cluster_mgrs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	clusterHealthWorkerType = "cluster_health"
+)
+
+// clusterHealthStatuses are the statuses of the data plane clusters whose health is evaluated
+var clusterHealthStatuses = []api.ClusterStatus{
+	api.ClusterReady,
+	api.ClusterFull,
+	api.ClusterQuarantined,
+}
+
+// ClusterHealthManager represents a cluster manager that periodically evaluates the health of the data plane clusters
+// from their status reports, quarantines the unhealthy ones and releases them once they have recovered.
+type ClusterHealthManager struct {
+	workers.BaseWorker
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+	clusterService         services.ClusterService
+}
+
+var _ workers.Worker = &ClusterHealthManager{}
+
+// NewClusterHealthManager creates a new cluster manager to evaluate the health of data plane clusters.
+func NewClusterHealthManager(reconciler workers.Reconciler, dataplaneClusterConfig *config.DataplaneClusterConfig, clusterService services.ClusterService) *ClusterHealthManager {
+	return &ClusterHealthManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: clusterHealthWorkerType,
+			Reconciler: reconciler,
+		},
+		dataplaneClusterConfig: dataplaneClusterConfig,
+		clusterService:         clusterService,
+	}
+}
+
+// Start initializes the cluster manager to evaluate the health of data plane clusters.
+func (m *ClusterHealthManager) Start() {
+	m.StartWorker(m)
+}
+
+// Stop causes the process for evaluating the health of data plane clusters to stop.
+func (m *ClusterHealthManager) Stop() {
+	m.StopWorker(m)
+	metrics.ResetMetricsForClusterHealthManager()
+}
+
+func (m *ClusterHealthManager) Reconcile() []error {
+	glog.Infoln("reconciling data plane clusters health")
+	var encounteredErrors []error
+
+	evaluator := clusterHealthEvaluator{healthConfig: m.dataplaneClusterConfig.ClusterHealthConfig}
+	now := time.Now()
+
+	for _, status := range clusterHealthStatuses {
+		clusters, serviceErr := m.clusterService.ListByStatus(status)
+		if serviceErr != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(serviceErr, "failed to list %s clusters", status))
+			continue
+		}
+
+		for i := range clusters {
+			if err := m.reconcileClusterHealth(evaluator, &clusters[i], now); err != nil {
+				encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile health of cluster %s", clusters[i].ClusterID))
+			}
+		}
+	}
+
+	return encounteredErrors
+}
+
+func (m *ClusterHealthManager) reconcileClusterHealth(evaluator clusterHealthEvaluator, cluster *api.Cluster, now time.Time) error {
+	health := evaluator.evaluate(cluster, now)
+	metrics.UpdateClusterHealthMetrics(cluster.ClusterID, health.heartbeatAge, cluster.FailedStatusReports, health.healthy)
+
+	quarantined := cluster.Status == api.ClusterQuarantined
+	if !m.dataplaneClusterConfig.ClusterHealthConfig.QuarantineEnabled {
+		if quarantined {
+			glog.Infof("quarantine is disabled, releasing cluster %q", cluster.ClusterID)
+			return m.updateQuarantine(cluster, false, "")
+		}
+		return nil
+	}
+
+	switch {
+	case !health.healthy && !quarantined:
+		glog.Infof("quarantining unhealthy cluster %q: %s", cluster.ClusterID, health.reason)
+		return m.updateQuarantine(cluster, true, health.reason)
+	case health.healthy && quarantined:
+		glog.Infof("releasing recovered cluster %q from quarantine", cluster.ClusterID)
+		return m.updateQuarantine(cluster, false, "")
+	}
+
+	return nil
+}
+
+func (m *ClusterHealthManager) updateQuarantine(cluster *api.Cluster, quarantined bool, reason string) error {
+	if serviceErr := m.clusterService.UpdateQuarantine(cluster.ClusterID, quarantined, reason); serviceErr != nil {
+		return serviceErr
+	}
+	return nil
+}
+
+// clusterHealth is the outcome of the evaluation of the health of a data plane cluster
+type clusterHealth struct {
+	healthy bool
+	// heartbeatAge is the time elapsed since the last status report of the cluster. It is 0 when the cluster has not
+	// reported its status yet
+	heartbeatAge time.Duration
+	// reason explains why the cluster is unhealthy
+	reason string
+}
+
+// clusterHealthEvaluator evaluates the health of a data plane cluster from the age of its last status report and the
+// number of consecutive status reports with a kas fleetshard operator that is not ready
+type clusterHealthEvaluator struct {
+	healthConfig config.ClusterHealthConfig
+}
+
+func (e clusterHealthEvaluator) evaluate(cluster *api.Cluster, now time.Time) clusterHealth {
+	// clusters that have not reported their status since the health tracking was introduced are given the benefit of the doubt
+	if cluster.StatusReportedAt == nil {
+		return clusterHealth{healthy: true}
+	}
+
+	heartbeatAge := now.Sub(*cluster.StatusReportedAt)
+	if heartbeatAge > e.healthConfig.HeartbeatTimeout {
+		return clusterHealth{
+			heartbeatAge: heartbeatAge,
+			reason:       fmt.Sprintf("no status report received for more than %s", e.healthConfig.HeartbeatTimeout),
+		}
+	}
+
+	if cluster.FailedStatusReports >= e.healthConfig.FailedStatusReportsThreshold {
+		return clusterHealth{
+			heartbeatAge: heartbeatAge,
+			reason:       fmt.Sprintf("kas fleetshard operator not ready in the last %d status reports", cluster.FailedStatusReports),
+		}
+	}
+
+	// a quarantined cluster must prove it has recovered before being released
+	if cluster.Status == api.ClusterQuarantined && cluster.HealthyStatusReports < e.healthConfig.HealthyStatusReportsThreshold {
+		return clusterHealth{
+			heartbeatAge: heartbeatAge,
+			reason:       cluster.QuarantineReason,
+		}
+	}
+
+	return clusterHealth{healthy: true, heartbeatAge: heartbeatAge}
+}
@@ -0,0 +1,169 @@
+package cluster_mgrs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+func Test_clusterHealthEvaluator_evaluate(t *testing.T) {
+	now := time.Now()
+	recently := now.Add(-1 * time.Minute)
+	longAgo := now.Add(-1 * time.Hour)
+
+	tests := []struct {
+		name        string
+		cluster     *api.Cluster
+		wantHealthy bool
+		wantReason  string
+	}{
+		{
+			name:        "should be healthy when the cluster has not reported its status yet",
+			cluster:     &api.Cluster{Status: api.ClusterReady},
+			wantHealthy: true,
+		},
+		{
+			name:        "should be healthy when the cluster reported its status recently without failures",
+			cluster:     &api.Cluster{Status: api.ClusterReady, StatusReportedAt: &recently, FailedStatusReports: 2},
+			wantHealthy: true,
+		},
+		{
+			name:       "should be unhealthy when the heartbeat timed out",
+			cluster:    &api.Cluster{Status: api.ClusterReady, StatusReportedAt: &longAgo},
+			wantReason: "no status report received for more than 5m0s",
+		},
+		{
+			name:       "should be unhealthy when too many status reports failed",
+			cluster:    &api.Cluster{Status: api.ClusterFull, StatusReportedAt: &recently, FailedStatusReports: 3},
+			wantReason: "kas fleetshard operator not ready in the last 3 status reports",
+		},
+		{
+			name:       "should stay unhealthy when a quarantined cluster has not reported enough healthy status reports",
+			cluster:    &api.Cluster{Status: api.ClusterQuarantined, StatusReportedAt: &recently, HealthyStatusReports: 2, QuarantineReason: "heartbeat timed out"},
+			wantReason: "heartbeat timed out",
+		},
+		{
+			name:        "should be healthy when a quarantined cluster has recovered",
+			cluster:     &api.Cluster{Status: api.ClusterQuarantined, StatusReportedAt: &recently, HealthyStatusReports: 3},
+			wantHealthy: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			evaluator := clusterHealthEvaluator{healthConfig: config.NewClusterHealthConfig()}
+			got := evaluator.evaluate(tt.cluster, now)
+			g.Expect(got.healthy).To(gomega.Equal(tt.wantHealthy))
+			g.Expect(got.reason).To(gomega.Equal(tt.wantReason))
+		})
+	}
+}
+
+func TestClusterHealthManager_Reconcile(t *testing.T) {
+	recently := time.Now().Add(-1 * time.Minute)
+	longAgo := time.Now().Add(-1 * time.Hour)
+
+	type quarantineUpdate struct {
+		clusterID   string
+		quarantined bool
+	}
+
+	tests := []struct {
+		name              string
+		quarantineEnabled bool
+		clusters          map[api.ClusterStatus][]api.Cluster
+		listErr           *errors.ServiceError
+		updateErr         *errors.ServiceError
+		wantUpdates       []quarantineUpdate
+		wantErrCount      int
+	}{
+		{
+			name:              "should quarantine unhealthy clusters and release recovered ones",
+			quarantineEnabled: true,
+			clusters: map[api.ClusterStatus][]api.Cluster{
+				api.ClusterReady: {
+					{ClusterID: "healthy", Status: api.ClusterReady, StatusReportedAt: &recently},
+					{ClusterID: "unhealthy", Status: api.ClusterReady, StatusReportedAt: &longAgo},
+				},
+				api.ClusterQuarantined: {
+					{ClusterID: "recovered", Status: api.ClusterQuarantined, StatusReportedAt: &recently, HealthyStatusReports: 3},
+					{ClusterID: "recovering", Status: api.ClusterQuarantined, StatusReportedAt: &recently, HealthyStatusReports: 1},
+				},
+			},
+			wantUpdates: []quarantineUpdate{
+				{clusterID: "unhealthy", quarantined: true},
+				{clusterID: "recovered", quarantined: false},
+			},
+		},
+		{
+			name:              "should release quarantined clusters when quarantine is disabled",
+			quarantineEnabled: false,
+			clusters: map[api.ClusterStatus][]api.Cluster{
+				api.ClusterReady: {
+					{ClusterID: "unhealthy", Status: api.ClusterReady, StatusReportedAt: &longAgo},
+				},
+				api.ClusterQuarantined: {
+					{ClusterID: "recovering", Status: api.ClusterQuarantined, StatusReportedAt: &recently},
+				},
+			},
+			wantUpdates: []quarantineUpdate{
+				{clusterID: "recovering", quarantined: false},
+			},
+		},
+		{
+			name:              "should return an error for each status whose clusters cannot be listed",
+			quarantineEnabled: true,
+			listErr:           errors.GeneralError("failed to list clusters"),
+			wantErrCount:      3,
+		},
+		{
+			name:              "should return an error when the quarantine cannot be updated",
+			quarantineEnabled: true,
+			clusters: map[api.ClusterStatus][]api.Cluster{
+				api.ClusterFull: {
+					{ClusterID: "unhealthy", Status: api.ClusterFull, StatusReportedAt: &recently, FailedStatusReports: 5},
+				},
+			},
+			updateErr: errors.GeneralError("failed to update quarantine"),
+			wantUpdates: []quarantineUpdate{
+				{clusterID: "unhealthy", quarantined: true},
+			},
+			wantErrCount: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			var updates []quarantineUpdate
+			clusterService := &services.ClusterServiceMock{
+				ListByStatusFunc: func(status api.ClusterStatus) ([]api.Cluster, *errors.ServiceError) {
+					if tt.listErr != nil {
+						return nil, tt.listErr
+					}
+					return tt.clusters[status], nil
+				},
+				UpdateQuarantineFunc: func(clusterID string, quarantined bool, reason string) *errors.ServiceError {
+					updates = append(updates, quarantineUpdate{clusterID: clusterID, quarantined: quarantined})
+					return tt.updateErr
+				},
+			}
+			dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+			dataplaneClusterConfig.ClusterHealthConfig.QuarantineEnabled = tt.quarantineEnabled
+
+			m := NewClusterHealthManager(w.Reconciler{}, dataplaneClusterConfig, clusterService)
+			errs := m.Reconcile()
+			g.Expect(errs).To(gomega.HaveLen(tt.wantErrCount))
+			g.Expect(updates).To(gomega.Equal(tt.wantUpdates))
+		})
+	}
+}
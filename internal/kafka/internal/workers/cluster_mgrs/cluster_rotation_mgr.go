@@ -0,0 +1,306 @@
+package cluster_mgrs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	clusterRotationWorkerType = "cluster_rotation"
+)
+
+// ClusterRotationManager represents a cluster manager that periodically replaces the outdated data plane clusters.
+// A replacement data plane cluster is registered for each outdated data plane cluster, the kafkas of the outdated data
+// plane cluster are drained to its replacement once it is ready, and the outdated data plane cluster is then handed
+// over for deprovisioning by the drain. As the drains do not migrate the data of the kafkas, the outdated data plane
+// clusters holding kafkas with data are not rotated.
+type ClusterRotationManager struct {
+	workers.BaseWorker
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+	clusterRotationService services.ClusterRotationService
+	clusterDrainService    services.ClusterDrainService
+	clusterService         services.ClusterService
+}
+
+// NewClusterRotationManager creates a new cluster manager to rotate data plane clusters.
+func NewClusterRotationManager(reconciler workers.Reconciler, dataplaneClusterConfig *config.DataplaneClusterConfig,
+	clusterRotationService services.ClusterRotationService, clusterDrainService services.ClusterDrainService, clusterService services.ClusterService) *ClusterRotationManager {
+	return &ClusterRotationManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: clusterRotationWorkerType,
+			Reconciler: reconciler,
+		},
+		dataplaneClusterConfig: dataplaneClusterConfig,
+		clusterRotationService: clusterRotationService,
+		clusterDrainService:    clusterDrainService,
+		clusterService:         clusterService,
+	}
+}
+
+// Start initializes the cluster manager to rotate data plane clusters.
+func (m *ClusterRotationManager) Start() {
+	m.StartWorker(m)
+}
+
+// Stop causes the process for rotating data plane clusters to stop.
+func (m *ClusterRotationManager) Stop() {
+	m.StopWorker(m)
+}
+
+func (m *ClusterRotationManager) Reconcile() []error {
+	rotationConfig := &m.dataplaneClusterConfig.ClusterRotationConfig
+	if !rotationConfig.Enabled || !m.dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled() {
+		glog.Infoln("cluster rotation is disabled. Cluster rotation reconcile event skipped")
+		return nil
+	}
+
+	glog.Infoln("reconciling cluster rotations")
+	var encounteredErrors []error
+
+	rotations, serviceErr := m.clusterRotationService.ListInProgress()
+	if serviceErr != nil {
+		return append(encounteredErrors, errors.Wrap(serviceErr, "failed to list cluster rotations in progress"))
+	}
+	glog.Infof("cluster rotations in progress count = %d", len(rotations))
+
+	inProgress := 0
+	for _, rotation := range rotations {
+		if err := m.reconcileRotation(rotation); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile rotation of cluster %s", rotation.ClusterID))
+		}
+		if rotation.Status == dbapi.ClusterRotationStatusProvisioning || rotation.Status == dbapi.ClusterRotationStatusDraining {
+			inProgress++
+		}
+	}
+
+	if rotationConfig.Paused {
+		glog.Infoln("cluster rotation is paused. No new cluster rotation is started")
+		return encounteredErrors
+	}
+
+	if err := m.startRotations(rotationConfig.MaxConcurrentRotations-inProgress, time.Now()); err != nil {
+		encounteredErrors = append(encounteredErrors, err)
+	}
+
+	return encounteredErrors
+}
+
+func (m *ClusterRotationManager) reconcileRotation(rotation *dbapi.ClusterRotation) error {
+	switch rotation.Status {
+	case dbapi.ClusterRotationStatusProvisioning:
+		return m.reconcileProvisioningRotation(rotation)
+	case dbapi.ClusterRotationStatusDraining:
+		return m.reconcileDrainingRotation(rotation)
+	}
+	return nil
+}
+
+// reconcileProvisioningRotation starts the drain of the rotated cluster once its replacement is ready. The rotation
+// fails if the replacement could not be provisioned.
+func (m *ClusterRotationManager) reconcileProvisioningRotation(rotation *dbapi.ClusterRotation) error {
+	replacement, serviceErr := m.clusterRotationService.FindReplacementCluster(rotation)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	if replacement == nil || replacement.Status == api.ClusterFailed || replacement.Status == api.ClusterDeprovisioning || replacement.Status == api.ClusterCleanup {
+		return m.failRotation(rotation, fmt.Sprintf("replacement cluster %s could not be provisioned", rotation.ReplacementID))
+	}
+
+	// the cluster id of the replacement is only known once its provisioning has started
+	if replacement.ClusterID != "" && rotation.ReplacementClusterID == "" {
+		if err := m.clusterRotationService.UpdateReplacementClusterID(rotation, replacement.ClusterID); err != nil {
+			return err
+		}
+	}
+
+	if replacement.Status != api.ClusterReady {
+		glog.Infof("waiting for replacement cluster %s of rotated cluster %s to be ready, current status is %q", rotation.ReplacementID, rotation.ClusterID, replacement.Status)
+		return nil
+	}
+
+	if m.dataplaneClusterConfig.ClusterRotationConfig.Paused {
+		glog.Infof("cluster rotation is paused. Postponing the drain of rotated cluster %s", rotation.ClusterID)
+		return nil
+	}
+
+	// the drains of the rotations never allow data loss: the rotation fails if the rotated cluster received kafkas
+	// holding data while its replacement was being provisioned
+	drain := &dbapi.ClusterDrain{
+		ClusterID:       rotation.ClusterID,
+		TargetClusterID: replacement.ClusterID,
+	}
+	if serviceErr := m.clusterDrainService.Create(drain); serviceErr != nil {
+		if !serviceErr.IsClientErrorClass() {
+			return serviceErr
+		}
+		// the drain may have been created by a previous run that failed to update the status of the rotation
+		existingDrain, getErr := m.clusterDrainService.GetByClusterID(rotation.ClusterID)
+		if getErr != nil && !getErr.Is404() {
+			return getErr
+		}
+		if existingDrain == nil || existingDrain.Status != dbapi.ClusterDrainStatusInProgress || existingDrain.TargetClusterID != replacement.ClusterID {
+			return m.failRotation(rotation, fmt.Sprintf("cluster %s cannot be drained: %s", rotation.ClusterID, serviceErr.Reason))
+		}
+	}
+
+	glog.Infof("replacement cluster %s of rotated cluster %s is ready, draining the rotated cluster", replacement.ClusterID, rotation.ClusterID)
+	if err := m.clusterRotationService.UpdateStatus(rotation, dbapi.ClusterRotationStatusDraining, ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// reconcileDrainingRotation completes the rotation once the drain of the rotated cluster has completed, the rotated
+// cluster being handed over for deprovisioning by the drain.
+func (m *ClusterRotationManager) reconcileDrainingRotation(rotation *dbapi.ClusterRotation) error {
+	drain, serviceErr := m.clusterDrainService.GetByClusterID(rotation.ClusterID)
+	if serviceErr != nil {
+		if serviceErr.Is404() {
+			return m.failRotation(rotation, fmt.Sprintf("no drain found for cluster %s", rotation.ClusterID))
+		}
+		return serviceErr
+	}
+
+	switch drain.Status {
+	case dbapi.ClusterDrainStatusCompleted:
+		glog.Infof("rotation of cluster %s to cluster %s completed", rotation.ClusterID, rotation.ReplacementClusterID)
+		if err := m.clusterRotationService.UpdateStatus(rotation, dbapi.ClusterRotationStatusCompleted, ""); err != nil {
+			return err
+		}
+	case dbapi.ClusterDrainStatusFailed:
+		return m.failRotation(rotation, fmt.Sprintf("drain of cluster %s failed: %s", rotation.ClusterID, drain.StatusReason))
+	}
+
+	return nil
+}
+
+// startRotations registers a replacement cluster for each outdated cluster, oldest first, until there are no more
+// slots for concurrent rotations
+func (m *ClusterRotationManager) startRotations(slots int, now time.Time) error {
+	if slots <= 0 {
+		glog.Infoln("maximum number of concurrent cluster rotations reached. No new cluster rotation is started")
+		return nil
+	}
+
+	// a cluster whose rotation failed is only rotated again once the failed rotation backoff has elapsed
+	failedRotationsSince := now.Add(-m.dataplaneClusterConfig.ClusterRotationConfig.FailedRotationBackoff)
+	candidates, serviceErr := m.clusterRotationService.ListCandidates(failedRotationsSince)
+	if serviceErr != nil {
+		return errors.Wrap(serviceErr, "failed to list cluster rotation candidates")
+	}
+
+	for _, cluster := range candidates {
+		if slots <= 0 {
+			break
+		}
+
+		reason, err := m.findRotationReason(cluster, now)
+		if err != nil {
+			return errors.Wrapf(err, "failed to evaluate rotation of cluster %s", cluster.ClusterID)
+		}
+		if reason == "" {
+			continue
+		}
+
+		if err := m.startRotation(cluster, reason); err != nil {
+			return errors.Wrapf(err, "failed to start rotation of cluster %s", cluster.ClusterID)
+		}
+		slots--
+	}
+
+	return nil
+}
+
+// findRotationReason returns why the cluster has to be rotated. An empty reason is returned if the cluster is not outdated.
+func (m *ClusterRotationManager) findRotationReason(cluster *api.Cluster, now time.Time) (string, error) {
+	rotationConfig := &m.dataplaneClusterConfig.ClusterRotationConfig
+
+	if rotationConfig.MaxClusterAge > 0 && now.Sub(cluster.CreatedAt) > rotationConfig.MaxClusterAge {
+		return fmt.Sprintf("cluster is older than %s", rotationConfig.MaxClusterAge), nil
+	}
+
+	if rotationConfig.MinOpenShiftVersion != "" {
+		version, serviceErr := m.clusterService.GetClusterVersion(cluster)
+		if serviceErr != nil {
+			return "", serviceErr
+		}
+		if rotationConfig.IsOpenShiftVersionOutdated(version) {
+			return fmt.Sprintf("OpenShift version %s is older than %s", version, rotationConfig.MinOpenShiftVersion), nil
+		}
+	}
+
+	return "", nil
+}
+
+// startRotation registers a replacement cluster with the same cloud provider, region, availability zones and
+// supported instance types as the rotated cluster
+func (m *ClusterRotationManager) startRotation(cluster *api.Cluster, reason string) error {
+	replacement := &api.Cluster{
+		CloudProvider:         cluster.CloudProvider,
+		Region:                cluster.Region,
+		MultiAZ:               cluster.MultiAZ,
+		SupportedInstanceType: cluster.SupportedInstanceType,
+		Status:                api.ClusterAccepted,
+		ProviderType:          api.ClusterProviderOCM,
+	}
+
+	glog.Infof("rotating cluster %s: %s", cluster.ClusterID, reason)
+	if err := m.clusterService.RegisterClusterJob(replacement); err != nil {
+		return err
+	}
+
+	// a replacement registered without its rotation is empty and is removed by the dynamic scale down
+	rotation := &dbapi.ClusterRotation{
+		ClusterID:     cluster.ClusterID,
+		ReplacementID: replacement.ID,
+		Reason:        reason,
+	}
+	if err := m.clusterRotationService.Create(rotation); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// failRotation hands the replacement cluster over for deprovisioning before stopping the rotation. A replacement
+// that already received kafkas from the rotated cluster is brought back to ready by the deprovisioning, as any other
+// non empty cluster, so that those kafkas keep running.
+func (m *ClusterRotationManager) failRotation(rotation *dbapi.ClusterRotation, reason string) error {
+	glog.Infof("stopping rotation of cluster %s: %s", rotation.ClusterID, reason)
+
+	// the replacement is deprovisioned first so that it is not left behind if the rotation fails to be updated:
+	// the rotation is still in progress and is failed again by the next reconcile
+	replacement, serviceErr := m.clusterRotationService.FindReplacementCluster(rotation)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	switch {
+	case replacement == nil || replacement.Status == api.ClusterDeprovisioning || replacement.Status == api.ClusterCleanup:
+		// the replacement is already gone or being deprovisioned
+	case replacement.ClusterID == "":
+		// the replacement was never created by the provider. There is nothing to deprovision and its record is left
+		// in its current status for an operator to investigate
+		glog.Infof("replacement cluster %s of rotated cluster %s was never created by the provider, leaving it in %q status", rotation.ReplacementID, rotation.ClusterID, replacement.Status)
+	default:
+		glog.Infof("deprovisioning replacement cluster %s of rotated cluster %s", rotation.ReplacementID, rotation.ClusterID)
+		if err := m.clusterService.UpdateStatus(*replacement, api.ClusterDeprovisioning); err != nil {
+			return errors.Wrapf(err, "failed to deprovision replacement cluster %s", rotation.ReplacementID)
+		}
+	}
+
+	if err := m.clusterRotationService.UpdateStatus(rotation, dbapi.ClusterRotationStatusFailed, reason); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,364 @@
+package cluster_mgrs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+const (
+	rotatedClusterID     = "rotated-cluster-id"
+	replacementID        = "replacement-id"
+	replacementClusterID = "replacement-cluster-id"
+)
+
+func buildClusterRotationConfig(modifyFn func(c *config.ClusterRotationConfig)) *config.DataplaneClusterConfig {
+	dataplaneClusterConfig := &config.DataplaneClusterConfig{
+		DataPlaneClusterScalingType: config.AutoScaling,
+		ClusterRotationConfig:       config.NewClusterRotationConfig(),
+	}
+	dataplaneClusterConfig.ClusterRotationConfig.Enabled = true
+	dataplaneClusterConfig.ClusterRotationConfig.MaxClusterAge = 24 * time.Hour
+	if modifyFn != nil {
+		modifyFn(&dataplaneClusterConfig.ClusterRotationConfig)
+	}
+	return dataplaneClusterConfig
+}
+
+func buildClusterRotation(status dbapi.ClusterRotationStatus) *dbapi.ClusterRotation {
+	return &dbapi.ClusterRotation{
+		Meta:          api.Meta{ID: "rotation-id"},
+		ClusterID:     rotatedClusterID,
+		ReplacementID: replacementID,
+		Status:        status,
+	}
+}
+
+func TestClusterRotationManager_Reconcile(t *testing.T) {
+	type fields struct {
+		dataplaneClusterConfig *config.DataplaneClusterConfig
+		rotation               *dbapi.ClusterRotation
+		replacement            *api.Cluster
+		drain                  *dbapi.ClusterDrain
+		createDrainErr         *errors.ServiceError
+		candidates             []*api.Cluster
+		clusterVersion         string
+	}
+
+	tests := []struct {
+		name                          string
+		fields                        fields
+		wantErr                       bool
+		wantRotationStatus            dbapi.ClusterRotationStatus
+		wantReplacementClusterID      string
+		wantDrains                    int
+		wantRegisteredClusters        int
+		wantRotationReasons           []string
+		wantListInProgressCalls       int
+		wantReplacementClusterIDCalls int
+		wantDeprovisionedReplacement  bool
+	}{
+		{
+			name: "should not reconcile when cluster rotation is disabled",
+			fields: fields{
+				dataplaneClusterConfig: buildClusterRotationConfig(func(c *config.ClusterRotationConfig) {
+					c.Enabled = false
+				}),
+			},
+			wantListInProgressCalls: 0,
+		},
+		{
+			name: "should record the cluster id of the replacement while it is being provisioned",
+			fields: fields{
+				dataplaneClusterConfig: buildClusterRotationConfig(nil),
+				rotation:               buildClusterRotation(dbapi.ClusterRotationStatusProvisioning),
+				replacement:            &api.Cluster{Meta: api.Meta{ID: replacementID}, ClusterID: replacementClusterID, Status: api.ClusterProvisioning},
+			},
+			wantRotationStatus:            dbapi.ClusterRotationStatusProvisioning,
+			wantReplacementClusterID:      replacementClusterID,
+			wantListInProgressCalls:       1,
+			wantReplacementClusterIDCalls: 1,
+		},
+		{
+			name: "should drain the rotated cluster to its replacement once it is ready",
+			fields: fields{
+				dataplaneClusterConfig: buildClusterRotationConfig(nil),
+				rotation:               buildClusterRotation(dbapi.ClusterRotationStatusProvisioning),
+				replacement:            &api.Cluster{Meta: api.Meta{ID: replacementID}, ClusterID: replacementClusterID, Status: api.ClusterReady},
+			},
+			wantRotationStatus:            dbapi.ClusterRotationStatusDraining,
+			wantReplacementClusterID:      replacementClusterID,
+			wantDrains:                    1,
+			wantListInProgressCalls:       1,
+			wantReplacementClusterIDCalls: 1,
+		},
+		{
+			name: "should not drain the rotated cluster when cluster rotation is paused",
+			fields: fields{
+				dataplaneClusterConfig: buildClusterRotationConfig(func(c *config.ClusterRotationConfig) {
+					c.Paused = true
+				}),
+				rotation:    buildClusterRotation(dbapi.ClusterRotationStatusProvisioning),
+				replacement: &api.Cluster{Meta: api.Meta{ID: replacementID}, ClusterID: replacementClusterID, Status: api.ClusterReady},
+				candidates:  []*api.Cluster{{ClusterID: "old-cluster-id", Meta: api.Meta{CreatedAt: time.Now().Add(-48 * time.Hour)}}},
+			},
+			wantRotationStatus:            dbapi.ClusterRotationStatusProvisioning,
+			wantReplacementClusterID:      replacementClusterID,
+			wantListInProgressCalls:       1,
+			wantReplacementClusterIDCalls: 1,
+		},
+		{
+			name: "should move the rotation to draining if the drain of the rotated cluster to its replacement already exists",
+			fields: fields{
+				dataplaneClusterConfig: buildClusterRotationConfig(nil),
+				rotation: func() *dbapi.ClusterRotation {
+					rotation := buildClusterRotation(dbapi.ClusterRotationStatusProvisioning)
+					rotation.ReplacementClusterID = replacementClusterID
+					return rotation
+				}(),
+				replacement:    &api.Cluster{Meta: api.Meta{ID: replacementID}, ClusterID: replacementClusterID, Status: api.ClusterReady},
+				createDrainErr: errors.Conflict("cluster %q is already being drained", rotatedClusterID),
+				drain:          &dbapi.ClusterDrain{ClusterID: rotatedClusterID, TargetClusterID: replacementClusterID, Status: dbapi.ClusterDrainStatusInProgress},
+			},
+			wantRotationStatus:       dbapi.ClusterRotationStatusDraining,
+			wantReplacementClusterID: replacementClusterID,
+			wantDrains:               1,
+			wantListInProgressCalls:  1,
+		},
+		{
+			name: "should fail the rotation if the rotated cluster cannot be drained",
+			fields: fields{
+				dataplaneClusterConfig: buildClusterRotationConfig(nil),
+				rotation: func() *dbapi.ClusterRotation {
+					rotation := buildClusterRotation(dbapi.ClusterRotationStatusProvisioning)
+					rotation.ReplacementClusterID = replacementClusterID
+					return rotation
+				}(),
+				replacement:    &api.Cluster{Meta: api.Meta{ID: replacementID}, ClusterID: replacementClusterID, Status: api.ClusterReady},
+				createDrainErr: errors.BadRequest("cluster %q cannot be drained as it is in %q status", rotatedClusterID, api.ClusterDeprovisioning),
+			},
+			wantRotationStatus:           dbapi.ClusterRotationStatusFailed,
+			wantReplacementClusterID:     replacementClusterID,
+			wantDrains:                   1,
+			wantListInProgressCalls:      1,
+			wantDeprovisionedReplacement: true,
+		},
+		{
+			name: "should fail the rotation and deprovision the replacement if the replacement failed to be provisioned",
+			fields: fields{
+				dataplaneClusterConfig: buildClusterRotationConfig(nil),
+				rotation: func() *dbapi.ClusterRotation {
+					rotation := buildClusterRotation(dbapi.ClusterRotationStatusProvisioning)
+					rotation.ReplacementClusterID = replacementClusterID
+					return rotation
+				}(),
+				replacement: &api.Cluster{Meta: api.Meta{ID: replacementID}, ClusterID: replacementClusterID, Status: api.ClusterFailed},
+			},
+			wantRotationStatus:           dbapi.ClusterRotationStatusFailed,
+			wantReplacementClusterID:     replacementClusterID,
+			wantListInProgressCalls:      1,
+			wantDeprovisionedReplacement: true,
+		},
+		{
+			name: "should fail the rotation without deprovisioning the replacement if it was never created by the provider",
+			fields: fields{
+				dataplaneClusterConfig: buildClusterRotationConfig(nil),
+				rotation:               buildClusterRotation(dbapi.ClusterRotationStatusProvisioning),
+				replacement:            &api.Cluster{Meta: api.Meta{ID: replacementID}, Status: api.ClusterFailed},
+			},
+			wantRotationStatus:      dbapi.ClusterRotationStatusFailed,
+			wantListInProgressCalls: 1,
+		},
+		{
+			name: "should complete the rotation once the drain of the rotated cluster has completed",
+			fields: fields{
+				dataplaneClusterConfig: buildClusterRotationConfig(nil),
+				rotation:               buildClusterRotation(dbapi.ClusterRotationStatusDraining),
+				drain:                  &dbapi.ClusterDrain{ClusterID: rotatedClusterID, Status: dbapi.ClusterDrainStatusCompleted},
+			},
+			wantRotationStatus:      dbapi.ClusterRotationStatusCompleted,
+			wantListInProgressCalls: 1,
+		},
+		{
+			name: "should fail the rotation if the drain of the rotated cluster failed",
+			fields: fields{
+				dataplaneClusterConfig: buildClusterRotationConfig(nil),
+				rotation:               buildClusterRotation(dbapi.ClusterRotationStatusDraining),
+				drain:                  &dbapi.ClusterDrain{ClusterID: rotatedClusterID, Status: dbapi.ClusterDrainStatusFailed},
+			},
+			wantRotationStatus:      dbapi.ClusterRotationStatusFailed,
+			wantListInProgressCalls: 1,
+		},
+		{
+			name: "should not start a new rotation when the maximum number of concurrent rotations is reached",
+			fields: fields{
+				dataplaneClusterConfig: buildClusterRotationConfig(nil),
+				rotation:               buildClusterRotation(dbapi.ClusterRotationStatusDraining),
+				drain:                  &dbapi.ClusterDrain{ClusterID: rotatedClusterID, Status: dbapi.ClusterDrainStatusInProgress},
+				candidates:             []*api.Cluster{{ClusterID: "old-cluster-id", Meta: api.Meta{CreatedAt: time.Now().Add(-48 * time.Hour)}}},
+			},
+			wantRotationStatus:      dbapi.ClusterRotationStatusDraining,
+			wantListInProgressCalls: 1,
+		},
+		{
+			name: "should rotate the clusters older than the max cluster age",
+			fields: fields{
+				dataplaneClusterConfig: buildClusterRotationConfig(func(c *config.ClusterRotationConfig) {
+					c.MaxConcurrentRotations = 2
+				}),
+				candidates: []*api.Cluster{
+					{ClusterID: "old-cluster-id", Meta: api.Meta{CreatedAt: time.Now().Add(-48 * time.Hour)}},
+					{ClusterID: "new-cluster-id", Meta: api.Meta{CreatedAt: time.Now().Add(-1 * time.Hour)}},
+				},
+			},
+			wantRegisteredClusters:  1,
+			wantRotationReasons:     []string{"cluster is older than 24h0m0s"},
+			wantListInProgressCalls: 1,
+		},
+		{
+			name: "should rotate the clusters with an OpenShift version lower than the min OpenShift version",
+			fields: fields{
+				dataplaneClusterConfig: buildClusterRotationConfig(func(c *config.ClusterRotationConfig) {
+					c.MaxClusterAge = 0
+					c.MinOpenShiftVersion = "4.11.0"
+				}),
+				candidates:     []*api.Cluster{{ClusterID: "new-cluster-id", Meta: api.Meta{CreatedAt: time.Now().Add(-1 * time.Hour)}}},
+				clusterVersion: "4.10.38",
+			},
+			wantRegisteredClusters:  1,
+			wantRotationReasons:     []string{"OpenShift version 4.10.38 is older than 4.11.0"},
+			wantListInProgressCalls: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			var rotations []*dbapi.ClusterRotation
+			if tt.fields.rotation != nil {
+				rotations = append(rotations, tt.fields.rotation)
+			}
+
+			clusterRotationService := &services.ClusterRotationServiceMock{
+				ListInProgressFunc: func() ([]*dbapi.ClusterRotation, *errors.ServiceError) {
+					return rotations, nil
+				},
+				FindReplacementClusterFunc: func(rotation *dbapi.ClusterRotation) (*api.Cluster, *errors.ServiceError) {
+					return tt.fields.replacement, nil
+				},
+				UpdateReplacementClusterIDFunc: func(rotation *dbapi.ClusterRotation, replacementClusterID string) *errors.ServiceError {
+					rotation.ReplacementClusterID = replacementClusterID
+					return nil
+				},
+				UpdateStatusFunc: func(rotation *dbapi.ClusterRotation, status dbapi.ClusterRotationStatus, reason string) *errors.ServiceError {
+					rotation.Status = status
+					rotation.StatusReason = reason
+					return nil
+				},
+				ListCandidatesFunc: func(failedRotationsSince time.Time) ([]*api.Cluster, *errors.ServiceError) {
+					return tt.fields.candidates, nil
+				},
+				CreateFunc: func(rotation *dbapi.ClusterRotation) *errors.ServiceError {
+					return nil
+				},
+			}
+			clusterDrainService := &services.ClusterDrainServiceMock{
+				CreateFunc: func(drain *dbapi.ClusterDrain) *errors.ServiceError {
+					return tt.fields.createDrainErr
+				},
+				GetByClusterIDFunc: func(clusterID string) (*dbapi.ClusterDrain, *errors.ServiceError) {
+					if tt.fields.drain == nil {
+						return nil, errors.NotFound("no drain found for cluster with cluster_id='%v'", clusterID)
+					}
+					return tt.fields.drain, nil
+				},
+			}
+			clusterService := &services.ClusterServiceMock{
+				GetClusterVersionFunc: func(cluster *api.Cluster) (string, *errors.ServiceError) {
+					return tt.fields.clusterVersion, nil
+				},
+				RegisterClusterJobFunc: func(clusterRequest *api.Cluster) *errors.ServiceError {
+					clusterRequest.ID = replacementID
+					return nil
+				},
+				UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
+					return nil
+				},
+			}
+			m := NewClusterRotationManager(w.Reconciler{}, tt.fields.dataplaneClusterConfig, clusterRotationService, clusterDrainService, clusterService)
+
+			g.Expect(len(m.Reconcile()) > 0).To(gomega.Equal(tt.wantErr))
+			g.Expect(clusterRotationService.ListInProgressCalls()).To(gomega.HaveLen(tt.wantListInProgressCalls))
+			g.Expect(clusterRotationService.UpdateReplacementClusterIDCalls()).To(gomega.HaveLen(tt.wantReplacementClusterIDCalls))
+			if tt.fields.rotation != nil {
+				g.Expect(tt.fields.rotation.Status).To(gomega.Equal(tt.wantRotationStatus))
+				g.Expect(tt.fields.rotation.ReplacementClusterID).To(gomega.Equal(tt.wantReplacementClusterID))
+			}
+
+			drainCalls := clusterDrainService.CreateCalls()
+			g.Expect(drainCalls).To(gomega.HaveLen(tt.wantDrains))
+			for _, call := range drainCalls {
+				g.Expect(call.Drain.ClusterID).To(gomega.Equal(rotatedClusterID))
+				g.Expect(call.Drain.TargetClusterID).To(gomega.Equal(replacementClusterID))
+			}
+
+			updateStatusCalls := clusterService.UpdateStatusCalls()
+			if tt.wantDeprovisionedReplacement {
+				g.Expect(updateStatusCalls).To(gomega.HaveLen(1))
+				g.Expect(updateStatusCalls[0].Cluster.ID).To(gomega.Equal(replacementID))
+				g.Expect(updateStatusCalls[0].Status).To(gomega.Equal(api.ClusterDeprovisioning))
+			} else {
+				g.Expect(updateStatusCalls).To(gomega.BeEmpty())
+			}
+
+			g.Expect(clusterService.RegisterClusterJobCalls()).To(gomega.HaveLen(tt.wantRegisteredClusters))
+			createCalls := clusterRotationService.CreateCalls()
+			g.Expect(createCalls).To(gomega.HaveLen(len(tt.wantRotationReasons)))
+			for i, reason := range tt.wantRotationReasons {
+				g.Expect(createCalls[i].Rotation.ReplacementID).To(gomega.Equal(replacementID))
+				g.Expect(createCalls[i].Rotation.Reason).To(gomega.Equal(reason))
+			}
+		})
+	}
+
+	t.Run("should not list the clusters whose rotation failed within the failed rotation backoff as candidates", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		clusterRotationService := &services.ClusterRotationServiceMock{
+			ListInProgressFunc: func() ([]*dbapi.ClusterRotation, *errors.ServiceError) {
+				return nil, nil
+			},
+			ListCandidatesFunc: func(failedRotationsSince time.Time) ([]*api.Cluster, *errors.ServiceError) {
+				return nil, nil
+			},
+		}
+		dataplaneClusterConfig := buildClusterRotationConfig(func(c *config.ClusterRotationConfig) {
+			c.FailedRotationBackoff = 2 * time.Hour
+		})
+		m := NewClusterRotationManager(w.Reconciler{}, dataplaneClusterConfig, clusterRotationService, &services.ClusterDrainServiceMock{}, &services.ClusterServiceMock{})
+		g.Expect(m.Reconcile()).To(gomega.BeEmpty())
+		calls := clusterRotationService.ListCandidatesCalls()
+		g.Expect(calls).To(gomega.HaveLen(1))
+		g.Expect(calls[0].FailedRotationsSince).To(gomega.BeTemporally("~", time.Now().Add(-2*time.Hour), time.Minute))
+	})
+
+	t.Run("should return an error if listing the rotations in progress fails", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		clusterRotationService := &services.ClusterRotationServiceMock{
+			ListInProgressFunc: func() ([]*dbapi.ClusterRotation, *errors.ServiceError) {
+				return nil, errors.GeneralError("failed to list cluster rotations")
+			},
+		}
+		m := NewClusterRotationManager(w.Reconciler{}, buildClusterRotationConfig(nil), clusterRotationService, &services.ClusterDrainServiceMock{}, &services.ClusterServiceMock{})
+		g.Expect(m.Reconcile()).To(gomega.HaveLen(1))
+	})
+}
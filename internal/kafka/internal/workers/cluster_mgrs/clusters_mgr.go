@@ -67,6 +67,7 @@ var clusterMetricsStatuses = []api.ClusterStatus{
 	api.ClusterWaitingForKasFleetShardOperator,
 	api.ClusterReady,
 	api.ClusterFull,
+	api.ClusterQuarantined,
 	api.ClusterFailed,
 	api.ClusterDeprovisioning,
 }
@@ -115,6 +116,8 @@ type ClusterManagerOptions struct {
 	ProviderFactory            clusters.ProviderFactory
 }
 
+var _ services.ClusterResourcesReconciler = &ClusterManager{}
+
 type processor func() []error
 
 // NewClusterManager creates a new cluster manager.
@@ -384,7 +387,7 @@ func (c *ClusterManager) reconcileReadyCluster(cluster api.Cluster) error {
 	}
 
 	// resources update if needed
-	if err := c.reconcileClusterResources(cluster); err != nil {
+	if err := c.ReconcileClusterResources(cluster); err != nil {
 		return errors.WithMessagef(err, "failed to reconcile ready cluster resources %s ", cluster.ClusterID)
 	}
 
@@ -478,7 +481,7 @@ func (c *ClusterManager) reconcileDynamicCapacityInfo(cluster api.Cluster) error
 }
 
 func (c *ClusterManager) reconcileWaitingForKasFleetshardOperatorCluster(cluster api.Cluster) error {
-	if err := c.reconcileClusterResources(cluster); err != nil {
+	if err := c.ReconcileClusterResources(cluster); err != nil {
 		return errors.WithMessagef(err, "failed to reconcile  waiting for Kas Fleetshard Operator cluster resources '%s'", cluster.ClusterID)
 	}
 
@@ -509,7 +512,7 @@ func (c *ClusterManager) reconcileProvisionedCluster(cluster api.Cluster) error
 	}
 
 	// SyncSet creation step
-	syncSetErr := c.reconcileClusterResources(cluster) //OSD cluster itself
+	syncSetErr := c.ReconcileClusterResources(cluster) //OSD cluster itself
 	if syncSetErr != nil {
 		return errors.WithMessagef(syncSetErr, "failed to reconcile cluster %s SyncSet: %s", cluster.ClusterID, syncSetErr.Error())
 	}
@@ -560,7 +563,9 @@ func (c *ClusterManager) reconcileKasFleetshardOperator(cluster api.Cluster) err
 	return nil
 }
 
-func (c *ClusterManager) reconcileClusterResources(cluster api.Cluster) error {
+// ReconcileClusterResources applies the resources managed by kas-fleet-manager to the given data plane cluster.
+// It is also used by the admin API to apply the resources on demand.
+func (c *ClusterManager) ReconcileClusterResources(cluster api.Cluster) error {
 	resourceSet := c.buildResourceSet(cluster)
 	if err := c.ClusterService.ApplyResources(&cluster, resourceSet); err != nil {
 		return errors.Wrapf(err, "failed to apply resources for cluster %s", cluster.ClusterID)
@@ -679,6 +684,12 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 			ClusterDNS:            p.ClusterDNS,
 			SupportedInstanceType: p.SupportedInstanceType,
 		}
+		if err := clusterRequest.SetLabels(p.Labels); err != nil {
+			return []error{errors.Wrapf(err, "failed to set labels of new cluster %s with config file", p.ClusterId)}
+		}
+		if err := clusterRequest.SetTaints(p.Taints); err != nil {
+			return []error{errors.Wrapf(err, "failed to set taints of new cluster %s with config file", p.ClusterId)}
+		}
 		if err := c.ClusterService.RegisterClusterJob(&clusterRequest); err != nil {
 			return []error{errors.Wrapf(err, "failed to register new cluster %s with config file", p.ClusterId)}
 		} else {
@@ -2432,7 +2432,7 @@ func TestClusterManager_reconcileClusterResourceSet(t *testing.T) {
 				},
 			}
 
-			g.Expect(c.reconcileClusterResources(tt.arg) != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(c.ReconcileClusterResources(tt.arg) != nil).To(gomega.Equal(tt.wantErr))
 		})
 	}
 }
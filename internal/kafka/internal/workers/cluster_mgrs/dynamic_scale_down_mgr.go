@@ -1,6 +1,8 @@
 package cluster_mgrs
 
 import (
+	"time"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	fleeterrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
@@ -19,10 +21,12 @@ const (
 type DynamicScaleDownManager struct {
 	workers.BaseWorker
 
-	dataplaneClusterConfig *config.DataplaneClusterConfig
-	clusterProvidersConfig *config.ProviderConfig
-	kafkaConfig            *config.KafkaConfig
-	clusterService         services.ClusterService
+	dataplaneClusterConfig     *config.DataplaneClusterConfig
+	clusterProvidersConfig     *config.ProviderConfig
+	kafkaConfig                *config.KafkaConfig
+	clusterService             services.ClusterService
+	capacityReservationService services.CapacityReservationService
+	clusterRotationService     services.ClusterRotationService
 }
 
 var _ workers.Worker = &DynamicScaleDownManager{}
@@ -33,6 +37,8 @@ func NewDynamicScaleDownManager(
 	clusterProvidersConfig *config.ProviderConfig,
 	kafkaConfig *config.KafkaConfig,
 	clusterService services.ClusterService,
+	capacityReservationService services.CapacityReservationService,
+	clusterRotationService services.ClusterRotationService,
 ) *DynamicScaleDownManager {
 
 	return &DynamicScaleDownManager{
@@ -42,10 +48,12 @@ func NewDynamicScaleDownManager(
 			Reconciler: reconciler,
 		},
 
-		dataplaneClusterConfig: dataplaneClusterConfig,
-		clusterProvidersConfig: clusterProvidersConfig,
-		kafkaConfig:            kafkaConfig,
-		clusterService:         clusterService,
+		dataplaneClusterConfig:     dataplaneClusterConfig,
+		clusterProvidersConfig:     clusterProvidersConfig,
+		kafkaConfig:                kafkaConfig,
+		clusterService:             clusterService,
+		capacityReservationService: capacityReservationService,
+		clusterRotationService:     clusterRotationService,
 	}
 }
 
@@ -84,8 +92,44 @@ func (m *DynamicScaleDownManager) processDynamicScaleDownReconcileEvent() error
 		return errList
 	}
 
+	capacityReservationUsages, serviceErr := m.capacityReservationService.ListActiveUsages()
+	if serviceErr != nil {
+		errList.AddErrors(serviceErr)
+		return errList
+	}
+
+	// clusters that the predictive scale up evaluation would need are not removed
+	var predictiveScaleUpConfig *config.PredictiveScaleUpConfig
+	var kafkaStreamingUnitCreations services.KafkaStreamingUnitCreationList
+	if m.dataplaneClusterConfig.DynamicScalingConfig.IsPredictiveScaleUpEnabled() {
+		predictiveScaleUpConfig = &m.dataplaneClusterConfig.DynamicScalingConfig.PredictiveScaleUp
+		kafkaStreamingUnitCreations, err = m.clusterService.FindStreamingUnitsCreatedSince(time.Now().Add(-predictiveScaleUpConfig.ObservationWindow))
+		if err != nil {
+			errList.AddErrors(err)
+			return errList
+		}
+	}
+
+	rotations, serviceErr := m.clusterRotationService.ListInProgress()
+	if serviceErr != nil {
+		errList.AddErrors(serviceErr)
+		return errList
+	}
+
 	processedClusters := m.createAMapOfProcessedClusters(kafkaStreamingUnitCountPerClusterList)
 
+	// the replacement clusters of the rotations in progress are empty until the kafkas of the rotated clusters are
+	// migrated to them, they are therefore skipped from scale down evaluation
+	for _, rotation := range rotations {
+		if existing, ok := processedClusters[rotation.ReplacementClusterID]; ok {
+			glog.V(10).Infof("cluster with cluster id %q is the replacement of rotated cluster %q. Skipping it from scale down evaluation", rotation.ReplacementClusterID, rotation.ClusterID)
+			processedClusters[rotation.ReplacementClusterID] = processed{
+				indexesOfStreamingUnitForSameClusterID: existing.indexesOfStreamingUnitForSameClusterID,
+				processed:                              true,
+			}
+		}
+	}
+
 	for _, suCount := range kafkaStreamingUnitCountPerClusterList {
 		clusterID := suCount.ClusterId
 		existing := processedClusters[clusterID]
@@ -103,6 +147,9 @@ func (m *DynamicScaleDownManager) processDynamicScaleDownReconcileEvent() error
 
 		var dynamicScaleDownProcessor dynamicScaleDownProcessor = &standardDynamicScaleDownProcessor{
 			kafkaStreamingUnitCountPerClusterList:  kafkaStreamingUnitCountPerClusterList,
+			capacityReservationUsages:              capacityReservationUsages,
+			kafkaStreamingUnitCreations:            kafkaStreamingUnitCreations,
+			predictiveScaleUpConfig:                predictiveScaleUpConfig,
 			regionsSupportedInstanceType:           regionsSupportedInstanceType,
 			supportedKafkaInstanceTypesConfig:      &m.kafkaConfig.SupportedInstanceTypes.Configuration,
 			clusterService:                         m.clusterService,
@@ -225,8 +272,13 @@ type standardDynamicScaleDownProcessor struct {
 	// indexesOfStreamingUnitForSameClusterID is the index of the straming unit in the "kafkaStreamingUnitCountPerClusterList" that has the
 	// same "clusterID" as the one that's current being processed
 	indexesOfStreamingUnitForSameClusterID []int
-	supportedKafkaInstanceTypesConfig      *config.SupportedKafkaInstanceTypesConfig
-	clusterService                         services.ClusterService
+	capacityReservationUsages              services.CapacityReservationUsageList
+	// kafkaStreamingUnitCreations and predictiveScaleUpConfig are only set
+	// when predictive scale up is enabled
+	kafkaStreamingUnitCreations       services.KafkaStreamingUnitCreationList
+	predictiveScaleUpConfig           *config.PredictiveScaleUpConfig
+	supportedKafkaInstanceTypesConfig *config.SupportedKafkaInstanceTypesConfig
+	clusterService                    services.ClusterService
 
 	// dryRun controls whether the ScaleDown method performs real actions.
 	// Useful when you don't want to trigger a real scale down.
@@ -335,14 +387,23 @@ func (p *standardDynamicScaleDownProcessor) isScaleUpNeededAfterCandidateCluster
 			continue
 		}
 
-		dynamicScaleUpProcessor := &standardDynamicScaleUpProcessor{
+		standardProcessor := &standardDynamicScaleUpProcessor{
 			locator:                               currLocator,
 			instanceTypeConfig:                    &instanceTypeConfig,
 			kafkaStreamingUnitCountPerClusterList: newkafkaStreamingUnitCountPerClusterList,
+			capacityReservationUsages:             p.capacityReservationUsages,
 			supportedKafkaInstanceTypesConfig:     p.supportedKafkaInstanceTypesConfig,
 			clusterService:                        p.clusterService,
 			dryRun:                                true,
 		}
+		var dynamicScaleUpProcessor dynamicScaleUpProcessor = standardProcessor
+		if p.predictiveScaleUpConfig != nil {
+			dynamicScaleUpProcessor = &predictiveDynamicScaleUpProcessor{
+				standardDynamicScaleUpProcessor: standardProcessor,
+				kafkaStreamingUnitCreations:     p.kafkaStreamingUnitCreations,
+				predictiveScaleUpConfig:         p.predictiveScaleUpConfig,
+			}
+		}
 
 		glog.Infof("evaluating whether deleting the cluster with cluster id %q would trigger scale up for locator '%+v'", p.clusterID, currLocator)
 		shouldScaleUp, err := dynamicScaleUpProcessor.ShouldScaleUp()
@@ -3,10 +3,13 @@ package cluster_mgrs
 import (
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/onsi/gomega"
 )
 
@@ -514,6 +517,7 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 		clusterProvidersConfig *config.ProviderConfig
 		kafkaConfig            *config.KafkaConfig
 		clusterService         services.ClusterService
+		clusterRotationService services.ClusterRotationService
 	}
 
 	tests := []struct {
@@ -554,6 +558,34 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should expect an error when predictive scale up is enabled and clusterService.FindStreamingUnitsCreatedSince returns an error",
+			fields: fields{
+				dataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType: config.AutoScaling,
+					DynamicScalingConfig: config.DynamicScalingConfig{
+						EnableDynamicScaleDownManagerScaleDownTrigger: true,
+						PredictiveScaleUp: config.PredictiveScaleUpConfig{
+							Enabled:           true,
+							ObservationWindow: time.Hour,
+							Lookahead:         time.Hour,
+						},
+					},
+				},
+				clusterService: &services.ClusterServiceMock{
+					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
+						return nil, nil
+					},
+					FindStreamingUnitsCreatedSinceFunc: func(since time.Time) (services.KafkaStreamingUnitCreationList, error) {
+						return nil, errors.New("some errors")
+					},
+					UpdateStatusFunc: nil, // should never be called
+				},
+				kafkaConfig:            nil, // should never be needed
+				clusterProvidersConfig: nil, // should never be needed
+			},
+			wantErr: true,
+		},
 		{
 			name: "Should not expect an error when clusterService.FindStreamingUnitCountByClusterAndInstanceType returns empty",
 			fields: fields{
@@ -1092,6 +1124,52 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 			wantErr:                   true,
 			wantUpdateStatusCallCount: 1,
 		},
+		{
+			name: "Should not scale down the replacement cluster of a rotation in progress",
+			fields: fields{
+				dataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType: config.AutoScaling,
+					DynamicScalingConfig: config.DynamicScalingConfig{
+						EnableDynamicScaleDownManagerScaleDownTrigger: true,
+					},
+				},
+				clusterService: &services.ClusterServiceMock{
+					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
+						return services.KafkaStreamingUnitCountPerClusterList{
+							services.KafkaStreamingUnitCountPerCluster{
+								Count:         0,
+								ClusterId:     "1",
+								Status:        api.ClusterReady.String(),
+								CloudProvider: "cp",
+								Region:        "r",
+							},
+						}, nil
+					},
+				},
+				clusterRotationService: &services.ClusterRotationServiceMock{
+					ListInProgressFunc: func() ([]*dbapi.ClusterRotation, *apiErrors.ServiceError) {
+						return []*dbapi.ClusterRotation{{ClusterID: "2", ReplacementClusterID: "1", Status: dbapi.ClusterRotationStatusDraining}}, nil
+					},
+				},
+				kafkaConfig: &config.KafkaConfig{
+					SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+						Configuration: config.SupportedKafkaInstanceTypesConfig{},
+					},
+				},
+				clusterProvidersConfig: &config.ProviderConfig{
+					ProvidersConfig: config.ProviderConfiguration{
+						SupportedProviders: config.ProviderList{
+							config.Provider{
+								Name:    "cp",
+								Regions: config.RegionList{},
+							},
+						},
+					},
+				},
+			},
+			wantErr:                   false,
+			wantUpdateStatusCallCount: 0,
+		},
 		{
 			name: "Should never call clusterService.UpdateStatus when dry run",
 			fields: fields{
@@ -1142,11 +1220,25 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 		tt := testcase
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
+			clusterRotationService := tt.fields.clusterRotationService
+			if clusterRotationService == nil {
+				clusterRotationService = &services.ClusterRotationServiceMock{
+					ListInProgressFunc: func() ([]*dbapi.ClusterRotation, *apiErrors.ServiceError) {
+						return nil, nil
+					},
+				}
+			}
 			mgr := DynamicScaleDownManager{
 				dataplaneClusterConfig: tt.fields.dataplaneClusterConfig,
 				clusterProvidersConfig: tt.fields.clusterProvidersConfig,
 				kafkaConfig:            tt.fields.kafkaConfig,
 				clusterService:         tt.fields.clusterService,
+				capacityReservationService: &services.CapacityReservationServiceMock{
+					ListActiveUsagesFunc: func() (services.CapacityReservationUsageList, *apiErrors.ServiceError) {
+						return nil, nil
+					},
+				},
+				clusterRotationService: clusterRotationService,
 			}
 
 			errs := mgr.Reconcile()
@@ -1,6 +1,9 @@
 package cluster_mgrs
 
 import (
+	"math"
+	"time"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	fleeterrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
@@ -23,7 +26,8 @@ type DynamicScaleUpManager struct {
 	ClusterProvidersConfig *config.ProviderConfig
 	KafkaConfig            *config.KafkaConfig
 
-	ClusterService services.ClusterService
+	ClusterService             services.ClusterService
+	CapacityReservationService services.CapacityReservationService
 }
 
 var _ workers.Worker = &DynamicScaleUpManager{}
@@ -34,6 +38,7 @@ func NewDynamicScaleUpManager(
 	clusterProvidersConfig *config.ProviderConfig,
 	kafkaConfig *config.KafkaConfig,
 	clusterService services.ClusterService,
+	capacityReservationService services.CapacityReservationService,
 ) *DynamicScaleUpManager {
 
 	return &DynamicScaleUpManager{
@@ -47,7 +52,8 @@ func NewDynamicScaleUpManager(
 		ClusterProvidersConfig: clusterProvidersConfig,
 		KafkaConfig:            kafkaConfig,
 
-		ClusterService: clusterService,
+		ClusterService:             clusterService,
+		CapacityReservationService: capacityReservationService,
 	}
 }
 
@@ -85,6 +91,22 @@ func (m *DynamicScaleUpManager) processDynamicScaleUpReconcileEvent() error {
 		return errList
 	}
 
+	capacityReservationUsages, serviceErr := m.CapacityReservationService.ListActiveUsages()
+	if serviceErr != nil {
+		errList.AddErrors(serviceErr)
+		return errList
+	}
+
+	predictiveScaleUpConfig := m.DataplaneClusterConfig.DynamicScalingConfig.PredictiveScaleUp
+	var kafkaStreamingUnitCreations services.KafkaStreamingUnitCreationList
+	if m.DataplaneClusterConfig.DynamicScalingConfig.IsPredictiveScaleUpEnabled() {
+		kafkaStreamingUnitCreations, err = m.ClusterService.FindStreamingUnitsCreatedSince(time.Now().Add(-predictiveScaleUpConfig.ObservationWindow))
+		if err != nil {
+			errList.AddErrors(err)
+			return errList
+		}
+	}
+
 	for _, provider := range m.ClusterProvidersConfig.ProvidersConfig.SupportedProviders {
 		for _, region := range provider.Regions {
 			for supportedInstanceTypeName := range region.SupportedInstanceTypes {
@@ -94,14 +116,23 @@ func (m *DynamicScaleUpManager) processDynamicScaleUpReconcileEvent() error {
 					instanceTypeName: supportedInstanceTypeName,
 				}
 				supportedInstanceTypeConfig := region.SupportedInstanceTypes[supportedInstanceTypeName]
-				var dynamicScaleUpProcessor dynamicScaleUpProcessor = &standardDynamicScaleUpProcessor{
+				standardProcessor := &standardDynamicScaleUpProcessor{
 					locator:                               currLocator,
 					instanceTypeConfig:                    &supportedInstanceTypeConfig,
 					kafkaStreamingUnitCountPerClusterList: kafkaStreamingUnitCountPerClusterList,
+					capacityReservationUsages:             capacityReservationUsages,
 					supportedKafkaInstanceTypesConfig:     &m.KafkaConfig.SupportedInstanceTypes.Configuration,
 					clusterService:                        m.ClusterService,
 					dryRun:                                !m.DataplaneClusterConfig.DynamicScalingConfig.IsDataplaneScaleUpTriggerEnabled(),
 				}
+				var dynamicScaleUpProcessor dynamicScaleUpProcessor = standardProcessor
+				if m.DataplaneClusterConfig.DynamicScalingConfig.IsPredictiveScaleUpEnabled() {
+					dynamicScaleUpProcessor = &predictiveDynamicScaleUpProcessor{
+						standardDynamicScaleUpProcessor: standardProcessor,
+						kafkaStreamingUnitCreations:     kafkaStreamingUnitCreations,
+						predictiveScaleUpConfig:         &predictiveScaleUpConfig,
+					}
+				}
 				glog.Infof("evaluating dynamic scale up for locator '%+v'", currLocator)
 				shouldScaleUp, err := dynamicScaleUpProcessor.ShouldScaleUp()
 				if err != nil {
@@ -186,8 +217,11 @@ type standardDynamicScaleUpProcessor struct {
 	// kafkaStreamingUnitCountPerClusterList must not contain any element
 	// with a Status attribute with value 'failed'
 	kafkaStreamingUnitCountPerClusterList services.KafkaStreamingUnitCountPerClusterList
-	supportedKafkaInstanceTypesConfig     *config.SupportedKafkaInstanceTypesConfig
-	clusterService                        services.ClusterService
+	// capacityReservationUsages are the usages of the active capacity reservations. The streaming units reserved and
+	// not consumed yet are considered as consumed
+	capacityReservationUsages         services.CapacityReservationUsageList
+	supportedKafkaInstanceTypesConfig *config.SupportedKafkaInstanceTypesConfig
+	clusterService                    services.ClusterService
 
 	// dryRun controls whether the ScaleUp method performs real actions.
 	// Useful when you don't want to trigger a real scale up.
@@ -232,6 +266,7 @@ func (p *standardDynamicScaleUpProcessor) ShouldScaleUp() (bool, error) {
 	summaryCalculator := instanceTypeConsumptionSummaryCalculator{
 		locator:                               p.locator,
 		kafkaStreamingUnitCountPerClusterList: p.kafkaStreamingUnitCountPerClusterList,
+		capacityReservationUsages:             p.capacityReservationUsages,
 		supportedKafkaInstanceTypesConfig:     p.supportedKafkaInstanceTypesConfig,
 	}
 
@@ -332,6 +367,82 @@ func (p *standardDynamicScaleUpProcessor) ongoingScaleUpAction(summary instanceT
 	return summary.ongoingScaleUpAction
 }
 
+// predictiveDynamicScaleUpProcessor is a dynamicScaleUpProcessor used when
+// predictive scale up is enabled. On top of the evaluation of the
+// standardDynamicScaleUpProcessor, it projects the streaming units of the
+// kafkas created during the observation window over the lookahead period so
+// that a new data plane cluster is ready before the demand arrives.
+type predictiveDynamicScaleUpProcessor struct {
+	*standardDynamicScaleUpProcessor
+	// kafkaStreamingUnitCreations are the streaming units of the kafkas created
+	// during the observation window
+	kafkaStreamingUnitCreations services.KafkaStreamingUnitCreationList
+	predictiveScaleUpConfig     *config.PredictiveScaleUpConfig
+}
+
+var _ dynamicScaleUpEvaluator = &predictiveDynamicScaleUpProcessor{}
+var _ dynamicScaleUpExecutor = &predictiveDynamicScaleUpProcessor{}
+var _ dynamicScaleUpProcessor = &predictiveDynamicScaleUpProcessor{}
+
+// ShouldScaleUp returns true if the standardDynamicScaleUpProcessor detects a
+// scale up need or if all the following conditions happen:
+//  1. If specified, the streaming units limit for the given instance type in
+//     the provider's region has not been reached
+//  2. There is no scale up action ongoing
+//  3. The free capacity for the given instance type in the provider's region
+//     minus the projected demand is smaller than the defined slack capacity.
+//     The projected demand is the creation rate of the observation window,
+//     in streaming units, multiplied by the lookahead period and rounded up
+//
+// Otherwise false is returned.
+func (p *predictiveDynamicScaleUpProcessor) ShouldScaleUp() (bool, error) {
+	shouldScaleUp, err := p.standardDynamicScaleUpProcessor.ShouldScaleUp()
+	if err != nil || shouldScaleUp {
+		return shouldScaleUp, err
+	}
+
+	summaryCalculator := instanceTypeConsumptionSummaryCalculator{
+		locator:                               p.locator,
+		kafkaStreamingUnitCountPerClusterList: p.kafkaStreamingUnitCountPerClusterList,
+		capacityReservationUsages:             p.capacityReservationUsages,
+		supportedKafkaInstanceTypesConfig:     p.supportedKafkaInstanceTypesConfig,
+	}
+
+	summary, err := summaryCalculator.Calculate()
+	if err != nil {
+		return false, err
+	}
+
+	if p.regionLimitReached(summary) || p.ongoingScaleUpAction(summary) {
+		return false, nil
+	}
+
+	projectedStreamingUnits := p.projectedStreamingUnits()
+	glog.Infof("projected demand for locator '%+v' over the next %s: '%v' streaming units", p.locator, p.predictiveScaleUpConfig.Lookahead, projectedStreamingUnits)
+	if projectedStreamingUnits == 0 {
+		return false, nil
+	}
+
+	if summary.freeStreamingUnits-projectedStreamingUnits < p.instanceTypeConfig.MinAvailableCapacitySlackStreamingUnits {
+		glog.Infof("there will not be enough capacity slack for locator '%+v' once the projected demand arrives. Cluster scale up action should be performed", p.locator)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// projectedStreamingUnits returns the streaming units expected to be consumed
+// during the lookahead period at the creation rate of the observation window
+func (p *predictiveDynamicScaleUpProcessor) projectedStreamingUnits() int {
+	createdStreamingUnits := p.kafkaStreamingUnitCreations.GetStreamingUnits(p.locator.provider, p.locator.region, p.locator.instanceTypeName)
+	if createdStreamingUnits == 0 || p.predictiveScaleUpConfig.ObservationWindow <= 0 {
+		return 0
+	}
+
+	ratio := float64(p.predictiveScaleUpConfig.Lookahead) / float64(p.predictiveScaleUpConfig.ObservationWindow)
+	return int(math.Ceil(float64(createdStreamingUnits) * ratio))
+}
+
 // instanceTypeConsumptionSummary contains a consumption summary
 // of an instance in a provider's region
 type instanceTypeConsumptionSummary struct {
@@ -359,6 +470,7 @@ type instanceTypeConsumptionSummary struct {
 type instanceTypeConsumptionSummaryCalculator struct {
 	locator                               supportedInstanceTypeLocator
 	kafkaStreamingUnitCountPerClusterList services.KafkaStreamingUnitCountPerClusterList
+	capacityReservationUsages             services.CapacityReservationUsageList
 	supportedKafkaInstanceTypesConfig     *config.SupportedKafkaInstanceTypesConfig
 }
 
@@ -375,6 +487,9 @@ type instanceTypeConsumptionSummaryCalculator struct {
 //   - A scale up action is ongoing if there is at least one cluster in the
 //     following states: 'provisioning', 'provisioned', 'accepted',
 //     'waiting_for_kas_fleetshard_operator'
+//
+// The streaming units reserved by the active capacity reservations and not
+// consumed yet are included in the consumed capacity.
 func (i *instanceTypeConsumptionSummaryCalculator) Calculate() (instanceTypeConsumptionSummary, error) {
 	biggestKafkaInstanceSizeCapacityConsumption, err := i.getBiggestCapacityConsumedSize()
 	if err != nil {
@@ -416,6 +531,14 @@ func (i *instanceTypeConsumptionSummaryCalculator) Calculate() (instanceTypeCons
 			continue
 		}
 
+		// quarantined clusters can't accept kafkas anymore. Their kafkas still consume capacity in the region but the cluster
+		// doesn't bring any free capacity
+		if kafkaStreamingUnitCountPerCluster.Status == api.ClusterQuarantined.String() {
+			consumedStreamingUnitsInRegion = consumedStreamingUnitsInRegion + int(kafkaStreamingUnitCountPerCluster.Count)
+			maxStreamingUnitsInRegion = maxStreamingUnitsInRegion + int(kafkaStreamingUnitCountPerCluster.Count)
+			continue
+		}
+
 		if kafkaStreamingUnitCountPerCluster.FreeStreamingUnits() >= int32(biggestKafkaInstanceSizeCapacityConsumption) {
 			atLeastOneClusterHasCapacityForBiggestInstanceType = true
 		}
@@ -424,6 +547,8 @@ func (i *instanceTypeConsumptionSummaryCalculator) Calculate() (instanceTypeCons
 		maxStreamingUnitsInRegion = maxStreamingUnitsInRegion + int(kafkaStreamingUnitCountPerCluster.MaxUnits)
 	}
 
+	consumedStreamingUnitsInRegion = consumedStreamingUnitsInRegion + i.capacityReservationUsages.UnusedStreamingUnits(i.locator.provider, i.locator.region, i.locator.instanceTypeName, "", 0)
+
 	freeStreamingUnitsInRegion := maxStreamingUnitsInRegion - consumedStreamingUnitsInRegion
 
 	return instanceTypeConsumptionSummary{
@@ -2,7 +2,9 @@ package cluster_mgrs
 
 import (
 	"testing"
+	"time"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
@@ -344,6 +346,143 @@ func Test_standardDynamicScaleUpProcessor_ShouldScaleUp(t *testing.T) {
 
 }
 
+func Test_predictiveDynamicScaleUpProcessor_ShouldScaleUp(t *testing.T) {
+	locator := newTestHelperBaseSupportedInstanceTypeLocator()
+	creations := func(region string, streamingUnits int) services.KafkaStreamingUnitCreationList {
+		return services.KafkaStreamingUnitCreationList{
+			{CloudProvider: locator.provider, Region: region, InstanceType: locator.instanceTypeName, StreamingUnits: streamingUnits},
+		}
+	}
+
+	type fields struct {
+		locator                               supportedInstanceTypeLocator
+		kafkaStreamingUnitCountPerClusterList services.KafkaStreamingUnitCountPerClusterList
+		instanceTypeConfig                    *config.InstanceTypeConfig
+		kafkaStreamingUnitCreations           services.KafkaStreamingUnitCreationList
+		lookahead                             time.Duration
+	}
+
+	// The base streaming unit count list has 3 free streaming units and enough capacity for the biggest kafka size
+	tests := []struct {
+		name    string
+		fields  fields
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "When no kafka was created during the observation window then no scale up is performed",
+			fields: fields{
+				locator:                               locator,
+				kafkaStreamingUnitCountPerClusterList: newTestHelperBaseKafkaStreamingUnitCountPerClusterList(),
+				instanceTypeConfig:                    &config.InstanceTypeConfig{MinAvailableCapacitySlackStreamingUnits: 1},
+				lookahead:                             time.Hour,
+			},
+			want: false,
+		},
+		{
+			name: "When the free capacity left after the projected demand is at least the slack capacity then no scale up is performed",
+			fields: fields{
+				locator:                               locator,
+				kafkaStreamingUnitCountPerClusterList: newTestHelperBaseKafkaStreamingUnitCountPerClusterList(),
+				instanceTypeConfig:                    &config.InstanceTypeConfig{MinAvailableCapacitySlackStreamingUnits: 1},
+				kafkaStreamingUnitCreations:           creations(locator.region, 4),
+				lookahead:                             30 * time.Minute,
+			},
+			want: false,
+		},
+		{
+			name: "When the free capacity left after the projected demand is smaller than the slack capacity then scale up is performed",
+			fields: fields{
+				locator:                               locator,
+				kafkaStreamingUnitCountPerClusterList: newTestHelperBaseKafkaStreamingUnitCountPerClusterList(),
+				instanceTypeConfig:                    &config.InstanceTypeConfig{MinAvailableCapacitySlackStreamingUnits: 1},
+				kafkaStreamingUnitCreations:           creations(locator.region, 4),
+				lookahead:                             time.Hour,
+			},
+			want: true,
+		},
+		{
+			name: "When the kafkas were created in another region then no scale up is performed",
+			fields: fields{
+				locator:                               locator,
+				kafkaStreamingUnitCountPerClusterList: newTestHelperBaseKafkaStreamingUnitCountPerClusterList(),
+				instanceTypeConfig:                    &config.InstanceTypeConfig{MinAvailableCapacitySlackStreamingUnits: 1},
+				kafkaStreamingUnitCreations:           creations("r2", 40),
+				lookahead:                             time.Hour,
+			},
+			want: false,
+		},
+		{
+			name: "When the region limit has been reached then no scale up is performed",
+			fields: fields{
+				locator:                               locator,
+				kafkaStreamingUnitCountPerClusterList: newTestHelperBaseKafkaStreamingUnitCountPerClusterList(),
+				instanceTypeConfig:                    &config.InstanceTypeConfig{Limit: &[]int{5}[0], MinAvailableCapacitySlackStreamingUnits: 1},
+				kafkaStreamingUnitCreations:           creations(locator.region, 40),
+				lookahead:                             time.Hour,
+			},
+			want: false,
+		},
+		{
+			name: "When there is an ongoing scale up action then no scale up is performed",
+			fields: fields{
+				locator: locator,
+				kafkaStreamingUnitCountPerClusterList: append(newTestHelperBaseKafkaStreamingUnitCountPerClusterList(), services.KafkaStreamingUnitCountPerCluster{
+					CloudProvider: locator.provider,
+					Region:        locator.region,
+					InstanceType:  locator.instanceTypeName,
+					Status:        api.ClusterProvisioning.String(),
+				}),
+				instanceTypeConfig:          &config.InstanceTypeConfig{MinAvailableCapacitySlackStreamingUnits: 1},
+				kafkaStreamingUnitCreations: creations(locator.region, 40),
+				lookahead:                   time.Hour,
+			},
+			want: false,
+		},
+		{
+			name: "When there is an error with the summary calculator an error is returned",
+			fields: fields{
+				locator: supportedInstanceTypeLocator{
+					provider:         "p1",
+					region:           "r1",
+					instanceTypeName: "unexistingInstanceType",
+				},
+				kafkaStreamingUnitCountPerClusterList: newTestHelperBaseKafkaStreamingUnitCountPerClusterList(),
+				instanceTypeConfig:                    &config.InstanceTypeConfig{MinAvailableCapacitySlackStreamingUnits: 1},
+				lookahead:                             time.Hour,
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			p := predictiveDynamicScaleUpProcessor{
+				standardDynamicScaleUpProcessor: &standardDynamicScaleUpProcessor{
+					locator:                               tt.fields.locator,
+					instanceTypeConfig:                    tt.fields.instanceTypeConfig,
+					kafkaStreamingUnitCountPerClusterList: tt.fields.kafkaStreamingUnitCountPerClusterList,
+					supportedKafkaInstanceTypesConfig:     newTestHelperBaseSupportedKafkaInstanceTypesConfig(),
+				},
+				kafkaStreamingUnitCreations: tt.fields.kafkaStreamingUnitCreations,
+				predictiveScaleUpConfig: &config.PredictiveScaleUpConfig{
+					Enabled:           true,
+					ObservationWindow: time.Hour,
+					Lookahead:         tt.fields.lookahead,
+				},
+			}
+
+			res, err := p.ShouldScaleUp()
+
+			g.Expect(res).To(gomega.Equal(tt.want))
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
 func Test_standardDynamicScaleUpProcessor_enoughCapacitySlackInRegion(t *testing.T) {
 	type fields struct {
 		standardDynamicScaleUpProcessor *standardDynamicScaleUpProcessor
@@ -878,6 +1017,7 @@ func Test_instanceTypeConsumptionSummaryCalculator_Calculate(t *testing.T) {
 		locator                                      supportedInstanceTypeLocator
 		kafkaStreamingUnitCountPerClusterListFactory func() services.KafkaStreamingUnitCountPerClusterList
 		supportedKafkaInstanceTypesConfigFactory     func() *config.SupportedKafkaInstanceTypesConfig
+		capacityReservationUsages                    services.CapacityReservationUsageList
 	}
 
 	tests := []struct {
@@ -906,6 +1046,66 @@ func Test_instanceTypeConsumptionSummaryCalculator_Calculate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Quarantined clusters consume streaming units without bringing any free capacity",
+			fields: fields{
+				locator: newTestHelperBaseSupportedInstanceTypeLocator(),
+				kafkaStreamingUnitCountPerClusterListFactory: func() services.KafkaStreamingUnitCountPerClusterList {
+					res := []services.KafkaStreamingUnitCountPerCluster(newTestHelperBaseKafkaStreamingUnitCountPerClusterList())
+					quarantinedCluster := services.KafkaStreamingUnitCountPerCluster{
+						CloudProvider: "p1",
+						Region:        "r1",
+						InstanceType:  "t1",
+						Count:         2,
+						MaxUnits:      6,
+						Status:        api.ClusterQuarantined.String(),
+					}
+
+					res = append(res, quarantinedCluster)
+					return res
+				},
+				supportedKafkaInstanceTypesConfigFactory: func() *config.SupportedKafkaInstanceTypesConfig {
+					return newTestHelperBaseSupportedKafkaInstanceTypesConfig()
+				},
+			},
+			want: instanceTypeConsumptionSummary{
+				maxStreamingUnits:                    10,
+				freeStreamingUnits:                   3,
+				consumedStreamingUnits:               7,
+				ongoingScaleUpAction:                 false,
+				biggestInstanceSizeCapacityAvailable: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Streaming units reserved and not consumed yet are consumed without bringing any free capacity",
+			fields: fields{
+				locator: newTestHelperBaseSupportedInstanceTypeLocator(),
+				kafkaStreamingUnitCountPerClusterListFactory: func() services.KafkaStreamingUnitCountPerClusterList {
+					return newTestHelperBaseKafkaStreamingUnitCountPerClusterList()
+				},
+				supportedKafkaInstanceTypesConfigFactory: func() *config.SupportedKafkaInstanceTypesConfig {
+					return newTestHelperBaseSupportedKafkaInstanceTypesConfig()
+				},
+				capacityReservationUsages: services.CapacityReservationUsageList{
+					{
+						Reservation:            &dbapi.CapacityReservation{OrganisationId: "org-1", CloudProvider: "p1", Region: "r1", InstanceType: "t1", StreamingUnits: 3},
+						ConsumedStreamingUnits: 1,
+					},
+					{
+						Reservation: &dbapi.CapacityReservation{OrganisationId: "org-2", CloudProvider: "p1", Region: "r2", InstanceType: "t1", StreamingUnits: 3},
+					},
+				},
+			},
+			want: instanceTypeConsumptionSummary{
+				maxStreamingUnits:                    8,
+				freeStreamingUnits:                   1,
+				consumedStreamingUnits:               7,
+				ongoingScaleUpAction:                 false,
+				biggestInstanceSizeCapacityAvailable: true,
+			},
+			wantErr: false,
+		},
 		{
 			name: "Cluster information that does not match the provided locator's region is ignored",
 			fields: fields{
@@ -1301,6 +1501,7 @@ func Test_instanceTypeConsumptionSummaryCalculator_Calculate(t *testing.T) {
 			summaryCalculator := instanceTypeConsumptionSummaryCalculator{
 				locator:                               tt.fields.locator,
 				kafkaStreamingUnitCountPerClusterList: tt.fields.kafkaStreamingUnitCountPerClusterListFactory(),
+				capacityReservationUsages:             tt.fields.capacityReservationUsages,
 				supportedKafkaInstanceTypesConfig:     tt.fields.supportedKafkaInstanceTypesConfigFactory(),
 			}
 			res, err := summaryCalculator.Calculate()
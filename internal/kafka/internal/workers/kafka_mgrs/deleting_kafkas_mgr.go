@@ -50,6 +50,13 @@ func (k *DeletingKafkaManager) Reconcile() []error {
 	glog.Infoln("reconciling deleting kafkas")
 	var encounteredErrors []error
 
+	// Kafkas deleted while a deletion grace period is configured are suspended first. Once their grace period has
+	// expired they can no longer be restored and are marked for deprovisioning, to be removed from the data plane
+	// cluster by the KAS Fleetshard operator.
+	if err := k.kafkaService.DeprovisionKafkasWithExpiredDeletionGracePeriod(); err != nil {
+		encounteredErrors = append(encounteredErrors, errors.Wrap(err, "failed to deprovision kafkas with an expired deletion grace period"))
+	}
+
 	// handle deleting kafka requests.
 	// Kafkas in a "deleting" state have been removed, along with all their resources (i.e. ManagedKafka, Kafka CRs),
 	// from the data plane cluster by the KAS Fleetshard operator. This reconcile phase ensures that any other
@@ -33,6 +33,9 @@ func TestDeletingKafkaManager_Reconcile(t *testing.T) {
 			name: "Should fail if listing kafkas in the reconciler fails",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
+					DeprovisionKafkasWithExpiredDeletionGracePeriodFunc: func() *errors.ServiceError {
+						return nil
+					},
 					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return nil, errors.GeneralError("fail to list kafka requests")
 					},
@@ -40,10 +43,27 @@ func TestDeletingKafkaManager_Reconcile(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should fail if deprovisioning kafkas with an expired deletion grace period fails",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					DeprovisionKafkasWithExpiredDeletionGracePeriodFunc: func() *errors.ServiceError {
+						return errors.GeneralError("fail to deprovision kafka requests")
+					},
+					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+						return []*dbapi.KafkaRequest{}, nil
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Should not fail if listing kafkas returns an empty list",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
+					DeprovisionKafkasWithExpiredDeletionGracePeriodFunc: func() *errors.ServiceError {
+						return nil
+					},
 					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return []*dbapi.KafkaRequest{}, nil
 					},
@@ -55,6 +75,9 @@ func TestDeletingKafkaManager_Reconcile(t *testing.T) {
 			name: "Should call reconcileDeletingKafkas and fail if an error is returned",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
+					DeprovisionKafkasWithExpiredDeletionGracePeriodFunc: func() *errors.ServiceError {
+						return nil
+					},
 					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return []*dbapi.KafkaRequest{
 							mockKafkas.BuildKafkaRequest(
@@ -82,6 +105,9 @@ func TestDeletingKafkaManager_Reconcile(t *testing.T) {
 			name: "Should call reconcileDeletingKafkas and not fail if no error is returned",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
+					DeprovisionKafkasWithExpiredDeletionGracePeriodFunc: func() *errors.ServiceError {
+						return nil
+					},
 					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return []*dbapi.KafkaRequest{
 							mockKafkas.BuildKafkaRequest(
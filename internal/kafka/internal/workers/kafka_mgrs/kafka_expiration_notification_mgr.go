@@ -0,0 +1,112 @@
+package kafka_mgrs
+
+import (
+	"sort"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// KafkaExpirationNotificationManager represents a kafka manager that periodically notifies the owners of the kafkas
+// that are about to expire
+type KafkaExpirationNotificationManager struct {
+	workers.BaseWorker
+	kafkaService   services.KafkaService
+	webhookService services.WebhookService
+	kafkaConfig    *config.KafkaConfig
+}
+
+// NewKafkaExpirationNotificationManager creates a new kafka manager to notify the owners of the kafkas that are about to expire
+func NewKafkaExpirationNotificationManager(kafkaService services.KafkaService, webhookService services.WebhookService, kafkaConfig *config.KafkaConfig, reconciler workers.Reconciler) *KafkaExpirationNotificationManager {
+	return &KafkaExpirationNotificationManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "kafka_expiration_notification",
+			Reconciler: reconciler,
+		},
+		kafkaService:   kafkaService,
+		webhookService: webhookService,
+		kafkaConfig:    kafkaConfig,
+	}
+}
+
+// Start initializes the kafka manager to notify the owners of the kafkas that are about to expire
+func (k *KafkaExpirationNotificationManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop causes the process for notifying the owners of the kafkas that are about to expire to stop
+func (k *KafkaExpirationNotificationManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *KafkaExpirationNotificationManager) Reconcile() []error {
+	glog.Infoln("reconciling kafka expiration notifications")
+	var encounteredErrors []error
+
+	if !k.kafkaConfig.KafkaLifespan.EnableDeletionOfExpiredKafka || len(k.kafkaConfig.KafkaLifespan.ExpirationNotificationThresholds) == 0 {
+		glog.Infoln("kafka expiration notifications are disabled")
+		return nil
+	}
+
+	// thresholds are sorted from the smallest to the largest so that the first threshold greater than the remaining
+	// lifetime of a kafka is the one it has to be notified for
+	thresholds := make([]time.Duration, len(k.kafkaConfig.KafkaLifespan.ExpirationNotificationThresholds))
+	copy(thresholds, k.kafkaConfig.KafkaLifespan.ExpirationNotificationThresholds)
+	sort.Slice(thresholds, func(i, j int) bool { return thresholds[i] < thresholds[j] })
+
+	now := time.Now()
+	expiringKafkas, serviceErr := k.kafkaService.ListKafkasExpiringBefore(now.Add(thresholds[len(thresholds)-1]))
+	if serviceErr != nil {
+		return append(encounteredErrors, errors.Wrap(serviceErr, "failed to list expiring kafkas"))
+	}
+	glog.Infof("expiring kafkas count = %d", len(expiringKafkas))
+
+	for _, kafka := range expiringKafkas {
+		if err := k.reconcileExpiringKafka(kafka, thresholds, now); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to notify the expiration of kafka %s", kafka.ID))
+		}
+	}
+
+	return encounteredErrors
+}
+
+func (k *KafkaExpirationNotificationManager) reconcileExpiringKafka(kafka *dbapi.KafkaRequest, thresholds []time.Duration, now time.Time) error {
+	remaining := kafka.ExpiresAt.Sub(now)
+	var threshold time.Duration
+	for _, t := range thresholds {
+		if remaining <= t {
+			threshold = t
+			break
+		}
+	}
+
+	// the kafka has already been notified for this threshold or a smaller one
+	thresholdSeconds := int64(threshold.Seconds())
+	if threshold == 0 || (kafka.ExpirationNotificationThresholdSeconds != 0 && kafka.ExpirationNotificationThresholdSeconds <= thresholdSeconds) {
+		return nil
+	}
+
+	glog.Infof("kafka %s owned by %q in organisation %q expires at %s, in less than %s", kafka.ID, kafka.Owner, kafka.OrganisationId, kafka.ExpiresAt.Format(time.RFC3339), threshold)
+	metrics.IncreaseKafkaExpirationNotificationsCountMetric(threshold)
+
+	if err := k.webhookService.Notify(kafka, dbapi.WebhookEventTypeKafkaExpiring); err != nil {
+		return err
+	}
+
+	if err := k.kafkaService.Updates(kafka, map[string]interface{}{
+		"expiration_notification_threshold_seconds": thresholdSeconds,
+	}); err != nil {
+		return err
+	}
+
+	kafka.ExpirationNotificationThresholdSeconds = thresholdSeconds
+	return nil
+}
@@ -0,0 +1,110 @@
+package kafka_mgrs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+func TestKafkaExpirationNotificationManager_Reconcile(t *testing.T) {
+	thresholds := []time.Duration{time.Hour, 72 * time.Hour, 24 * time.Hour}
+
+	type fields struct {
+		kafkas           []*dbapi.KafkaRequest
+		listErr          *errors.ServiceError
+		thresholds       []time.Duration
+		deletionDisabled bool
+	}
+
+	tests := []struct {
+		name                string
+		fields              fields
+		wantErrCount        int
+		wantNotified        []string
+		wantThresholdsNotif []int64
+		wantListCalls       int
+	}{
+		{
+			name: "should notify the kafkas that reached a threshold they have not been notified for yet",
+			fields: fields{
+				thresholds: thresholds,
+				kafkas: []*dbapi.KafkaRequest{
+					{Meta: api.Meta{ID: "never-notified"}, ExpiresAt: time.Now().Add(48 * time.Hour)},
+					{Meta: api.Meta{ID: "notified-larger-threshold"}, ExpiresAt: time.Now().Add(30 * time.Minute), ExpirationNotificationThresholdSeconds: int64((24 * time.Hour).Seconds())},
+					{Meta: api.Meta{ID: "already-notified"}, ExpiresAt: time.Now().Add(12 * time.Hour), ExpirationNotificationThresholdSeconds: int64((24 * time.Hour).Seconds())},
+				},
+			},
+			wantListCalls:       1,
+			wantNotified:        []string{"never-notified", "notified-larger-threshold"},
+			wantThresholdsNotif: []int64{int64((72 * time.Hour).Seconds()), int64(time.Hour.Seconds())},
+		},
+		{
+			name: "should return an error if the expiring kafkas cannot be listed",
+			fields: fields{
+				thresholds: thresholds,
+				listErr:    errors.GeneralError("failed to list expiring kafkas"),
+			},
+			wantListCalls: 1,
+			wantErrCount:  1,
+		},
+		{
+			name: "should not notify any kafka when no thresholds are configured",
+			fields: fields{
+				thresholds: []time.Duration{},
+			},
+		},
+		{
+			name: "should not notify any kafka when the deletion of expired kafkas is disabled",
+			fields: fields{
+				thresholds:       thresholds,
+				deletionDisabled: true,
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			kafkaService := &services.KafkaServiceMock{
+				ListKafkasExpiringBeforeFunc: func(expiresBefore time.Time) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					return tt.fields.kafkas, tt.fields.listErr
+				},
+				UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+					return nil
+				},
+			}
+			webhookService := &services.WebhookServiceMock{
+				NotifyFunc: func(kafkaRequest *dbapi.KafkaRequest, eventType dbapi.WebhookEventType) *errors.ServiceError {
+					return nil
+				},
+			}
+			kafkaConfig := config.NewKafkaConfig()
+			kafkaConfig.KafkaLifespan.EnableDeletionOfExpiredKafka = !tt.fields.deletionDisabled
+			kafkaConfig.KafkaLifespan.ExpirationNotificationThresholds = tt.fields.thresholds
+
+			k := NewKafkaExpirationNotificationManager(kafkaService, webhookService, kafkaConfig, w.Reconciler{})
+			g.Expect(k.Reconcile()).To(gomega.HaveLen(tt.wantErrCount))
+			g.Expect(kafkaService.ListKafkasExpiringBeforeCalls()).To(gomega.HaveLen(tt.wantListCalls))
+
+			notifyCalls := webhookService.NotifyCalls()
+			updatesCalls := kafkaService.UpdatesCalls()
+			g.Expect(notifyCalls).To(gomega.HaveLen(len(tt.wantNotified)))
+			g.Expect(updatesCalls).To(gomega.HaveLen(len(tt.wantNotified)))
+			for i, id := range tt.wantNotified {
+				g.Expect(notifyCalls[i].KafkaRequest.ID).To(gomega.Equal(id))
+				g.Expect(notifyCalls[i].EventType).To(gomega.Equal(dbapi.WebhookEventTypeKafkaExpiring))
+				g.Expect(updatesCalls[i].Values).To(gomega.Equal(map[string]interface{}{
+					"expiration_notification_threshold_seconds": tt.wantThresholdsNotif[i],
+				}))
+			}
+		})
+	}
+}
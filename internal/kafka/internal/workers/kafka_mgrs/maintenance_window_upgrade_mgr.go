@@ -0,0 +1,100 @@
+package kafka_mgrs
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// MaintenanceWindowUpgradeManager represents a kafka manager that periodically rolls out the pending upgrades of kafkas
+// within their maintenance window.
+type MaintenanceWindowUpgradeManager struct {
+	workers.BaseWorker
+	kafkaService             services.KafkaService
+	maintenanceWindowService services.MaintenanceWindowService
+}
+
+// NewMaintenanceWindowUpgradeManager creates a new kafka manager to roll out the pending upgrades of kafkas.
+func NewMaintenanceWindowUpgradeManager(kafkaService services.KafkaService, maintenanceWindowService services.MaintenanceWindowService, reconciler workers.Reconciler) *MaintenanceWindowUpgradeManager {
+	return &MaintenanceWindowUpgradeManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "maintenance_window_upgrade",
+			Reconciler: reconciler,
+		},
+		kafkaService:             kafkaService,
+		maintenanceWindowService: maintenanceWindowService,
+	}
+}
+
+// Start initializes the kafka manager to roll out the pending upgrades of kafkas.
+func (k *MaintenanceWindowUpgradeManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop causes the process for rolling out the pending upgrades of kafkas to stop.
+func (k *MaintenanceWindowUpgradeManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *MaintenanceWindowUpgradeManager) Reconcile() []error {
+	glog.Infoln("reconciling pending kafka upgrades")
+	var encounteredErrors []error
+
+	kafkas, serviceErr := k.maintenanceWindowService.ListKafkasWithPendingUpgrades()
+	if serviceErr != nil {
+		return append(encounteredErrors, errors.Wrap(serviceErr, "failed to list kafkas with pending upgrades"))
+	}
+	glog.Infof("kafkas with pending upgrades count = %d", len(kafkas))
+
+	for _, kafka := range kafkas {
+		if err := k.reconcilePendingUpgrade(kafka); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to roll out pending upgrades of kafka %s", kafka.ID))
+		}
+	}
+
+	return encounteredErrors
+}
+
+// reconcilePendingUpgrade promotes the pending versions of the kafka to its desired versions once the kafka is within its
+// maintenance window. Kafkas that are not ready or that are already being upgraded are left untouched until the next run.
+func (k *MaintenanceWindowUpgradeManager) reconcilePendingUpgrade(kafka *dbapi.KafkaRequest) error {
+	if kafka.Status != constants.KafkaRequestStatusReady.String() || kafka.KafkaUpgrading || kafka.StrimziUpgrading || kafka.KafkaIBPUpgrading {
+		glog.V(10).Infof("postponing pending upgrades of kafka %s with status %s", kafka.ID, kafka.Status)
+		return nil
+	}
+
+	canUpgradeNow, err := k.maintenanceWindowService.CanUpgradeNow(kafka)
+	if err != nil {
+		return err
+	}
+	if !canUpgradeNow {
+		return nil
+	}
+
+	fields := map[string]interface{}{
+		"pending_strimzi_version":   "",
+		"pending_kafka_version":     "",
+		"pending_kafka_ibp_version": "",
+	}
+	if kafka.PendingStrimziVersion != "" {
+		fields["desired_strimzi_version"] = kafka.PendingStrimziVersion
+	}
+	if kafka.PendingKafkaVersion != "" {
+		fields["desired_kafka_version"] = kafka.PendingKafkaVersion
+	}
+	if kafka.PendingKafkaIBPVersion != "" {
+		fields["desired_kafka_ibp_version"] = kafka.PendingKafkaIBPVersion
+	}
+
+	glog.Infof("rolling out pending upgrades of kafka %s within its maintenance window", kafka.ID)
+	if err := k.kafkaService.Updates(kafka, fields); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package kafka_mgrs
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+func buildKafkaWithPendingUpgrades(status constants.KafkaStatus) *dbapi.KafkaRequest {
+	return &dbapi.KafkaRequest{
+		Status:                 status.String(),
+		DesiredKafkaVersion:    "2.8.0",
+		DesiredStrimziVersion:  "strimzi-cluster-operator.v0.23.0",
+		DesiredKafkaIBPVersion: "2.8",
+		PendingKafkaVersion:    "3.0.0",
+		PendingStrimziVersion:  "strimzi-cluster-operator.v0.24.0",
+	}
+}
+
+func TestMaintenanceWindowUpgradeManager_Reconcile(t *testing.T) {
+	type fields struct {
+		kafkaService             *services.KafkaServiceMock
+		maintenanceWindowService *services.MaintenanceWindowServiceMock
+	}
+
+	tests := []struct {
+		name        string
+		fields      fields
+		wantErr     bool
+		wantUpdates []map[string]interface{}
+	}{
+		{
+			name: "should return an error if listing the kafkas with pending upgrades fails",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{},
+				maintenanceWindowService: &services.MaintenanceWindowServiceMock{
+					ListKafkasWithPendingUpgradesFunc: func() (dbapi.KafkaList, *errors.ServiceError) {
+						return nil, errors.GeneralError("failed to list kafkas")
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should roll out the pending upgrades of a ready kafka within its maintenance window",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+						return nil
+					},
+				},
+				maintenanceWindowService: &services.MaintenanceWindowServiceMock{
+					ListKafkasWithPendingUpgradesFunc: func() (dbapi.KafkaList, *errors.ServiceError) {
+						return dbapi.KafkaList{buildKafkaWithPendingUpgrades(constants.KafkaRequestStatusReady)}, nil
+					},
+					CanUpgradeNowFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *errors.ServiceError) {
+						return true, nil
+					},
+				},
+			},
+			wantErr: false,
+			wantUpdates: []map[string]interface{}{
+				{
+					"desired_kafka_version":     "3.0.0",
+					"desired_strimzi_version":   "strimzi-cluster-operator.v0.24.0",
+					"pending_kafka_version":     "",
+					"pending_strimzi_version":   "",
+					"pending_kafka_ibp_version": "",
+				},
+			},
+		},
+		{
+			name: "should not roll out the pending upgrades of a kafka outside of its maintenance window",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{},
+				maintenanceWindowService: &services.MaintenanceWindowServiceMock{
+					ListKafkasWithPendingUpgradesFunc: func() (dbapi.KafkaList, *errors.ServiceError) {
+						return dbapi.KafkaList{buildKafkaWithPendingUpgrades(constants.KafkaRequestStatusReady)}, nil
+					},
+					CanUpgradeNowFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *errors.ServiceError) {
+						return false, nil
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "should not roll out the pending upgrades of a kafka which is not ready",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{},
+				maintenanceWindowService: &services.MaintenanceWindowServiceMock{
+					ListKafkasWithPendingUpgradesFunc: func() (dbapi.KafkaList, *errors.ServiceError) {
+						return dbapi.KafkaList{buildKafkaWithPendingUpgrades(constants.KafkaRequestStatusSuspended)}, nil
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "should not roll out the pending upgrades of a kafka which is already being upgraded",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{},
+				maintenanceWindowService: &services.MaintenanceWindowServiceMock{
+					ListKafkasWithPendingUpgradesFunc: func() (dbapi.KafkaList, *errors.ServiceError) {
+						kafka := buildKafkaWithPendingUpgrades(constants.KafkaRequestStatusReady)
+						kafka.StrimziUpgrading = true
+						return dbapi.KafkaList{kafka}, nil
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if checking the maintenance window of the kafka fails",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{},
+				maintenanceWindowService: &services.MaintenanceWindowServiceMock{
+					ListKafkasWithPendingUpgradesFunc: func() (dbapi.KafkaList, *errors.ServiceError) {
+						return dbapi.KafkaList{buildKafkaWithPendingUpgrades(constants.KafkaRequestStatusReady)}, nil
+					},
+					CanUpgradeNowFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *errors.ServiceError) {
+						return false, errors.GeneralError("failed to get maintenance window")
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error if updating the kafka fails",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+						return errors.GeneralError("failed to update kafka")
+					},
+				},
+				maintenanceWindowService: &services.MaintenanceWindowServiceMock{
+					ListKafkasWithPendingUpgradesFunc: func() (dbapi.KafkaList, *errors.ServiceError) {
+						return dbapi.KafkaList{buildKafkaWithPendingUpgrades(constants.KafkaRequestStatusReady)}, nil
+					},
+					CanUpgradeNowFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *errors.ServiceError) {
+						return true, nil
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			k := NewMaintenanceWindowUpgradeManager(tt.fields.kafkaService, tt.fields.maintenanceWindowService, w.Reconciler{})
+
+			g.Expect(len(k.Reconcile()) > 0).To(gomega.Equal(tt.wantErr))
+			if tt.wantUpdates != nil {
+				calls := tt.fields.kafkaService.UpdatesCalls()
+				g.Expect(calls).To(gomega.HaveLen(len(tt.wantUpdates)))
+				for i, call := range calls {
+					g.Expect(call.Values).To(gomega.Equal(tt.wantUpdates[i]))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package kafka_mgrs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// UpgradeCampaignManager represents a kafka manager that periodically rolls out the upgrade campaigns in progress
+type UpgradeCampaignManager struct {
+	workers.BaseWorker
+	upgradeCampaignService   services.UpgradeCampaignService
+	kafkaService             services.KafkaService
+	clusterService           services.ClusterService
+	maintenanceWindowService services.MaintenanceWindowService
+}
+
+// NewUpgradeCampaignManager creates a new kafka manager to roll out the upgrade campaigns
+func NewUpgradeCampaignManager(upgradeCampaignService services.UpgradeCampaignService, kafkaService services.KafkaService, clusterService services.ClusterService,
+	maintenanceWindowService services.MaintenanceWindowService, reconciler workers.Reconciler) *UpgradeCampaignManager {
+	return &UpgradeCampaignManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "upgrade_campaign",
+			Reconciler: reconciler,
+		},
+		upgradeCampaignService:   upgradeCampaignService,
+		kafkaService:             kafkaService,
+		clusterService:           clusterService,
+		maintenanceWindowService: maintenanceWindowService,
+	}
+}
+
+// Start initializes the kafka manager to roll out the upgrade campaigns
+func (k *UpgradeCampaignManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop causes the process for rolling out the upgrade campaigns to stop
+func (k *UpgradeCampaignManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *UpgradeCampaignManager) Reconcile() []error {
+	glog.Infoln("reconciling upgrade campaigns")
+	var encounteredErrors []error
+
+	campaigns, serviceErr := k.upgradeCampaignService.ListInProgress()
+	if serviceErr != nil {
+		return append(encounteredErrors, errors.Wrap(serviceErr, "failed to list upgrade campaigns in progress"))
+	}
+	glog.Infof("upgrade campaigns in progress count = %d", len(campaigns))
+
+	for _, campaign := range campaigns {
+		if err := k.reconcileCampaign(campaign); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile upgrade campaign %s", campaign.ID))
+		}
+	}
+
+	return encounteredErrors
+}
+
+// reconcileCampaign updates the progress of the kafkas being upgraded by the campaign and starts the upgrade of the
+// next batch of kafkas. The campaign is paused as soon as the upgrade of one of its kafkas fails or stalls.
+func (k *UpgradeCampaignManager) reconcileCampaign(campaign *dbapi.UpgradeCampaign) error {
+	now := time.Now()
+	upgrading := 0
+
+	for i := range campaign.Kafkas {
+		campaignKafka := &campaign.Kafkas[i]
+		if campaignKafka.Status != dbapi.UpgradeCampaignKafkaStatusUpgrading {
+			continue
+		}
+
+		stillUpgrading, err := k.reconcileUpgradingKafka(campaign, campaignKafka, now)
+		if err != nil {
+			return err
+		}
+		if stillUpgrading {
+			upgrading++
+			continue
+		}
+
+		if campaignKafka.Status == dbapi.UpgradeCampaignKafkaStatusFailed {
+			reason := fmt.Sprintf("upgrade of kafka %s failed: %s", campaignKafka.KafkaID, campaignKafka.FailedReason)
+			glog.Infof("pausing upgrade campaign %s: %s", campaign.ID, reason)
+			if err := k.upgradeCampaignService.UpdateStatus(campaign, dbapi.UpgradeCampaignStatusPaused, reason); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+
+	slots := campaign.MaxConcurrentUpgrades - upgrading
+	if campaign.BatchSize < slots {
+		slots = campaign.BatchSize
+	}
+
+	for i := range campaign.Kafkas {
+		if slots <= 0 {
+			break
+		}
+		campaignKafka := &campaign.Kafkas[i]
+		if campaignKafka.Status != dbapi.UpgradeCampaignKafkaStatusPending {
+			continue
+		}
+
+		if err := k.startKafkaUpgrade(campaign, campaignKafka, now); err != nil {
+			return err
+		}
+		if campaignKafka.Status == dbapi.UpgradeCampaignKafkaStatusUpgrading {
+			slots--
+		}
+	}
+
+	counts := campaign.CountKafkasByStatus()
+	if counts[dbapi.UpgradeCampaignKafkaStatusPending] == 0 && counts[dbapi.UpgradeCampaignKafkaStatusUpgrading] == 0 {
+		glog.Infof("upgrade campaign %s completed", campaign.ID)
+		if err := k.upgradeCampaignService.UpdateStatus(campaign, dbapi.UpgradeCampaignStatusCompleted, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileUpgradingKafka checks whether the upgrade of the kafka completed, failed or stalled. true is returned if the
+// kafka is still being upgraded.
+func (k *UpgradeCampaignManager) reconcileUpgradingKafka(campaign *dbapi.UpgradeCampaign, campaignKafka *dbapi.UpgradeCampaignKafka, now time.Time) (bool, error) {
+	kafka, serviceErr := k.kafkaService.GetByID(campaignKafka.KafkaID)
+	if serviceErr != nil && !serviceErr.Is404() {
+		return false, serviceErr
+	}
+
+	switch {
+	case kafka == nil || isKafkaBeingDeleted(kafka):
+		campaignKafka.Status = dbapi.UpgradeCampaignKafkaStatusSkipped
+		campaignKafka.FailedReason = "kafka has been deleted"
+	case kafka.Status == constants.KafkaRequestStatusFailed.String():
+		campaignKafka.Status = dbapi.UpgradeCampaignKafkaStatusFailed
+		campaignKafka.FailedReason = fmt.Sprintf("kafka is in %s status", kafka.Status)
+	case hasReachedCampaignVersions(campaign, kafka):
+		campaignKafka.Status = dbapi.UpgradeCampaignKafkaStatusCompleted
+	case campaignKafka.StartedAt != nil && now.Sub(*campaignKafka.StartedAt) > time.Duration(campaign.StallTimeoutMinutes)*time.Minute:
+		campaignKafka.Status = dbapi.UpgradeCampaignKafkaStatusFailed
+		campaignKafka.FailedReason = fmt.Sprintf("upgrade did not complete within %d minutes", campaign.StallTimeoutMinutes)
+	default:
+		return true, nil
+	}
+
+	campaignKafka.FinishedAt = &now
+	return false, k.updateCampaignKafka(campaignKafka)
+}
+
+// startKafkaUpgrade sets the desired versions of the kafka to the target versions of the campaign. Kafkas that are not
+// ready, already being upgraded or outside of their maintenance window are left pending until the next run.
+func (k *UpgradeCampaignManager) startKafkaUpgrade(campaign *dbapi.UpgradeCampaign, campaignKafka *dbapi.UpgradeCampaignKafka, now time.Time) error {
+	kafka, serviceErr := k.kafkaService.GetByID(campaignKafka.KafkaID)
+	if serviceErr != nil && !serviceErr.Is404() {
+		return serviceErr
+	}
+
+	if kafka == nil || isKafkaBeingDeleted(kafka) || kafka.Status == constants.KafkaRequestStatusFailed.String() {
+		campaignKafka.Status = dbapi.UpgradeCampaignKafkaStatusSkipped
+		campaignKafka.FailedReason = "kafka has been deleted or is in failed status"
+		campaignKafka.FinishedAt = &now
+		return k.updateCampaignKafka(campaignKafka)
+	}
+
+	if hasReachedCampaignVersions(campaign, kafka) {
+		campaignKafka.Status = dbapi.UpgradeCampaignKafkaStatusCompleted
+		campaignKafka.FinishedAt = &now
+		return k.updateCampaignKafka(campaignKafka)
+	}
+
+	if kafka.Status != constants.KafkaRequestStatusReady.String() || kafka.KafkaUpgrading || kafka.StrimziUpgrading || kafka.KafkaIBPUpgrading {
+		glog.V(10).Infof("postponing upgrade of kafka %s with status %s by campaign %s", kafka.ID, kafka.Status, campaign.ID)
+		return nil
+	}
+
+	if !campaign.IgnoreMaintenanceWindows {
+		canUpgradeNow, err := k.maintenanceWindowService.CanUpgradeNow(kafka)
+		if err != nil {
+			return err
+		}
+		if !canUpgradeNow {
+			return nil
+		}
+	}
+
+	strimziVersion := kafka.DesiredStrimziVersion
+	if campaign.StrimziVersion != "" {
+		strimziVersion = campaign.StrimziVersion
+	}
+	ibpVersion := kafka.DesiredKafkaIBPVersion
+	if campaign.KafkaIBPVersion != "" {
+		ibpVersion = campaign.KafkaIBPVersion
+	}
+
+	cluster, serviceErr := k.clusterService.FindClusterByID(kafka.ClusterID)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	available := false
+	if cluster != nil {
+		var err error
+		available, err = k.clusterService.IsStrimziKafkaVersionAvailableInCluster(cluster, strimziVersion, campaign.KafkaVersion, ibpVersion)
+		if err != nil {
+			return err
+		}
+	}
+	if !available {
+		campaignKafka.Status = dbapi.UpgradeCampaignKafkaStatusSkipped
+		campaignKafka.FailedReason = fmt.Sprintf("kafka version %s with strimzi version %s and kafka ibp version %s is not available in cluster %s", campaign.KafkaVersion, strimziVersion, ibpVersion, kafka.ClusterID)
+		campaignKafka.FinishedAt = &now
+		return k.updateCampaignKafka(campaignKafka)
+	}
+
+	// the versions rolled out by the campaign supersede the ones waiting for a maintenance window
+	fields := map[string]interface{}{
+		"desired_kafka_version":     campaign.KafkaVersion,
+		"desired_strimzi_version":   strimziVersion,
+		"desired_kafka_ibp_version": ibpVersion,
+		"pending_kafka_version":     "",
+	}
+	if campaign.StrimziVersion != "" {
+		fields["pending_strimzi_version"] = ""
+	}
+	if campaign.KafkaIBPVersion != "" {
+		fields["pending_kafka_ibp_version"] = ""
+	}
+
+	glog.Infof("upgrading kafka %s to kafka version %s as part of upgrade campaign %s", kafka.ID, campaign.KafkaVersion, campaign.ID)
+	if err := k.kafkaService.Updates(kafka, fields); err != nil {
+		return err
+	}
+
+	campaignKafka.Status = dbapi.UpgradeCampaignKafkaStatusUpgrading
+	campaignKafka.StartedAt = &now
+	return k.updateCampaignKafka(campaignKafka)
+}
+
+func (k *UpgradeCampaignManager) updateCampaignKafka(campaignKafka *dbapi.UpgradeCampaignKafka) error {
+	if err := k.upgradeCampaignService.UpdateCampaignKafka(campaignKafka); err != nil {
+		return err
+	}
+	return nil
+}
+
+// hasReachedCampaignVersions returns true if the kafka runs the target versions of the campaign
+func hasReachedCampaignVersions(campaign *dbapi.UpgradeCampaign, kafka *dbapi.KafkaRequest) bool {
+	if kafka.KafkaUpgrading || kafka.StrimziUpgrading || kafka.KafkaIBPUpgrading {
+		return false
+	}
+	return kafka.ActualKafkaVersion == campaign.KafkaVersion &&
+		(campaign.StrimziVersion == "" || kafka.ActualStrimziVersion == campaign.StrimziVersion) &&
+		(campaign.KafkaIBPVersion == "" || kafka.ActualKafkaIBPVersion == campaign.KafkaIBPVersion)
+}
+
+func isKafkaBeingDeleted(kafka *dbapi.KafkaRequest) bool {
+	return kafka.Status == constants.KafkaRequestStatusDeprovision.String() || kafka.Status == constants.KafkaRequestStatusDeleting.String()
+}
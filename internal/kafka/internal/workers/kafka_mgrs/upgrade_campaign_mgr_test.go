@@ -0,0 +1,270 @@
+package kafka_mgrs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+func buildUpgradeCampaign(kafkas ...dbapi.UpgradeCampaignKafka) *dbapi.UpgradeCampaign {
+	return &dbapi.UpgradeCampaign{
+		Meta:                  api.Meta{ID: "campaign-id"},
+		Status:                dbapi.UpgradeCampaignStatusInProgress,
+		KafkaVersion:          "3.0.0",
+		BatchSize:             2,
+		MaxConcurrentUpgrades: 2,
+		StallTimeoutMinutes:   60,
+		Kafkas:                kafkas,
+	}
+}
+
+func buildUpgradeCampaignKafka(kafkaID string, status dbapi.UpgradeCampaignKafkaStatus, startedAt *time.Time) dbapi.UpgradeCampaignKafka {
+	return dbapi.UpgradeCampaignKafka{
+		UpgradeCampaignID: "campaign-id",
+		KafkaID:           kafkaID,
+		Status:            status,
+		StartedAt:         startedAt,
+	}
+}
+
+func buildCampaignTargetKafka(id string, status constants.KafkaStatus, actualKafkaVersion string) *dbapi.KafkaRequest {
+	return &dbapi.KafkaRequest{
+		Meta:                   api.Meta{ID: id},
+		ClusterID:              "cluster-id",
+		Status:                 status.String(),
+		ActualKafkaVersion:     actualKafkaVersion,
+		DesiredKafkaVersion:    actualKafkaVersion,
+		DesiredStrimziVersion:  "strimzi-cluster-operator.v0.24.0",
+		DesiredKafkaIBPVersion: "2.8",
+	}
+}
+
+func TestUpgradeCampaignManager_Reconcile(t *testing.T) {
+	startedAt := time.Now().Add(-10 * time.Minute)
+	stalledAt := time.Now().Add(-2 * time.Hour)
+
+	type fields struct {
+		upgradeCampaignService   *services.UpgradeCampaignServiceMock
+		kafkaService             *services.KafkaServiceMock
+		clusterService           *services.ClusterServiceMock
+		maintenanceWindowService *services.MaintenanceWindowServiceMock
+	}
+
+	clusterServiceWithVersion := func(available bool) *services.ClusterServiceMock {
+		return &services.ClusterServiceMock{
+			FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+				return &api.Cluster{ClusterID: clusterID}, nil
+			},
+			IsStrimziKafkaVersionAvailableInClusterFunc: func(cluster *api.Cluster, strimziVersion string, kafkaVersion string, ibpVersion string) (bool, error) {
+				return available, nil
+			},
+		}
+	}
+
+	kafkaServiceReturning := func(kafkas ...*dbapi.KafkaRequest) *services.KafkaServiceMock {
+		return &services.KafkaServiceMock{
+			GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+				for _, kafka := range kafkas {
+					if kafka.ID == id {
+						return kafka, nil
+					}
+				}
+				return nil, errors.NotFound("kafka %s not found", id)
+			},
+			UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+				return nil
+			},
+		}
+	}
+
+	upgradeCampaignServiceReturning := func(campaign *dbapi.UpgradeCampaign) *services.UpgradeCampaignServiceMock {
+		return &services.UpgradeCampaignServiceMock{
+			ListInProgressFunc: func() (dbapi.UpgradeCampaignList, *errors.ServiceError) {
+				return dbapi.UpgradeCampaignList{campaign}, nil
+			},
+			UpdateCampaignKafkaFunc: func(campaignKafka *dbapi.UpgradeCampaignKafka) *errors.ServiceError {
+				return nil
+			},
+			UpdateStatusFunc: func(campaign *dbapi.UpgradeCampaign, status dbapi.UpgradeCampaignStatus, reason string) *errors.ServiceError {
+				campaign.Status = status
+				return nil
+			},
+		}
+	}
+
+	alwaysInMaintenanceWindow := &services.MaintenanceWindowServiceMock{
+		CanUpgradeNowFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *errors.ServiceError) {
+			return true, nil
+		},
+	}
+
+	tests := []struct {
+		name               string
+		campaign           *dbapi.UpgradeCampaign
+		fields             fields
+		wantErr            bool
+		wantCampaignStatus dbapi.UpgradeCampaignStatus
+		wantKafkaStatuses  []dbapi.UpgradeCampaignKafkaStatus
+		wantUpdates        int
+	}{
+		{
+			name:     "should start the upgrade of the first batch of pending kafkas",
+			campaign: buildUpgradeCampaign(buildUpgradeCampaignKafka("kafka-1", dbapi.UpgradeCampaignKafkaStatusPending, nil), buildUpgradeCampaignKafka("kafka-2", dbapi.UpgradeCampaignKafkaStatusPending, nil), buildUpgradeCampaignKafka("kafka-3", dbapi.UpgradeCampaignKafkaStatusPending, nil)),
+			fields: fields{
+				kafkaService: kafkaServiceReturning(
+					buildCampaignTargetKafka("kafka-1", constants.KafkaRequestStatusReady, "2.8.0"),
+					buildCampaignTargetKafka("kafka-2", constants.KafkaRequestStatusReady, "2.8.0"),
+					buildCampaignTargetKafka("kafka-3", constants.KafkaRequestStatusReady, "2.8.0"),
+				),
+				clusterService:           clusterServiceWithVersion(true),
+				maintenanceWindowService: alwaysInMaintenanceWindow,
+			},
+			wantCampaignStatus: dbapi.UpgradeCampaignStatusInProgress,
+			wantKafkaStatuses:  []dbapi.UpgradeCampaignKafkaStatus{dbapi.UpgradeCampaignKafkaStatusUpgrading, dbapi.UpgradeCampaignKafkaStatusUpgrading, dbapi.UpgradeCampaignKafkaStatusPending},
+			wantUpdates:        2,
+		},
+		{
+			name:     "should not exceed the maximum number of concurrent upgrades",
+			campaign: buildUpgradeCampaign(buildUpgradeCampaignKafka("kafka-1", dbapi.UpgradeCampaignKafkaStatusUpgrading, &startedAt), buildUpgradeCampaignKafka("kafka-2", dbapi.UpgradeCampaignKafkaStatusPending, nil), buildUpgradeCampaignKafka("kafka-3", dbapi.UpgradeCampaignKafkaStatusPending, nil)),
+			fields: fields{
+				kafkaService: kafkaServiceReturning(
+					&dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-1"}, Status: constants.KafkaRequestStatusReady.String(), ActualKafkaVersion: "2.8.0", KafkaUpgrading: true},
+					buildCampaignTargetKafka("kafka-2", constants.KafkaRequestStatusReady, "2.8.0"),
+					buildCampaignTargetKafka("kafka-3", constants.KafkaRequestStatusReady, "2.8.0"),
+				),
+				clusterService:           clusterServiceWithVersion(true),
+				maintenanceWindowService: alwaysInMaintenanceWindow,
+			},
+			wantCampaignStatus: dbapi.UpgradeCampaignStatusInProgress,
+			wantKafkaStatuses:  []dbapi.UpgradeCampaignKafkaStatus{dbapi.UpgradeCampaignKafkaStatusUpgrading, dbapi.UpgradeCampaignKafkaStatusUpgrading, dbapi.UpgradeCampaignKafkaStatusPending},
+			wantUpdates:        1,
+		},
+		{
+			name:     "should leave pending the kafkas outside of their maintenance window",
+			campaign: buildUpgradeCampaign(buildUpgradeCampaignKafka("kafka-1", dbapi.UpgradeCampaignKafkaStatusPending, nil)),
+			fields: fields{
+				kafkaService:   kafkaServiceReturning(buildCampaignTargetKafka("kafka-1", constants.KafkaRequestStatusReady, "2.8.0")),
+				clusterService: clusterServiceWithVersion(true),
+				maintenanceWindowService: &services.MaintenanceWindowServiceMock{
+					CanUpgradeNowFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *errors.ServiceError) {
+						return false, nil
+					},
+				},
+			},
+			wantCampaignStatus: dbapi.UpgradeCampaignStatusInProgress,
+			wantKafkaStatuses:  []dbapi.UpgradeCampaignKafkaStatus{dbapi.UpgradeCampaignKafkaStatusPending},
+			wantUpdates:        0,
+		},
+		{
+			name:     "should skip the kafkas whose cluster does not support the target versions",
+			campaign: buildUpgradeCampaign(buildUpgradeCampaignKafka("kafka-1", dbapi.UpgradeCampaignKafkaStatusPending, nil)),
+			fields: fields{
+				kafkaService:             kafkaServiceReturning(buildCampaignTargetKafka("kafka-1", constants.KafkaRequestStatusReady, "2.8.0")),
+				clusterService:           clusterServiceWithVersion(false),
+				maintenanceWindowService: alwaysInMaintenanceWindow,
+			},
+			wantCampaignStatus: dbapi.UpgradeCampaignStatusCompleted,
+			wantKafkaStatuses:  []dbapi.UpgradeCampaignKafkaStatus{dbapi.UpgradeCampaignKafkaStatusSkipped},
+			wantUpdates:        0,
+		},
+		{
+			name:     "should complete the campaign once all its kafkas reached the target versions",
+			campaign: buildUpgradeCampaign(buildUpgradeCampaignKafka("kafka-1", dbapi.UpgradeCampaignKafkaStatusUpgrading, &startedAt), buildUpgradeCampaignKafka("kafka-2", dbapi.UpgradeCampaignKafkaStatusPending, nil)),
+			fields: fields{
+				kafkaService: kafkaServiceReturning(
+					buildCampaignTargetKafka("kafka-1", constants.KafkaRequestStatusReady, "3.0.0"),
+					buildCampaignTargetKafka("kafka-2", constants.KafkaRequestStatusReady, "3.0.0"),
+				),
+			},
+			wantCampaignStatus: dbapi.UpgradeCampaignStatusCompleted,
+			wantKafkaStatuses:  []dbapi.UpgradeCampaignKafkaStatus{dbapi.UpgradeCampaignKafkaStatusCompleted, dbapi.UpgradeCampaignKafkaStatusCompleted},
+			wantUpdates:        0,
+		},
+		{
+			name:     "should pause the campaign if the upgrade of a kafka failed",
+			campaign: buildUpgradeCampaign(buildUpgradeCampaignKafka("kafka-1", dbapi.UpgradeCampaignKafkaStatusUpgrading, &startedAt), buildUpgradeCampaignKafka("kafka-2", dbapi.UpgradeCampaignKafkaStatusPending, nil)),
+			fields: fields{
+				kafkaService: kafkaServiceReturning(
+					buildCampaignTargetKafka("kafka-1", constants.KafkaRequestStatusFailed, "2.8.0"),
+					buildCampaignTargetKafka("kafka-2", constants.KafkaRequestStatusReady, "2.8.0"),
+				),
+			},
+			wantCampaignStatus: dbapi.UpgradeCampaignStatusPaused,
+			wantKafkaStatuses:  []dbapi.UpgradeCampaignKafkaStatus{dbapi.UpgradeCampaignKafkaStatusFailed, dbapi.UpgradeCampaignKafkaStatusPending},
+			wantUpdates:        0,
+		},
+		{
+			name:     "should pause the campaign if the upgrade of a kafka stalled",
+			campaign: buildUpgradeCampaign(buildUpgradeCampaignKafka("kafka-1", dbapi.UpgradeCampaignKafkaStatusUpgrading, &stalledAt), buildUpgradeCampaignKafka("kafka-2", dbapi.UpgradeCampaignKafkaStatusPending, nil)),
+			fields: fields{
+				kafkaService: kafkaServiceReturning(
+					&dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-1"}, Status: constants.KafkaRequestStatusReady.String(), ActualKafkaVersion: "2.8.0", KafkaUpgrading: true},
+					buildCampaignTargetKafka("kafka-2", constants.KafkaRequestStatusReady, "2.8.0"),
+				),
+			},
+			wantCampaignStatus: dbapi.UpgradeCampaignStatusPaused,
+			wantKafkaStatuses:  []dbapi.UpgradeCampaignKafkaStatus{dbapi.UpgradeCampaignKafkaStatusFailed, dbapi.UpgradeCampaignKafkaStatusPending},
+			wantUpdates:        0,
+		},
+		{
+			name:     "should skip the kafkas that have been deleted",
+			campaign: buildUpgradeCampaign(buildUpgradeCampaignKafka("kafka-1", dbapi.UpgradeCampaignKafkaStatusUpgrading, &startedAt)),
+			fields: fields{
+				kafkaService: kafkaServiceReturning(),
+			},
+			wantCampaignStatus: dbapi.UpgradeCampaignStatusCompleted,
+			wantKafkaStatuses:  []dbapi.UpgradeCampaignKafkaStatus{dbapi.UpgradeCampaignKafkaStatusSkipped},
+			wantUpdates:        0,
+		},
+		{
+			name:     "should return an error if getting a kafka fails",
+			campaign: buildUpgradeCampaign(buildUpgradeCampaignKafka("kafka-1", dbapi.UpgradeCampaignKafkaStatusPending, nil)),
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return nil, errors.GeneralError("failed to get kafka")
+					},
+				},
+			},
+			wantErr:            true,
+			wantCampaignStatus: dbapi.UpgradeCampaignStatusInProgress,
+			wantKafkaStatuses:  []dbapi.UpgradeCampaignKafkaStatus{dbapi.UpgradeCampaignKafkaStatusPending},
+			wantUpdates:        0,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			upgradeCampaignService := upgradeCampaignServiceReturning(tt.campaign)
+			k := NewUpgradeCampaignManager(upgradeCampaignService, tt.fields.kafkaService, tt.fields.clusterService, tt.fields.maintenanceWindowService, w.Reconciler{})
+
+			g.Expect(len(k.Reconcile()) > 0).To(gomega.Equal(tt.wantErr))
+			g.Expect(tt.campaign.Status).To(gomega.Equal(tt.wantCampaignStatus))
+			for i, status := range tt.wantKafkaStatuses {
+				g.Expect(tt.campaign.Kafkas[i].Status).To(gomega.Equal(status))
+			}
+			g.Expect(tt.fields.kafkaService.UpdatesCalls()).To(gomega.HaveLen(tt.wantUpdates))
+		})
+	}
+
+	t.Run("should return an error if listing the upgrade campaigns in progress fails", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		upgradeCampaignService := &services.UpgradeCampaignServiceMock{
+			ListInProgressFunc: func() (dbapi.UpgradeCampaignList, *errors.ServiceError) {
+				return nil, errors.GeneralError("failed to list upgrade campaigns")
+			},
+		}
+		k := NewUpgradeCampaignManager(upgradeCampaignService, &services.KafkaServiceMock{}, &services.ClusterServiceMock{}, &services.MaintenanceWindowServiceMock{}, w.Reconciler{})
+		g.Expect(k.Reconcile()).To(gomega.HaveLen(1))
+	})
+}
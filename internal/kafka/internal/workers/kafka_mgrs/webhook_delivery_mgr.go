@@ -0,0 +1,57 @@
+package kafka_mgrs
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// WebhookDeliveryManager represents a kafka manager that periodically delivers the kafka lifecycle events to the
+// webhook subscriptions
+type WebhookDeliveryManager struct {
+	workers.BaseWorker
+	webhookService services.WebhookService
+}
+
+// NewWebhookDeliveryManager creates a new kafka manager to deliver the kafka lifecycle events to the webhook subscriptions
+func NewWebhookDeliveryManager(webhookService services.WebhookService, reconciler workers.Reconciler) *WebhookDeliveryManager {
+	return &WebhookDeliveryManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "webhook_delivery",
+			Reconciler: reconciler,
+		},
+		webhookService: webhookService,
+	}
+}
+
+// Start initializes the kafka manager to deliver the kafka lifecycle events to the webhook subscriptions
+func (k *WebhookDeliveryManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop causes the process for delivering the kafka lifecycle events to the webhook subscriptions to stop
+func (k *WebhookDeliveryManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *WebhookDeliveryManager) Reconcile() []error {
+	glog.Infoln("reconciling webhook deliveries")
+	var encounteredErrors []error
+
+	deliveries, serviceErr := k.webhookService.ListDueDeliveries()
+	if serviceErr != nil {
+		return append(encounteredErrors, errors.Wrap(serviceErr, "failed to list due webhook deliveries"))
+	}
+	glog.Infof("due webhook deliveries count = %d", len(deliveries))
+
+	for _, delivery := range deliveries {
+		if err := k.webhookService.Deliver(delivery); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to deliver webhook delivery %s", delivery.ID))
+		}
+	}
+
+	return encounteredErrors
+}
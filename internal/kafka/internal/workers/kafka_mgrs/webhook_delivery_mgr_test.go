@@ -0,0 +1,75 @@
+package kafka_mgrs
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+func TestWebhookDeliveryManager_Reconcile(t *testing.T) {
+	deliveries := dbapi.WebhookDeliveryList{
+		{Meta: api.Meta{ID: "delivery-1"}},
+		{Meta: api.Meta{ID: "delivery-2"}},
+	}
+
+	tests := []struct {
+		name           string
+		webhookService *services.WebhookServiceMock
+		wantErrCount   int
+		wantDelivered  int
+	}{
+		{
+			name: "should attempt all the due deliveries",
+			webhookService: &services.WebhookServiceMock{
+				ListDueDeliveriesFunc: func() (dbapi.WebhookDeliveryList, *errors.ServiceError) {
+					return deliveries, nil
+				},
+				DeliverFunc: func(delivery *dbapi.WebhookDelivery) *errors.ServiceError {
+					return nil
+				},
+			},
+			wantDelivered: 2,
+		},
+		{
+			name: "should keep attempting the deliveries when the outcome of one of them cannot be persisted",
+			webhookService: &services.WebhookServiceMock{
+				ListDueDeliveriesFunc: func() (dbapi.WebhookDeliveryList, *errors.ServiceError) {
+					return deliveries, nil
+				},
+				DeliverFunc: func(delivery *dbapi.WebhookDelivery) *errors.ServiceError {
+					if delivery.ID == "delivery-1" {
+						return errors.GeneralError("failed to update webhook delivery")
+					}
+					return nil
+				},
+			},
+			wantErrCount:  1,
+			wantDelivered: 2,
+		},
+		{
+			name: "should return an error if the due deliveries cannot be listed",
+			webhookService: &services.WebhookServiceMock{
+				ListDueDeliveriesFunc: func() (dbapi.WebhookDeliveryList, *errors.ServiceError) {
+					return nil, errors.GeneralError("failed to list due webhook deliveries")
+				},
+			},
+			wantErrCount:  1,
+			wantDelivered: 0,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			k := NewWebhookDeliveryManager(tt.webhookService, w.Reconciler{})
+			g.Expect(k.Reconcile()).To(gomega.HaveLen(tt.wantErrCount))
+			g.Expect(tt.webhookService.DeliverCalls()).To(gomega.HaveLen(tt.wantDelivered))
+		})
+	}
+}
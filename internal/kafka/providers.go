@@ -41,6 +41,7 @@ func ConfigProviders() di.Option {
 		// Configuration for the Kafka service...
 		di.Provide(config.NewAWSConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(config.NewGCPConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
+		di.Provide(config.NewEKSConfig, di.As(new(environments2.ConfigModule))),
 
 		di.Provide(config.NewSupportedProvidersConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
 		di.Provide(observatoriumClient.NewObservabilityConfigurationConfig, di.As(new(environments2.ConfigModule))),
@@ -69,15 +70,26 @@ func ServiceProviders() di.Option {
 		di.Provide(services.NewClusterPlacementStrategy),
 		di.Provide(services.NewDataPlaneClusterService, di.As(new(services.DataPlaneClusterService))),
 		di.Provide(services.NewDataPlaneKafkaService, di.As(new(services.DataPlaneKafkaService))),
+		di.Provide(services.NewMaintenanceWindowService),
+		di.Provide(services.NewUpgradeCampaignService),
+		di.Provide(services.NewCapacityReservationService),
+		di.Provide(services.NewClusterDrainService),
+		di.Provide(services.NewClusterRotationService),
+		di.Provide(services.NewKafkaEventService),
+		di.Provide(services.NewWebhookService),
 		di.Provide(handlers.NewAuthenticationBuilder),
 		di.Provide(clusters.NewDefaultProviderFactory, di.As(new(clusters.ProviderFactory))),
 		di.Provide(routes.NewRouteLoader),
 		di.Provide(quota.NewDefaultQuotaServiceFactory),
-		di.Provide(cluster_mgrs.NewClusterManager, di.As(new(workers.Worker))),
+		di.Provide(cluster_mgrs.NewClusterManager, di.As(new(workers.Worker)), di.As(new(services.ClusterResourcesReconciler))),
 		di.Provide(cluster_mgrs.NewDynamicScaleUpManager, di.As(new(workers.Worker))),
 		di.Provide(cluster_mgrs.NewCleanupClustersManager, di.As(new(workers.Worker))),
 		di.Provide(cluster_mgrs.NewDeprovisioningClustersManager, di.As(new(workers.Worker))),
 		di.Provide(cluster_mgrs.NewDynamicScaleDownManager, di.As(new(workers.Worker))),
+		di.Provide(cluster_mgrs.NewClusterDrainManager, di.As(new(workers.Worker))),
+		di.Provide(cluster_mgrs.NewClusterRotationManager, di.As(new(workers.Worker))),
+		di.Provide(cluster_mgrs.NewClusterHealthManager, di.As(new(workers.Worker))),
+		di.Provide(cluster_mgrs.NewCapacitySimulator, di.As(new(services.ClusterCapacitySimulator))),
 		di.Provide(kafka_mgrs.NewKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewAcceptedKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewPreparingKafkaManager, di.As(new(workers.Worker))),
@@ -85,6 +97,10 @@ func ServiceProviders() di.Option {
 		di.Provide(kafka_mgrs.NewProvisioningKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewReadyKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewKafkaCNAMEManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewMaintenanceWindowUpgradeManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewUpgradeCampaignManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewWebhookDeliveryManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewKafkaExpirationNotificationManager, di.As(new(workers.Worker))),
 		di.Provide(acl.NewEnterpriseClusterRegistrationAccessListMiddleware),
 	)
 }
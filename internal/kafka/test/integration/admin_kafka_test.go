@@ -295,7 +295,7 @@ func TestAdminKafka_Delete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := tt.args.ctx(h)
 			client := test.NewAdminPrivateAPIClient(h)
-			_, resp, err := client.DefaultApi.DeleteKafkaById(ctx, kafkaId, true)
+			_, resp, err := client.DefaultApi.DeleteKafkaById(ctx, kafkaId, true, nil)
 			if resp != nil {
 				resp.Body.Close()
 			}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 
@@ -104,6 +105,9 @@ const (
 	ClusterWaitingForKasFleetShardOperator ClusterStatus = "waiting_for_kas_fleetshard_operator"
 	// ClusterFull the cluster is full and cannot accept more Kafka clusters
 	ClusterFull ClusterStatus = "full"
+	// ClusterQuarantined the cluster stopped reporting its status or repeatedly reported failures. It does not accept
+	// Kafka instances until it recovers
+	ClusterQuarantined ClusterStatus = "quarantined"
 
 	ClusterProviderOCM        ClusterProviderType = "ocm"
 	ClusterProviderAwsEKS     ClusterProviderType = "aws_eks"
@@ -123,6 +127,7 @@ var ordinals = map[string]int{
 	ClusterProvisioned.String():                     20,
 	ClusterWaitingForKasFleetShardOperator.String(): 30,
 	ClusterReady.String():                           40,
+	ClusterQuarantined.String():                     45,
 	ClusterDeprovisioning.String():                  50,
 	ClusterCleanup.String():                         60,
 	ClusterFailed.String():                          70,
@@ -176,6 +181,39 @@ type Cluster struct {
 	// for now used only for enterprise OSD clusters
 	ClusterType    string `json:"cluster_type"`
 	OrganizationID string `json:"organization_id"`
+
+	// Unschedulable is set when the cluster is cordoned or being drained. Kafkas are no longer placed on unschedulable clusters
+	Unschedulable bool `json:"unschedulable"`
+
+	// Labels holds the labels of the cluster as a JSON object. Kafkas matching the labels of a cluster are preferably
+	// placed on it by the weighted placement strategy
+	Labels JSON `json:"labels"`
+	// Taints holds the taints of the cluster as a JSON array. Only kafkas tolerating all the taints of a cluster can be
+	// placed on it by the weighted placement strategy. See ClusterTaint for the format of the JSON stored.
+	Taints JSON `json:"taints"`
+
+	// StatusReportedAt is the time of the last status report of the kas fleetshard operator of the cluster
+	StatusReportedAt *time.Time `json:"status_reported_at"`
+	// FailedStatusReports is the number of consecutive status reports with the kas fleetshard operator not ready
+	FailedStatusReports int `json:"failed_status_reports"`
+	// HealthyStatusReports is the number of consecutive status reports with the kas fleetshard operator ready
+	HealthyStatusReports int `json:"healthy_status_reports"`
+	// QuarantineReason is why the cluster is quarantined. It is empty when the cluster is not quarantined
+	QuarantineReason string `json:"quarantine_reason"`
+	// StatusBeforeQuarantine is the status the cluster is restored to when released from quarantine. It is empty when
+	// the cluster is not quarantined
+	StatusBeforeQuarantine ClusterStatus `json:"status_before_quarantine"`
+}
+
+// ClusterTaint repels from a data plane cluster the kafkas whose placement attribute named Key does not have the
+// given Value, e.g. a cluster with the taint 'organisation_id=123' is dedicated to the kafkas of organisation '123'
+type ClusterTaint struct {
+	Key   string `json:"key" yaml:"key"`
+	Value string `json:"value" yaml:"value"`
+}
+
+func (t ClusterTaint) String() string {
+	return fmt.Sprintf("%s=%s", t.Key, t.Value)
 }
 
 type ClusterList []*Cluster
@@ -510,6 +548,60 @@ func (cluster *Cluster) RetrieveDynamicCapacityInfo() map[string]DynamicCapacity
 	return dynamicCapacityInfo
 }
 
+// RetrieveLabels returns the labels of the cluster
+func (cluster *Cluster) RetrieveLabels() map[string]string {
+	labels := map[string]string{}
+	if cluster.Labels != nil {
+		// only log error returned by Unmarshal as the json stored in the cluster object should always be a valid json object.
+		if err := json.Unmarshal(cluster.Labels, &labels); err != nil {
+			glog.Errorf("Failed to retrieve labels of cluster %q: %s", cluster.ClusterID, err.Error())
+		}
+	}
+
+	return labels
+}
+
+// SetLabels sets the labels of the cluster into a json object that can be persisted in the database
+func (cluster *Cluster) SetLabels(labels map[string]string) error {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	marshalledLabels, err := json.Marshal(labels)
+	if err != nil {
+		return err
+	}
+
+	cluster.Labels = marshalledLabels
+	return nil
+}
+
+// RetrieveTaints returns the taints of the cluster
+func (cluster *Cluster) RetrieveTaints() []ClusterTaint {
+	taints := []ClusterTaint{}
+	if cluster.Taints != nil {
+		// only log error returned by Unmarshal as the json stored in the cluster object should always be a valid json array.
+		if err := json.Unmarshal(cluster.Taints, &taints); err != nil {
+			glog.Errorf("Failed to retrieve taints of cluster %q: %s", cluster.ClusterID, err.Error())
+		}
+	}
+
+	return taints
+}
+
+// SetTaints sets the taints of the cluster into a json array that can be persisted in the database
+func (cluster *Cluster) SetTaints(taints []ClusterTaint) error {
+	if taints == nil {
+		taints = []ClusterTaint{}
+	}
+	marshalledTaints, err := json.Marshal(taints)
+	if err != nil {
+		return err
+	}
+
+	cluster.Taints = marshalledTaints
+	return nil
+}
+
 // GetSupportedInstanceTypes returns a list of the supported instance types for
 // the cluster. If there are no supported instance types the result is
 // an empty list
@@ -1131,6 +1131,78 @@ func Test_Cluster_RetrieveDynamicCapacityInfo(t *testing.T) {
 	}
 }
 
+func Test_Cluster_RetrieveLabels(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster *Cluster
+		want    map[string]string
+	}{
+		{
+			name: "returns an empty map when json object is nil",
+			want: map[string]string{},
+			cluster: &Cluster{
+				Labels: nil,
+			},
+		},
+		{
+			name: "retrieves the labels from the given marshalled value",
+			want: map[string]string{"organisation_id": "123"},
+			cluster: &Cluster{
+				Labels: JSON([]byte(`{"organisation_id":"123"}`)),
+			},
+		},
+		{
+			name: "returns an empty map when json object is invalid",
+			want: map[string]string{},
+			cluster: &Cluster{
+				Labels: JSON([]byte(`["organisation_id"]`)),
+			},
+		},
+	}
+
+	g := gomega.NewWithT(t)
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(test *testing.T) {
+			test.Parallel()
+			g.Expect(tt.cluster.RetrieveLabels()).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_Cluster_SetTaints(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  []ClusterTaint
+		want JSON
+	}{
+		{
+			name: "sets the taints to an empty array when nil",
+			arg:  nil,
+			want: JSON([]byte("[]")),
+		},
+		{
+			name: "sets the taints to the given marshalled value",
+			arg:  []ClusterTaint{{Key: "organisation_id", Value: "123"}},
+			want: JSON([]byte(`[{"key":"organisation_id","value":"123"}]`)),
+		},
+	}
+
+	g := gomega.NewWithT(t)
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(test *testing.T) {
+			test.Parallel()
+			cluster := &Cluster{}
+			g.Expect(cluster.SetTaints(tt.arg)).To(gomega.Succeed())
+			g.Expect(cluster.Taints).To(gomega.Equal(tt.want))
+			g.Expect(cluster.RetrieveTaints()).To(gomega.HaveLen(len(tt.arg)))
+		})
+	}
+}
+
 func Test_Cluster_GetSupportedInstanceTypes(t *testing.T) {
 	tests := []struct {
 		name    string
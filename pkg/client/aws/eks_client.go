@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"encoding/base64"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	awscredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	errors "github.com/pkg/errors"
+)
+
+const (
+	// eksClusterIDHeader is the header used by the aws-iam-authenticator to bind a token to an EKS cluster
+	eksClusterIDHeader = "x-k8s-aws-id"
+	// eksTokenPrefix is the prefix of the bearer tokens accepted by the EKS API servers
+	eksTokenPrefix = "k8s-aws-v1."
+	// eksTokenPresignDuration is how long the pre-signed STS request backing an EKS token is valid for.
+	// The EKS API servers only accept tokens for 15 minutes regardless of this value.
+	eksTokenPresignDuration = 60 * time.Second
+)
+
+//go:generate moq -out eks_client_moq.go . EKSClient
+type EKSClient interface {
+	CreateCluster(input *eks.CreateClusterInput) (*eks.Cluster, error)
+	DescribeCluster(clusterName string) (*eks.Cluster, error)
+	DeleteCluster(clusterName string) error
+	CreateNodegroup(input *eks.CreateNodegroupInput) (*eks.Nodegroup, error)
+	DescribeNodegroup(clusterName string, nodegroupName string) (*eks.Nodegroup, error)
+	ListNodegroups(clusterName string) ([]string, error)
+	DeleteNodegroup(clusterName string, nodegroupName string) error
+	AssociateIdentityProviderConfig(input *eks.AssociateIdentityProviderConfigInput) error
+	// GetClusterToken returns a bearer token that can be used to authenticate against the API server of the given cluster
+	GetClusterToken(clusterName string) (string, error)
+}
+
+type EKSClientFactory interface {
+	NewEKSClient(credentials Config, region string) (EKSClient, error)
+}
+
+type DefaultEKSClientFactory struct{}
+
+func (f *DefaultEKSClientFactory) NewEKSClient(credentials Config, region string) (EKSClient, error) {
+	return newEKSClient(credentials, region)
+}
+
+func NewDefaultEKSClientFactory() *DefaultEKSClientFactory {
+	return &DefaultEKSClientFactory{}
+}
+
+type MockEKSClientFactory struct {
+	mock EKSClient
+}
+
+func (m *MockEKSClientFactory) NewEKSClient(credentials Config, region string) (EKSClient, error) {
+	return m.mock, nil
+}
+
+func NewMockEKSClientFactory(client EKSClient) *MockEKSClientFactory {
+	return &MockEKSClientFactory{
+		mock: client,
+	}
+}
+
+var _ EKSClient = &eksCl{}
+
+type eksCl struct {
+	eksClient eksiface.EKSAPI
+	stsClient stsiface.STSAPI
+}
+
+func newEKSClient(credentials Config, region string) (EKSClient, error) {
+	cfg := &aws.Config{
+		Credentials: awscredentials.NewStaticCredentials(
+			credentials.AccessKeyID,
+			credentials.SecretAccessKey,
+			""),
+		Region:  aws.String(region),
+		Retryer: client.DefaultRetryer{NumMaxRetries: 2},
+	}
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &eksCl{
+		eksClient: eks.New(sess),
+		stsClient: sts.New(sess),
+	}, nil
+}
+
+func (client *eksCl) CreateCluster(input *eks.CreateClusterInput) (*eks.Cluster, error) {
+	output, err := client.eksClient.CreateCluster(input)
+	if err != nil {
+		return nil, wrapAWSError(err, "Failed to create EKS cluster.")
+	}
+	return output.Cluster, nil
+}
+
+func (client *eksCl) DescribeCluster(clusterName string) (*eks.Cluster, error) {
+	output, err := client.eksClient.DescribeCluster(&eks.DescribeClusterInput{
+		Name: &clusterName,
+	})
+	if err != nil {
+		return nil, wrapAWSError(err, "Failed to describe EKS cluster.")
+	}
+	return output.Cluster, nil
+}
+
+func (client *eksCl) DeleteCluster(clusterName string) error {
+	_, err := client.eksClient.DeleteCluster(&eks.DeleteClusterInput{
+		Name: &clusterName,
+	})
+	if err != nil {
+		return wrapAWSError(err, "Failed to delete EKS cluster.")
+	}
+	return nil
+}
+
+func (client *eksCl) CreateNodegroup(input *eks.CreateNodegroupInput) (*eks.Nodegroup, error) {
+	output, err := client.eksClient.CreateNodegroup(input)
+	if err != nil {
+		return nil, wrapAWSError(err, "Failed to create EKS node group.")
+	}
+	return output.Nodegroup, nil
+}
+
+func (client *eksCl) DescribeNodegroup(clusterName string, nodegroupName string) (*eks.Nodegroup, error) {
+	output, err := client.eksClient.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   &clusterName,
+		NodegroupName: &nodegroupName,
+	})
+	if err != nil {
+		return nil, wrapAWSError(err, "Failed to describe EKS node group.")
+	}
+	return output.Nodegroup, nil
+}
+
+func (client *eksCl) ListNodegroups(clusterName string) ([]string, error) {
+	var nodegroups []string
+	err := client.eksClient.ListNodegroupsPages(&eks.ListNodegroupsInput{
+		ClusterName: &clusterName,
+	}, func(page *eks.ListNodegroupsOutput, lastPage bool) bool {
+		nodegroups = append(nodegroups, aws.StringValueSlice(page.Nodegroups)...)
+		return true
+	})
+	if err != nil {
+		return nil, wrapAWSError(err, "Failed to list EKS node groups.")
+	}
+	return nodegroups, nil
+}
+
+func (client *eksCl) DeleteNodegroup(clusterName string, nodegroupName string) error {
+	_, err := client.eksClient.DeleteNodegroup(&eks.DeleteNodegroupInput{
+		ClusterName:   &clusterName,
+		NodegroupName: &nodegroupName,
+	})
+	if err != nil {
+		return wrapAWSError(err, "Failed to delete EKS node group.")
+	}
+	return nil
+}
+
+func (client *eksCl) AssociateIdentityProviderConfig(input *eks.AssociateIdentityProviderConfigInput) error {
+	_, err := client.eksClient.AssociateIdentityProviderConfig(input)
+	if err != nil {
+		return wrapAWSError(err, "Failed to associate identity provider config to EKS cluster.")
+	}
+	return nil
+}
+
+// GetClusterToken builds a token in the same way the aws-iam-authenticator does: a pre-signed STS GetCallerIdentity
+// request bound to the cluster name, which the EKS API server uses to authenticate the caller
+func (client *eksCl) GetClusterToken(clusterName string) (string, error) {
+	request, _ := client.stsClient.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+	request.HTTPRequest.Header.Add(eksClusterIDHeader, clusterName)
+	presignedURL, err := request.Presign(eksTokenPresignDuration)
+	if err != nil {
+		return "", wrapAWSError(err, "Failed to pre-sign EKS token request.")
+	}
+	return eksTokenPrefix + base64.RawURLEncoding.EncodeToString([]byte(presignedURL)), nil
+}
+
+// IsEKSResourceNotFoundError returns true if the given error is returned by the EKS API for a missing resource
+func IsEKSResourceNotFoundError(err error) bool {
+	return hasAWSErrorCode(err, eks.ErrCodeResourceNotFoundException)
+}
+
+// IsEKSResourceInUseError returns true if the given error is returned by the EKS API for an already existing resource
+func IsEKSResourceInUseError(err error) bool {
+	return hasAWSErrorCode(err, eks.ErrCodeResourceInUseException)
+}
+
+func hasAWSErrorCode(err error, code string) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == code
+	}
+	return false
+}
@@ -208,6 +208,14 @@ const (
 	ErrorInvalidDnsName       ServiceErrorCode = 47
 	ErrorInvalidDnsNameReason string           = "Dns name is invalid"
 
+	// Kafka instance cannot be deleted as it is protected against deletion
+	ErrorKafkaDeletionProtected       ServiceErrorCode = 48
+	ErrorKafkaDeletionProtectedReason string           = "Kafka instance is protected against deletion"
+
+	// Kafka instance cannot be resized as its data plane cluster cannot accommodate the new size
+	ErrorKafkaClusterCapacityReached       ServiceErrorCode = 49
+	ErrorKafkaClusterCapacityReachedReason string           = "The data plane cluster of the Kafka instance cannot accommodate the requested size"
+
 	// Too Many requests error. Used by rate limiting
 	ErrorTooManyRequests       ServiceErrorCode = 429
 	ErrorTooManyRequestsReason string           = "Too many requests"
@@ -319,6 +327,8 @@ func Errors() ServiceErrors {
 		ServiceError{ErrorInvalidClusterId, ErrorInvalidClusterIdReason, http.StatusBadRequest, nil},
 		ServiceError{ErrorInvalidExternalClusterId, ErrorInvalidExternalClusterIdReason, http.StatusBadRequest, nil},
 		ServiceError{ErrorInvalidDnsName, ErrorInvalidDnsNameReason, http.StatusBadRequest, nil},
+		ServiceError{ErrorKafkaDeletionProtected, ErrorKafkaDeletionProtectedReason, http.StatusConflict, nil},
+		ServiceError{ErrorKafkaClusterCapacityReached, ErrorKafkaClusterCapacityReachedReason, http.StatusConflict, nil},
 	}
 }
 
@@ -678,6 +688,14 @@ func InvalidDnsName(reason string, values ...interface{}) *ServiceError {
 	return New(ErrorInvalidDnsName, reason, values...)
 }
 
+func KafkaDeletionProtected(reason string, values ...interface{}) *ServiceError {
+	return New(ErrorKafkaDeletionProtected, reason, values...)
+}
+
+func KafkaClusterCapacityReached(reason string, values ...interface{}) *ServiceError {
+	return New(ErrorKafkaClusterCapacityReached, reason, values...)
+}
+
 func DuplicateKafkaClusterName() *ServiceError {
 	return New(ErrorDuplicateKafkaClusterName, ErrorDuplicateKafkaClusterNameReason)
 }
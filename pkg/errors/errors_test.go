@@ -1024,6 +1024,34 @@ func Test_TooManyKafkaInstancesReached(t *testing.T) {
 	}
 }
 
+func Test_KafkaClusterCapacityReached(t *testing.T) {
+	type args struct {
+		reason string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *ServiceError
+	}{
+		{
+			name: "should return new ErrorKafkaClusterCapacityReached error",
+			args: args{
+				reason: "the data plane cluster of the kafka instance cannot accommodate the requested size",
+			},
+			want: New(ErrorKafkaClusterCapacityReached, "the data plane cluster of the kafka instance cannot accommodate the requested size"),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			g.Expect(KafkaClusterCapacityReached(tt.args.reason)).To(gomega.MatchError(tt.want))
+		})
+	}
+}
+
 func Test_Validation(t *testing.T) {
 	type args struct {
 		reason string
@@ -36,6 +36,10 @@ const (
 	KafkaRequestsStatusCount        = "kafka_requests_status_count"
 	KafkaRequestsCurrentStatusInfo  = "kafka_requests_current_status_info"
 
+	// KafkaExpirationNotificationsCount - name of the metric for the notifications sent before the expiration of Kafkas
+	KafkaExpirationNotificationsCount = "kafka_expiration_notifications_count"
+	labelThreshold                    = "threshold"
+
 	// ClusterOperationsSuccessCount - name of the metric for cluster-related successful operations
 	ClusterOperationsSuccessCount = "cluster_operations_success_count"
 	// ClusterOperationsTotalCount - name of the metric for all cluster-related operations
@@ -53,6 +57,13 @@ const (
 
 	KafkaPerClusterCount = "kafka_per_cluster_count"
 
+	// ClusterHealthHeartbeatAge - metric name for the time elapsed since the last status report of a data plane cluster
+	ClusterHealthHeartbeatAge = "cluster_health_heartbeat_age_in_seconds"
+	// ClusterHealthFailedStatusReports - metric name for the number of consecutive failing status reports of a data plane cluster
+	ClusterHealthFailedStatusReports = "cluster_health_failed_status_reports"
+	// ClusterHealthy - metric name for the health of a data plane cluster. 1 when healthy, 0 otherwise
+	ClusterHealthy = "cluster_healthy"
+
 	LeaderWorker = "leader_worker"
 
 	// ObservatoriumRequestCount - metric name for the number of observatorium requests sent
@@ -141,6 +152,11 @@ var KafkaOperationsCountMetricsLabels = []string{
 	labelOperation,
 }
 
+// kafkaExpirationNotificationsCountMetricLabels - is the slice of labels to add to the Kafka expiration notifications count metric
+var kafkaExpirationNotificationsCountMetricLabels = []string{
+	labelThreshold,
+}
+
 var KafkaPerClusterCountMetricsLabels = []string{
 	LabelClusterID,
 	LabelClusterExternalID,
@@ -161,6 +177,10 @@ var ClusterStatusCountMetricsLabels = []string{
 	LabelStatus,
 }
 
+var clusterHealthMetricsLabels = []string{
+	LabelClusterID,
+}
+
 var ReconcilerMetricsLabels = []string{
 	labelWorkerType,
 }
@@ -371,6 +391,55 @@ func UpdateKafkaPerClusterCountMetric(clusterId string, clusterExternalID string
 	kafkaPerClusterCountMetric.With(labels).Set(float64(count))
 }
 
+var clusterHealthHeartbeatAgeMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: KasFleetManager,
+		Name:      ClusterHealthHeartbeatAge,
+		Help:      "the time elapsed since the last status report of a data plane cluster",
+	},
+	clusterHealthMetricsLabels,
+)
+
+var clusterHealthFailedStatusReportsMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: KasFleetManager,
+		Name:      ClusterHealthFailedStatusReports,
+		Help:      "the number of consecutive status reports of a data plane cluster with a kas fleetshard operator that is not ready",
+	},
+	clusterHealthMetricsLabels,
+)
+
+var clusterHealthyMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: KasFleetManager,
+		Name:      ClusterHealthy,
+		Help:      "the health of a data plane cluster. 1 when healthy, 0 otherwise",
+	},
+	clusterHealthMetricsLabels,
+)
+
+// UpdateClusterHealthMetrics - records the heartbeat age, the failed status reports and the health of a data plane cluster
+func UpdateClusterHealthMetrics(clusterId string, heartbeatAge time.Duration, failedStatusReports int, healthy bool) {
+	labels := prometheus.Labels{
+		LabelClusterID: clusterId,
+	}
+	clusterHealthHeartbeatAgeMetric.With(labels).Set(heartbeatAge.Seconds())
+	clusterHealthFailedStatusReportsMetric.With(labels).Set(float64(failedStatusReports))
+	healthyValue := 0.0
+	if healthy {
+		healthyValue = 1.0
+	}
+	clusterHealthyMetric.With(labels).Set(healthyValue)
+}
+
+// ResetMetricsForClusterHealthManager will reset the metrics for the ClusterHealthManager background reconciler
+// This is needed because if current process is not the leader anymore, the metrics need to be reset otherwise staled data will be scraped
+func ResetMetricsForClusterHealthManager() {
+	clusterHealthHeartbeatAgeMetric.Reset()
+	clusterHealthFailedStatusReportsMetric.Reset()
+	clusterHealthyMetric.Reset()
+}
+
 // create a new gaugeVec metric to record the current number of consumed cluster resource quota by the cluster provider account used by the service
 var clusterProviderResourceQuotaConsumedMetric = prometheus.NewGaugeVec(
 	prometheus.GaugeOpts{
@@ -556,6 +625,24 @@ func IncreaseKafkaTotalOperationsCountMetric(operation constants.KafkaOperation)
 	kafkaOperationsTotalCountMetric.With(labels).Inc()
 }
 
+// create a new counterVec for the notifications sent before the expiration of Kafkas
+var kafkaExpirationNotificationsCountMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: KasFleetManager,
+		Name:      KafkaExpirationNotificationsCount,
+		Help:      "number of notifications sent before the expiration of kafkas, by threshold before the expiration",
+	},
+	kafkaExpirationNotificationsCountMetricLabels,
+)
+
+// IncreaseKafkaExpirationNotificationsCountMetric - increase counter for the kafkaExpirationNotificationsCountMetric
+func IncreaseKafkaExpirationNotificationsCountMetric(threshold time.Duration) {
+	labels := prometheus.Labels{
+		labelThreshold: threshold.String(),
+	}
+	kafkaExpirationNotificationsCountMetric.With(labels).Inc()
+}
+
 // #### Metrics for Kafkas - End ####
 
 // #### Metrics for Reconcilers - Start ####
@@ -824,6 +911,9 @@ func init() {
 	prometheus.MustRegister(clusterProviderResourceQuotaConsumedMetric)
 	prometheus.MustRegister(prewarmingStatusInfoCountMetric)
 	prometheus.MustRegister(clusterProviderResourceQuotaMaxAllowedMetric)
+	prometheus.MustRegister(clusterHealthHeartbeatAgeMetric)
+	prometheus.MustRegister(clusterHealthFailedStatusReportsMetric)
+	prometheus.MustRegister(clusterHealthyMetric)
 
 	// metrics for Kafkas
 	prometheus.MustRegister(requestKafkaCreationDurationMetric)
@@ -832,6 +922,7 @@ func init() {
 	prometheus.MustRegister(kafkaStatusSinceCreatedMetric)
 	prometheus.MustRegister(kafkaRequestsCurrentStatusInfoMetric)
 	prometheus.MustRegister(KafkaStatusCountMetric)
+	prometheus.MustRegister(kafkaExpirationNotificationsCountMetric)
 
 	// metrics for reconcilers
 	prometheus.MustRegister(reconcilerDurationMetric)
@@ -899,12 +990,14 @@ func Reset() {
 	clusterStatusCapacityAvailableMetric.Reset()
 	clusterProviderResourceQuotaConsumedMetric.Reset()
 	clusterProviderResourceQuotaMaxAllowedMetric.Reset()
+	ResetMetricsForClusterHealthManager()
 
 	requestKafkaCreationDurationMetric.Reset()
 	kafkaOperationsSuccessCountMetric.Reset()
 	kafkaOperationsTotalCountMetric.Reset()
 	kafkaStatusSinceCreatedMetric.Reset()
 	KafkaStatusCountMetric.Reset()
+	kafkaExpirationNotificationsCountMetric.Reset()
 
 	reconcilerDurationMetric.Reset()
 	reconcilerSuccessCountMetric.Reset()
@@ -79,6 +79,7 @@ func ServiceProviders() di.Option {
 		}),
 
 		di.Provide(aws.NewDefaultClientFactory, di.As(new(aws.ClientFactory))),
+		di.Provide(aws.NewDefaultEKSClientFactory, di.As(new(aws.EKSClientFactory))),
 
 		di.Provide(acl.NewAccessControlListMiddleware),
 		di.Provide(handlers.NewErrorsHandler),
@@ -38,10 +38,27 @@ const MaximumComplexity = 10
 type checkUnbalancedBraces func() error
 
 type DBQuery struct {
-	Query        string
-	Values       []interface{}
-	ValidColumns []string
-	ColumnPrefix string
+	Query           string
+	Values          []interface{}
+	ValidColumns    []string
+	ColumnPrefix    string
+	KeyValueColumns []KeyValueColumn
+}
+
+// KeyValueColumn - a column whose values are key/value pairs stored in a separate table (i.e. labels). It is searched
+// with `<Name>.<key> <op> <value>` and translated into the Condition. The Condition must contain a `%s` verb, replaced
+// by the comparison operator, and two placeholders: the first one is bound to the key and the second one to the value.
+// For example: `id IN (SELECT kafka_id FROM kafka_labels WHERE key = ? AND value %s ?)`
+type KeyValueColumn struct {
+	Name      string
+	Condition string
+}
+
+// keyValueCondition - the key/value column condition being parsed
+type keyValueCondition struct {
+	column *KeyValueColumn
+	key    string
+	op     string
 }
 
 // QueryParser - This object is to be used to parse and validate WHERE clauses (only portion after the `WHERE` is supported)
@@ -60,7 +77,7 @@ var _ QueryParser = &queryParser{}
 // Tokens:
 // OPEN_BRACE       = (
 // CLOSED_BRACE     = )
-// COLUMN -         = [A-Za-z][A-Za-z0-9_]*
+// COLUMN -         = [A-Za-z][A-Za-z0-9_]*(\.[A-Za-z0-9_.\-]+)?  (the optional suffix is the key of a key/value column)
 // VALUE            = [^ ^(^)]+
 // QUOTED_VALUE     = `'([^']|\\')*'`
 // EQ               = =
@@ -105,6 +122,9 @@ func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedB
 		return nil
 	}
 
+	// the key/value column condition being parsed, if any. The condition is written once its value is known
+	var pendingKeyValueCondition *keyValueCondition
+
 	onNewToken := func(token *state_machine.ParsedToken) error {
 		switch token.Family {
 		case braceTokenFamily:
@@ -114,19 +134,36 @@ func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedB
 			p.dbqry.Query += token.Value
 			return nil
 		case valueTokenFamily:
+			if pendingKeyValueCondition != nil {
+				p.addKeyValueCondition(pendingKeyValueCondition, token.Value)
+				pendingKeyValueCondition = nil
+				return nil
+			}
 			p.dbqry.Query += " ?"
 			p.dbqry.Values = append(p.dbqry.Values, token.Value)
 			return nil
 		case quotedValueTokenFamily:
-			p.dbqry.Query += " ?"
 			// unescape
 			tmp := strings.ReplaceAll(token.Value, `\'`, "'")
 			// remove quotes:
 			if len(tmp) > 1 {
 				tmp = string([]rune(tmp)[1 : len(tmp)-1])
 			}
+			if pendingKeyValueCondition != nil {
+				p.addKeyValueCondition(pendingKeyValueCondition, tmp)
+				pendingKeyValueCondition = nil
+				return nil
+			}
+			p.dbqry.Query += " ?"
 			p.dbqry.Values = append(p.dbqry.Values, tmp)
 			return nil
+		case opTokenFamily:
+			if pendingKeyValueCondition != nil {
+				pendingKeyValueCondition.op = token.Value
+				return nil
+			}
+			p.dbqry.Query += " " + token.Value
+			return nil
 		case logicalOpTokenFamily:
 			complexity++
 			if complexity > MaximumComplexity {
@@ -135,6 +172,10 @@ func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedB
 			p.dbqry.Query += " " + token.Value + " "
 			return nil
 		case columnTokenFamily:
+			if keyValueColumn, key, ok := p.findKeyValueColumn(token.Value); ok {
+				pendingKeyValueCondition = &keyValueCondition{column: keyValueColumn, key: key}
+				return nil
+			}
 			// we want column names to be lowercase
 			columnName := strings.ToLower(token.Value)
 			if !contains(p.dbqry.ValidColumns, columnName) {
@@ -155,7 +196,7 @@ func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedB
 		Tokens: []state_machine.TokenDefinition{
 			{Name: openBrace, Family: braceTokenFamily, AcceptPattern: `\(`},
 			{Name: closedBrace, Family: braceTokenFamily, AcceptPattern: `\)`},
-			{Name: column, Family: columnTokenFamily, AcceptPattern: `[A-Za-z][A-Za-z0-9_]*`},
+			{Name: column, Family: columnTokenFamily, AcceptPattern: `[A-Za-z][A-Za-z0-9_]*(\.[A-Za-z0-9_.\-]+)?`},
 			{Name: value, Family: valueTokenFamily, AcceptPattern: `[^'][^ ^(^)]*`},
 			{Name: quotedValue, Family: quotedValueTokenFamily, AcceptPattern: `'([^']|\\')*'`},
 			{Name: eq, Family: opTokenFamily, AcceptPattern: `=`},
@@ -195,6 +236,25 @@ func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedB
 	}
 }
 
+// findKeyValueColumn returns the key/value column and the key referenced by the given column token, if any
+func (p *queryParser) findKeyValueColumn(token string) (*KeyValueColumn, string, bool) {
+	name, key, found := strings.Cut(token, ".")
+	if !found || key == "" {
+		return nil, "", false
+	}
+	for i := range p.dbqry.KeyValueColumns {
+		if p.dbqry.KeyValueColumns[i].Name == strings.ToLower(name) {
+			return &p.dbqry.KeyValueColumns[i], key, true
+		}
+	}
+	return nil, "", false
+}
+
+func (p *queryParser) addKeyValueCondition(condition *keyValueCondition, value string) {
+	p.dbqry.Query += fmt.Sprintf(condition.column.Condition, condition.op)
+	p.dbqry.Values = append(p.dbqry.Values, condition.key, value)
+}
+
 func (p *queryParser) Parse(sql string) (*DBQuery, error) {
 	state, checkBalancedBraces := p.initStateMachine()
 
@@ -234,3 +294,15 @@ func NewQueryParserWithColumnPrefix(columnsPrefix string, columns ...string) Que
 	query.ColumnPrefix = columnsPrefix
 	return &queryParser{dbqry: query}
 }
+
+// NewQueryParserWithKeyValueColumns - returns a query parser that also accepts the given key/value columns in the search
+func NewQueryParserWithKeyValueColumns(keyValueColumns []KeyValueColumn, columns ...string) QueryParser {
+	query := DBQuery{}
+	if len(columns) == 0 {
+		query.ValidColumns = validColumns
+	} else {
+		query.ValidColumns = columns
+	}
+	query.KeyValueColumns = keyValueColumns
+	return &queryParser{dbqry: query}
+}
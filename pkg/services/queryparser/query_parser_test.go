@@ -146,6 +146,26 @@ func Test_QueryParser(t *testing.T) {
 			outValues: []interface{}{"Value", "value1", "value2", "b", "c", "e", "%test%"},
 			wantErr:   false,
 		},
+		{
+			name:      "Parse with key/value columns",
+			qry:       "(labels.team = 'payments' or labels.env like prod%) and name = test",
+			qryParser: NewQueryParserWithKeyValueColumns([]KeyValueColumn{{Name: "labels", Condition: "id IN (SELECT kafka_id FROM kafka_labels WHERE key = ? AND value %s ?)"}}),
+			outQry:    "(id IN (SELECT kafka_id FROM kafka_labels WHERE key = ? AND value = ?) or id IN (SELECT kafka_id FROM kafka_labels WHERE key = ? AND value like ?)) and name = ?",
+			outValues: []interface{}{"team", "payments", "env", "prod%", "test"},
+			wantErr:   false,
+		},
+		{
+			name:      "Key/value column without a key",
+			qry:       "labels. = payments",
+			qryParser: NewQueryParserWithKeyValueColumns([]KeyValueColumn{{Name: "labels", Condition: "id IN (SELECT kafka_id FROM kafka_labels WHERE key = ? AND value %s ?)"}}),
+			wantErr:   true,
+		},
+		{
+			name:      "Key/value column not accepted by the parser",
+			qry:       "labels.team = payments",
+			qryParser: NewQueryParser(),
+			wantErr:   true,
+		},
 	}
 
 	for _, testcase := range tests {